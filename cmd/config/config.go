@@ -40,6 +40,27 @@ type Config struct {
 	JWTSecret     string
 	JWTExpiresIn  int
 	CORSOrigins   string
+
+	// Alertas de estoque
+	AlertasWebhookURL     string
+	AlertasDiasVencimento int
+
+	// Disparo de campanhas
+	SMTPHost              string
+	SMTPPort              string
+	SMTPUser              string
+	SMTPPassword          string
+	SMTPFrom              string
+	WhatsAppAPIURL        string
+	WhatsAppAPIToken      string
+	SMSAPIURL             string
+	SMSAPIToken           string
+	DisparoFake           bool
+	DisparoLimiteEmail    int
+	DisparoLimiteWhatsApp int
+	DisparoLimiteSMS      int
+	RastreioBaseURL       string
+	RastreioSegredo       string
 }
 
 func NewConfig() *Config {
@@ -54,6 +75,14 @@ func getEnvWithDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvIntWithDefault retorna o valor inteiro da variável de ambiente ou um valor padrão
+func getEnvIntWithDefault(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 // getEnvOrFail retorna o valor da variável de ambiente ou falha a aplicação
 func getEnvOrFail(key string) string {
 	value, exists := os.LookupEnv(key)
@@ -89,6 +118,31 @@ func init() {
 		Cfg.JWTExpiresIn = 30 // valor padrão
 	}
 
+	Cfg.AlertasWebhookURL = os.Getenv("ALERTAS_WEBHOOK_URL")
+	Cfg.AlertasDiasVencimento = getEnvIntWithDefault("ALERTAS_DIAS_VENCIMENTO", 30)
+
+	Cfg.SMTPHost = os.Getenv("SMTP_HOST")
+	Cfg.SMTPPort = getEnvWithDefault("SMTP_PORT", "587")
+	Cfg.SMTPUser = os.Getenv("SMTP_USER")
+	Cfg.SMTPPassword = os.Getenv("SMTP_PASSWORD")
+	Cfg.SMTPFrom = os.Getenv("SMTP_FROM")
+	Cfg.WhatsAppAPIURL = os.Getenv("WHATSAPP_API_URL")
+	Cfg.WhatsAppAPIToken = os.Getenv("WHATSAPP_API_TOKEN")
+	Cfg.SMSAPIURL = os.Getenv("SMS_API_URL")
+	Cfg.SMSAPIToken = os.Getenv("SMS_API_TOKEN")
+	Cfg.DisparoFake, _ = strconv.ParseBool(os.Getenv("DISPARO_FAKE"))
+	Cfg.DisparoLimiteEmail = getEnvIntWithDefault("DISPARO_LIMITE_EMAIL", 60)
+	Cfg.DisparoLimiteWhatsApp = getEnvIntWithDefault("DISPARO_LIMITE_WHATSAPP", 30)
+	Cfg.DisparoLimiteSMS = getEnvIntWithDefault("DISPARO_LIMITE_SMS", 30)
+	Cfg.RastreioBaseURL = os.Getenv("RASTREIO_BASE_URL")
+	// Com rastreio, os links públicos são assinados com uma chave própria, que não pode ser a do JWT
+	if Cfg.RastreioBaseURL != "" {
+		Cfg.RastreioSegredo = getEnvOrFail("RASTREIO_SEGREDO")
+		if Cfg.RastreioSegredo == Cfg.JWTSecret {
+			log.Fatalf("❌ RASTREIO_SEGREDO must be different from JWT_SECRET")
+		}
+	}
+
 	rng := rand.Reader
 	PrivateKey, err = rsa.GenerateKey(rng, 2048)
 	if err != nil {
@@ -234,6 +234,21 @@ func TestNewForbiddenError(t *testing.T) {
 	assert.Nil(t, err.Causes)
 }
 
+func TestNewConflictError(t *testing.T) {
+	// Arrange
+	message := "Resource in conflicting state"
+
+	// Act
+	err := NewConflictError(message)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, message, err.Message)
+	assert.Equal(t, "conflict", err.Err)
+	assert.Equal(t, http.StatusConflict, err.Code)
+	assert.Nil(t, err.Causes)
+}
+
 // =============================================================================
 // TESTES DE EDGE CASES
 // =============================================================================
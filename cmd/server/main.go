@@ -1,6 +1,8 @@
 package main
 
 import (
+	"time"
+
 	"github.com/betine97/back-project.git/cmd/config"
 	"github.com/betine97/back-project.git/src/controller"
 	"github.com/betine97/back-project.git/src/controller/middlewares"
@@ -9,6 +11,8 @@ import (
 	"github.com/betine97/back-project.git/src/model/persistence"
 	"github.com/betine97/back-project.git/src/model/service"
 	"github.com/betine97/back-project.git/src/model/service/crypto"
+	"github.com/betine97/back-project.git/src/model/service/disparo"
+	"github.com/betine97/back-project.git/src/model/service/scheduler"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"go.uber.org/zap"
@@ -36,9 +40,25 @@ func main() {
 	zap.L().Info("✅ Bancos de clientes conectados com sucesso", zap.Int("total", len(clientDB)))
 
 	zap.L().Info("🔧 Inicializando dependências...")
-	userController := initDependencies(dbmaster, clientDB)
+	userController, userService := initDependencies(dbmaster, clientDB)
 	zap.L().Info("✅ Dependências inicializadas com sucesso")
 
+	zap.L().Info("⏰ Iniciando jobs agendados...")
+	jobs := scheduler.NewScheduler()
+	// Com várias réplicas, os jobs exclusivos rodam em uma só a cada execução
+	lock := scheduler.NewRedisLock(config.RedisClient)
+	jobs.Register("alertas_estoque", 24*time.Hour, scheduler.Exclusivo(lock, "alertas_estoque", time.Hour, func() {
+		userService.ExecutarAlertasEstoqueJob(config.NewConfig().AlertasDiasVencimento)
+	}))
+	jobs.Register("precos_agendados", 15*time.Minute, userService.ExecutarPrecosAgendadosJob)
+	jobs.Register("fidelidade", 24*time.Hour, scheduler.Exclusivo(lock, "fidelidade", time.Hour, userService.ExecutarFidelidadeJob))
+	jobs.Register("publicos_dinamicos", time.Hour, userService.ExecutarPublicosDinamicosJob)
+	jobs.Register("disparos", 10*time.Second, userService.ExecutarDisparosJob)
+	jobs.Register("envios_parados", 10*time.Minute, scheduler.Exclusivo(lock, "envios_parados", 10*time.Minute, userService.ExecutarEnviosParadosJob))
+	jobs.Register("campanhas", time.Minute, scheduler.Exclusivo(lock, "campanhas", 10*time.Minute, userService.ExecutarCampanhasJob))
+	jobs.Start()
+	defer jobs.Stop()
+
 	zap.L().Info("🌐 Configurando servidor Fiber...")
 	app := fiber.New(fiber.Config{
 		ErrorHandler: func(ctx *fiber.Ctx, err error) error {
@@ -78,7 +98,7 @@ func main() {
 
 }
 
-func initDependencies(masterDB *gorm.DB, clientDB map[string]*gorm.DB) controller.ControllerInterface {
+func initDependencies(masterDB *gorm.DB, clientDB map[string]*gorm.DB) (controller.ControllerInterface, service.ServiceInterface) {
 	cryptoService := &crypto.Crypto{}
 	persistenceDBMASTER := persistence.NewDBConnectionDBMaster(masterDB)
 	persistenceDBCLIENT := persistence.NewDBConnectionDBClient(clientDB)
@@ -89,6 +109,45 @@ func initDependencies(masterDB *gorm.DB, clientDB map[string]*gorm.DB) controlle
 	// Create token generator
 	tokenGenerator := interfaces.NewJWTTokenGenerator()
 
-	service := service.NewServiceInstance(cryptoService, persistenceDBMASTER, persistenceDBCLIENT, redisWrapper, tokenGenerator)
-	return controller.NewControllerInstance(service)
+	// Notificações de alertas (webhook opcional)
+	notifier := interfaces.NewWebhookNotifier(config.NewConfig().AlertasWebhookURL)
+
+	srv := service.NewServiceInstance(cryptoService, persistenceDBMASTER, persistenceDBCLIENT, redisWrapper, tokenGenerator, notifier, newDisparador())
+	return controller.NewControllerInstance(srv), srv
+}
+
+// newDisparador monta os canais de disparo de campanhas configurados e o rastreio dos envios. Com DISPARO_FAKE as
+// mensagens só são registradas em memória, para desenvolvimento.
+func newDisparador() *disparo.Disparador {
+	cfg := config.NewConfig()
+
+	var canais []disparo.Channel
+	if cfg.DisparoFake {
+		canais = append(canais,
+			disparo.NewFakeChannel(disparo.CanalEmail),
+			disparo.NewFakeChannel(disparo.CanalWhatsApp),
+			disparo.NewFakeChannel(disparo.CanalSMS))
+	} else {
+		if cfg.SMTPHost != "" {
+			canais = append(canais, disparo.NewSMTPChannel(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.SMTPFrom))
+		}
+		if cfg.WhatsAppAPIURL != "" {
+			canais = append(canais, disparo.NewHTTPChannel(disparo.CanalWhatsApp, cfg.WhatsAppAPIURL, cfg.WhatsAppAPIToken))
+		}
+		if cfg.SMSAPIURL != "" {
+			canais = append(canais, disparo.NewHTTPChannel(disparo.CanalSMS, cfg.SMSAPIURL, cfg.SMSAPIToken))
+		}
+	}
+
+	limites := map[string]int{
+		disparo.CanalEmail:    cfg.DisparoLimiteEmail,
+		disparo.CanalWhatsApp: cfg.DisparoLimiteWhatsApp,
+		disparo.CanalSMS:      cfg.DisparoLimiteSMS,
+	}
+	disparador := disparo.NewDisparador(disparo.NewRedisFila(config.RedisClient), limites, canais...)
+	// O rastreio precisa da URL pública da API, usada nos links das mensagens
+	if cfg.RastreioBaseURL != "" {
+		disparador.DefinirRastreador(disparo.NewRastreador(cfg.RastreioBaseURL, cfg.RastreioSegredo))
+	}
+	return disparador
 }
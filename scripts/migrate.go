@@ -0,0 +1,691 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/betine97/back-project.git/cmd/config"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/betine97/back-project.git/src/model/service"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Estruturas criadas em cada banco de cliente, na ordem em que devem ser aplicadas. Bancos que já têm a
+// estrutura (criada à mão pelos scripts da documentação) pulam a migração.
+// produtos.categoria continua gravada com o nome para os relatórios existentes.
+var migracoes = []struct {
+	nome   string
+	existe func(db *gorm.DB) bool
+	sql    string
+}{
+	{
+		nome:   "produtos.estoque_minimo",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("produtos", "estoque_minimo") },
+		sql:    `ALTER TABLE produtos ADD COLUMN estoque_minimo INT NOT NULL DEFAULT 0`,
+	},
+	{
+		nome:   "alertas_estoque",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("alertas_estoque") },
+		sql: `CREATE TABLE alertas_estoque (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			tipo VARCHAR(20) NOT NULL,
+			id_produto INT NOT NULL,
+			id_lote INT NOT NULL DEFAULT 0,
+			nome_produto VARCHAR(255) NOT NULL,
+			quantidade INT NOT NULL,
+			estoque_minimo INT NULL,
+			vencimento DATE NULL,
+			dias_para_vencer INT NULL,
+			mensagem VARCHAR(500) NOT NULL,
+			data_geracao DATETIME NOT NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'pendente',
+			pendente TINYINT AS (IF(status = 'pendente', 1, NULL)) STORED,
+			UNIQUE KEY uk_alertas_pendentes (tipo, id_produto, id_lote, pendente)
+		)`,
+	},
+	{
+		nome:   "inventarios",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("inventarios") },
+		sql: `CREATE TABLE inventarios (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			descricao VARCHAR(255) NOT NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'aberto',
+			data_abertura DATETIME NOT NULL,
+			data_fechamento DATETIME NULL
+		)`,
+	},
+	{
+		nome:   "inventario_itens",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("inventario_itens") },
+		sql: `CREATE TABLE inventario_itens (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_inventario INT NOT NULL,
+			id_estoque INT NOT NULL,
+			id_produto INT NOT NULL,
+			id_lote INT NOT NULL,
+			quantidade_sistema INT NOT NULL,
+			custo_unitario DECIMAL(10,2) NOT NULL,
+			UNIQUE KEY uk_inventario_lote (id_inventario, id_estoque),
+			CONSTRAINT fk_inventario_itens_inventario FOREIGN KEY (id_inventario) REFERENCES inventarios (id)
+		)`,
+	},
+	{
+		nome:   "inventario_contagens",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("inventario_contagens") },
+		sql: `CREATE TABLE inventario_contagens (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_inventario INT NOT NULL,
+			id_item INT NOT NULL,
+			dispositivo VARCHAR(100) NOT NULL,
+			quantidade INT NOT NULL,
+			data_contagem DATETIME NOT NULL,
+			UNIQUE KEY uk_contagem_item_dispositivo (id_item, dispositivo),
+			CONSTRAINT fk_inventario_contagens_item FOREIGN KEY (id_item) REFERENCES inventario_itens (id)
+		)`,
+	},
+	{
+		nome:   "movimentacoes_estoque",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("movimentacoes_estoque") },
+		sql: `CREATE TABLE movimentacoes_estoque (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_estoque INT NOT NULL,
+			id_produto INT NOT NULL,
+			id_lote INT NOT NULL,
+			tipo VARCHAR(20) NOT NULL,
+			quantidade INT NOT NULL,
+			custo_unitario DECIMAL(10,2) NOT NULL,
+			origem VARCHAR(30) NOT NULL,
+			id_referencia INT NULL,
+			data_movimento DATETIME NOT NULL,
+			observacao VARCHAR(500) NULL,
+			INDEX idx_movimentacoes_produto (id_produto, data_movimento)
+		)`,
+	},
+	{
+		nome:   "estoques.quantidade_recebida",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("estoques", "quantidade_recebida") },
+		sql:    `ALTER TABLE estoques ADD COLUMN quantidade_recebida INT NULL`,
+	},
+	{
+		// Lotes anteriores à coluna não têm a entrada gravada; ela é reconstruída uma vez pelas movimentações
+		nome: "estoques.quantidade_recebida (carga)",
+		existe: func(db *gorm.DB) bool {
+			var pendentes int64
+			if err := db.Table("estoques").Where("quantidade_recebida IS NULL").Count(&pendentes).Error; err != nil {
+				return false
+			}
+			return pendentes == 0
+		},
+		sql: `UPDATE estoques e SET e.quantidade_recebida = e.quantidade -
+			COALESCE((SELECT SUM(m.quantidade) FROM movimentacoes_estoque m WHERE m.id_estoque = e.id_estoque), 0)
+			WHERE e.quantidade_recebida IS NULL`,
+	},
+	{
+		// Colunas geradas ignoram código de barras e SKU vazios, que podem se repetir
+		nome:   "produtos.codigos_unicos",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasIndex("produtos", "uk_produtos_sku") },
+		sql: `ALTER TABLE produtos
+			ADD COLUMN codigo_barra_unico VARCHAR(14) GENERATED ALWAYS AS (NULLIF(codigo_barra, '')) STORED,
+			ADD COLUMN sku_unico VARCHAR(100) GENERATED ALWAYS AS (NULLIF(sku, '')) STORED,
+			ADD UNIQUE KEY uk_produtos_codigo_barra (codigo_barra_unico),
+			ADD UNIQUE KEY uk_produtos_sku (sku_unico)`,
+	},
+	{
+		nome:   "historico_precos",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("historico_precos") },
+		sql: `CREATE TABLE historico_precos (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_produto INT NOT NULL,
+			preco_anterior DECIMAL(10,2) NOT NULL,
+			preco_novo DECIMAL(10,2) NOT NULL,
+			origem VARCHAR(20) NOT NULL,
+			id_agendamento INT NULL,
+			alterado_por VARCHAR(100) NOT NULL,
+			data_alteracao DATETIME NOT NULL,
+			INDEX idx_historico_precos_produto (id_produto, data_alteracao)
+		)`,
+	},
+	{
+		nome:   "precos_agendados",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("precos_agendados") },
+		sql: `CREATE TABLE precos_agendados (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_produto INT NOT NULL,
+			preco_venda DECIMAL(10,2) NOT NULL,
+			data_vigencia DATETIME NOT NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'pendente',
+			criado_por VARCHAR(100) NOT NULL,
+			data_criacao DATETIME NOT NULL,
+			data_aplicacao DATETIME NULL,
+			INDEX idx_precos_agendados_vigencia (status, data_vigencia),
+			INDEX idx_precos_agendados_produto (id_produto)
+		)`,
+	},
+	{
+		nome:   "produtos.tipo",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("produtos", "tipo") },
+		sql: `ALTER TABLE produtos
+			ADD COLUMN tipo VARCHAR(20) NOT NULL DEFAULT 'simples',
+			ADD COLUMN id_produto_pai INT NULL,
+			ADD INDEX idx_produtos_pai (id_produto_pai),
+			ADD CONSTRAINT fk_produtos_pai FOREIGN KEY (id_produto_pai) REFERENCES produtos (id_produto)`,
+	},
+	{
+		nome:   "kit_componentes",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("kit_componentes") },
+		sql: `CREATE TABLE kit_componentes (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_kit INT NOT NULL,
+			id_produto INT NOT NULL,
+			quantidade INT NOT NULL,
+			UNIQUE KEY uk_kit_componente (id_kit, id_produto),
+			INDEX idx_kit_componentes_produto (id_produto),
+			CONSTRAINT fk_kit_componentes_kit FOREIGN KEY (id_kit) REFERENCES produtos (id_produto),
+			CONSTRAINT fk_kit_componentes_produto FOREIGN KEY (id_produto) REFERENCES produtos (id_produto)
+		)`,
+	},
+	{
+		nome:   "categorias",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("categorias") },
+		sql: `CREATE TABLE categorias (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			nome VARCHAR(100) NOT NULL,
+			nome_normalizado VARCHAR(100) NOT NULL,
+			id_categoria_pai INT NULL,
+			data_criacao DATETIME NOT NULL,
+			INDEX idx_categorias_pai (id_categoria_pai),
+			INDEX idx_categorias_nome_normalizado (nome_normalizado),
+			FOREIGN KEY (id_categoria_pai) REFERENCES categorias(id)
+		)`,
+	},
+	{
+		nome:   "produtos.id_categoria",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("produtos", "id_categoria") },
+		sql: `ALTER TABLE produtos
+			ADD COLUMN id_categoria INT NULL AFTER categoria,
+			ADD INDEX idx_produtos_categoria (id_categoria),
+			ADD FOREIGN KEY (id_categoria) REFERENCES categorias(id)`,
+	},
+	{
+		nome:   "importacoes",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("importacoes") },
+		sql: `CREATE TABLE importacoes (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			recurso VARCHAR(20) NOT NULL,
+			nome_arquivo VARCHAR(255) NOT NULL,
+			formato VARCHAR(10) NOT NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'processando',
+			dry_run TINYINT(1) NOT NULL DEFAULT 0,
+			atualizar_existentes TINYINT(1) NOT NULL DEFAULT 0,
+			total_linhas INT NOT NULL DEFAULT 0,
+			inseridas INT NOT NULL DEFAULT 0,
+			atualizadas INT NOT NULL DEFAULT 0,
+			ignoradas INT NOT NULL DEFAULT 0,
+			com_erro INT NOT NULL DEFAULT 0,
+			mensagem_erro VARCHAR(255) NULL,
+			criado_por VARCHAR(100) NOT NULL,
+			data_criacao DATETIME NOT NULL,
+			data_conclusao DATETIME NULL,
+			INDEX idx_importacoes_recurso (recurso, id)
+		)`,
+	},
+	{
+		nome:   "importacao_erros",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("importacao_erros") },
+		sql: `CREATE TABLE importacao_erros (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_importacao INT NOT NULL,
+			linha INT NOT NULL,
+			campo VARCHAR(50) NULL,
+			mensagem VARCHAR(500) NOT NULL,
+			INDEX idx_importacao_erros_importacao (id_importacao, linha),
+			FOREIGN KEY (id_importacao) REFERENCES importacoes(id) ON DELETE CASCADE
+		)`,
+	},
+	{
+		nome:   "vendas",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("vendas") },
+		sql: `CREATE TABLE vendas (
+			id_venda INT AUTO_INCREMENT PRIMARY KEY,
+			id_cliente INT NULL,
+			data_venda DATETIME NOT NULL,
+			subtotal DECIMAL(10,2) NOT NULL DEFAULT 0,
+			desconto DECIMAL(10,2) NOT NULL DEFAULT 0,
+			valor_total DECIMAL(10,2) NOT NULL DEFAULT 0,
+			forma_pagamento VARCHAR(20) NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'aberta',
+			observacao VARCHAR(500) NULL,
+			data_pagamento DATETIME NULL,
+			data_cancelamento DATETIME NULL,
+			INDEX idx_vendas_status (status, id_venda),
+			INDEX idx_vendas_cliente (id_cliente, id_venda),
+			FOREIGN KEY (id_cliente) REFERENCES clientes(id)
+		)`,
+	},
+	{
+		nome:   "itens_venda",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("itens_venda") },
+		sql: `CREATE TABLE itens_venda (
+			id_item INT AUTO_INCREMENT PRIMARY KEY,
+			id_venda INT NOT NULL,
+			id_produto INT NOT NULL,
+			nome_produto VARCHAR(255) NOT NULL,
+			quantidade INT NOT NULL,
+			preco_unitario DECIMAL(10,2) NOT NULL,
+			desconto DECIMAL(10,2) NOT NULL DEFAULT 0,
+			subtotal DECIMAL(10,2) NOT NULL,
+			INDEX idx_itens_venda_venda (id_venda),
+			INDEX idx_itens_venda_produto (id_produto),
+			FOREIGN KEY (id_venda) REFERENCES vendas(id_venda) ON DELETE CASCADE,
+			FOREIGN KEY (id_produto) REFERENCES produtos(id_produto)
+		)`,
+	},
+	{
+		nome:   "caixa_sessoes",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("caixa_sessoes") },
+		sql: `CREATE TABLE caixa_sessoes (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			operador VARCHAR(100) NOT NULL,
+			identificacao VARCHAR(50) NULL,
+			status VARCHAR(20) NOT NULL DEFAULT 'aberto',
+			valor_abertura DECIMAL(10,2) NOT NULL DEFAULT 0,
+			valor_esperado DECIMAL(10,2) NULL,
+			valor_contado DECIMAL(10,2) NULL,
+			diferenca DECIMAL(10,2) NULL,
+			data_abertura DATETIME NOT NULL,
+			data_fechamento DATETIME NULL,
+			observacao_fechamento VARCHAR(500) NULL,
+			aberto TINYINT AS (IF(status = 'aberto', 1, NULL)) STORED,
+			INDEX idx_caixa_sessoes_operador (operador, status),
+			INDEX idx_caixa_sessoes_status (status, id),
+			UNIQUE KEY uk_caixa_sessoes_aberto (operador, aberto)
+		)`,
+	},
+	{
+		nome:   "caixa_movimentos",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("caixa_movimentos") },
+		sql: `CREATE TABLE caixa_movimentos (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_sessao INT NOT NULL,
+			tipo VARCHAR(20) NOT NULL,
+			forma_pagamento VARCHAR(20) NULL,
+			valor DECIMAL(10,2) NOT NULL,
+			id_venda INT NULL,
+			descricao VARCHAR(255) NULL,
+			data_movimento DATETIME NOT NULL,
+			INDEX idx_caixa_movimentos_sessao (id_sessao, id),
+			INDEX idx_caixa_movimentos_venda (id_venda),
+			FOREIGN KEY (id_sessao) REFERENCES caixa_sessoes(id) ON DELETE CASCADE,
+			FOREIGN KEY (id_venda) REFERENCES vendas(id_venda)
+		)`,
+	},
+	{
+		nome:   "vendas.id_caixa_sessao",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("vendas", "id_caixa_sessao") },
+		sql: `ALTER TABLE vendas
+			ADD COLUMN id_caixa_sessao INT NULL AFTER data_cancelamento,
+			ADD INDEX idx_vendas_caixa_sessao (id_caixa_sessao),
+			ADD FOREIGN KEY (id_caixa_sessao) REFERENCES caixa_sessoes(id)`,
+	},
+	{
+		nome:   "itens_venda.quantidade_devolvida",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("itens_venda", "quantidade_devolvida") },
+		sql: `ALTER TABLE itens_venda
+			ADD COLUMN quantidade_devolvida INT NOT NULL DEFAULT 0 AFTER subtotal`,
+	},
+	{
+		nome:   "devolucoes",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("devolucoes") },
+		sql: `CREATE TABLE devolucoes (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_venda INT NOT NULL,
+			id_cliente INT NULL,
+			data_devolucao DATETIME NOT NULL,
+			tipo_reembolso VARCHAR(20) NOT NULL,
+			forma_reembolso VARCHAR(20) NULL,
+			valor_total DECIMAL(10,2) NOT NULL,
+			motivo VARCHAR(500) NULL,
+			id_caixa_sessao INT NULL,
+			operador VARCHAR(100) NOT NULL,
+			INDEX idx_devolucoes_venda (id_venda),
+			INDEX idx_devolucoes_cliente (id_cliente, id),
+			FOREIGN KEY (id_venda) REFERENCES vendas(id_venda),
+			FOREIGN KEY (id_cliente) REFERENCES clientes(id),
+			FOREIGN KEY (id_caixa_sessao) REFERENCES caixa_sessoes(id)
+		)`,
+	},
+	{
+		nome:   "itens_devolucao",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("itens_devolucao") },
+		sql: `CREATE TABLE itens_devolucao (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_devolucao INT NOT NULL,
+			id_item_venda INT NOT NULL,
+			id_produto INT NOT NULL,
+			quantidade INT NOT NULL,
+			valor DECIMAL(10,2) NOT NULL,
+			destino VARCHAR(20) NOT NULL DEFAULT 'estoque',
+			INDEX idx_itens_devolucao_devolucao (id_devolucao),
+			INDEX idx_itens_devolucao_item (id_item_venda),
+			FOREIGN KEY (id_devolucao) REFERENCES devolucoes(id) ON DELETE CASCADE,
+			FOREIGN KEY (id_item_venda) REFERENCES itens_venda(id_item),
+			FOREIGN KEY (id_produto) REFERENCES produtos(id_produto)
+		)`,
+	},
+	{
+		nome:   "creditos_clientes",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("creditos_clientes") },
+		sql: `CREATE TABLE creditos_clientes (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_cliente INT NOT NULL,
+			tipo VARCHAR(10) NOT NULL,
+			valor DECIMAL(10,2) NOT NULL,
+			origem VARCHAR(20) NOT NULL,
+			id_referencia INT NULL,
+			data_lancamento DATETIME NOT NULL,
+			descricao VARCHAR(255) NULL,
+			INDEX idx_creditos_clientes_cliente (id_cliente, id),
+			FOREIGN KEY (id_cliente) REFERENCES clientes(id) ON DELETE CASCADE
+		)`,
+	},
+	{
+		nome: "criterios RFM",
+		existe: func(db *gorm.DB) bool {
+			var total int64
+			db.Table("criterios").Where("nome_condicao LIKE ?", "RFM %").Count(&total)
+			return total > 0
+		},
+		sql: `INSERT INTO criterios (nome_condicao) VALUES
+			('RFM Campeões'),
+			('RFM Leais'),
+			('RFM Novos'),
+			('RFM Precisam de Atenção'),
+			('RFM Em Risco'),
+			('RFM Hibernando'),
+			('RFM Sem Compras')`,
+	},
+	{
+		nome:   "fidelidade_config",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("fidelidade_config") },
+		sql: `CREATE TABLE fidelidade_config (
+			id INT PRIMARY KEY,
+			ativo TINYINT(1) NOT NULL DEFAULT 0,
+			pontos_por_real DECIMAL(10,4) NOT NULL DEFAULT 1,
+			valor_ponto DECIMAL(10,4) NOT NULL DEFAULT 0,
+			validade_dias INT NOT NULL DEFAULT 0,
+			minimo_resgate INT NOT NULL DEFAULT 0,
+			dias_aviso_expiracao INT NOT NULL DEFAULT 0,
+			data_atualizacao DATETIME NULL
+		)`,
+	},
+	{
+		nome:   "pontos_clientes",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("pontos_clientes") },
+		sql: `CREATE TABLE pontos_clientes (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_cliente INT NOT NULL,
+			tipo VARCHAR(10) NOT NULL,
+			pontos INT NOT NULL,
+			pontos_disponiveis INT NOT NULL DEFAULT 0,
+			origem VARCHAR(20) NOT NULL,
+			id_referencia INT NULL,
+			data_lancamento DATETIME NOT NULL,
+			data_expiracao DATETIME NULL,
+			aviso_enviado TINYINT(1) NOT NULL DEFAULT 0,
+			descricao VARCHAR(255) NULL,
+			INDEX idx_pontos_clientes_cliente (id_cliente, id),
+			INDEX idx_pontos_clientes_expiracao (tipo, pontos_disponiveis, data_expiracao),
+			INDEX idx_pontos_clientes_referencia (origem, id_referencia),
+			FOREIGN KEY (id_cliente) REFERENCES clientes(id) ON DELETE CASCADE
+		)`,
+	},
+	{
+		nome:   "publicos_regras",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("publicos_regras") },
+		sql: `CREATE TABLE publicos_regras (
+			id_publico INT PRIMARY KEY,
+			regra TEXT NOT NULL,
+			data_atualizacao DATETIME NOT NULL,
+			FOREIGN KEY (id_publico) REFERENCES publicos_clientes(id) ON DELETE CASCADE
+		)`,
+	},
+	{
+		nome:   "criterios.regra",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("criterios", "regra") },
+		sql:    `ALTER TABLE criterios ADD COLUMN regra TEXT NULL AFTER nome_condicao`,
+	},
+	{
+		nome: "criterios parametrizados",
+		existe: func(db *gorm.DB) bool {
+			var total int64
+			db.Table("criterios").Where("regra IS NOT NULL").Count(&total)
+			return total > 0
+		},
+		sql: `INSERT INTO criterios (nome_condicao, regra) VALUES
+			('Pets de Porte Grande', '{"campo":"pet.porte","operador":"eq","valor":"Grande"}'),
+			('Pets Aniversariantes do Mês', '{"campo":"pet.meses_para_aniversario","operador":"eq","valor":0}'),
+			('Clientes Cadastrados nos Últimos 30 Dias', '{"campo":"cliente.dias_cadastro","operador":"lte","valor":30}')`,
+	},
+	{
+		nome:   "publicos_clientes.tipo",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("publicos_clientes", "tipo") },
+		sql: `ALTER TABLE publicos_clientes
+			ADD COLUMN tipo VARCHAR(10) NOT NULL DEFAULT 'estatico' AFTER status,
+			ADD COLUMN ultima_atualizacao DATETIME NULL AFTER tipo,
+			ADD INDEX idx_publicos_clientes_tipo (tipo)`,
+	},
+	{
+		nome:   "publicos_membros_eventos",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("publicos_membros_eventos") },
+		sql: `CREATE TABLE publicos_membros_eventos (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_publico INT NOT NULL,
+			id_cliente INT NOT NULL,
+			tipo VARCHAR(10) NOT NULL,
+			origem VARCHAR(20) NOT NULL,
+			data_evento DATETIME NOT NULL,
+			INDEX idx_publicos_membros_eventos_publico (id_publico, data_evento),
+			INDEX idx_publicos_membros_eventos_cliente (id_cliente),
+			FOREIGN KEY (id_publico) REFERENCES publicos_clientes(id) ON DELETE CASCADE
+		)`,
+	},
+	{
+		// Remove associações repetidas, mantendo a mais antiga, antes de criar o índice único
+		nome: "addclientes_publicos duplicados",
+		existe: func(db *gorm.DB) bool {
+			return db.Migrator().HasIndex("addclientes_publicos", "uk_addclientes_publicos_publico_cliente")
+		},
+		sql: `DELETE a FROM addclientes_publicos a
+			INNER JOIN addclientes_publicos b ON b.id_publico = a.id_publico AND b.id_cliente = a.id_cliente AND b.id < a.id`,
+	},
+	{
+		nome: "addclientes_publicos.uk_publico_cliente",
+		existe: func(db *gorm.DB) bool {
+			return db.Migrator().HasIndex("addclientes_publicos", "uk_addclientes_publicos_publico_cliente")
+		},
+		sql: `ALTER TABLE addclientes_publicos
+			ADD UNIQUE INDEX uk_addclientes_publicos_publico_cliente (id_publico, id_cliente)`,
+	},
+	{
+		nome:   "publicos_exclusoes",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("publicos_exclusoes") },
+		sql: `CREATE TABLE publicos_exclusoes (
+			id_publico INT NOT NULL,
+			id_cliente INT NOT NULL,
+			motivo VARCHAR(255) NULL,
+			data_exclusao DATETIME NOT NULL,
+			PRIMARY KEY (id_publico, id_cliente),
+			INDEX idx_publicos_exclusoes_cliente (id_cliente),
+			FOREIGN KEY (id_publico) REFERENCES publicos_clientes(id) ON DELETE CASCADE
+		)`,
+	},
+	{
+		nome:   "campanhas_envios",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("campanhas_envios") },
+		sql: `CREATE TABLE campanhas_envios (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_campanha INT NOT NULL,
+			id_cliente INT NOT NULL,
+			canal VARCHAR(10) NOT NULL,
+			destino VARCHAR(255) NOT NULL,
+			status VARCHAR(20) NOT NULL,
+			tentativas INT NOT NULL DEFAULT 0,
+			erro VARCHAR(500) NULL,
+			data_criacao DATETIME NOT NULL,
+			data_envio DATETIME NULL,
+			UNIQUE INDEX uk_campanhas_envios_campanha_cliente_canal (id_campanha, id_cliente, canal),
+			INDEX idx_campanhas_envios_campanha_status (id_campanha, status),
+			FOREIGN KEY (id_campanha) REFERENCES campanhas(id) ON DELETE CASCADE
+		)`,
+	},
+	{
+		// Última mudança de status; a varredura de envios parados usa a data para achar envios perdidos da fila
+		nome:   "campanhas_envios.data_atualizacao",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("campanhas_envios", "data_atualizacao") },
+		sql: `ALTER TABLE campanhas_envios
+			ADD COLUMN data_atualizacao DATETIME NULL,
+			ADD INDEX idx_campanhas_envios_status_atualizacao (status, data_atualizacao)`,
+	},
+	{
+		nome:   "campanhas_envios.mensagem",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("campanhas_envios", "mensagem") },
+		sql: `ALTER TABLE campanhas_envios
+			ADD COLUMN assunto VARCHAR(255) NULL,
+			ADD COLUMN mensagem TEXT NULL`,
+	},
+	{
+		nome:   "templates_mensagens",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("templates_mensagens") },
+		sql: `CREATE TABLE templates_mensagens (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			nome VARCHAR(100) NOT NULL,
+			canal VARCHAR(10) NOT NULL,
+			assunto VARCHAR(255) NULL,
+			corpo TEXT NOT NULL,
+			data_criacao DATETIME NOT NULL,
+			data_atualizacao DATETIME NOT NULL,
+			INDEX idx_templates_mensagens_canal (canal)
+		)`,
+	},
+	{
+		nome:   "campanhas_canais",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("campanhas_canais") },
+		sql: `CREATE TABLE campanhas_canais (
+			id_campanha INT NOT NULL,
+			canal VARCHAR(10) NOT NULL,
+			id_template INT NULL,
+			PRIMARY KEY (id_campanha, canal),
+			INDEX idx_campanhas_canais_template (id_template),
+			FOREIGN KEY (id_campanha) REFERENCES campanhas(id) ON DELETE CASCADE,
+			FOREIGN KEY (id_template) REFERENCES templates_mensagens(id)
+		)`,
+	},
+	{
+		nome:   "campanhas.data_disparo",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("campanhas", "data_disparo") },
+		sql: `ALTER TABLE campanhas
+			ADD COLUMN data_disparo VARCHAR(20) NULL,
+			ADD COLUMN erro_disparo VARCHAR(500) NULL`,
+	},
+	{
+		nome:   "campanhas_envios.rastreio",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("campanhas_envios", "data_entrega") },
+		sql: `ALTER TABLE campanhas_envios
+			ADD COLUMN data_entrega DATETIME NULL,
+			ADD COLUMN data_abertura DATETIME NULL,
+			ADD COLUMN data_clique DATETIME NULL,
+			ADD COLUMN data_descadastro DATETIME NULL,
+			ADD COLUMN cliques INT NOT NULL DEFAULT 0`,
+	},
+	{
+		// Público pelo qual o cliente recebeu o envio; as métricas por público agrupam por ele
+		nome:   "campanhas_envios.id_publico",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasColumn("campanhas_envios", "id_publico") },
+		sql: `ALTER TABLE campanhas_envios
+			ADD COLUMN id_publico INT NULL AFTER id_cliente,
+			ADD INDEX idx_campanhas_envios_campanha_publico (id_campanha, id_publico)`,
+	},
+	{
+		nome:   "clientes_consentimentos",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("clientes_consentimentos") },
+		sql: `CREATE TABLE clientes_consentimentos (
+			id_cliente INT NOT NULL,
+			canal VARCHAR(20) NOT NULL,
+			consentido TINYINT(1) NOT NULL,
+			origem VARCHAR(20) NOT NULL,
+			detalhe VARCHAR(255) NULL,
+			data DATETIME NOT NULL,
+			PRIMARY KEY (id_cliente, canal),
+			INDEX idx_clientes_consentimentos_canal (canal, consentido),
+			FOREIGN KEY (id_cliente) REFERENCES clientes(id) ON DELETE CASCADE
+		)`,
+	},
+	{
+		// Sem chave estrangeira: o histórico é a prova do consentimento e fica mesmo depois de o cliente ser excluído
+		nome:   "clientes_consentimentos_historico",
+		existe: func(db *gorm.DB) bool { return db.Migrator().HasTable("clientes_consentimentos_historico") },
+		sql: `CREATE TABLE clientes_consentimentos_historico (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			id_cliente INT NOT NULL,
+			canal VARCHAR(20) NOT NULL,
+			consentido TINYINT(1) NOT NULL,
+			origem VARCHAR(20) NOT NULL,
+			detalhe VARCHAR(255) NULL,
+			id_envio INT NULL,
+			data DATETIME NOT NULL,
+			INDEX idx_clientes_consentimentos_historico_cliente (id_cliente, canal, id)
+		)`,
+	},
+}
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "lista as migrações pendentes e simula a normalização do catálogo sem gravar")
+	flag.Parse()
+
+	_ = config.NewConfig()
+
+	clientDB, err := config.ConnectionDBClients()
+	if err != nil {
+		zap.L().Fatal("❌ Falha ao conectar com bancos de clientes", zap.Error(err))
+	}
+
+	dbClient := persistence.NewDBConnectionDBClient(clientDB)
+	srv := service.NewServiceInstance(nil, nil, dbClient, nil, nil, nil, nil)
+
+	// As chaves de clientDB têm o prefixo "db_"; o serviço recebe o userID sem ele
+	for _, userID := range dbClient.GetClientIDs() {
+		db := clientDB["db_"+userID]
+		pendente := false
+		for _, migracao := range migracoes {
+			if migracao.existe(db) {
+				continue
+			}
+			if *dryRun {
+				pendente = true
+				zap.L().Info("Migração pendente", zap.String("cliente", userID), zap.String("migracao", migracao.nome))
+				continue
+			}
+			if err := db.Exec(migracao.sql).Error; err != nil {
+				zap.L().Fatal("❌ Falha ao aplicar migração", zap.String("cliente", userID), zap.String("migracao", migracao.nome), zap.Error(err))
+			}
+			zap.L().Info("✅ Migração aplicada", zap.String("cliente", userID), zap.String("migracao", migracao.nome))
+		}
+
+		// Sem a estrutura criada não há como simular a normalização
+		if pendente {
+			continue
+		}
+
+		resultado, restErr := srv.NormalizarCatalogoService(userID, *dryRun)
+		if restErr != nil {
+			zap.L().Fatal("❌ Falha ao normalizar catálogo", zap.String("cliente", userID), zap.String("erro", restErr.Error()))
+		}
+		zap.L().Info("✅ Catálogo normalizado",
+			zap.String("cliente", userID),
+			zap.Bool("dry_run", resultado.DryRun),
+			zap.Int("categorias", len(resultado.Categorias)),
+			zap.Int("categorias_criadas", resultado.CategoriasCriadas),
+			zap.Int("destinado_para_nao_mapeados", len(resultado.DestinadoParaNaoMapeados)),
+			zap.Int("especies_nao_mapeadas", len(resultado.EspeciesNaoMapeadas)))
+	}
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE ALERTAS DE ESTOQUE ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetAlertasEstoque(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get alertas estoque controller")
+
+	userID := ctx.Locals("userID").(string)
+	tipo := ctx.Query("tipo")
+	status := ctx.Query("status")
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarAlertasEstoqueService(userID, tipo, status)
+		return exportar(ctx, "alertas", formato, dtos.AlertaEstoqueResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	alertas, err := ctl.service.GetAlertasEstoqueService(userID, tipo, status, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting alertas estoque", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(alertas)
+}
+
+func (ctl *Controller) GerarAlertasEstoque(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting gerar alertas estoque controller")
+
+	userID := ctx.Locals("userID").(string)
+	dias := ctx.QueryInt("dias", 0)
+
+	resultado, err := ctl.service.GerarAlertasEstoqueService(userID, dias)
+	if err != nil {
+		zap.L().Error("Error generating alertas estoque", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resultado)
+}
+
+func (ctl *Controller) ResolverAlertaEstoque(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting resolver alerta estoque controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	success, err := ctl.service.ResolverAlertaEstoqueService(userID, id)
+	if err != nil {
+		zap.L().Error("Error resolving alerta estoque", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if !success {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Error resolving alerta",
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Alerta resolved successfully",
+	})
+}
+
+func (ctl *Controller) UpdateEstoqueMinimo(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting update estoque minimo controller")
+
+	id := ctx.Params("id")
+	request := ctx.Locals("updateEstoqueMinimo").(dtos.UpdateEstoqueMinimoRequest)
+	userID := ctx.Locals("userID").(string)
+
+	success, err := ctl.service.UpdateEstoqueMinimoService(userID, id, request)
+	if err != nil {
+		zap.L().Error("Error updating estoque minimo", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if !success {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Error updating estoque minimo",
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Estoque minimo updated successfully",
+	})
+}
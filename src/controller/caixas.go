@@ -0,0 +1,171 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE CAIXA ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) AbrirCaixa(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting abrir caixa controller")
+
+	abrirCaixa := ctx.Locals("abrirCaixa").(dtos.AbrirCaixaRequest)
+
+	userID := ctx.Locals("userID").(string)
+	caixa, err := ctl.service.AbrirCaixaService(userID, abrirCaixa)
+	if err != nil {
+		zap.L().Error("Error opening caixa", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(caixa)
+}
+
+func (ctl *Controller) GetAllCaixas(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get all caixas controller")
+
+	userID := ctx.Locals("userID").(string)
+	status := ctx.Query("status")
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarCaixasService(userID, status)
+		return exportar(ctx, "caixas", formato, dtos.CaixaSessaoResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	caixas, err := ctl.service.GetAllCaixasService(userID, status, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting caixas", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(caixas)
+}
+
+func (ctl *Controller) GetCaixaAtual(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get caixa atual controller")
+
+	userID := ctx.Locals("userID").(string)
+	operador := ctx.Query("operador")
+
+	caixa, err := ctl.service.GetCaixaAtualService(userID, operador)
+	if err != nil {
+		zap.L().Error("Error getting caixa atual", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(caixa)
+}
+
+func (ctl *Controller) GetCaixaByID(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get caixa by ID controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	caixa, err := ctl.service.GetCaixaByIDService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting caixa by ID", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(caixa)
+}
+
+func (ctl *Controller) GetCaixaMovimentos(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get caixa movimentos controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	movimentos, err := ctl.service.GetCaixaMovimentosService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting caixa movimentos", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(movimentos)
+}
+
+func (ctl *Controller) RegistrarSangria(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting registrar sangria controller")
+	return ctl.registrarMovimentoCaixa(ctx, entity.CaixaMovimentoSangria)
+}
+
+func (ctl *Controller) RegistrarSuprimento(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting registrar suprimento controller")
+	return ctl.registrarMovimentoCaixa(ctx, entity.CaixaMovimentoSuprimento)
+}
+
+func (ctl *Controller) registrarMovimentoCaixa(ctx *fiber.Ctx, tipo string) error {
+	id := ctx.Params("id")
+	movimentoCaixa := ctx.Locals("movimentoCaixa").(dtos.MovimentoCaixaRequest)
+
+	userID := ctx.Locals("userID").(string)
+	movimento, err := ctl.service.RegistrarMovimentoCaixaService(userID, id, tipo, movimentoCaixa)
+	if err != nil {
+		zap.L().Error("Error registering movimento caixa", zap.String("tipo", tipo), zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(movimento)
+}
+
+func (ctl *Controller) FecharCaixa(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting fechar caixa controller")
+
+	id := ctx.Params("id")
+	fecharCaixa := ctx.Locals("fecharCaixa").(dtos.FecharCaixaRequest)
+
+	userID := ctx.Locals("userID").(string)
+	relatorio, err := ctl.service.FecharCaixaService(userID, id, fecharCaixa)
+	if err != nil {
+		zap.L().Error("Error closing caixa", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(relatorio)
+}
+
+func (ctl *Controller) GetRelatorioCaixa(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get relatorio caixa controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	relatorio, err := ctl.service.GetRelatorioCaixaService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting relatorio caixa", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(relatorio)
+}
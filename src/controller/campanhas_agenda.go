@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE AGENDA DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) PausarCampanha(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting pausar campanha controller")
+
+	userID := ctx.Locals("userID").(string)
+	campanha, err := ctl.service.PausarCampanhaService(userID, ctx.Params("id"))
+	if err != nil {
+		zap.L().Error("Error pausing campanha", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(campanha)
+}
+
+func (ctl *Controller) RetomarCampanha(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting retomar campanha controller")
+
+	userID := ctx.Locals("userID").(string)
+	campanha, err := ctl.service.RetomarCampanhaService(userID, ctx.Params("id"))
+	if err != nil {
+		zap.L().Error("Error resuming campanha", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(campanha)
+}
@@ -0,0 +1,165 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE CATEGORIAS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetCategorias(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get categorias controller")
+
+	userID := ctx.Locals("userID").(string)
+
+	categorias, err := ctl.service.GetCategoriasService(userID)
+	if err != nil {
+		zap.L().Error("Error getting categorias", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(categorias)
+}
+
+func (ctl *Controller) GetCategoriaByID(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get categoria by ID controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	categoria, err := ctl.service.GetCategoriaByIDService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting categoria by ID", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(categoria)
+}
+
+func (ctl *Controller) CreateCategoria(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create categoria controller")
+
+	request := ctx.Locals("createCategoria").(dtos.CreateCategoriaRequest)
+	userID := ctx.Locals("userID").(string)
+
+	id, err := ctl.service.CreateCategoriaService(userID, request)
+	if err != nil {
+		zap.L().Error("Error creating categoria", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Categoria created successfully",
+		"id":      id,
+	})
+}
+
+func (ctl *Controller) UpdateCategoria(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting update categoria controller")
+
+	id := ctx.Params("id")
+	request := ctx.Locals("updateCategoria").(dtos.UpdateCategoriaRequest)
+	userID := ctx.Locals("userID").(string)
+
+	_, err := ctl.service.UpdateCategoriaService(userID, id, request)
+	if err != nil {
+		zap.L().Error("Error updating categoria", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Categoria updated successfully",
+	})
+}
+
+func (ctl *Controller) MoverCategoria(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting mover categoria controller")
+
+	id := ctx.Params("id")
+	request := ctx.Locals("moverCategoria").(dtos.MoverCategoriaRequest)
+	userID := ctx.Locals("userID").(string)
+
+	_, err := ctl.service.MoverCategoriaService(userID, id, request)
+	if err != nil {
+		zap.L().Error("Error moving categoria", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Categoria moved successfully",
+	})
+}
+
+func (ctl *Controller) MesclarCategoria(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting mesclar categoria controller")
+
+	id := ctx.Params("id")
+	request := ctx.Locals("mesclarCategoria").(dtos.MesclarCategoriaRequest)
+	userID := ctx.Locals("userID").(string)
+
+	_, err := ctl.service.MesclarCategoriaService(userID, id, request)
+	if err != nil {
+		zap.L().Error("Error merging categoria", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Categorias merged successfully",
+	})
+}
+
+func (ctl *Controller) DeleteCategoria(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting delete categoria controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	_, err := ctl.service.DeleteCategoriaService(userID, id)
+	if err != nil {
+		zap.L().Error("Error deleting categoria", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Categoria deleted successfully",
+	})
+}
+
+func (ctl *Controller) GetEspecies(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get especies controller")
+
+	return ctx.Status(fiber.StatusOK).JSON(ctl.service.GetEspeciesService())
+}
+
+// NormalizarCatalogo com ?dry_run=true apenas mostra o que seria alterado
+func (ctl *Controller) NormalizarCatalogo(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting normalizar catalogo controller")
+
+	userID := ctx.Locals("userID").(string)
+	dryRun := ctx.QueryBool("dry_run", false)
+
+	resultado, err := ctl.service.NormalizarCatalogoService(userID, dryRun)
+	if err != nil {
+		zap.L().Error("Error normalizing catalogo", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resultado)
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE CONSENTIMENTOS DE CLIENTES ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetConsentimentosCliente(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get consentimentos cliente controller")
+
+	userID := ctx.Locals("userID").(string)
+	consentimentos, err := ctl.service.GetConsentimentosClienteService(userID, ctx.Params("id"))
+	if err != nil {
+		zap.L().Error("Error getting consentimentos of cliente", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(consentimentos)
+}
+
+func (ctl *Controller) SetConsentimentosCliente(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting set consentimentos cliente controller")
+
+	request := ctx.Locals("consentimentosCliente").(dtos.ConsentimentosClienteRequest)
+
+	userID := ctx.Locals("userID").(string)
+	consentimentos, err := ctl.service.SetConsentimentosClienteService(userID, ctx.Params("id"), request)
+	if err != nil {
+		zap.L().Error("Error registering consentimentos of cliente", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(consentimentos)
+}
+
+func (ctl *Controller) GetHistoricoConsentimentos(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get historico consentimentos controller")
+
+	userID := ctx.Locals("userID").(string)
+	canal := ctx.Query("canal")
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarHistoricoConsentimentosService(userID, ctx.Params("id"), canal)
+		return exportar(ctx, "consentimentos_historico", formato, dtos.ConsentimentoHistoricoResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	historico, err := ctl.service.GetHistoricoConsentimentosService(userID, ctx.Params("id"), canal, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting historico of consentimentos", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(historico)
+}
@@ -35,6 +35,17 @@ type ControllerInterface interface {
 	GetAllProducts(ctx *fiber.Ctx) error
 	CreateProduct(ctx *fiber.Ctx) error
 	DeleteProduct(ctx *fiber.Ctx) error
+	GetProductByID(ctx *fiber.Ctx) error
+	GetProductByBarcode(ctx *fiber.Ctx) error
+	GetProductBySKU(ctx *fiber.Ctx) error
+	UpdateProduct(ctx *fiber.Ctx) error
+
+	// Variações e Kits
+	GetVariacoes(ctx *fiber.Ctx) error
+	CreateVariacao(ctx *fiber.Ctx) error
+	VincularVariacao(ctx *fiber.Ctx) error
+	GetKitComponentes(ctx *fiber.Ctx) error
+	SetKitComponentes(ctx *fiber.Ctx) error
 
 	GetAllPedidos(ctx *fiber.Ctx) error
 	GetPedidoById(ctx *fiber.Ctx) error
@@ -44,9 +55,46 @@ type ControllerInterface interface {
 	GetItensPedido(ctx *fiber.Ctx) error
 	CreateItemPedido(ctx *fiber.Ctx) error
 
+	// Vendas
+	GetAllVendas(ctx *fiber.Ctx) error
+	GetVendaByID(ctx *fiber.Ctx) error
+	GetItensVenda(ctx *fiber.Ctx) error
+	CreateVenda(ctx *fiber.Ctx) error
+	AdicionarItemVenda(ctx *fiber.Ctx) error
+	PagarVenda(ctx *fiber.Ctx) error
+	CancelarVenda(ctx *fiber.Ctx) error
+
+	// Devoluções e crédito na loja
+	CreateDevolucao(ctx *fiber.Ctx) error
+	GetAllDevolucoes(ctx *fiber.Ctx) error
+	GetDevolucaoByID(ctx *fiber.Ctx) error
+	GetCreditosCliente(ctx *fiber.Ctx) error
+
+	// RFM de clientes
+	GetRFMClientes(ctx *fiber.Ctx) error
+
+	// Fidelidade
+	GetFidelidadeConfig(ctx *fiber.Ctx) error
+	UpdateFidelidadeConfig(ctx *fiber.Ctx) error
+	ExpirarPontos(ctx *fiber.Ctx) error
+	GetPontosCliente(ctx *fiber.Ctx) error
+	ResgatarPontos(ctx *fiber.Ctx) error
+
+	// Caixa
+	AbrirCaixa(ctx *fiber.Ctx) error
+	GetAllCaixas(ctx *fiber.Ctx) error
+	GetCaixaAtual(ctx *fiber.Ctx) error
+	GetCaixaByID(ctx *fiber.Ctx) error
+	GetCaixaMovimentos(ctx *fiber.Ctx) error
+	RegistrarSangria(ctx *fiber.Ctx) error
+	RegistrarSuprimento(ctx *fiber.Ctx) error
+	FecharCaixa(ctx *fiber.Ctx) error
+	GetRelatorioCaixa(ctx *fiber.Ctx) error
+
 	// Estoque
 	GetAllEstoque(ctx *fiber.Ctx) error
 	CreateEstoque(ctx *fiber.Ctx) error
+	CreateSaidaEstoque(ctx *fiber.Ctx) error
 
 	// Clientes
 	GetAllClientes(ctx *fiber.Ctx) error
@@ -71,6 +119,32 @@ type ControllerInterface interface {
 	CreateCampanha(ctx *fiber.Ctx) error
 	AssociarPublicosCampanha(ctx *fiber.Ctx) error
 	GetPublicosCampanha(ctx *fiber.Ctx) error
+	DispararCampanha(ctx *fiber.Ctx) error
+	GetEnviosCampanha(ctx *fiber.Ctx) error
+	GetCanaisCampanha(ctx *fiber.Ctx) error
+	SetCanaisCampanha(ctx *fiber.Ctx) error
+	ValidarCampanha(ctx *fiber.Ctx) error
+	PausarCampanha(ctx *fiber.Ctx) error
+	RetomarCampanha(ctx *fiber.Ctx) error
+	RegistrarAbertura(ctx *fiber.Ctx) error
+	RegistrarClique(ctx *fiber.Ctx) error
+	RegistrarStatusEnvio(ctx *fiber.Ctx) error
+	ConfirmarDescadastro(ctx *fiber.Ctx) error
+	RegistrarDescadastro(ctx *fiber.Ctx) error
+
+	// Consentimentos de clientes
+	GetConsentimentosCliente(ctx *fiber.Ctx) error
+	SetConsentimentosCliente(ctx *fiber.Ctx) error
+	GetHistoricoConsentimentos(ctx *fiber.Ctx) error
+
+	// Templates de mensagens
+	GetTemplatesMensagens(ctx *fiber.Ctx) error
+	GetTemplateMensagemByID(ctx *fiber.Ctx) error
+	CreateTemplateMensagem(ctx *fiber.Ctx) error
+	UpdateTemplateMensagem(ctx *fiber.Ctx) error
+	DeleteTemplateMensagem(ctx *fiber.Ctx) error
+	GetCamposTemplate(ctx *fiber.Ctx) error
+	PreviewTemplateMensagem(ctx *fiber.Ctx) error
 
 	// Endereços
 	GetAllEnderecos(ctx *fiber.Ctx) error
@@ -79,12 +153,24 @@ type ControllerInterface interface {
 
 	// Critérios
 	GetAllCriterios(ctx *fiber.Ctx) error
+	GetCamposCriterios(ctx *fiber.Ctx) error
+	CreateCriterio(ctx *fiber.Ctx) error
 
 	// Públicos
 	GetAllPublicos(ctx *fiber.Ctx) error
 	CreatePublico(ctx *fiber.Ctx) error
 	AssociarCriteriosPublico(ctx *fiber.Ctx) error
 	GetCriteriosPublico(ctx *fiber.Ctx) error
+	PreviewPublico(ctx *fiber.Ctx) error
+	UpdateTipoPublico(ctx *fiber.Ctx) error
+	AtualizarPublico(ctx *fiber.Ctx) error
+	GetHistoricoPublico(ctx *fiber.Ctx) error
+	AdicionarMembrosPublico(ctx *fiber.Ctx) error
+	RemoverMembrosPublico(ctx *fiber.Ctx) error
+	ExcluirClientesPublico(ctx *fiber.Ctx) error
+	RemoverExclusaoPublico(ctx *fiber.Ctx) error
+	GetExclusoesPublico(ctx *fiber.Ctx) error
+	OperacaoPublicos(ctx *fiber.Ctx) error
 	GetClientesDoPublico(ctx *fiber.Ctx) error
 	GetClientesDoPublicoTest(ctx *fiber.Ctx) error
 
@@ -94,6 +180,51 @@ type ControllerInterface interface {
 
 	// Completude
 	GetCompletudeClientes(ctx *fiber.Ctx) error
+
+	// Alertas de Estoque
+	GetAlertasEstoque(ctx *fiber.Ctx) error
+	GerarAlertasEstoque(ctx *fiber.Ctx) error
+	ResolverAlertaEstoque(ctx *fiber.Ctx) error
+	UpdateEstoqueMinimo(ctx *fiber.Ctx) error
+
+	// Inventários
+	CreateInventario(ctx *fiber.Ctx) error
+	GetAllInventarios(ctx *fiber.Ctx) error
+	GetInventarioByID(ctx *fiber.Ctx) error
+	RegistrarContagem(ctx *fiber.Ctx) error
+	GetDivergenciasInventario(ctx *fiber.Ctx) error
+	FecharInventario(ctx *fiber.Ctx) error
+	CancelarInventario(ctx *fiber.Ctx) error
+
+	// Relatórios
+	GetValorizacaoEstoque(ctx *fiber.Ctx) error
+	GetMargemBruta(ctx *fiber.Ctx) error
+
+	// Preços
+	GetHistoricoPrecos(ctx *fiber.Ctx) error
+	CreatePrecoAgendado(ctx *fiber.Ctx) error
+	GetPrecosAgendados(ctx *fiber.Ctx) error
+	CancelarPrecoAgendado(ctx *fiber.Ctx) error
+	GetPrecoVigente(ctx *fiber.Ctx) error
+
+	// Categorias
+	GetCategorias(ctx *fiber.Ctx) error
+	GetCategoriaByID(ctx *fiber.Ctx) error
+	CreateCategoria(ctx *fiber.Ctx) error
+	UpdateCategoria(ctx *fiber.Ctx) error
+	MoverCategoria(ctx *fiber.Ctx) error
+	MesclarCategoria(ctx *fiber.Ctx) error
+	DeleteCategoria(ctx *fiber.Ctx) error
+	GetEspecies(ctx *fiber.Ctx) error
+	NormalizarCatalogo(ctx *fiber.Ctx) error
+
+	// Importações
+	ImportarProdutos(ctx *fiber.Ctx) error
+	ImportarClientes(ctx *fiber.Ctx) error
+	ImportarFornecedores(ctx *fiber.Ctx) error
+	GetImportacoes(ctx *fiber.Ctx) error
+	GetImportacaoByID(ctx *fiber.Ctx) error
+	GetImportacaoErros(ctx *fiber.Ctx) error
 }
 
 type Controller struct {
@@ -165,6 +296,12 @@ func (ctl *Controller) GetAllFornecedores(ctx *fiber.Ctx) error {
 	limit := ctx.QueryInt("limit", 30)
 
 	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarFornecedoresService(userID)
+		return exportar(ctx, "fornecedores", formato, dtos.FornecedorResponse{}, exportarFn, err)
+	}
+
 	fornecedores, err := ctl.service.GetAllFornecedoresService(userID, page, limit)
 	if err != nil {
 		zap.L().Error("❌ Erro ao buscar fornecedores", zap.Error(err))
@@ -300,6 +437,12 @@ func (ctl *Controller) GetAllProducts(ctx *fiber.Ctx) error {
 	limit := ctx.QueryInt("limit", 30)
 
 	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarProductsService(userID)
+		return exportar(ctx, "produtos", formato, dtos.ProductResponse{}, exportarFn, err)
+	}
+
 	products, err := ctl.service.GetAllProductsService(userID, page, limit)
 	if err != nil {
 		zap.L().Error("Error getting all products", zap.Error(err))
@@ -361,6 +504,75 @@ func (ctl *Controller) DeleteProduct(ctx *fiber.Ctx) error {
 	})
 }
 
+func (ctl *Controller) GetProductByID(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get product by ID controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	product, err := ctl.service.GetProductByIDService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting product by ID", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(product)
+}
+
+func (ctl *Controller) GetProductByBarcode(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get product by barcode controller")
+
+	barcode := ctx.Params("codigo")
+	userID := ctx.Locals("userID").(string)
+
+	product, err := ctl.service.GetProductByBarcodeService(userID, barcode)
+	if err != nil {
+		zap.L().Error("Error getting product by barcode", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(product)
+}
+
+func (ctl *Controller) GetProductBySKU(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get product by SKU controller")
+
+	sku := ctx.Params("sku")
+	userID := ctx.Locals("userID").(string)
+
+	product, err := ctl.service.GetProductBySKUService(userID, sku)
+	if err != nil {
+		zap.L().Error("Error getting product by SKU", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(product)
+}
+
+func (ctl *Controller) UpdateProduct(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting update product controller")
+
+	id := ctx.Params("id")
+	updateProduct := ctx.Locals("updateProduct").(dtos.UpdateProductRequest)
+	userID := ctx.Locals("userID").(string)
+
+	product, err := ctl.service.UpdateProductService(userID, id, updateProduct)
+	if err != nil {
+		zap.L().Error("Error updating product", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(product)
+}
+
 // FUNÇÕES DE PEDIDOS ------------------------------------------------------------------------------------------------------------------------------------
 
 func (ctl *Controller) GetAllPedidos(ctx *fiber.Ctx) error {
@@ -371,6 +583,12 @@ func (ctl *Controller) GetAllPedidos(ctx *fiber.Ctx) error {
 	limit := ctx.QueryInt("limit", 30)
 
 	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarPedidosService(userID)
+		return exportar(ctx, "pedidos", formato, dtos.PedidoResponse{}, exportarFn, err)
+	}
+
 	pedidos, err := ctl.service.GetAllPedidosService(userID, page, limit)
 	if err != nil {
 		zap.L().Error("❌ Erro ao buscar pedidos", zap.Error(err))
@@ -483,6 +701,12 @@ func (ctl *Controller) GetAllEstoque(ctx *fiber.Ctx) error {
 	zap.L().Info("Starting get all estoque controller")
 
 	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarEstoqueService(userID)
+		return exportar(ctx, "estoque", formato, dtos.DetalhesEstoqueResponse{}, exportarFn, err)
+	}
+
 	page := ctx.QueryInt("page", 1)
 	limit := ctx.QueryInt("limit", 10)
 
@@ -539,6 +763,12 @@ func (ctl *Controller) GetAllClientes(ctx *fiber.Ctx) error {
 	limit := ctx.QueryInt("limit", 30)
 
 	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarClientesService(userID)
+		return exportar(ctx, "clientes", formato, dtos.ClienteResponse{}, exportarFn, err)
+	}
+
 	clientes, err := ctl.service.GetAllClientesService(userID, page, limit)
 	if err != nil {
 		zap.L().Error("❌ Erro ao buscar clientes", zap.Error(err))
@@ -641,6 +871,12 @@ func (ctl *Controller) GetAllEnderecos(ctx *fiber.Ctx) error {
 	limit := ctx.QueryInt("limit", 30)
 
 	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarEnderecosService(userID)
+		return exportar(ctx, "enderecos", formato, dtos.EnderecoResponse{}, exportarFn, err)
+	}
+
 	enderecos, err := ctl.service.GetAllEnderecosService(userID, page, limit)
 	if err != nil {
 		zap.L().Error("❌ Erro ao buscar endereços", zap.Error(err))
@@ -745,6 +981,33 @@ func (ctl *Controller) GetAllCriterios(ctx *fiber.Ctx) error {
 	return ctx.Status(fiber.StatusOK).JSON(criterios)
 }
 
+// CreateCriterio cadastra um critério parametrizado, reutilizável nas regras dos públicos
+func (ctl *Controller) CreateCriterio(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create criterio controller")
+
+	request := ctx.Locals("createCriterio").(dtos.CreateCriterioRequest)
+	userID := ctx.Locals("userID").(string)
+
+	criterio, err := ctl.service.CreateCriterioService(userID, request)
+	if err != nil {
+		zap.L().Error("Error creating criterio", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(criterio)
+}
+
+// GetCamposCriterios lista os campos, operadores e valores aceitos nas regras dos públicos
+func (ctl *Controller) GetCamposCriterios(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get campos criterios controller")
+
+	campos := ctl.service.GetCamposCriteriosService()
+
+	return ctx.Status(fiber.StatusOK).JSON(campos)
+}
+
 // FUNÇÕES DE PÚBLICOS ------------------------------------------------------------------------------------------------------------------------------------
 
 func (ctl *Controller) GetAllPublicos(ctx *fiber.Ctx) error {
@@ -755,6 +1018,12 @@ func (ctl *Controller) GetAllPublicos(ctx *fiber.Ctx) error {
 	limit := ctx.QueryInt("limit", 30)
 
 	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarPublicosService(userID)
+		return exportar(ctx, "publicos", formato, dtos.PublicoResponse{}, exportarFn, err)
+	}
+
 	publicos, err := ctl.service.GetAllPublicosService(userID, page, limit)
 	if err != nil {
 		zap.L().Error("❌ Erro ao buscar públicos", zap.Error(err))
@@ -826,6 +1095,24 @@ func (ctl *Controller) AssociarCriteriosPublico(ctx *fiber.Ctx) error {
 	})
 }
 
+// PreviewPublico mostra o total, o detalhamento e uma amostra dos clientes de uma regra sem gravar o público
+func (ctl *Controller) PreviewPublico(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting preview publico controller")
+
+	request := ctx.Locals("previewPublico").(dtos.PreviewPublicoRequest)
+	userID := ctx.Locals("userID").(string)
+
+	preview, err := ctl.service.PreviewPublicoService(userID, request)
+	if err != nil {
+		zap.L().Error("Error getting publico preview", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(preview)
+}
+
 func (ctl *Controller) GetCriteriosPublico(ctx *fiber.Ctx) error {
 	zap.L().Info("📋 Buscando critérios do público")
 
@@ -850,6 +1137,12 @@ func (ctl *Controller) GetClientesDoPublico(ctx *fiber.Ctx) error {
 	idPublico := ctx.Params("id")
 	userID := ctx.Locals("userID").(string)
 
+	// A exportação leva só os clientes que consentiram no canal (?canal=) ou em algum canal
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarClientesPublicoService(userID, idPublico, ctx.Query("canal"))
+		return exportar(ctx, "clientes_publico", formato, dtos.ClienteResponse{}, exportarFn, err)
+	}
+
 	// Obter parâmetros de paginação da query string
 	page := ctx.QueryInt("page", 1)
 	limit := ctx.QueryInt("limit", 30)
@@ -897,6 +1190,12 @@ func (ctl *Controller) GetAllPets(ctx *fiber.Ctx) error {
 	limit := ctx.QueryInt("limit", 30)
 
 	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarPetsService(userID)
+		return exportar(ctx, "pets", formato, dtos.PetResponse{}, exportarFn, err)
+	}
+
 	pets, err := ctl.service.GetAllPetsService(userID, page, limit)
 	if err != nil {
 		zap.L().Error("❌ Erro ao buscar pets", zap.Error(err))
@@ -1045,6 +1344,12 @@ func (ctl *Controller) GetAllTags(ctx *fiber.Ctx) error {
 	limit := ctx.QueryInt("limit", 30)
 
 	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarTagsService(userID)
+		return exportar(ctx, "tags", formato, dtos.TagResponse{}, exportarFn, err)
+	}
+
 	tags, err := ctl.service.GetAllTagsService(userID, page, limit)
 	if err != nil {
 		zap.L().Error("❌ Erro ao buscar tags", zap.Error(err))
@@ -1092,6 +1397,12 @@ func (ctl *Controller) GetAllCampanhas(ctx *fiber.Ctx) error {
 	limit := ctx.QueryInt("limit", 30)
 
 	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarCampanhasService(userID)
+		return exportar(ctx, "campanhas", formato, dtos.CampanhaResponse{}, exportarFn, err)
+	}
+
 	campanhas, err := ctl.service.GetAllCampanhasService(userID, page, limit)
 	if err != nil {
 		zap.L().Error("❌ Erro ao buscar campanhas", zap.Error(err))
@@ -1110,7 +1421,7 @@ func (ctl *Controller) GetCampanhaByID(ctx *fiber.Ctx) error {
 	id := ctx.Params("id")
 	userID := ctx.Locals("userID").(string)
 
-	campanha, err := ctl.service.GetCampanhaByIDService(userID, id)
+	campanha, err := ctl.service.GetCampanhaByIDService(userID, id, ctx.QueryInt("janela", 7))
 	if err != nil {
 		zap.L().Error("Error getting campanha by ID", zap.Error(err))
 		return ctx.Status(err.Code).JSON(fiber.Map{
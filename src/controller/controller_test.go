@@ -16,99 +16,6 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-// Mock para ServiceInterface
-type MockService struct {
-	mock.Mock
-}
-
-func (m *MockService) CreateUserService(request dtos.CreateUser) (*entity.User, *exceptions.RestErr) {
-	args := m.Called(request)
-	if args.Get(1) == nil {
-		return args.Get(0).(*entity.User), nil
-	}
-	return nil, args.Get(1).(*exceptions.RestErr)
-}
-
-func (m *MockService) LoginUserService(request dtos.UserLogin) (string, *exceptions.RestErr) {
-	args := m.Called(request)
-	if args.Get(1) == nil {
-		return args.String(0), nil
-	}
-	return "", args.Get(1).(*exceptions.RestErr)
-}
-
-func (m *MockService) GetAllFornecedoresService(userID string) (*dtos.FornecedorListResponse, *exceptions.RestErr) {
-	args := m.Called(userID)
-	if args.Get(1) == nil {
-		return args.Get(0).(*dtos.FornecedorListResponse), nil
-	}
-	return nil, args.Get(1).(*exceptions.RestErr)
-}
-
-func (m *MockService) CreateFornecedorService(userID string, request dtos.CreateFornecedorRequest) (bool, *exceptions.RestErr) {
-	args := m.Called(userID, request)
-	if args.Get(1) == nil {
-		return args.Bool(0), nil
-	}
-	return false, args.Get(1).(*exceptions.RestErr)
-}
-
-func (m *MockService) ChangeStatusFornecedorService(userID string, id string) (bool, *exceptions.RestErr) {
-	args := m.Called(userID, id)
-	if args.Get(1) == nil {
-		return args.Bool(0), nil
-	}
-	return false, args.Get(1).(*exceptions.RestErr)
-}
-
-func (m *MockService) UpdateFornecedorFieldService(userID string, id string, campo string, valor string) (bool, *exceptions.RestErr) {
-	args := m.Called(userID, id, campo, valor)
-	if args.Get(1) == nil {
-		return args.Bool(0), nil
-	}
-	return false, args.Get(1).(*exceptions.RestErr)
-}
-
-func (m *MockService) DeleteFornecedorService(userID string, id string) (bool, *exceptions.RestErr) {
-	args := m.Called(userID, id)
-	if args.Get(1) == nil {
-		return args.Bool(0), nil
-	}
-	return false, args.Get(1).(*exceptions.RestErr)
-}
-
-func (m *MockService) GetAllProductsService(userID string) (*dtos.ProductListResponse, *exceptions.RestErr) {
-	args := m.Called(userID)
-	if args.Get(1) == nil {
-		return args.Get(0).(*dtos.ProductListResponse), nil
-	}
-	return nil, args.Get(1).(*exceptions.RestErr)
-}
-
-func (m *MockService) CreateProductService(userID string, request dtos.CreateProductRequest) (bool, *exceptions.RestErr) {
-	args := m.Called(userID, request)
-	if args.Get(1) == nil {
-		return args.Bool(0), nil
-	}
-	return false, args.Get(1).(*exceptions.RestErr)
-}
-
-func (m *MockService) DeleteProductService(userID string, id string) (bool, *exceptions.RestErr) {
-	args := m.Called(userID, id)
-	if args.Get(1) == nil {
-		return args.Bool(0), nil
-	}
-	return false, args.Get(1).(*exceptions.RestErr)
-}
-
-func (m *MockService) GetAllPedidosService(userID string) (*dtos.PedidoListResponse, *exceptions.RestErr) {
-	args := m.Called(userID)
-	if args.Get(1) == nil {
-		return args.Get(0).(*dtos.PedidoListResponse), nil
-	}
-	return nil, args.Get(1).(*exceptions.RestErr)
-}
-
 // Helper function para criar app de teste
 func setupTestApp(_ ControllerInterface) *fiber.App {
 	app := fiber.New(fiber.Config{
@@ -366,7 +273,7 @@ func TestController_GetAllFornecedores_Success(t *testing.T) {
 		Total: 2,
 	}
 
-	mockService.On("GetAllFornecedoresService", "1").Return(expectedResponse, nil)
+	mockService.On("GetAllFornecedoresService", "1", 1, 30).Return(expectedResponse, nil)
 
 	// Act
 	req := httptest.NewRequest("GET", "/fornecedores", nil)
@@ -448,7 +355,7 @@ func TestController_GetAllProducts_Success(t *testing.T) {
 		Total: 1,
 	}
 
-	mockService.On("GetAllProductsService", "1").Return(expectedResponse, nil)
+	mockService.On("GetAllProductsService", "1", 1, 30).Return(expectedResponse, nil)
 
 	// Act
 	req := httptest.NewRequest("GET", "/produtos", nil)
@@ -490,7 +397,7 @@ func TestController_GetAllPedidos_Success(t *testing.T) {
 		Total: 1,
 	}
 
-	mockService.On("GetAllPedidosService", "1").Return(expectedResponse, nil)
+	mockService.On("GetAllPedidosService", "1", 1, 30).Return(expectedResponse, nil)
 
 	// Act
 	req := httptest.NewRequest("GET", "/pedidos", nil)
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE DEVOLUÇÕES ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) CreateDevolucao(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create devolucao controller")
+
+	id := ctx.Params("id")
+	createDevolucao := ctx.Locals("createDevolucao").(dtos.CreateDevolucaoRequest)
+
+	userID := ctx.Locals("userID").(string)
+	devolucao, err := ctl.service.CreateDevolucaoService(userID, id, createDevolucao)
+	if err != nil {
+		zap.L().Error("Error creating devolucao", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(devolucao)
+}
+
+func (ctl *Controller) GetAllDevolucoes(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get all devolucoes controller")
+
+	userID := ctx.Locals("userID").(string)
+	idVenda := ctx.Query("id_venda")
+	idCliente := ctx.Query("id_cliente")
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarDevolucoesService(userID, idVenda, idCliente)
+		return exportar(ctx, "devolucoes", formato, dtos.DevolucaoResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	devolucoes, err := ctl.service.GetAllDevolucoesService(userID, idVenda, idCliente, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting devolucoes", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(devolucoes)
+}
+
+func (ctl *Controller) GetDevolucaoByID(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get devolucao by ID controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	devolucao, err := ctl.service.GetDevolucaoByIDService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting devolucao by ID", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(devolucao)
+}
+
+func (ctl *Controller) GetCreditosCliente(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get creditos cliente controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	extrato, err := ctl.service.GetCreditosClienteService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting creditos cliente", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(extrato)
+}
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE DISPAROS DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+// DispararCampanha coloca na fila os envios da campanha; o envio acontece em segundo plano
+func (ctl *Controller) DispararCampanha(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting disparar campanha controller")
+
+	request := ctx.Locals("dispararCampanha").(dtos.DispararCampanhaRequest)
+
+	userID := ctx.Locals("userID").(string)
+	resultado, err := ctl.service.DispararCampanhaService(userID, ctx.Params("id"), request)
+	if err != nil {
+		zap.L().Error("Error dispatching campanha", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusAccepted).JSON(resultado)
+}
+
+func (ctl *Controller) GetEnviosCampanha(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get envios campanha controller")
+
+	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarEnviosCampanhaService(userID, ctx.Params("id"), ctx.Query("canal"), ctx.Query("status"))
+		return exportar(ctx, "envios", formato, dtos.CampanhaEnvioResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	envios, err := ctl.service.GetEnviosCampanhaService(userID, ctx.Params("id"), ctx.Query("canal"), ctx.Query("status"), page, limit)
+	if err != nil {
+		zap.L().Error("Error getting envios of campanha", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(envios)
+}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"bufio"
+	"fmt"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	"github.com/betine97/back-project.git/src/model/service"
+	"github.com/betine97/back-project.git/src/view"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE EXPORTAÇÃO ------------------------------------------------------------------------------------------------------------------------------------
+
+// formatoExportacao devolve o ?format= pedido, ou vazio quando a listagem deve responder em JSON
+func formatoExportacao(ctx *fiber.Ctx) string {
+	formato := ctx.Query("format")
+	if formato == "json" {
+		return ""
+	}
+	return formato
+}
+
+// exportar responde com o arquivo da listagem, escrevendo os registros conforme são lidos do banco.
+// modelo é um valor do DTO de resposta, usado para montar o cabeçalho.
+func exportar(ctx *fiber.Ctx, nome string, formato string, modelo interface{}, exportarFn service.ExportarFunc, restErr *exceptions.RestErr) error {
+	zap.L().Info("Starting exportar controller", zap.String("recurso", nome), zap.String("format", formato))
+
+	contentType := view.ContentTypeExportacao(formato)
+	if contentType == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Invalid format '%s', expected 'json', 'csv' or 'xlsx'", formato),
+		})
+	}
+	if restErr != nil {
+		zap.L().Error("Error exporting", zap.String("recurso", nome), zap.Error(restErr))
+		return ctx.Status(restErr.Code).JSON(fiber.Map{
+			"error": restErr.Error(),
+		})
+	}
+
+	ctx.Set(fiber.HeaderContentType, contentType)
+	ctx.Attachment(fmt.Sprintf("%s_%s.%s", nome, time.Now().Format("2006-01-02"), formato))
+
+	// O status e os cabeçalhos já foram enviados quando o streaming começa; erros a partir daqui só podem ser registrados
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		writer, err := view.NewExportWriter(w, formato, modelo)
+		if err != nil {
+			zap.L().Error("Error starting export", zap.String("recurso", nome), zap.Error(err))
+			return
+		}
+		if err := exportarFn(writer.Write); err != nil {
+			zap.L().Error("Error streaming export", zap.String("recurso", nome), zap.Error(err))
+			return
+		}
+		if err := writer.Close(); err != nil {
+			zap.L().Error("Error finishing export", zap.String("recurso", nome), zap.Error(err))
+			return
+		}
+		zap.L().Info("Export completed successfully", zap.String("recurso", nome))
+	})
+	return nil
+}
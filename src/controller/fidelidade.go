@@ -0,0 +1,91 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE FIDELIDADE ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetFidelidadeConfig(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get fidelidade config controller")
+
+	userID := ctx.Locals("userID").(string)
+	config, err := ctl.service.GetFidelidadeConfigService(userID)
+	if err != nil {
+		zap.L().Error("Error getting fidelidade config", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(config)
+}
+
+func (ctl *Controller) UpdateFidelidadeConfig(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting update fidelidade config controller")
+
+	updateConfig := ctx.Locals("updateFidelidadeConfig").(dtos.UpdateFidelidadeConfigRequest)
+
+	userID := ctx.Locals("userID").(string)
+	config, err := ctl.service.UpdateFidelidadeConfigService(userID, updateConfig)
+	if err != nil {
+		zap.L().Error("Error updating fidelidade config", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(config)
+}
+
+func (ctl *Controller) ExpirarPontos(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting expirar pontos controller")
+
+	userID := ctx.Locals("userID").(string)
+	resultado, err := ctl.service.ExpirarPontosService(userID)
+	if err != nil {
+		zap.L().Error("Error expiring pontos", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resultado)
+}
+
+func (ctl *Controller) GetPontosCliente(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get pontos cliente controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	extrato, err := ctl.service.GetPontosClienteService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting pontos cliente", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(extrato)
+}
+
+func (ctl *Controller) ResgatarPontos(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting resgatar pontos controller")
+
+	id := ctx.Params("id")
+	resgatarPontos := ctx.Locals("resgatarPontos").(dtos.ResgatarPontosRequest)
+
+	userID := ctx.Locals("userID").(string)
+	resgate, err := ctl.service.ResgatarPontosService(userID, id, resgatarPontos)
+	if err != nil {
+		zap.L().Error("Error redeeming pontos", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(resgate)
+}
@@ -26,7 +26,7 @@ func TestController_GetAllFornecedores_ServiceError(t *testing.T) {
 		return controller.GetAllFornecedores(c)
 	})
 
-	mockService.On("GetAllFornecedoresService", "1").Return(nil, exceptions.NewInternalServerError("Database connection failed"))
+	mockService.On("GetAllFornecedoresService", "1", 1, 30).Return(nil, exceptions.NewInternalServerError("Database connection failed"))
 
 	// Act
 	req := httptest.NewRequest("GET", "/fornecedores", nil)
@@ -0,0 +1,180 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/betine97/back-project.git/src/controller/middlewares"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE IMPORTAÇÃO ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) ImportarProdutos(ctx *fiber.Ctx) error {
+	return ctl.importar(ctx, entity.ImportacaoRecursoProdutos)
+}
+
+func (ctl *Controller) ImportarClientes(ctx *fiber.Ctx) error {
+	return ctl.importar(ctx, entity.ImportacaoRecursoClientes)
+}
+
+func (ctl *Controller) ImportarFornecedores(ctx *fiber.Ctx) error {
+	return ctl.importar(ctx, entity.ImportacaoRecursoFornecedores)
+}
+
+// importar recebe o formulário multipart: arquivo (CSV ou XLSX), mapeamento (JSON opcional), dry_run e atualizar_existentes
+func (ctl *Controller) importar(ctx *fiber.Ctx, recurso string) error {
+	zap.L().Info("Starting importar controller", zap.String("recurso", recurso))
+
+	userID := ctx.Locals("userID").(string)
+
+	arquivo, err := ctx.FormFile("arquivo")
+	if err != nil {
+		zap.L().Warn("Import file not sent", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Field 'arquivo' with a CSV or XLSX file is required",
+		})
+	}
+
+	aberto, err := arquivo.Open()
+	if err != nil {
+		zap.L().Error("Error opening import file", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error reading the file",
+		})
+	}
+	defer aberto.Close()
+
+	conteudo, err := io.ReadAll(aberto)
+	if err != nil {
+		zap.L().Error("Error reading import file", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error reading the file",
+		})
+	}
+
+	request := dtos.ImportacaoRequest{
+		NomeArquivo:         arquivo.Filename,
+		Conteudo:            conteudo,
+		DryRun:              formBool(ctx, "dry_run"),
+		AtualizarExistentes: formBool(ctx, "atualizar_existentes"),
+	}
+
+	if mapeamento := ctx.FormValue("mapeamento"); mapeamento != "" {
+		if err := json.Unmarshal([]byte(mapeamento), &request.Mapeamento); err != nil {
+			zap.L().Warn("Invalid mapeamento", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Field 'mapeamento' must be a JSON object like {\"column\": \"field\"}",
+			})
+		}
+	}
+
+	iniciada, restErr := ctl.service.ImportarService(userID, recurso, request, middlewares.ValidarCampos)
+	if restErr != nil {
+		zap.L().Error("Error starting importacao", zap.Error(restErr))
+		return ctx.Status(restErr.Code).JSON(fiber.Map{
+			"error": restErr.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"message":           "Importacao started successfully",
+		"id_importacao":     iniciada.ID,
+		"status":            iniciada.Status,
+		"total_linhas":      iniciada.TotalLinhas,
+		"colunas":           iniciada.Colunas,
+		"colunas_ignoradas": iniciada.ColunasIgnoradas,
+	})
+}
+
+func (ctl *Controller) GetImportacoes(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get importacoes controller")
+
+	userID := ctx.Locals("userID").(string)
+	recurso := ctx.Query("recurso")
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarImportacoesService(userID, recurso)
+		return exportar(ctx, "importacoes", formato, dtos.ImportacaoResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	importacoes, err := ctl.service.GetImportacoesService(userID, recurso, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting importacoes", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(importacoes)
+}
+
+func (ctl *Controller) GetImportacaoByID(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get importacao by ID controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	importacao, err := ctl.service.GetImportacaoByIDService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting importacao by ID", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(importacao)
+}
+
+func (ctl *Controller) GetImportacaoErros(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get importacao erros controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarImportacaoErrosService(userID, id)
+		return exportar(ctx, "importacao_erros", formato, dtos.ImportacaoErroResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	erros, err := ctl.service.GetImportacaoErrosService(userID, id, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting importacao erros", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(erros)
+}
+
+// formBool aceita a opção no formulário ou na query string
+func formBool(ctx *fiber.Ctx, campo string) bool {
+	if valor := ctx.FormValue(campo); valor != "" {
+		return valor == "true" || valor == "1"
+	}
+	return ctx.QueryBool(campo, false)
+}
@@ -0,0 +1,172 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE INVENTÁRIO ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) CreateInventario(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create inventario controller")
+
+	createInventario := ctx.Locals("createInventario").(dtos.CreateInventarioRequest)
+
+	userID := ctx.Locals("userID").(string)
+	inventarioID, err := ctl.service.CreateInventarioService(userID, createInventario)
+	if err != nil {
+		zap.L().Error("Error creating inventario", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if inventarioID == 0 {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Error creating inventario",
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message":       "Inventario created successfully",
+		"id_inventario": inventarioID,
+	})
+}
+
+func (ctl *Controller) GetAllInventarios(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get all inventarios controller")
+
+	userID := ctx.Locals("userID").(string)
+	status := ctx.Query("status")
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarInventariosService(userID, status)
+		return exportar(ctx, "inventarios", formato, dtos.InventarioResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	inventarios, err := ctl.service.GetAllInventariosService(userID, status, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting inventarios", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(inventarios)
+}
+
+func (ctl *Controller) GetInventarioByID(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get inventario by ID controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	inventario, err := ctl.service.GetInventarioByIDService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting inventario by ID", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(inventario)
+}
+
+func (ctl *Controller) RegistrarContagem(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting registrar contagem controller")
+
+	id := ctx.Params("id")
+	request := ctx.Locals("registrarContagem").(dtos.RegistrarContagemRequest)
+	userID := ctx.Locals("userID").(string)
+
+	resultado, err := ctl.service.RegistrarContagemService(userID, id, request)
+	if err != nil {
+		zap.L().Error("Error registering contagem", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resultado)
+}
+
+func (ctl *Controller) GetDivergenciasInventario(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get divergencias inventario controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	divergencias, err := ctl.service.GetDivergenciasInventarioService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting divergencias inventario", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(divergencias)
+}
+
+func (ctl *Controller) FecharInventario(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting fechar inventario controller")
+
+	id := ctx.Params("id")
+	var request dtos.FecharInventarioRequest
+
+	// O corpo é opcional; sem ele apenas os lotes contados são ajustados
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&request); err != nil {
+			zap.L().Error("Error reading request data", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Unable to read request data",
+			})
+		}
+	}
+
+	userID := ctx.Locals("userID").(string)
+	resultado, err := ctl.service.FecharInventarioService(userID, id, request)
+	if err != nil {
+		zap.L().Error("Error closing inventario", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resultado)
+}
+
+func (ctl *Controller) CancelarInventario(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting cancelar inventario controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	success, err := ctl.service.CancelarInventarioService(userID, id)
+	if err != nil {
+		zap.L().Error("Error canceling inventario", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if !success {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Error canceling inventario",
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Inventario canceled successfully",
+	})
+}
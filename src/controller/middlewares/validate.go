@@ -4,9 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/betine97/back-project.git/cmd/config/exceptions"
 	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/betine97/back-project.git/src/model/service/catalogo"
+	"github.com/betine97/back-project.git/src/model/service/gtin"
 	"github.com/go-playground/locales/en"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
@@ -25,6 +29,39 @@ func init() {
 	unt := ut.New(en, en)
 	transl, _ = unt.GetTranslator("en")
 	en_translation.RegisterDefaultTranslations(Validate, transl)
+
+	// Código de barras no padrão GTIN (EAN-13, EAN-8, UPC-A ou GTIN-14) com dígito verificador
+	Validate.RegisterValidation("gtin", func(fl validator.FieldLevel) bool {
+		return fl.Field().String() == "" || gtin.Valido(fl.Field().String())
+	})
+	Validate.RegisterTranslation("gtin", transl, func(ut ut.Translator) error {
+		return ut.Add("gtin", "{0} must be a valid GTIN/EAN-13 barcode", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("gtin", fe.Field())
+		return t
+	})
+
+	// Espécies e público-alvo da lista controlada (aceita variações de grafia, como "cães" ou "GATOS")
+	Validate.RegisterValidation("especie", func(fl validator.FieldLevel) bool {
+		_, ok := catalogo.NormalizarEspecie(fl.Field().String())
+		return ok
+	})
+	Validate.RegisterTranslation("especie", transl, func(ut ut.Translator) error {
+		return ut.Add("especie", "{0} must be one of: "+strings.Join(catalogo.Especies, ", "), true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("especie", fe.Field())
+		return t
+	})
+	Validate.RegisterValidation("destinado_para", func(fl validator.FieldLevel) bool {
+		_, ok := catalogo.NormalizarDestinadoPara(fl.Field().String())
+		return fl.Field().String() == "" || ok
+	})
+	Validate.RegisterTranslation("destinado_para", transl, func(ut ut.Translator) error {
+		return ut.Add("destinado_para", "{0} must be one of: "+strings.Join(append(append([]string{}, catalogo.Especies...), catalogo.DestinadoTodos), ", "), true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("destinado_para", fe.Field())
+		return t
+	})
 }
 
 func UserValidationMiddleware(ctx *fiber.Ctx) error {
@@ -233,12 +270,14 @@ func ValidateUnexpectedProductFields(ctx *fiber.Ctx, data []byte) error {
 		"sku":            true,
 		"categoria":      true,
 		"destinado_para": true,
+		"id_categoria":   true,
 		"variacao":       true,
 		"marca":          true,
 		"descricao":      true,
 		"status":         true,
 		"preco_venda":    true,
 		"id_fornecedor":  true,
+		"estoque_minimo": true,
 	}
 
 	var unexpectedFields []string
@@ -964,3 +1003,1323 @@ func CampanhaValidationMiddleware(ctx *fiber.Ctx) error {
 	ctx.Locals("createCampanha", createCampanha)
 	return ctx.Next()
 }
+
+func EstoqueMinimoValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting estoque minimo validation")
+
+	var request dtos.UpdateEstoqueMinimoRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("updateEstoqueMinimo", request)
+	return ctx.Next()
+}
+
+func InventarioValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting inventario validation")
+
+	var request dtos.CreateInventarioRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("createInventario", request)
+	return ctx.Next()
+}
+
+func ContagemInventarioValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting contagem inventario validation")
+
+	var request dtos.RegistrarContagemRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("registrarContagem", request)
+	return ctx.Next()
+}
+
+func ProductUpdateValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting product update validation")
+
+	var updateProduct dtos.UpdateProductRequest
+	data := ctx.Body()
+
+	err := ValidateUnexpectedProductUpdateFields(ctx, data)
+	if err != nil {
+		zap.L().Error("Unexpected fields in the request", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := json.Unmarshal(data, &updateProduct); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(&updateProduct); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("updateProduct", updateProduct)
+	zap.L().Info("Product update validation completed successfully")
+	return ctx.Next()
+}
+
+func ValidateUnexpectedProductUpdateFields(ctx *fiber.Ctx, data []byte) error {
+
+	zap.L().Info("Validating unexpected product update fields")
+
+	var rawMap map[string]interface{}
+
+	if err := json.Unmarshal(data, &rawMap); err != nil {
+		zap.L().Error("Formato de JSON inválido", zap.Error(err))
+		return exceptions.NewBadRequestError("Invalid JSON format")
+	}
+
+	expectedFields := map[string]bool{
+		"codigo_barra":   true,
+		"nome_produto":   true,
+		"sku":            true,
+		"categoria":      true,
+		"destinado_para": true,
+		"id_categoria":   true,
+		"variacao":       true,
+		"marca":          true,
+		"descricao":      true,
+		"status":         true,
+		"preco_venda":    true,
+		"id_fornecedor":  true,
+		"estoque_minimo": true,
+	}
+
+	var unexpectedFields []string
+	for field := range rawMap {
+		if !expectedFields[field] {
+			unexpectedFields = append(unexpectedFields, field)
+		}
+	}
+
+	if len(unexpectedFields) == 0 {
+		return nil
+	}
+
+	return exceptions.NewBadRequestError(fmt.Sprintf("Unexpected fields: %v. Please remove them and try again.", unexpectedFields))
+}
+
+func PrecoAgendadoValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting preco agendado validation")
+
+	var request dtos.CreatePrecoAgendadoRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("createPrecoAgendado", request)
+	return ctx.Next()
+}
+
+func VariacaoValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting variacao validation")
+
+	var request dtos.CreateVariacaoRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("createVariacao", request)
+	return ctx.Next()
+}
+
+func KitComponentesValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting kit componentes validation")
+
+	var request dtos.SetKitComponentesRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("setKitComponentes", request)
+	return ctx.Next()
+}
+
+func SaidaEstoqueValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting saida estoque validation")
+
+	var request dtos.CreateSaidaEstoqueRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("createSaidaEstoque", request)
+	return ctx.Next()
+}
+
+func CreateCategoriaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create categoria validation")
+
+	var request dtos.CreateCategoriaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("createCategoria", request)
+	return ctx.Next()
+}
+
+func UpdateCategoriaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting update categoria validation")
+
+	var request dtos.UpdateCategoriaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("updateCategoria", request)
+	return ctx.Next()
+}
+
+func MoverCategoriaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting mover categoria validation")
+
+	var request dtos.MoverCategoriaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("moverCategoria", request)
+	return ctx.Next()
+}
+
+func MesclarCategoriaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting mesclar categoria validation")
+
+	var request dtos.MesclarCategoriaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("mesclarCategoria", request)
+	return ctx.Next()
+}
+
+// ValidarCampos aplica as regras de validação a um DTO já montado (usado nas importações em lote, linha a linha).
+// Field traz o nome do campo no JSON, que é o mesmo das colunas do arquivo.
+func ValidarCampos(request interface{}) []exceptions.Causes {
+	err := Validate.Struct(request)
+	if err == nil {
+		return nil
+	}
+
+	var jsonValidationError validator.ValidationErrors
+	if !errors.As(err, &jsonValidationError) {
+		return []exceptions.Causes{{FieldMessage: "Error trying to convert fields"}}
+	}
+
+	tipo := reflect.Indirect(reflect.ValueOf(request)).Type()
+	errorsCauses := []exceptions.Causes{}
+	for _, e := range jsonValidationError {
+		campo := e.Field()
+		if field, ok := tipo.FieldByName(e.StructField()); ok {
+			if nome := strings.Split(field.Tag.Get("json"), ",")[0]; nome != "" {
+				campo = nome
+			}
+		}
+		errorsCauses = append(errorsCauses, exceptions.Causes{
+			FieldMessage: e.Translate(transl),
+			Field:        campo,
+		})
+	}
+	return errorsCauses
+}
+
+func VendaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting venda validation")
+
+	var request dtos.CreateVendaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("createVenda", request)
+	return ctx.Next()
+}
+
+func ItemVendaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting item venda validation")
+
+	var request dtos.ItemVendaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("itemVenda", request)
+	return ctx.Next()
+}
+
+func AbrirCaixaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting abrir caixa validation")
+
+	var request dtos.AbrirCaixaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("abrirCaixa", request)
+	return ctx.Next()
+}
+
+func MovimentoCaixaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting movimento caixa validation")
+
+	var request dtos.MovimentoCaixaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("movimentoCaixa", request)
+	return ctx.Next()
+}
+
+func FecharCaixaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting fechar caixa validation")
+
+	var request dtos.FecharCaixaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("fecharCaixa", request)
+	return ctx.Next()
+}
+
+func DevolucaoValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting devolucao validation")
+
+	var request dtos.CreateDevolucaoRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("createDevolucao", request)
+	return ctx.Next()
+}
+
+func FidelidadeConfigValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting fidelidade config validation")
+
+	var request dtos.UpdateFidelidadeConfigRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("updateFidelidadeConfig", request)
+	return ctx.Next()
+}
+
+func ResgatarPontosValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting resgatar pontos validation")
+
+	var request dtos.ResgatarPontosRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("resgatarPontos", request)
+	return ctx.Next()
+}
+
+func CreateCriterioValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create criterio validation")
+
+	var request dtos.CreateCriterioRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("createCriterio", request)
+	return ctx.Next()
+}
+
+func PreviewPublicoValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting preview publico validation")
+
+	var request dtos.PreviewPublicoRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("previewPublico", request)
+	return ctx.Next()
+}
+
+func UpdateTipoPublicoValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting update tipo publico validation")
+
+	var request dtos.UpdateTipoPublicoRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("updateTipoPublico", request)
+	return ctx.Next()
+}
+
+func MembrosPublicoValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting membros publico validation")
+
+	var request dtos.MembrosPublicoRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("membrosPublico", request)
+	return ctx.Next()
+}
+
+func ExcluirClientesPublicoValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting excluir clientes publico validation")
+
+	var request dtos.ExcluirClientesPublicoRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("excluirClientesPublico", request)
+	return ctx.Next()
+}
+
+func OperacaoPublicosValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting operacao publicos validation")
+
+	var request dtos.OperacaoPublicosRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("operacaoPublicos", request)
+	return ctx.Next()
+}
+
+func DispararCampanhaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting disparar campanha validation")
+
+	var request dtos.DispararCampanhaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("dispararCampanha", request)
+	return ctx.Next()
+}
+
+func CanaisCampanhaValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting canais campanha validation")
+
+	var request dtos.CanaisCampanhaRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("canaisCampanha", request)
+	return ctx.Next()
+}
+
+func TemplateMensagemValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting template mensagem validation")
+
+	var request dtos.TemplateMensagemRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("templateMensagem", request)
+	return ctx.Next()
+}
+
+func StatusEnvioValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting status envio validation")
+
+	var request dtos.StatusEnvioRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("statusEnvio", request)
+	return ctx.Next()
+}
+
+func ConsentimentosClienteValidationMiddleware(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting consentimentos cliente validation")
+
+	var request dtos.ConsentimentosClienteRequest
+	data := ctx.Body()
+
+	if err := json.Unmarshal(data, &request); err != nil {
+		zap.L().Error("Error when unmarshalling data", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid field type",
+		})
+	}
+
+	if err := Validate.Struct(request); err != nil {
+		var jsonValidationError validator.ValidationErrors
+		if errors.As(err, &jsonValidationError) {
+			errorsCauses := []exceptions.Causes{}
+			for _, e := range jsonValidationError {
+				cause := exceptions.Causes{
+					FieldMessage: e.Translate(transl),
+					Field:        e.Field(),
+				}
+				errorsCauses = append(errorsCauses, cause)
+			}
+			zap.L().Error("Error validating fields", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"request invalid": exceptions.NewBadRequestValidationError("Some fields are invalid", errorsCauses),
+			})
+		}
+
+		zap.L().Info("Error converting fields", zap.Error(err))
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Error trying to convert fields",
+		})
+	}
+
+	ctx.Locals("consentimentosCliente", request)
+	return ctx.Next()
+}
@@ -0,0 +1,5310 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	exceptions "github.com/betine97/back-project.git/cmd/config/exceptions"
+
+	mock "github.com/stretchr/testify/mock"
+
+	service "github.com/betine97/back-project.git/src/model/service"
+)
+
+// MockService is an autogenerated mock type for the ServiceInterface type
+type MockService struct {
+	mock.Mock
+}
+
+// AbrirCaixaService provides a mock function with given fields: userID, request
+func (_m *MockService) AbrirCaixaService(userID string, request dtos.AbrirCaixaRequest) (*dtos.CaixaSessaoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbrirCaixaService")
+	}
+
+	var r0 *dtos.CaixaSessaoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.AbrirCaixaRequest) (*dtos.CaixaSessaoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.AbrirCaixaRequest) *dtos.CaixaSessaoResponse); ok {
+		r0 = rf(userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CaixaSessaoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.AbrirCaixaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// AdicionarClientesAoPublicoService provides a mock function with given fields: userID, idPublico
+func (_m *MockService) AdicionarClientesAoPublicoService(userID string, idPublico string) (*dtos.AdicionarClientesPublicoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdicionarClientesAoPublicoService")
+	}
+
+	var r0 *dtos.AdicionarClientesPublicoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.AdicionarClientesPublicoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.AdicionarClientesPublicoResponse); ok {
+		r0 = rf(userID, idPublico)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.AdicionarClientesPublicoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// AdicionarItemVendaService provides a mock function with given fields: userID, id, request
+func (_m *MockService) AdicionarItemVendaService(userID string, id string, request dtos.ItemVendaRequest) (*dtos.VendaDetalheResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdicionarItemVendaService")
+	}
+
+	var r0 *dtos.VendaDetalheResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.ItemVendaRequest) (*dtos.VendaDetalheResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.ItemVendaRequest) *dtos.VendaDetalheResponse); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.VendaDetalheResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.ItemVendaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// AdicionarMembrosPublicoService provides a mock function with given fields: userID, idPublico, request
+func (_m *MockService) AdicionarMembrosPublicoService(userID string, idPublico string, request dtos.MembrosPublicoRequest) (*dtos.AdicionarMembrosPublicoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdicionarMembrosPublicoService")
+	}
+
+	var r0 *dtos.AdicionarMembrosPublicoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.MembrosPublicoRequest) (*dtos.AdicionarMembrosPublicoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.MembrosPublicoRequest) *dtos.AdicionarMembrosPublicoResponse); ok {
+		r0 = rf(userID, idPublico, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.AdicionarMembrosPublicoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.MembrosPublicoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// AplicarPrecosAgendadosService provides a mock function with given fields: userID
+func (_m *MockService) AplicarPrecosAgendadosService(userID string) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AplicarPrecosAgendadosService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (int, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// AssociarCriteriosPublicoService provides a mock function with given fields: userID, idPublico, request
+func (_m *MockService) AssociarCriteriosPublicoService(userID string, idPublico string, request dtos.AssociarCriteriosRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssociarCriteriosPublicoService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.AssociarCriteriosRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.AssociarCriteriosRequest) bool); ok {
+		r0 = rf(userID, idPublico, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.AssociarCriteriosRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// AssociarPublicosCampanhaService provides a mock function with given fields: userID, idCampanha, request
+func (_m *MockService) AssociarPublicosCampanhaService(userID string, idCampanha string, request dtos.AssociarPublicosCampanhaRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCampanha, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssociarPublicosCampanhaService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.AssociarPublicosCampanhaRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, idCampanha, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.AssociarPublicosCampanhaRequest) bool); ok {
+		r0 = rf(userID, idCampanha, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.AssociarPublicosCampanhaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCampanha, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// AtribuirTagsClienteService provides a mock function with given fields: userID, clienteID, request
+func (_m *MockService) AtribuirTagsClienteService(userID string, clienteID string, request dtos.AtribuirTagsClienteRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, clienteID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AtribuirTagsClienteService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.AtribuirTagsClienteRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, clienteID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.AtribuirTagsClienteRequest) bool); ok {
+		r0 = rf(userID, clienteID, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.AtribuirTagsClienteRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, clienteID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// AtualizarPublicoService provides a mock function with given fields: userID, idPublico
+func (_m *MockService) AtualizarPublicoService(userID string, idPublico string) (*dtos.AtualizacaoPublicoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AtualizarPublicoService")
+	}
+
+	var r0 *dtos.AtualizacaoPublicoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.AtualizacaoPublicoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.AtualizacaoPublicoResponse); ok {
+		r0 = rf(userID, idPublico)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.AtualizacaoPublicoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// BuscarClientesCriteriosService provides a mock function with given fields: userID, idPublico
+func (_m *MockService) BuscarClientesCriteriosService(userID string, idPublico string) (*dtos.ClienteCriterioListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuscarClientesCriteriosService")
+	}
+
+	var r0 *dtos.ClienteCriterioListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.ClienteCriterioListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.ClienteCriterioListResponse); ok {
+		r0 = rf(userID, idPublico)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ClienteCriterioListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CancelarInventarioService provides a mock function with given fields: userID, id
+func (_m *MockService) CancelarInventarioService(userID string, id string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelarInventarioService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CancelarPrecoAgendadoService provides a mock function with given fields: userID, idProduto, idAgendamento
+func (_m *MockService) CancelarPrecoAgendadoService(userID string, idProduto string, idAgendamento string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, idProduto, idAgendamento)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelarPrecoAgendadoService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, idProduto, idAgendamento)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) bool); ok {
+		r0 = rf(userID, idProduto, idAgendamento)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idProduto, idAgendamento)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CancelarVendaService provides a mock function with given fields: userID, id
+func (_m *MockService) CancelarVendaService(userID string, id string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelarVendaService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ChangeStatusFornecedorService provides a mock function with given fields: userID, id
+func (_m *MockService) ChangeStatusFornecedorService(userID string, id string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeStatusFornecedorService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateCampanhaService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateCampanhaService(userID string, request dtos.CreateCampanhaRequest) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCampanhaService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateCampanhaRequest) (int, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateCampanhaRequest) int); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateCampanhaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateCategoriaService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateCategoriaService(userID string, request dtos.CreateCategoriaRequest) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategoriaService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateCategoriaRequest) (int, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateCategoriaRequest) int); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateCategoriaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateClienteService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateClienteService(userID string, request dtos.CreateClienteRequest) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClienteService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateClienteRequest) (int, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateClienteRequest) int); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateClienteRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateCriterioService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateCriterioService(userID string, request dtos.CreateCriterioRequest) (*dtos.CriterioResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCriterioService")
+	}
+
+	var r0 *dtos.CriterioResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateCriterioRequest) (*dtos.CriterioResponse, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateCriterioRequest) *dtos.CriterioResponse); ok {
+		r0 = rf(userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CriterioResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateCriterioRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateDevolucaoService provides a mock function with given fields: userID, id, request
+func (_m *MockService) CreateDevolucaoService(userID string, id string, request dtos.CreateDevolucaoRequest) (*dtos.DevolucaoDetalheResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDevolucaoService")
+	}
+
+	var r0 *dtos.DevolucaoDetalheResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.CreateDevolucaoRequest) (*dtos.DevolucaoDetalheResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.CreateDevolucaoRequest) *dtos.DevolucaoDetalheResponse); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.DevolucaoDetalheResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.CreateDevolucaoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateEnderecoService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateEnderecoService(userID string, request dtos.CreateEnderecoRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEnderecoService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateEnderecoRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateEnderecoRequest) bool); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateEnderecoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateEstoqueService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateEstoqueService(userID string, request dtos.CreateEstoqueRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEstoqueService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateEstoqueRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateEstoqueRequest) bool); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateEstoqueRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateFornecedorService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateFornecedorService(userID string, request dtos.CreateFornecedorRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFornecedorService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateFornecedorRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateFornecedorRequest) bool); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateFornecedorRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateInventarioService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateInventarioService(userID string, request dtos.CreateInventarioRequest) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInventarioService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateInventarioRequest) (int, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateInventarioRequest) int); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateInventarioRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateItemPedidoService provides a mock function with given fields: userID, idPedido, request
+func (_m *MockService) CreateItemPedidoService(userID string, idPedido string, request dtos.CreateItemPedidoRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPedido, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateItemPedidoService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.CreateItemPedidoRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, idPedido, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.CreateItemPedidoRequest) bool); ok {
+		r0 = rf(userID, idPedido, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.CreateItemPedidoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPedido, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreatePedidoService provides a mock function with given fields: userID, request
+func (_m *MockService) CreatePedidoService(userID string, request dtos.CreatePedidoRequest) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePedidoService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreatePedidoRequest) (int, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreatePedidoRequest) int); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreatePedidoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreatePetService provides a mock function with given fields: userID, request
+func (_m *MockService) CreatePetService(userID string, request dtos.CreatePetRequest) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePetService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreatePetRequest) (int, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreatePetRequest) int); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreatePetRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreatePrecoAgendadoService provides a mock function with given fields: userID, idProduto, request
+func (_m *MockService) CreatePrecoAgendadoService(userID string, idProduto string, request dtos.CreatePrecoAgendadoRequest) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID, idProduto, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePrecoAgendadoService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.CreatePrecoAgendadoRequest) (int, *exceptions.RestErr)); ok {
+		return rf(userID, idProduto, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.CreatePrecoAgendadoRequest) int); ok {
+		r0 = rf(userID, idProduto, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.CreatePrecoAgendadoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idProduto, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateProductService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateProductService(userID string, request dtos.CreateProductRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProductService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateProductRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateProductRequest) bool); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateProductRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreatePublicoService provides a mock function with given fields: userID, request
+func (_m *MockService) CreatePublicoService(userID string, request dtos.CreatePublicoRequest) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePublicoService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreatePublicoRequest) (int, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreatePublicoRequest) int); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreatePublicoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateSaidaEstoqueService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateSaidaEstoqueService(userID string, request dtos.CreateSaidaEstoqueRequest) (*dtos.SaidaEstoqueResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSaidaEstoqueService")
+	}
+
+	var r0 *dtos.SaidaEstoqueResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateSaidaEstoqueRequest) (*dtos.SaidaEstoqueResponse, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateSaidaEstoqueRequest) *dtos.SaidaEstoqueResponse); ok {
+		r0 = rf(userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.SaidaEstoqueResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateSaidaEstoqueRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateTagService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateTagService(userID string, request dtos.CreateTagRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTagService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateTagRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateTagRequest) bool); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateTagRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateTemplateMensagemService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateTemplateMensagemService(userID string, request dtos.TemplateMensagemRequest) (*dtos.TemplateMensagemResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTemplateMensagemService")
+	}
+
+	var r0 *dtos.TemplateMensagemResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.TemplateMensagemRequest) (*dtos.TemplateMensagemResponse, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.TemplateMensagemRequest) *dtos.TemplateMensagemResponse); ok {
+		r0 = rf(userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.TemplateMensagemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.TemplateMensagemRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateUserService provides a mock function with given fields: request
+func (_m *MockService) CreateUserService(request dtos.CreateUser) (*entity.User, *exceptions.RestErr) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserService")
+	}
+
+	var r0 *entity.User
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(dtos.CreateUser) (*entity.User, *exceptions.RestErr)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(dtos.CreateUser) *entity.User); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dtos.CreateUser) *exceptions.RestErr); ok {
+		r1 = rf(request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateVariacaoService provides a mock function with given fields: userID, id, request
+func (_m *MockService) CreateVariacaoService(userID string, id string, request dtos.CreateVariacaoRequest) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariacaoService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.CreateVariacaoRequest) (int, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.CreateVariacaoRequest) int); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.CreateVariacaoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateVendaService provides a mock function with given fields: userID, request
+func (_m *MockService) CreateVendaService(userID string, request dtos.CreateVendaRequest) (int, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVendaService")
+	}
+
+	var r0 int
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateVendaRequest) (int, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.CreateVendaRequest) int); ok {
+		r0 = rf(userID, request)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.CreateVendaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteCategoriaService provides a mock function with given fields: userID, id
+func (_m *MockService) DeleteCategoriaService(userID string, id string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategoriaService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteClienteService provides a mock function with given fields: userID, id
+func (_m *MockService) DeleteClienteService(userID string, id string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteClienteService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteEnderecoService provides a mock function with given fields: userID, idEndereco
+func (_m *MockService) DeleteEnderecoService(userID string, idEndereco string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, idEndereco)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEnderecoService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, idEndereco)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(userID, idEndereco)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idEndereco)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteFornecedorService provides a mock function with given fields: userID, id
+func (_m *MockService) DeleteFornecedorService(userID string, id string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFornecedorService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteProductService provides a mock function with given fields: userID, id
+func (_m *MockService) DeleteProductService(userID string, id string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProductService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteTemplateMensagemService provides a mock function with given fields: userID, id
+func (_m *MockService) DeleteTemplateMensagemService(userID string, id string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTemplateMensagemService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// DispararCampanhaService provides a mock function with given fields: userID, idCampanha, request
+func (_m *MockService) DispararCampanhaService(userID string, idCampanha string, request dtos.DispararCampanhaRequest) (*dtos.DisparoCampanhaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCampanha, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DispararCampanhaService")
+	}
+
+	var r0 *dtos.DisparoCampanhaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.DispararCampanhaRequest) (*dtos.DisparoCampanhaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCampanha, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.DispararCampanhaRequest) *dtos.DisparoCampanhaResponse); ok {
+		r0 = rf(userID, idCampanha, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.DisparoCampanhaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.DispararCampanhaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCampanha, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExcluirClientesPublicoService provides a mock function with given fields: userID, idPublico, request
+func (_m *MockService) ExcluirClientesPublicoService(userID string, idPublico string, request dtos.ExcluirClientesPublicoRequest) (*dtos.ExcluirClientesPublicoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExcluirClientesPublicoService")
+	}
+
+	var r0 *dtos.ExcluirClientesPublicoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.ExcluirClientesPublicoRequest) (*dtos.ExcluirClientesPublicoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.ExcluirClientesPublicoRequest) *dtos.ExcluirClientesPublicoResponse); ok {
+		r0 = rf(userID, idPublico, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ExcluirClientesPublicoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.ExcluirClientesPublicoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExecutarAlertasEstoqueJob provides a mock function with given fields: dias
+func (_m *MockService) ExecutarAlertasEstoqueJob(dias int) {
+	_m.Called(dias)
+}
+
+// ExecutarCampanhasJob provides a mock function with no fields
+func (_m *MockService) ExecutarCampanhasJob() {
+	_m.Called()
+}
+
+// ExecutarDisparosJob provides a mock function with no fields
+func (_m *MockService) ExecutarDisparosJob() {
+	_m.Called()
+}
+
+// ExecutarEnviosParadosJob provides a mock function with no fields
+func (_m *MockService) ExecutarEnviosParadosJob() {
+	_m.Called()
+}
+
+// ExecutarFidelidadeJob provides a mock function with no fields
+func (_m *MockService) ExecutarFidelidadeJob() {
+	_m.Called()
+}
+
+// ExecutarPrecosAgendadosJob provides a mock function with no fields
+func (_m *MockService) ExecutarPrecosAgendadosJob() {
+	_m.Called()
+}
+
+// ExecutarPublicosDinamicosJob provides a mock function with no fields
+func (_m *MockService) ExecutarPublicosDinamicosJob() {
+	_m.Called()
+}
+
+// ExpirarPontosService provides a mock function with given fields: userID
+func (_m *MockService) ExpirarPontosService(userID string) (*dtos.ExpiracaoPontosResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpirarPontosService")
+	}
+
+	var r0 *dtos.ExpiracaoPontosResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (*dtos.ExpiracaoPontosResponse, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dtos.ExpiracaoPontosResponse); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ExpiracaoPontosResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarAlertasEstoqueService provides a mock function with given fields: userID, tipo, status
+func (_m *MockService) ExportarAlertasEstoqueService(userID string, tipo string, status string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, tipo, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarAlertasEstoqueService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, tipo, status)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, tipo, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, tipo, status)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarCaixasService provides a mock function with given fields: userID, status
+func (_m *MockService) ExportarCaixasService(userID string, status string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarCaixasService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, status)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, status)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarCampanhasService provides a mock function with given fields: userID
+func (_m *MockService) ExportarCampanhasService(userID string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarCampanhasService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) service.ExportarFunc); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarClientesPublicoService provides a mock function with given fields: userID, idPublico, canal
+func (_m *MockService) ExportarClientesPublicoService(userID string, idPublico string, canal string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico, canal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarClientesPublicoService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico, canal)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, idPublico, canal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico, canal)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarClientesService provides a mock function with given fields: userID
+func (_m *MockService) ExportarClientesService(userID string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarClientesService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) service.ExportarFunc); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarDevolucoesService provides a mock function with given fields: userID, idVenda, idCliente
+func (_m *MockService) ExportarDevolucoesService(userID string, idVenda string, idCliente string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, idVenda, idCliente)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarDevolucoesService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, idVenda, idCliente)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, idVenda, idCliente)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idVenda, idCliente)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarEnderecosService provides a mock function with given fields: userID
+func (_m *MockService) ExportarEnderecosService(userID string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarEnderecosService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) service.ExportarFunc); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarEnviosCampanhaService provides a mock function with given fields: userID, idCampanha, canal, status
+func (_m *MockService) ExportarEnviosCampanhaService(userID string, idCampanha string, canal string, status string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCampanha, canal, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarEnviosCampanhaService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, idCampanha, canal, status)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, idCampanha, canal, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCampanha, canal, status)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarEstoqueService provides a mock function with given fields: userID
+func (_m *MockService) ExportarEstoqueService(userID string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarEstoqueService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) service.ExportarFunc); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarFornecedoresService provides a mock function with given fields: userID
+func (_m *MockService) ExportarFornecedoresService(userID string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarFornecedoresService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) service.ExportarFunc); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarHistoricoConsentimentosService provides a mock function with given fields: userID, idCliente, canal
+func (_m *MockService) ExportarHistoricoConsentimentosService(userID string, idCliente string, canal string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCliente, canal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarHistoricoConsentimentosService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, idCliente, canal)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, idCliente, canal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCliente, canal)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarHistoricoPrecosService provides a mock function with given fields: userID, idProduto
+func (_m *MockService) ExportarHistoricoPrecosService(userID string, idProduto string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, idProduto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarHistoricoPrecosService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, idProduto)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, idProduto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idProduto)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarImportacaoErrosService provides a mock function with given fields: userID, id
+func (_m *MockService) ExportarImportacaoErrosService(userID string, id string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarImportacaoErrosService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarImportacoesService provides a mock function with given fields: userID, recurso
+func (_m *MockService) ExportarImportacoesService(userID string, recurso string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, recurso)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarImportacoesService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, recurso)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, recurso)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, recurso)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarInventariosService provides a mock function with given fields: userID, status
+func (_m *MockService) ExportarInventariosService(userID string, status string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarInventariosService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, status)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, status)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarPedidosService provides a mock function with given fields: userID
+func (_m *MockService) ExportarPedidosService(userID string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarPedidosService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) service.ExportarFunc); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarPetsService provides a mock function with given fields: userID
+func (_m *MockService) ExportarPetsService(userID string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarPetsService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) service.ExportarFunc); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarProductsService provides a mock function with given fields: userID
+func (_m *MockService) ExportarProductsService(userID string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarProductsService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) service.ExportarFunc); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarPublicosService provides a mock function with given fields: userID
+func (_m *MockService) ExportarPublicosService(userID string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarPublicosService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) service.ExportarFunc); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarRFMClientesService provides a mock function with given fields: userID, segmento
+func (_m *MockService) ExportarRFMClientesService(userID string, segmento string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, segmento)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarRFMClientesService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, segmento)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, segmento)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, segmento)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarTagsService provides a mock function with given fields: userID
+func (_m *MockService) ExportarTagsService(userID string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarTagsService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) service.ExportarFunc); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarTemplatesMensagensService provides a mock function with given fields: userID, canal
+func (_m *MockService) ExportarTemplatesMensagensService(userID string, canal string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, canal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarTemplatesMensagensService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, canal)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, canal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, canal)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExportarVendasService provides a mock function with given fields: userID, status, idCliente
+func (_m *MockService) ExportarVendasService(userID string, status string, idCliente string) (service.ExportarFunc, *exceptions.RestErr) {
+	ret := _m.Called(userID, status, idCliente)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportarVendasService")
+	}
+
+	var r0 service.ExportarFunc
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (service.ExportarFunc, *exceptions.RestErr)); ok {
+		return rf(userID, status, idCliente)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) service.ExportarFunc); ok {
+		r0 = rf(userID, status, idCliente)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(service.ExportarFunc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, status, idCliente)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// FecharCaixaService provides a mock function with given fields: userID, id, request
+func (_m *MockService) FecharCaixaService(userID string, id string, request dtos.FecharCaixaRequest) (*dtos.RelatorioCaixaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FecharCaixaService")
+	}
+
+	var r0 *dtos.RelatorioCaixaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.FecharCaixaRequest) (*dtos.RelatorioCaixaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.FecharCaixaRequest) *dtos.RelatorioCaixaResponse); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.RelatorioCaixaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.FecharCaixaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// FecharInventarioService provides a mock function with given fields: userID, id, request
+func (_m *MockService) FecharInventarioService(userID string, id string, request dtos.FecharInventarioRequest) (*dtos.FecharInventarioResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FecharInventarioService")
+	}
+
+	var r0 *dtos.FecharInventarioResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.FecharInventarioRequest) (*dtos.FecharInventarioResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.FecharInventarioRequest) *dtos.FecharInventarioResponse); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.FecharInventarioResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.FecharInventarioRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GerarAlertasEstoqueService provides a mock function with given fields: userID, dias
+func (_m *MockService) GerarAlertasEstoqueService(userID string, dias int) (*dtos.GerarAlertasResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, dias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GerarAlertasEstoqueService")
+	}
+
+	var r0 *dtos.GerarAlertasResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int) (*dtos.GerarAlertasResponse, *exceptions.RestErr)); ok {
+		return rf(userID, dias)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *dtos.GerarAlertasResponse); ok {
+		r0 = rf(userID, dias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.GerarAlertasResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, dias)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAlertasEstoqueService provides a mock function with given fields: userID, tipo, status, page, limit
+func (_m *MockService) GetAlertasEstoqueService(userID string, tipo string, status string, page int, limit int) (*dtos.AlertaEstoqueListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, tipo, status, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlertasEstoqueService")
+	}
+
+	var r0 *dtos.AlertaEstoqueListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) (*dtos.AlertaEstoqueListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, tipo, status, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) *dtos.AlertaEstoqueListResponse); ok {
+		r0 = rf(userID, tipo, status, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.AlertaEstoqueListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, tipo, status, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllCaixasService provides a mock function with given fields: userID, status, page, limit
+func (_m *MockService) GetAllCaixasService(userID string, status string, page int, limit int) (*dtos.CaixaSessaoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, status, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCaixasService")
+	}
+
+	var r0 *dtos.CaixaSessaoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, int) (*dtos.CaixaSessaoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, status, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) *dtos.CaixaSessaoListResponse); ok {
+		r0 = rf(userID, status, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CaixaSessaoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, status, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllCampanhasService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetAllCampanhasService(userID string, page int, limit int) (*dtos.CampanhaListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCampanhasService")
+	}
+
+	var r0 *dtos.CampanhaListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.CampanhaListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.CampanhaListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CampanhaListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllClientesService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetAllClientesService(userID string, page int, limit int) (*dtos.ClienteListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllClientesService")
+	}
+
+	var r0 *dtos.ClienteListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.ClienteListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.ClienteListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ClienteListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllCriteriosService provides a mock function with given fields: userID
+func (_m *MockService) GetAllCriteriosService(userID string) (*dtos.CriterioListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCriteriosService")
+	}
+
+	var r0 *dtos.CriterioListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (*dtos.CriterioListResponse, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dtos.CriterioListResponse); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CriterioListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllDevolucoesService provides a mock function with given fields: userID, idVenda, idCliente, page, limit
+func (_m *MockService) GetAllDevolucoesService(userID string, idVenda string, idCliente string, page int, limit int) (*dtos.DevolucaoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idVenda, idCliente, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllDevolucoesService")
+	}
+
+	var r0 *dtos.DevolucaoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) (*dtos.DevolucaoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idVenda, idCliente, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) *dtos.DevolucaoListResponse); ok {
+		r0 = rf(userID, idVenda, idCliente, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.DevolucaoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, idVenda, idCliente, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllEnderecosService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetAllEnderecosService(userID string, page int, limit int) (*dtos.EnderecoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllEnderecosService")
+	}
+
+	var r0 *dtos.EnderecoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.EnderecoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.EnderecoListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.EnderecoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllEstoqueService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetAllEstoqueService(userID string, page int, limit int) (*dtos.DetalhesEstoqueListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllEstoqueService")
+	}
+
+	var r0 *dtos.DetalhesEstoqueListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.DetalhesEstoqueListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.DetalhesEstoqueListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.DetalhesEstoqueListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllFornecedoresService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetAllFornecedoresService(userID string, page int, limit int) (*dtos.FornecedorListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllFornecedoresService")
+	}
+
+	var r0 *dtos.FornecedorListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.FornecedorListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.FornecedorListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.FornecedorListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllInventariosService provides a mock function with given fields: userID, status, page, limit
+func (_m *MockService) GetAllInventariosService(userID string, status string, page int, limit int) (*dtos.InventarioListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, status, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllInventariosService")
+	}
+
+	var r0 *dtos.InventarioListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, int) (*dtos.InventarioListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, status, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) *dtos.InventarioListResponse); ok {
+		r0 = rf(userID, status, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.InventarioListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, status, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllPedidosService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetAllPedidosService(userID string, page int, limit int) (*dtos.PedidoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPedidosService")
+	}
+
+	var r0 *dtos.PedidoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.PedidoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.PedidoListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PedidoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllPetsService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetAllPetsService(userID string, page int, limit int) (*dtos.PetListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPetsService")
+	}
+
+	var r0 *dtos.PetListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.PetListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.PetListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PetListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllProductsService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetAllProductsService(userID string, page int, limit int) (*dtos.ProductListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllProductsService")
+	}
+
+	var r0 *dtos.ProductListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.ProductListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.ProductListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ProductListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllPublicosService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetAllPublicosService(userID string, page int, limit int) (*dtos.PublicoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPublicosService")
+	}
+
+	var r0 *dtos.PublicoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.PublicoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.PublicoListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PublicoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllTagsService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetAllTagsService(userID string, page int, limit int) (*dtos.TagListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllTagsService")
+	}
+
+	var r0 *dtos.TagListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.TagListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.TagListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.TagListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetAllVendasService provides a mock function with given fields: userID, status, idCliente, page, limit
+func (_m *MockService) GetAllVendasService(userID string, status string, idCliente string, page int, limit int) (*dtos.VendaListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, status, idCliente, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllVendasService")
+	}
+
+	var r0 *dtos.VendaListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) (*dtos.VendaListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, status, idCliente, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) *dtos.VendaListResponse); ok {
+		r0 = rf(userID, status, idCliente, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.VendaListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, status, idCliente, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCaixaAtualService provides a mock function with given fields: userID, operador
+func (_m *MockService) GetCaixaAtualService(userID string, operador string) (*dtos.CaixaSessaoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, operador)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCaixaAtualService")
+	}
+
+	var r0 *dtos.CaixaSessaoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.CaixaSessaoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, operador)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.CaixaSessaoResponse); ok {
+		r0 = rf(userID, operador)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CaixaSessaoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, operador)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCaixaByIDService provides a mock function with given fields: userID, id
+func (_m *MockService) GetCaixaByIDService(userID string, id string) (*dtos.CaixaSessaoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCaixaByIDService")
+	}
+
+	var r0 *dtos.CaixaSessaoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.CaixaSessaoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.CaixaSessaoResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CaixaSessaoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCaixaMovimentosService provides a mock function with given fields: userID, id
+func (_m *MockService) GetCaixaMovimentosService(userID string, id string) (*dtos.CaixaMovimentoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCaixaMovimentosService")
+	}
+
+	var r0 *dtos.CaixaMovimentoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.CaixaMovimentoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.CaixaMovimentoListResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CaixaMovimentoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCampanhaByIDService provides a mock function with given fields: userID, id, janelaDias
+func (_m *MockService) GetCampanhaByIDService(userID string, id string, janelaDias int) (*dtos.CampanhaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, janelaDias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampanhaByIDService")
+	}
+
+	var r0 *dtos.CampanhaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int) (*dtos.CampanhaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, janelaDias)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int) *dtos.CampanhaResponse); ok {
+		r0 = rf(userID, id, janelaDias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CampanhaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, janelaDias)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCamposCriteriosService provides a mock function with no fields
+func (_m *MockService) GetCamposCriteriosService() *dtos.CampoCriterioListResponse {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCamposCriteriosService")
+	}
+
+	var r0 *dtos.CampoCriterioListResponse
+	if rf, ok := ret.Get(0).(func() *dtos.CampoCriterioListResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CampoCriterioListResponse)
+		}
+	}
+
+	return r0
+}
+
+// GetCamposTemplateService provides a mock function with no fields
+func (_m *MockService) GetCamposTemplateService() *dtos.CamposTemplateResponse {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCamposTemplateService")
+	}
+
+	var r0 *dtos.CamposTemplateResponse
+	if rf, ok := ret.Get(0).(func() *dtos.CamposTemplateResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CamposTemplateResponse)
+		}
+	}
+
+	return r0
+}
+
+// GetCanaisCampanhaService provides a mock function with given fields: userID, idCampanha
+func (_m *MockService) GetCanaisCampanhaService(userID string, idCampanha string) (*dtos.CanaisCampanhaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCampanha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCanaisCampanhaService")
+	}
+
+	var r0 *dtos.CanaisCampanhaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.CanaisCampanhaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCampanha)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.CanaisCampanhaResponse); ok {
+		r0 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CanaisCampanhaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCategoriaByIDService provides a mock function with given fields: userID, id
+func (_m *MockService) GetCategoriaByIDService(userID string, id string) (*dtos.CategoriaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoriaByIDService")
+	}
+
+	var r0 *dtos.CategoriaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.CategoriaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.CategoriaResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CategoriaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCategoriasService provides a mock function with given fields: userID
+func (_m *MockService) GetCategoriasService(userID string) (*dtos.CategoriaTreeResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoriasService")
+	}
+
+	var r0 *dtos.CategoriaTreeResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (*dtos.CategoriaTreeResponse, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dtos.CategoriaTreeResponse); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CategoriaTreeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetClienteByIDService provides a mock function with given fields: userID, id
+func (_m *MockService) GetClienteByIDService(userID string, id string) (*dtos.ClienteResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClienteByIDService")
+	}
+
+	var r0 *dtos.ClienteResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.ClienteResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.ClienteResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ClienteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetClientesDoPublicoService provides a mock function with given fields: userID, idPublico, page, limit
+func (_m *MockService) GetClientesDoPublicoService(userID string, idPublico string, page int, limit int) (*dtos.ClientesPublicoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientesDoPublicoService")
+	}
+
+	var r0 *dtos.ClientesPublicoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, int) (*dtos.ClientesPublicoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) *dtos.ClientesPublicoListResponse); ok {
+		r0 = rf(userID, idPublico, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ClientesPublicoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCompletudeClientesService provides a mock function with given fields: userID, page, limit
+func (_m *MockService) GetCompletudeClientesService(userID string, page int, limit int) (*dtos.CompletudeListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCompletudeClientesService")
+	}
+
+	var r0 *dtos.CompletudeListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, int, int) (*dtos.CompletudeListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *dtos.CompletudeListResponse); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CompletudeListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetConsentimentosClienteService provides a mock function with given fields: userID, idCliente
+func (_m *MockService) GetConsentimentosClienteService(userID string, idCliente string) (*dtos.ConsentimentosClienteResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCliente)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsentimentosClienteService")
+	}
+
+	var r0 *dtos.ConsentimentosClienteResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.ConsentimentosClienteResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCliente)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.ConsentimentosClienteResponse); ok {
+		r0 = rf(userID, idCliente)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ConsentimentosClienteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCliente)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCreditosClienteService provides a mock function with given fields: userID, id
+func (_m *MockService) GetCreditosClienteService(userID string, id string) (*dtos.ExtratoCreditoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditosClienteService")
+	}
+
+	var r0 *dtos.ExtratoCreditoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.ExtratoCreditoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.ExtratoCreditoResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ExtratoCreditoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetCriteriosPublicoService provides a mock function with given fields: userID, idPublico
+func (_m *MockService) GetCriteriosPublicoService(userID string, idPublico string) (*dtos.PublicoCriterioListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCriteriosPublicoService")
+	}
+
+	var r0 *dtos.PublicoCriterioListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.PublicoCriterioListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.PublicoCriterioListResponse); ok {
+		r0 = rf(userID, idPublico)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PublicoCriterioListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetDevolucaoByIDService provides a mock function with given fields: userID, id
+func (_m *MockService) GetDevolucaoByIDService(userID string, id string) (*dtos.DevolucaoDetalheResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDevolucaoByIDService")
+	}
+
+	var r0 *dtos.DevolucaoDetalheResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.DevolucaoDetalheResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.DevolucaoDetalheResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.DevolucaoDetalheResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetDivergenciasInventarioService provides a mock function with given fields: userID, id
+func (_m *MockService) GetDivergenciasInventarioService(userID string, id string) (*dtos.InventarioDivergenciasResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDivergenciasInventarioService")
+	}
+
+	var r0 *dtos.InventarioDivergenciasResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.InventarioDivergenciasResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.InventarioDivergenciasResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.InventarioDivergenciasResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetEnviosCampanhaService provides a mock function with given fields: userID, idCampanha, canal, status, page, limit
+func (_m *MockService) GetEnviosCampanhaService(userID string, idCampanha string, canal string, status string, page int, limit int) (*dtos.CampanhaEnvioListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCampanha, canal, status, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnviosCampanhaService")
+	}
+
+	var r0 *dtos.CampanhaEnvioListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string, string, int, int) (*dtos.CampanhaEnvioListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCampanha, canal, status, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, int, int) *dtos.CampanhaEnvioListResponse); ok {
+		r0 = rf(userID, idCampanha, canal, status, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CampanhaEnvioListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCampanha, canal, status, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetEspeciesService provides a mock function with no fields
+func (_m *MockService) GetEspeciesService() *dtos.EspeciesResponse {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEspeciesService")
+	}
+
+	var r0 *dtos.EspeciesResponse
+	if rf, ok := ret.Get(0).(func() *dtos.EspeciesResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.EspeciesResponse)
+		}
+	}
+
+	return r0
+}
+
+// GetExclusoesPublicoService provides a mock function with given fields: userID, idPublico, page, limit
+func (_m *MockService) GetExclusoesPublicoService(userID string, idPublico string, page int, limit int) (*dtos.PublicoExclusaoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExclusoesPublicoService")
+	}
+
+	var r0 *dtos.PublicoExclusaoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, int) (*dtos.PublicoExclusaoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) *dtos.PublicoExclusaoListResponse); ok {
+		r0 = rf(userID, idPublico, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PublicoExclusaoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetFidelidadeConfigService provides a mock function with given fields: userID
+func (_m *MockService) GetFidelidadeConfigService(userID string) (*dtos.FidelidadeConfigResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFidelidadeConfigService")
+	}
+
+	var r0 *dtos.FidelidadeConfigResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) (*dtos.FidelidadeConfigResponse, *exceptions.RestErr)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dtos.FidelidadeConfigResponse); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.FidelidadeConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) *exceptions.RestErr); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetHistoricoConsentimentosService provides a mock function with given fields: userID, idCliente, canal, page, limit
+func (_m *MockService) GetHistoricoConsentimentosService(userID string, idCliente string, canal string, page int, limit int) (*dtos.ConsentimentoHistoricoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCliente, canal, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricoConsentimentosService")
+	}
+
+	var r0 *dtos.ConsentimentoHistoricoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) (*dtos.ConsentimentoHistoricoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCliente, canal, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) *dtos.ConsentimentoHistoricoListResponse); ok {
+		r0 = rf(userID, idCliente, canal, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ConsentimentoHistoricoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCliente, canal, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetHistoricoPrecosService provides a mock function with given fields: userID, idProduto, page, limit
+func (_m *MockService) GetHistoricoPrecosService(userID string, idProduto string, page int, limit int) (*dtos.HistoricoPrecoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idProduto, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricoPrecosService")
+	}
+
+	var r0 *dtos.HistoricoPrecoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, int) (*dtos.HistoricoPrecoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idProduto, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) *dtos.HistoricoPrecoListResponse); ok {
+		r0 = rf(userID, idProduto, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.HistoricoPrecoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, idProduto, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetHistoricoPublicoService provides a mock function with given fields: userID, idPublico, idCliente, tipo, page, limit
+func (_m *MockService) GetHistoricoPublicoService(userID string, idPublico string, idCliente int, tipo string, page int, limit int) (*dtos.PublicoHistoricoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico, idCliente, tipo, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricoPublicoService")
+	}
+
+	var r0 *dtos.PublicoHistoricoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, string, int, int) (*dtos.PublicoHistoricoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico, idCliente, tipo, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, string, int, int) *dtos.PublicoHistoricoListResponse); ok {
+		r0 = rf(userID, idPublico, idCliente, tipo, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PublicoHistoricoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico, idCliente, tipo, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetImportacaoByIDService provides a mock function with given fields: userID, id
+func (_m *MockService) GetImportacaoByIDService(userID string, id string) (*dtos.ImportacaoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportacaoByIDService")
+	}
+
+	var r0 *dtos.ImportacaoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.ImportacaoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.ImportacaoResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ImportacaoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetImportacaoErrosService provides a mock function with given fields: userID, id, page, limit
+func (_m *MockService) GetImportacaoErrosService(userID string, id string, page int, limit int) (*dtos.ImportacaoErroListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportacaoErrosService")
+	}
+
+	var r0 *dtos.ImportacaoErroListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, int) (*dtos.ImportacaoErroListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) *dtos.ImportacaoErroListResponse); ok {
+		r0 = rf(userID, id, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ImportacaoErroListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetImportacoesService provides a mock function with given fields: userID, recurso, page, limit
+func (_m *MockService) GetImportacoesService(userID string, recurso string, page int, limit int) (*dtos.ImportacaoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, recurso, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportacoesService")
+	}
+
+	var r0 *dtos.ImportacaoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, int) (*dtos.ImportacaoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, recurso, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) *dtos.ImportacaoListResponse); ok {
+		r0 = rf(userID, recurso, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ImportacaoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, recurso, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetInventarioByIDService provides a mock function with given fields: userID, id
+func (_m *MockService) GetInventarioByIDService(userID string, id string) (*dtos.InventarioResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventarioByIDService")
+	}
+
+	var r0 *dtos.InventarioResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.InventarioResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.InventarioResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.InventarioResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetItensPedidoService provides a mock function with given fields: userID, idPedido, page, limit
+func (_m *MockService) GetItensPedidoService(userID string, idPedido string, page int, limit int) (*dtos.DetalhesPedidoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPedido, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItensPedidoService")
+	}
+
+	var r0 *dtos.DetalhesPedidoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, int) (*dtos.DetalhesPedidoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPedido, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) *dtos.DetalhesPedidoListResponse); ok {
+		r0 = rf(userID, idPedido, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.DetalhesPedidoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPedido, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetItensVendaService provides a mock function with given fields: userID, id
+func (_m *MockService) GetItensVendaService(userID string, id string) (*dtos.ItemVendaListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItensVendaService")
+	}
+
+	var r0 *dtos.ItemVendaListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.ItemVendaListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.ItemVendaListResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ItemVendaListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetKitComponentesService provides a mock function with given fields: userID, id
+func (_m *MockService) GetKitComponentesService(userID string, id string) (*dtos.KitComponentesResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKitComponentesService")
+	}
+
+	var r0 *dtos.KitComponentesResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.KitComponentesResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.KitComponentesResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.KitComponentesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetMargemBrutaService provides a mock function with given fields: userID, dataCorte
+func (_m *MockService) GetMargemBrutaService(userID string, dataCorte string) (*dtos.MargemBrutaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, dataCorte)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMargemBrutaService")
+	}
+
+	var r0 *dtos.MargemBrutaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.MargemBrutaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, dataCorte)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.MargemBrutaResponse); ok {
+		r0 = rf(userID, dataCorte)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.MargemBrutaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, dataCorte)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPedidoByIdService provides a mock function with given fields: userID, id
+func (_m *MockService) GetPedidoByIdService(userID string, id string) (*dtos.PedidoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPedidoByIdService")
+	}
+
+	var r0 *dtos.PedidoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.PedidoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.PedidoResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PedidoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPontosClienteService provides a mock function with given fields: userID, id
+func (_m *MockService) GetPontosClienteService(userID string, id string) (*dtos.ExtratoPontosResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPontosClienteService")
+	}
+
+	var r0 *dtos.ExtratoPontosResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.ExtratoPontosResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.ExtratoPontosResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ExtratoPontosResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPrecoVigenteService provides a mock function with given fields: userID, idProduto, data
+func (_m *MockService) GetPrecoVigenteService(userID string, idProduto string, data string) (*dtos.PrecoVigenteResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idProduto, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrecoVigenteService")
+	}
+
+	var r0 *dtos.PrecoVigenteResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (*dtos.PrecoVigenteResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idProduto, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *dtos.PrecoVigenteResponse); ok {
+		r0 = rf(userID, idProduto, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PrecoVigenteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idProduto, data)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPrecosAgendadosService provides a mock function with given fields: userID, idProduto, status
+func (_m *MockService) GetPrecosAgendadosService(userID string, idProduto string, status string) (*dtos.PrecoAgendadoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idProduto, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrecosAgendadosService")
+	}
+
+	var r0 *dtos.PrecoAgendadoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (*dtos.PrecoAgendadoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idProduto, status)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *dtos.PrecoAgendadoListResponse); ok {
+		r0 = rf(userID, idProduto, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PrecoAgendadoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idProduto, status)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetProductByBarcodeService provides a mock function with given fields: userID, barcode
+func (_m *MockService) GetProductByBarcodeService(userID string, barcode string) (*dtos.ProductResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, barcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByBarcodeService")
+	}
+
+	var r0 *dtos.ProductResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.ProductResponse, *exceptions.RestErr)); ok {
+		return rf(userID, barcode)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.ProductResponse); ok {
+		r0 = rf(userID, barcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, barcode)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetProductByIDService provides a mock function with given fields: userID, id
+func (_m *MockService) GetProductByIDService(userID string, id string) (*dtos.ProductResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByIDService")
+	}
+
+	var r0 *dtos.ProductResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.ProductResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.ProductResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetProductBySKUService provides a mock function with given fields: userID, sku
+func (_m *MockService) GetProductBySKUService(userID string, sku string) (*dtos.ProductResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, sku)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductBySKUService")
+	}
+
+	var r0 *dtos.ProductResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.ProductResponse, *exceptions.RestErr)); ok {
+		return rf(userID, sku)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.ProductResponse); ok {
+		r0 = rf(userID, sku)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, sku)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetPublicosCampanhaService provides a mock function with given fields: userID, idCampanha
+func (_m *MockService) GetPublicosCampanhaService(userID string, idCampanha string) (*dtos.PublicosCampanhaListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCampanha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicosCampanhaService")
+	}
+
+	var r0 *dtos.PublicosCampanhaListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.PublicosCampanhaListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCampanha)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.PublicosCampanhaListResponse); ok {
+		r0 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PublicosCampanhaListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetRFMClientesService provides a mock function with given fields: userID, segmento, page, limit
+func (_m *MockService) GetRFMClientesService(userID string, segmento string, page int, limit int) (*dtos.RFMClienteListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, segmento, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRFMClientesService")
+	}
+
+	var r0 *dtos.RFMClienteListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, int) (*dtos.RFMClienteListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, segmento, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) *dtos.RFMClienteListResponse); ok {
+		r0 = rf(userID, segmento, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.RFMClienteListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, segmento, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetRelatorioCaixaService provides a mock function with given fields: userID, id
+func (_m *MockService) GetRelatorioCaixaService(userID string, id string) (*dtos.RelatorioCaixaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelatorioCaixaService")
+	}
+
+	var r0 *dtos.RelatorioCaixaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.RelatorioCaixaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.RelatorioCaixaResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.RelatorioCaixaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTagsClienteService provides a mock function with given fields: userID, clienteID
+func (_m *MockService) GetTagsClienteService(userID string, clienteID string) (*dtos.TagsClienteListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, clienteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagsClienteService")
+	}
+
+	var r0 *dtos.TagsClienteListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.TagsClienteListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, clienteID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.TagsClienteListResponse); ok {
+		r0 = rf(userID, clienteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.TagsClienteListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, clienteID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTemplateMensagemByIDService provides a mock function with given fields: userID, id
+func (_m *MockService) GetTemplateMensagemByIDService(userID string, id string) (*dtos.TemplateMensagemResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplateMensagemByIDService")
+	}
+
+	var r0 *dtos.TemplateMensagemResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.TemplateMensagemResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.TemplateMensagemResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.TemplateMensagemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTemplatesMensagensService provides a mock function with given fields: userID, canal, page, limit
+func (_m *MockService) GetTemplatesMensagensService(userID string, canal string, page int, limit int) (*dtos.TemplateMensagemListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, canal, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplatesMensagensService")
+	}
+
+	var r0 *dtos.TemplateMensagemListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, int, int) (*dtos.TemplateMensagemListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, canal, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) *dtos.TemplateMensagemListResponse); ok {
+		r0 = rf(userID, canal, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.TemplateMensagemListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) *exceptions.RestErr); ok {
+		r1 = rf(userID, canal, page, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetValorizacaoEstoqueService provides a mock function with given fields: userID, dataCorte, agrupamento
+func (_m *MockService) GetValorizacaoEstoqueService(userID string, dataCorte string, agrupamento string) (*dtos.ValorizacaoEstoqueResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, dataCorte, agrupamento)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetValorizacaoEstoqueService")
+	}
+
+	var r0 *dtos.ValorizacaoEstoqueResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (*dtos.ValorizacaoEstoqueResponse, *exceptions.RestErr)); ok {
+		return rf(userID, dataCorte, agrupamento)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *dtos.ValorizacaoEstoqueResponse); ok {
+		r0 = rf(userID, dataCorte, agrupamento)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ValorizacaoEstoqueResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, dataCorte, agrupamento)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetVariacoesService provides a mock function with given fields: userID, id
+func (_m *MockService) GetVariacoesService(userID string, id string) (*dtos.VariacaoListResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariacoesService")
+	}
+
+	var r0 *dtos.VariacaoListResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.VariacaoListResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.VariacaoListResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.VariacaoListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetVendaByIDService provides a mock function with given fields: userID, id
+func (_m *MockService) GetVendaByIDService(userID string, id string) (*dtos.VendaDetalheResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVendaByIDService")
+	}
+
+	var r0 *dtos.VendaDetalheResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.VendaDetalheResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.VendaDetalheResponse); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.VendaDetalheResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ImportarService provides a mock function with given fields: userID, recurso, request, validar
+func (_m *MockService) ImportarService(userID string, recurso string, request dtos.ImportacaoRequest, validar service.ValidadorCampos) (*dtos.ImportacaoIniciadaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, recurso, request, validar)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportarService")
+	}
+
+	var r0 *dtos.ImportacaoIniciadaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.ImportacaoRequest, service.ValidadorCampos) (*dtos.ImportacaoIniciadaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, recurso, request, validar)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.ImportacaoRequest, service.ValidadorCampos) *dtos.ImportacaoIniciadaResponse); ok {
+		r0 = rf(userID, recurso, request, validar)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ImportacaoIniciadaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.ImportacaoRequest, service.ValidadorCampos) *exceptions.RestErr); ok {
+		r1 = rf(userID, recurso, request, validar)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// LoginUserService provides a mock function with given fields: request
+func (_m *MockService) LoginUserService(request dtos.UserLogin) (string, *exceptions.RestErr) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginUserService")
+	}
+
+	var r0 string
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(dtos.UserLogin) (string, *exceptions.RestErr)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(dtos.UserLogin) string); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(dtos.UserLogin) *exceptions.RestErr); ok {
+		r1 = rf(request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// MesclarCategoriaService provides a mock function with given fields: userID, id, request
+func (_m *MockService) MesclarCategoriaService(userID string, id string, request dtos.MesclarCategoriaRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MesclarCategoriaService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.MesclarCategoriaRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.MesclarCategoriaRequest) bool); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.MesclarCategoriaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// MoverCategoriaService provides a mock function with given fields: userID, id, request
+func (_m *MockService) MoverCategoriaService(userID string, id string, request dtos.MoverCategoriaRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoverCategoriaService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.MoverCategoriaRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.MoverCategoriaRequest) bool); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.MoverCategoriaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// NormalizarCatalogoService provides a mock function with given fields: userID, dryRun
+func (_m *MockService) NormalizarCatalogoService(userID string, dryRun bool) (*dtos.NormalizacaoCatalogoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NormalizarCatalogoService")
+	}
+
+	var r0 *dtos.NormalizacaoCatalogoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, bool) (*dtos.NormalizacaoCatalogoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) *dtos.NormalizacaoCatalogoResponse); ok {
+		r0 = rf(userID, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.NormalizacaoCatalogoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) *exceptions.RestErr); ok {
+		r1 = rf(userID, dryRun)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// OperacaoPublicosService provides a mock function with given fields: userID, request
+func (_m *MockService) OperacaoPublicosService(userID string, request dtos.OperacaoPublicosRequest) (*dtos.OperacaoPublicosResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OperacaoPublicosService")
+	}
+
+	var r0 *dtos.OperacaoPublicosResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.OperacaoPublicosRequest) (*dtos.OperacaoPublicosResponse, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.OperacaoPublicosRequest) *dtos.OperacaoPublicosResponse); ok {
+		r0 = rf(userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.OperacaoPublicosResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.OperacaoPublicosRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// PagarVendaService provides a mock function with given fields: userID, id, request
+func (_m *MockService) PagarVendaService(userID string, id string, request dtos.PagarVendaRequest) (*dtos.VendaDetalheResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PagarVendaService")
+	}
+
+	var r0 *dtos.VendaDetalheResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.PagarVendaRequest) (*dtos.VendaDetalheResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.PagarVendaRequest) *dtos.VendaDetalheResponse); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.VendaDetalheResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.PagarVendaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// PausarCampanhaService provides a mock function with given fields: userID, idCampanha
+func (_m *MockService) PausarCampanhaService(userID string, idCampanha string) (*dtos.CampanhaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCampanha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PausarCampanhaService")
+	}
+
+	var r0 *dtos.CampanhaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.CampanhaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCampanha)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.CampanhaResponse); ok {
+		r0 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CampanhaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// PreviewPublicoService provides a mock function with given fields: userID, request
+func (_m *MockService) PreviewPublicoService(userID string, request dtos.PreviewPublicoRequest) (*dtos.PreviewPublicoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewPublicoService")
+	}
+
+	var r0 *dtos.PreviewPublicoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.PreviewPublicoRequest) (*dtos.PreviewPublicoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.PreviewPublicoRequest) *dtos.PreviewPublicoResponse); ok {
+		r0 = rf(userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PreviewPublicoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.PreviewPublicoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// PreviewTemplateMensagemService provides a mock function with given fields: userID, id, idCliente, idCampanha
+func (_m *MockService) PreviewTemplateMensagemService(userID string, id string, idCliente string, idCampanha string) (*dtos.PreviewTemplateResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, idCliente, idCampanha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewTemplateMensagemService")
+	}
+
+	var r0 *dtos.PreviewTemplateResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*dtos.PreviewTemplateResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, idCliente, idCampanha)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *dtos.PreviewTemplateResponse); ok {
+		r0 = rf(userID, id, idCliente, idCampanha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PreviewTemplateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, idCliente, idCampanha)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// RegistrarAberturaService provides a mock function with given fields: token
+func (_m *MockService) RegistrarAberturaService(token string) *exceptions.RestErr {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarAberturaService")
+	}
+
+	var r0 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) *exceptions.RestErr); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*exceptions.RestErr)
+		}
+	}
+
+	return r0
+}
+
+// RegistrarCliqueService provides a mock function with given fields: token, destino, assinatura
+func (_m *MockService) RegistrarCliqueService(token string, destino string, assinatura string) (string, *exceptions.RestErr) {
+	ret := _m.Called(token, destino, assinatura)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarCliqueService")
+	}
+
+	var r0 string
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (string, *exceptions.RestErr)); ok {
+		return rf(token, destino, assinatura)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = rf(token, destino, assinatura)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(token, destino, assinatura)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// RegistrarContagemService provides a mock function with given fields: userID, id, request
+func (_m *MockService) RegistrarContagemService(userID string, id string, request dtos.RegistrarContagemRequest) (*dtos.RegistrarContagemResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarContagemService")
+	}
+
+	var r0 *dtos.RegistrarContagemResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.RegistrarContagemRequest) (*dtos.RegistrarContagemResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.RegistrarContagemRequest) *dtos.RegistrarContagemResponse); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.RegistrarContagemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.RegistrarContagemRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// RegistrarDescadastroService provides a mock function with given fields: token
+func (_m *MockService) RegistrarDescadastroService(token string) *exceptions.RestErr {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarDescadastroService")
+	}
+
+	var r0 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) *exceptions.RestErr); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*exceptions.RestErr)
+		}
+	}
+
+	return r0
+}
+
+// RegistrarMovimentoCaixaService provides a mock function with given fields: userID, id, tipo, request
+func (_m *MockService) RegistrarMovimentoCaixaService(userID string, id string, tipo string, request dtos.MovimentoCaixaRequest) (*dtos.CaixaMovimentoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, tipo, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarMovimentoCaixaService")
+	}
+
+	var r0 *dtos.CaixaMovimentoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string, dtos.MovimentoCaixaRequest) (*dtos.CaixaMovimentoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, tipo, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, dtos.MovimentoCaixaRequest) *dtos.CaixaMovimentoResponse); ok {
+		r0 = rf(userID, id, tipo, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CaixaMovimentoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, dtos.MovimentoCaixaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, tipo, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// RegistrarStatusEnvioService provides a mock function with given fields: token, assinatura, request
+func (_m *MockService) RegistrarStatusEnvioService(token string, assinatura string, request dtos.StatusEnvioRequest) *exceptions.RestErr {
+	ret := _m.Called(token, assinatura, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarStatusEnvioService")
+	}
+
+	var r0 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.StatusEnvioRequest) *exceptions.RestErr); ok {
+		r0 = rf(token, assinatura, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*exceptions.RestErr)
+		}
+	}
+
+	return r0
+}
+
+// RemoverExclusaoPublicoService provides a mock function with given fields: userID, idPublico, idCliente
+func (_m *MockService) RemoverExclusaoPublicoService(userID string, idPublico string, idCliente string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico, idCliente)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoverExclusaoPublicoService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico, idCliente)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) bool); ok {
+		r0 = rf(userID, idPublico, idCliente)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico, idCliente)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoverMembrosPublicoService provides a mock function with given fields: userID, idPublico, request
+func (_m *MockService) RemoverMembrosPublicoService(userID string, idPublico string, request dtos.MembrosPublicoRequest) (*dtos.RemoverMembrosPublicoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoverMembrosPublicoService")
+	}
+
+	var r0 *dtos.RemoverMembrosPublicoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.MembrosPublicoRequest) (*dtos.RemoverMembrosPublicoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.MembrosPublicoRequest) *dtos.RemoverMembrosPublicoResponse); ok {
+		r0 = rf(userID, idPublico, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.RemoverMembrosPublicoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.MembrosPublicoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoverTagsClienteService provides a mock function with given fields: userID, clienteID, request
+func (_m *MockService) RemoverTagsClienteService(userID string, clienteID string, request dtos.RemoverTagsClienteRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, clienteID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoverTagsClienteService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.RemoverTagsClienteRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, clienteID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.RemoverTagsClienteRequest) bool); ok {
+		r0 = rf(userID, clienteID, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.RemoverTagsClienteRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, clienteID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResgatarPontosService provides a mock function with given fields: userID, id, request
+func (_m *MockService) ResgatarPontosService(userID string, id string, request dtos.ResgatarPontosRequest) (*dtos.ResgatePontosResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResgatarPontosService")
+	}
+
+	var r0 *dtos.ResgatePontosResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.ResgatarPontosRequest) (*dtos.ResgatePontosResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.ResgatarPontosRequest) *dtos.ResgatePontosResponse); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ResgatePontosResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.ResgatarPontosRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResolverAlertaEstoqueService provides a mock function with given fields: userID, id
+func (_m *MockService) ResolverAlertaEstoqueService(userID string, id string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolverAlertaEstoqueService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// RetomarCampanhaService provides a mock function with given fields: userID, idCampanha
+func (_m *MockService) RetomarCampanhaService(userID string, idCampanha string) (*dtos.CampanhaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCampanha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetomarCampanhaService")
+	}
+
+	var r0 *dtos.CampanhaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string) (*dtos.CampanhaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCampanha)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dtos.CampanhaResponse); ok {
+		r0 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CampanhaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// SetCanaisCampanhaService provides a mock function with given fields: userID, idCampanha, request
+func (_m *MockService) SetCanaisCampanhaService(userID string, idCampanha string, request dtos.CanaisCampanhaRequest) (*dtos.CanaisCampanhaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCampanha, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCanaisCampanhaService")
+	}
+
+	var r0 *dtos.CanaisCampanhaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.CanaisCampanhaRequest) (*dtos.CanaisCampanhaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCampanha, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.CanaisCampanhaRequest) *dtos.CanaisCampanhaResponse); ok {
+		r0 = rf(userID, idCampanha, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.CanaisCampanhaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.CanaisCampanhaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCampanha, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// SetConsentimentosClienteService provides a mock function with given fields: userID, idCliente, request
+func (_m *MockService) SetConsentimentosClienteService(userID string, idCliente string, request dtos.ConsentimentosClienteRequest) (*dtos.ConsentimentosClienteResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCliente, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetConsentimentosClienteService")
+	}
+
+	var r0 *dtos.ConsentimentosClienteResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.ConsentimentosClienteRequest) (*dtos.ConsentimentosClienteResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCliente, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.ConsentimentosClienteRequest) *dtos.ConsentimentosClienteResponse); ok {
+		r0 = rf(userID, idCliente, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ConsentimentosClienteResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.ConsentimentosClienteRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCliente, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// SetKitComponentesService provides a mock function with given fields: userID, id, request
+func (_m *MockService) SetKitComponentesService(userID string, id string, request dtos.SetKitComponentesRequest) (*dtos.KitComponentesResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetKitComponentesService")
+	}
+
+	var r0 *dtos.KitComponentesResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.SetKitComponentesRequest) (*dtos.KitComponentesResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.SetKitComponentesRequest) *dtos.KitComponentesResponse); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.KitComponentesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.SetKitComponentesRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateCategoriaService provides a mock function with given fields: userID, id, request
+func (_m *MockService) UpdateCategoriaService(userID string, id string, request dtos.UpdateCategoriaRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategoriaService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.UpdateCategoriaRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.UpdateCategoriaRequest) bool); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.UpdateCategoriaRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateEstoqueMinimoService provides a mock function with given fields: userID, id, request
+func (_m *MockService) UpdateEstoqueMinimoService(userID string, id string, request dtos.UpdateEstoqueMinimoRequest) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEstoqueMinimoService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.UpdateEstoqueMinimoRequest) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.UpdateEstoqueMinimoRequest) bool); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.UpdateEstoqueMinimoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateFidelidadeConfigService provides a mock function with given fields: userID, request
+func (_m *MockService) UpdateFidelidadeConfigService(userID string, request dtos.UpdateFidelidadeConfigRequest) (*dtos.FidelidadeConfigResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFidelidadeConfigService")
+	}
+
+	var r0 *dtos.FidelidadeConfigResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, dtos.UpdateFidelidadeConfigRequest) (*dtos.FidelidadeConfigResponse, *exceptions.RestErr)); ok {
+		return rf(userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, dtos.UpdateFidelidadeConfigRequest) *dtos.FidelidadeConfigResponse); ok {
+		r0 = rf(userID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.FidelidadeConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dtos.UpdateFidelidadeConfigRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateFornecedorFieldService provides a mock function with given fields: userID, id, campo, valor
+func (_m *MockService) UpdateFornecedorFieldService(userID string, id string, campo string, valor string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, campo, valor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFornecedorFieldService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id, campo, valor)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) bool); ok {
+		r0 = rf(userID, id, campo, valor)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, campo, valor)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateProductService provides a mock function with given fields: userID, id, request
+func (_m *MockService) UpdateProductService(userID string, id string, request dtos.UpdateProductRequest) (*dtos.ProductResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProductService")
+	}
+
+	var r0 *dtos.ProductResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.UpdateProductRequest) (*dtos.ProductResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.UpdateProductRequest) *dtos.ProductResponse); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ProductResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.UpdateProductRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateTemplateMensagemService provides a mock function with given fields: userID, id, request
+func (_m *MockService) UpdateTemplateMensagemService(userID string, id string, request dtos.TemplateMensagemRequest) (*dtos.TemplateMensagemResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTemplateMensagemService")
+	}
+
+	var r0 *dtos.TemplateMensagemResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.TemplateMensagemRequest) (*dtos.TemplateMensagemResponse, *exceptions.RestErr)); ok {
+		return rf(userID, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.TemplateMensagemRequest) *dtos.TemplateMensagemResponse); ok {
+		r0 = rf(userID, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.TemplateMensagemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.TemplateMensagemRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateTipoPublicoService provides a mock function with given fields: userID, idPublico, request
+func (_m *MockService) UpdateTipoPublicoService(userID string, idPublico string, request dtos.UpdateTipoPublicoRequest) (*dtos.PublicoResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idPublico, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTipoPublicoService")
+	}
+
+	var r0 *dtos.PublicoResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, dtos.UpdateTipoPublicoRequest) (*dtos.PublicoResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idPublico, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, dtos.UpdateTipoPublicoRequest) *dtos.PublicoResponse); ok {
+		r0 = rf(userID, idPublico, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.PublicoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, dtos.UpdateTipoPublicoRequest) *exceptions.RestErr); ok {
+		r1 = rf(userID, idPublico, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ValidarCampanhaService provides a mock function with given fields: userID, idCampanha, canais
+func (_m *MockService) ValidarCampanhaService(userID string, idCampanha string, canais []string) (*dtos.ValidacaoCampanhaResponse, *exceptions.RestErr) {
+	ret := _m.Called(userID, idCampanha, canais)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidarCampanhaService")
+	}
+
+	var r0 *dtos.ValidacaoCampanhaResponse
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, []string) (*dtos.ValidacaoCampanhaResponse, *exceptions.RestErr)); ok {
+		return rf(userID, idCampanha, canais)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, []string) *dtos.ValidacaoCampanhaResponse); ok {
+		r0 = rf(userID, idCampanha, canais)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dtos.ValidacaoCampanhaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, []string) *exceptions.RestErr); ok {
+		r1 = rf(userID, idCampanha, canais)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// ValidarDescadastroService provides a mock function with given fields: token
+func (_m *MockService) ValidarDescadastroService(token string) *exceptions.RestErr {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidarDescadastroService")
+	}
+
+	var r0 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string) *exceptions.RestErr); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*exceptions.RestErr)
+		}
+	}
+
+	return r0
+}
+
+// VincularVariacaoService provides a mock function with given fields: userID, id, idVariacao
+func (_m *MockService) VincularVariacaoService(userID string, id string, idVariacao string) (bool, *exceptions.RestErr) {
+	ret := _m.Called(userID, id, idVariacao)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VincularVariacaoService")
+	}
+
+	var r0 bool
+	var r1 *exceptions.RestErr
+	if rf, ok := ret.Get(0).(func(string, string, string) (bool, *exceptions.RestErr)); ok {
+		return rf(userID, id, idVariacao)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) bool); ok {
+		r0 = rf(userID, id, idVariacao)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) *exceptions.RestErr); ok {
+		r1 = rf(userID, id, idVariacao)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*exceptions.RestErr)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewMockService creates a new instance of MockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockService {
+	mock := &MockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE PREÇOS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetHistoricoPrecos(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get historico precos controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarHistoricoPrecosService(userID, id)
+		return exportar(ctx, "historico_precos", formato, dtos.HistoricoPrecoResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	historico, err := ctl.service.GetHistoricoPrecosService(userID, id, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting historico precos", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(historico)
+}
+
+func (ctl *Controller) CreatePrecoAgendado(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create preco agendado controller")
+
+	id := ctx.Params("id")
+	request := ctx.Locals("createPrecoAgendado").(dtos.CreatePrecoAgendadoRequest)
+	userID := ctx.Locals("userID").(string)
+
+	idAgendamento, err := ctl.service.CreatePrecoAgendadoService(userID, id, request)
+	if err != nil {
+		zap.L().Error("Error creating preco agendado", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message":        "Preco agendado created successfully",
+		"id_agendamento": idAgendamento,
+	})
+}
+
+func (ctl *Controller) GetPrecosAgendados(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get precos agendados controller")
+
+	id := ctx.Params("id")
+	status := ctx.Query("status")
+	userID := ctx.Locals("userID").(string)
+
+	agendamentos, err := ctl.service.GetPrecosAgendadosService(userID, id, status)
+	if err != nil {
+		zap.L().Error("Error getting precos agendados", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(agendamentos)
+}
+
+func (ctl *Controller) CancelarPrecoAgendado(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting cancelar preco agendado controller")
+
+	id := ctx.Params("id")
+	idAgendamento := ctx.Params("id_agendamento")
+	userID := ctx.Locals("userID").(string)
+
+	success, err := ctl.service.CancelarPrecoAgendadoService(userID, id, idAgendamento)
+	if err != nil {
+		zap.L().Error("Error canceling preco agendado", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if !success {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Error canceling preco agendado",
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Preco agendado canceled successfully",
+	})
+}
+
+func (ctl *Controller) GetPrecoVigente(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get preco vigente controller")
+
+	id := ctx.Params("id")
+	data := ctx.Query("data")
+	userID := ctx.Locals("userID").(string)
+
+	preco, err := ctl.service.GetPrecoVigenteService(userID, id, data)
+	if err != nil {
+		zap.L().Error("Error getting preco vigente", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(preco)
+}
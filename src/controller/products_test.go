@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	"github.com/betine97/back-project.git/src/controller/middlewares"
 	"github.com/betine97/back-project.git/src/model/dtos"
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/assert"
@@ -26,7 +27,7 @@ func TestController_GetAllProducts_ServiceError(t *testing.T) {
 		return controller.GetAllProducts(c)
 	})
 
-	mockService.On("GetAllProductsService", "1").Return(nil, exceptions.NewInternalServerError("Database connection failed"))
+	mockService.On("GetAllProductsService", "1", 1, 30).Return(nil, exceptions.NewInternalServerError("Database connection failed"))
 
 	// Act
 	req := httptest.NewRequest("GET", "/produtos", nil)
@@ -51,11 +52,6 @@ func TestController_CreateProduct_Success(t *testing.T) {
 	controller := NewControllerInstance(mockService)
 	app := setupTestApp(controller)
 
-	app.Post("/produtos", func(c *fiber.Ctx) error {
-		c.Locals("userID", "1")
-		return controller.CreateProduct(c)
-	})
-
 	createRequest := dtos.CreateProductRequest{
 		NomeProduto: "Produto Teste",
 		SKU:         "SKU001",
@@ -63,6 +59,12 @@ func TestController_CreateProduct_Success(t *testing.T) {
 		Status:      "Ativo",
 	}
 
+	app.Post("/produtos", func(c *fiber.Ctx) error {
+		c.Locals("userID", "1")
+		c.Locals("createProduct", createRequest)
+		return controller.CreateProduct(c)
+	})
+
 	mockService.On("CreateProductService", "1", createRequest).Return(true, nil)
 
 	// Act
@@ -90,7 +92,7 @@ func TestController_CreateProduct_InvalidJSON(t *testing.T) {
 	controller := NewControllerInstance(mockService)
 	app := setupTestApp(controller)
 
-	app.Post("/produtos", func(c *fiber.Ctx) error {
+	app.Post("/produtos", middlewares.ProductValidationMiddleware, func(c *fiber.Ctx) error {
 		c.Locals("userID", "1")
 		return controller.CreateProduct(c)
 	})
@@ -108,7 +110,7 @@ func TestController_CreateProduct_InvalidJSON(t *testing.T) {
 	body, _ := io.ReadAll(resp.Body)
 	json.Unmarshal(body, &response)
 
-	assert.Equal(t, "Unable to read request data", response["error"])
+	assert.Equal(t, "Invalid JSON format", response["error"])
 }
 
 func TestController_CreateProduct_ServiceError(t *testing.T) {
@@ -117,17 +119,18 @@ func TestController_CreateProduct_ServiceError(t *testing.T) {
 	controller := NewControllerInstance(mockService)
 	app := setupTestApp(controller)
 
-	app.Post("/produtos", func(c *fiber.Ctx) error {
-		c.Locals("userID", "1")
-		return controller.CreateProduct(c)
-	})
-
 	createRequest := dtos.CreateProductRequest{
 		NomeProduto: "",
 		SKU:         "SKU001",
 		PrecoVenda:  -10.00, // Preço inválido
 	}
 
+	app.Post("/produtos", func(c *fiber.Ctx) error {
+		c.Locals("userID", "1")
+		c.Locals("createProduct", createRequest)
+		return controller.CreateProduct(c)
+	})
+
 	mockService.On("CreateProductService", "1", createRequest).Return(false, exceptions.NewBadRequestError("Invalid product data"))
 
 	// Act
@@ -155,17 +158,18 @@ func TestController_CreateProduct_ServiceReturnsFalse(t *testing.T) {
 	controller := NewControllerInstance(mockService)
 	app := setupTestApp(controller)
 
-	app.Post("/produtos", func(c *fiber.Ctx) error {
-		c.Locals("userID", "1")
-		return controller.CreateProduct(c)
-	})
-
 	createRequest := dtos.CreateProductRequest{
 		NomeProduto: "Produto Teste",
 		SKU:         "SKU001",
 		PrecoVenda:  29.99,
 	}
 
+	app.Post("/produtos", func(c *fiber.Ctx) error {
+		c.Locals("userID", "1")
+		c.Locals("createProduct", createRequest)
+		return controller.CreateProduct(c)
+	})
+
 	mockService.On("CreateProductService", "1", createRequest).Return(false, nil)
 
 	// Act
@@ -290,7 +294,7 @@ func TestController_GetAllPedidos_ServiceError(t *testing.T) {
 		return controller.GetAllPedidos(c)
 	})
 
-	mockService.On("GetAllPedidosService", "1").Return(nil, exceptions.NewInternalServerError("Database connection failed"))
+	mockService.On("GetAllPedidosService", "1", 1, 30).Return(nil, exceptions.NewInternalServerError("Database connection failed"))
 
 	// Act
 	req := httptest.NewRequest("GET", "/pedidos", nil)
@@ -325,7 +329,7 @@ func TestController_GetAllPedidos_EmptyList(t *testing.T) {
 		Total:   0,
 	}
 
-	mockService.On("GetAllPedidosService", "1").Return(expectedResponse, nil)
+	mockService.On("GetAllPedidosService", "1", 1, 30).Return(expectedResponse, nil)
 
 	// Act
 	req := httptest.NewRequest("GET", "/pedidos", nil)
@@ -376,7 +380,7 @@ func TestController_GetAllPedidos_MultipleItems(t *testing.T) {
 		Total: 2,
 	}
 
-	mockService.On("GetAllPedidosService", "1").Return(expectedResponse, nil)
+	mockService.On("GetAllPedidosService", "1", 1, 30).Return(expectedResponse, nil)
 
 	// Act
 	req := httptest.NewRequest("GET", "/pedidos", nil)
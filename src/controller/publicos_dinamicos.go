@@ -0,0 +1,68 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE PÚBLICOS DINÂMICOS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) UpdateTipoPublico(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting update tipo publico controller")
+
+	updateTipo := ctx.Locals("updateTipoPublico").(dtos.UpdateTipoPublicoRequest)
+
+	userID := ctx.Locals("userID").(string)
+	publico, err := ctl.service.UpdateTipoPublicoService(userID, ctx.Params("id"), updateTipo)
+	if err != nil {
+		zap.L().Error("Error updating tipo of publico", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(publico)
+}
+
+func (ctl *Controller) AtualizarPublico(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting atualizar publico controller")
+
+	userID := ctx.Locals("userID").(string)
+	resultado, err := ctl.service.AtualizarPublicoService(userID, ctx.Params("id"))
+	if err != nil {
+		zap.L().Error("Error refreshing publico", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resultado)
+}
+
+func (ctl *Controller) GetHistoricoPublico(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get historico publico controller")
+
+	userID := ctx.Locals("userID").(string)
+	idCliente := ctx.QueryInt("id_cliente", 0)
+	tipo := ctx.Query("tipo")
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	historico, err := ctl.service.GetHistoricoPublicoService(userID, ctx.Params("id"), idCliente, tipo, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting historico of publico", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(historico)
+}
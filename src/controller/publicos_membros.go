@@ -0,0 +1,126 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE MEMBROS E EXCLUSÕES DE PÚBLICOS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) AdicionarMembrosPublico(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting adicionar membros publico controller")
+
+	request := ctx.Locals("membrosPublico").(dtos.MembrosPublicoRequest)
+
+	userID := ctx.Locals("userID").(string)
+	resultado, err := ctl.service.AdicionarMembrosPublicoService(userID, ctx.Params("id"), request)
+	if err != nil {
+		zap.L().Error("Error adding membros to publico", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resultado)
+}
+
+func (ctl *Controller) RemoverMembrosPublico(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting remover membros publico controller")
+
+	request := ctx.Locals("membrosPublico").(dtos.MembrosPublicoRequest)
+
+	userID := ctx.Locals("userID").(string)
+	resultado, err := ctl.service.RemoverMembrosPublicoService(userID, ctx.Params("id"), request)
+	if err != nil {
+		zap.L().Error("Error removing membros from publico", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resultado)
+}
+
+func (ctl *Controller) ExcluirClientesPublico(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting excluir clientes publico controller")
+
+	request := ctx.Locals("excluirClientesPublico").(dtos.ExcluirClientesPublicoRequest)
+
+	userID := ctx.Locals("userID").(string)
+	resultado, err := ctl.service.ExcluirClientesPublicoService(userID, ctx.Params("id"), request)
+	if err != nil {
+		zap.L().Error("Error excluding clientes from publico", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resultado)
+}
+
+func (ctl *Controller) RemoverExclusaoPublico(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting remover exclusao publico controller")
+
+	userID := ctx.Locals("userID").(string)
+	success, err := ctl.service.RemoverExclusaoPublicoService(userID, ctx.Params("id"), ctx.Params("id_cliente"))
+	if err != nil {
+		zap.L().Error("Error removing exclusao of publico", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if !success {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Error removing exclusao of publico",
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Exclusao removed successfully",
+	})
+}
+
+func (ctl *Controller) GetExclusoesPublico(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get exclusoes publico controller")
+
+	userID := ctx.Locals("userID").(string)
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	exclusoes, err := ctl.service.GetExclusoesPublicoService(userID, ctx.Params("id"), page, limit)
+	if err != nil {
+		zap.L().Error("Error getting exclusoes of publico", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(exclusoes)
+}
+
+// OperacaoPublicos cria um público com a união, interseção ou diferença de públicos existentes
+func (ctl *Controller) OperacaoPublicos(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting operacao publicos controller")
+
+	request := ctx.Locals("operacaoPublicos").(dtos.OperacaoPublicosRequest)
+
+	userID := ctx.Locals("userID").(string)
+	resultado, err := ctl.service.OperacaoPublicosService(userID, request)
+	if err != nil {
+		zap.L().Error("Error running operacao on publicos", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(resultado)
+}
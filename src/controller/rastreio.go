@@ -0,0 +1,107 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// GIF transparente de 1x1 devolvido pelo pixel de abertura
+var pixelTransparente = []byte{
+	0x47, 0x49, 0x46, 0x38, 0x39, 0x61, 0x01, 0x00, 0x01, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0x21, 0xf9, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x00, 0x02, 0x02, 0x44, 0x01, 0x00, 0x3b,
+}
+
+// FUNÇÕES DE RASTREIO DE ENVIOS ------------------------------------------------------------------------------------------------------------------------------------
+
+// RegistrarAbertura responde sempre com o pixel, mesmo quando a abertura não é registrada, para não quebrar o email
+func (ctl *Controller) RegistrarAbertura(ctx *fiber.Ctx) error {
+	if err := ctl.service.RegistrarAberturaService(ctx.Params("token")); err != nil {
+		zap.L().Warn("Open not registered", zap.Error(err))
+	}
+
+	ctx.Set(fiber.HeaderContentType, "image/gif")
+	ctx.Set(fiber.HeaderCacheControl, "no-store")
+	return ctx.Status(fiber.StatusOK).Send(pixelTransparente)
+}
+
+func (ctl *Controller) RegistrarClique(ctx *fiber.Ctx) error {
+	destino, err := ctl.service.RegistrarCliqueService(ctx.Params("token"), ctx.Query("url"), ctx.Query("assinatura"))
+	if err != nil {
+		zap.L().Error("Error registering click", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Redirect(destino, fiber.StatusFound)
+}
+
+func (ctl *Controller) RegistrarStatusEnvio(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting registrar status envio controller")
+
+	request := ctx.Locals("statusEnvio").(dtos.StatusEnvioRequest)
+
+	if err := ctl.service.RegistrarStatusEnvioService(ctx.Params("token"), ctx.Query("assinatura"), request); err != nil {
+		zap.L().Error("Error registering status of envio", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Status registered successfully",
+	})
+}
+
+// Página de confirmação aberta pelo link da mensagem. O formulário envia o POST para o mesmo endereço, assim
+// leitores de link (antivírus, pré-visualização) que só fazem GET não descadastram o cliente.
+const paginaConfirmarDescadastro = `<!DOCTYPE html>
+<html lang="pt-BR">
+<head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>Descadastro</title></head>
+<body>
+<p>Deseja parar de receber nossas mensagens por este canal?</p>
+<form method="post"><button type="submit">Confirmar descadastro</button></form>
+</body>
+</html>`
+
+// Página mostrada ao cliente depois do descadastro
+const paginaDescadastro = `<!DOCTYPE html>
+<html lang="pt-BR">
+<head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>Descadastro</title></head>
+<body><p>Pronto! Você não vai mais receber nossas mensagens por este canal.</p></body>
+</html>`
+
+// ConfirmarDescadastro atende o link de descadastro da mensagem (GET) com a página de confirmação; nada é revogado aqui
+func (ctl *Controller) ConfirmarDescadastro(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting confirmar descadastro controller")
+
+	if err := ctl.service.ValidarDescadastroService(ctx.Params("token")); err != nil {
+		zap.L().Error("Error validating descadastro", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	ctx.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	ctx.Set(fiber.HeaderCacheControl, "no-store")
+	return ctx.Status(fiber.StatusOK).SendString(paginaConfirmarDescadastro)
+}
+
+// RegistrarDescadastro revoga o consentimento pelo formulário da página de confirmação e pelo descadastro em um
+// clique dos clientes de email (POST com List-Unsubscribe=One-Click, RFC 8058)
+func (ctl *Controller) RegistrarDescadastro(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting registrar descadastro controller")
+
+	if err := ctl.service.RegistrarDescadastroService(ctx.Params("token")); err != nil {
+		zap.L().Error("Error registering descadastro", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	ctx.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	ctx.Set(fiber.HeaderCacheControl, "no-store")
+	return ctx.Status(fiber.StatusOK).SendString(paginaDescadastro)
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"bytes"
+
+	"github.com/betine97/back-project.git/src/view"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE RELATÓRIOS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetValorizacaoEstoque(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get valorizacao estoque controller")
+
+	userID := ctx.Locals("userID").(string)
+	dataCorte := ctx.Query("data_corte")
+	agrupamento := ctx.Query("agrupamento", "produto")
+
+	relatorio, err := ctl.service.GetValorizacaoEstoqueService(userID, dataCorte, agrupamento)
+	if err != nil {
+		zap.L().Error("Error getting valorizacao estoque", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if ctx.Query("format") == "csv" {
+		var buf bytes.Buffer
+		if err := view.WriteValorizacaoEstoqueCSV(&buf, relatorio); err != nil {
+			zap.L().Error("Error writing valorizacao estoque CSV", zap.Error(err))
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "Error generating CSV",
+			})
+		}
+		return sendCSV(ctx, "valorizacao_estoque_"+relatorio.DataCorte+".csv", buf.Bytes())
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(relatorio)
+}
+
+func (ctl *Controller) GetMargemBruta(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get margem bruta controller")
+
+	userID := ctx.Locals("userID").(string)
+	dataCorte := ctx.Query("data_corte")
+
+	relatorio, err := ctl.service.GetMargemBrutaService(userID, dataCorte)
+	if err != nil {
+		zap.L().Error("Error getting margem bruta", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if ctx.Query("format") == "csv" {
+		var buf bytes.Buffer
+		if err := view.WriteMargemBrutaCSV(&buf, relatorio); err != nil {
+			zap.L().Error("Error writing margem bruta CSV", zap.Error(err))
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "Error generating CSV",
+			})
+		}
+		return sendCSV(ctx, "margem_bruta_"+relatorio.DataCorte+".csv", buf.Bytes())
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(relatorio)
+}
+
+// sendCSV envia o conteúdo como anexo CSV
+func sendCSV(ctx *fiber.Ctx, filename string, content []byte) error {
+	ctx.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	ctx.Attachment(filename)
+	return ctx.Status(fiber.StatusOK).Send(content)
+}
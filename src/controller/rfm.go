@@ -0,0 +1,41 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE RFM DE CLIENTES ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetRFMClientes(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get RFM clientes controller")
+
+	userID := ctx.Locals("userID").(string)
+	segmento := ctx.Query("segmento")
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarRFMClientesService(userID, segmento)
+		return exportar(ctx, "rfm_clientes", formato, dtos.RFMClienteResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	clientes, err := ctl.service.GetRFMClientesService(userID, segmento, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting RFM clientes", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(clientes)
+}
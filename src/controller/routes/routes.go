@@ -15,6 +15,14 @@ func SetupRoutes(app *fiber.App, userController controller.ControllerInterface)
 	app.Post("/cadastro", middlewares.UserValidationMiddleware, userController.CreateUser)
 	app.Post("/login", userController.LoginUser)
 
+	// Rastreio de envios de campanhas (público; o token é assinado)
+	rastreio := app.Group("/rastreio")
+	rastreio.Get("/:token/abertura", userController.RegistrarAbertura)
+	rastreio.Get("/:token/clique", userController.RegistrarClique)
+	rastreio.Post("/:token/status", middlewares.StatusEnvioValidationMiddleware, userController.RegistrarStatusEnvio)
+	rastreio.Get("/:token/descadastro", userController.ConfirmarDescadastro)
+	rastreio.Post("/:token/descadastro", userController.RegistrarDescadastro)
+
 	// Rota de teste temporária (SEM autenticação) - REMOVER EM PRODUÇÃO
 	app.Get("/test/publicos/:id/clientes", userController.GetClientesDoPublicoTest)
 
@@ -24,6 +32,7 @@ func SetupRoutes(app *fiber.App, userController controller.ControllerInterface)
 	fornecedores := api.Group("/fornecedores")
 	fornecedores.Get("/", userController.GetAllFornecedores)
 	fornecedores.Post("/", userController.CreateFornecedor)
+	fornecedores.Post("/importar", userController.ImportarFornecedores)
 	fornecedores.Put("changestatus/:id", userController.ChangeStatusFornecedor)
 	fornecedores.Put("changefields/:id", userController.UpdateFornecedorField)
 	fornecedores.Delete("/:id", userController.DeleteFornecedor)
@@ -32,7 +41,43 @@ func SetupRoutes(app *fiber.App, userController controller.ControllerInterface)
 	produtos := api.Group("/produtos")
 	produtos.Get("/", userController.GetAllProducts)
 	produtos.Post("/", middlewares.ProductValidationMiddleware, userController.CreateProduct)
+	produtos.Post("/importar", userController.ImportarProdutos)
+	produtos.Get("/barcode/:codigo", userController.GetProductByBarcode)
+	produtos.Get("/sku/:sku", userController.GetProductBySKU)
+	produtos.Get("/:id", userController.GetProductByID)
+	produtos.Patch("/:id", middlewares.ProductUpdateValidationMiddleware, userController.UpdateProduct)
 	produtos.Delete("/:id", userController.DeleteProduct)
+	produtos.Put("/:id/estoque-minimo", middlewares.EstoqueMinimoValidationMiddleware, userController.UpdateEstoqueMinimo)
+	produtos.Get("/:id/variacoes", userController.GetVariacoes)
+	produtos.Post("/:id/variacoes", middlewares.VariacaoValidationMiddleware, userController.CreateVariacao)
+	produtos.Put("/:id/variacoes/:id_variacao", userController.VincularVariacao)
+	produtos.Get("/:id/componentes", userController.GetKitComponentes)
+	produtos.Put("/:id/componentes", middlewares.KitComponentesValidationMiddleware, userController.SetKitComponentes)
+	produtos.Get("/:id/precos/historico", userController.GetHistoricoPrecos)
+	produtos.Get("/:id/precos/vigente", userController.GetPrecoVigente)
+	produtos.Get("/:id/precos/agendados", userController.GetPrecosAgendados)
+	produtos.Post("/:id/precos/agendados", middlewares.PrecoAgendadoValidationMiddleware, userController.CreatePrecoAgendado)
+	produtos.Delete("/:id/precos/agendados/:id_agendamento", userController.CancelarPrecoAgendado)
+
+	// Protected categorias routes (com autenticação)
+	categorias := api.Group("/categorias")
+	categorias.Get("/", userController.GetCategorias)
+	categorias.Post("/", middlewares.CreateCategoriaValidationMiddleware, userController.CreateCategoria)
+	categorias.Post("/normalizar", userController.NormalizarCatalogo)
+	categorias.Get("/:id", userController.GetCategoriaByID)
+	categorias.Put("/:id", middlewares.UpdateCategoriaValidationMiddleware, userController.UpdateCategoria)
+	categorias.Delete("/:id", userController.DeleteCategoria)
+	categorias.Post("/:id/mover", middlewares.MoverCategoriaValidationMiddleware, userController.MoverCategoria)
+	categorias.Post("/:id/mesclar", middlewares.MesclarCategoriaValidationMiddleware, userController.MesclarCategoria)
+
+	// Protected importacoes routes (com autenticação)
+	importacoes := api.Group("/importacoes")
+	importacoes.Get("/", userController.GetImportacoes)
+	importacoes.Get("/:id", userController.GetImportacaoByID)
+	importacoes.Get("/:id/erros", userController.GetImportacaoErros)
+
+	// Protected especies routes (com autenticação)
+	api.Get("/especies", userController.GetEspecies)
 
 	// Protected pedidos routes (com autenticação)
 	pedidos := api.Group("/pedidos")
@@ -44,10 +89,66 @@ func SetupRoutes(app *fiber.App, userController controller.ControllerInterface)
 	pedidos.Get("/:id/itens", userController.GetItensPedido)
 	pedidos.Post("/:id/itens", middlewares.ItemPedidoValidationMiddleware, userController.CreateItemPedido)
 
+	// Protected vendas routes (com autenticação)
+	vendas := api.Group("/vendas")
+	vendas.Get("/", userController.GetAllVendas)
+	vendas.Post("/", middlewares.VendaValidationMiddleware, userController.CreateVenda)
+	vendas.Get("/:id", userController.GetVendaByID)
+	vendas.Get("/:id/itens", userController.GetItensVenda)
+	vendas.Post("/:id/itens", middlewares.ItemVendaValidationMiddleware, userController.AdicionarItemVenda)
+	vendas.Post("/:id/pagar", userController.PagarVenda)
+	vendas.Post("/:id/cancelar", userController.CancelarVenda)
+	vendas.Post("/:id/devolucoes", middlewares.DevolucaoValidationMiddleware, userController.CreateDevolucao)
+
+	// Protected devolucoes routes (com autenticação)
+	devolucoes := api.Group("/devolucoes")
+	devolucoes.Get("/", userController.GetAllDevolucoes)
+	devolucoes.Get("/:id", userController.GetDevolucaoByID)
+
+	// Protected caixas routes (com autenticação)
+	caixas := api.Group("/caixas")
+	caixas.Get("/", userController.GetAllCaixas)
+	caixas.Post("/", middlewares.AbrirCaixaValidationMiddleware, userController.AbrirCaixa)
+	caixas.Get("/atual", userController.GetCaixaAtual)
+	caixas.Get("/:id", userController.GetCaixaByID)
+	caixas.Get("/:id/movimentos", userController.GetCaixaMovimentos)
+	caixas.Post("/:id/sangrias", middlewares.MovimentoCaixaValidationMiddleware, userController.RegistrarSangria)
+	caixas.Post("/:id/suprimentos", middlewares.MovimentoCaixaValidationMiddleware, userController.RegistrarSuprimento)
+	caixas.Post("/:id/fechar", middlewares.FecharCaixaValidationMiddleware, userController.FecharCaixa)
+	caixas.Get("/:id/relatorio", userController.GetRelatorioCaixa)
+
+	// Protected fidelidade routes (com autenticação)
+	fidelidade := api.Group("/fidelidade")
+	fidelidade.Get("/config", userController.GetFidelidadeConfig)
+	fidelidade.Put("/config", middlewares.FidelidadeConfigValidationMiddleware, userController.UpdateFidelidadeConfig)
+	fidelidade.Post("/expirar", userController.ExpirarPontos)
+
 	// Protected estoque routes (com autenticação)
 	estoque := api.Group("/estoque")
 	estoque.Get("/", userController.GetAllEstoque)
 	estoque.Post("/", middlewares.EstoqueValidationMiddleware, userController.CreateEstoque)
+	estoque.Post("/saidas", middlewares.SaidaEstoqueValidationMiddleware, userController.CreateSaidaEstoque)
+
+	// Protected alertas routes (com autenticação)
+	alertas := api.Group("/alertas")
+	alertas.Get("/", userController.GetAlertasEstoque)
+	alertas.Post("/gerar", userController.GerarAlertasEstoque)
+	alertas.Put("/:id/resolver", userController.ResolverAlertaEstoque)
+
+	// Protected inventarios routes (com autenticação)
+	inventarios := api.Group("/inventarios")
+	inventarios.Get("/", userController.GetAllInventarios)
+	inventarios.Post("/", middlewares.InventarioValidationMiddleware, userController.CreateInventario)
+	inventarios.Get("/:id", userController.GetInventarioByID)
+	inventarios.Post("/:id/contagens", middlewares.ContagemInventarioValidationMiddleware, userController.RegistrarContagem)
+	inventarios.Get("/:id/divergencias", userController.GetDivergenciasInventario)
+	inventarios.Post("/:id/fechar", userController.FecharInventario)
+	inventarios.Post("/:id/cancelar", userController.CancelarInventario)
+
+	// Protected relatorios routes (com autenticação)
+	relatorios := api.Group("/relatorios")
+	relatorios.Get("/valorizacao-estoque", userController.GetValorizacaoEstoque)
+	relatorios.Get("/margem-bruta", userController.GetMargemBruta)
 
 	// Protected clientes routes (com autenticação)
 	clientes := api.Group("/clientes")
@@ -55,7 +156,9 @@ func SetupRoutes(app *fiber.App, userController controller.ControllerInterface)
 	clientes.Get("/buscar-criterios/:id_publico", userController.BuscarClientesCriterios)
 	clientes.Post("/adicionar-ao-publico/:id_publico", userController.AdicionarClientesAoPublico)
 	clientes.Get("/id/:id", userController.GetClienteByID)
+	clientes.Get("/rfm", userController.GetRFMClientes)
 	clientes.Post("/", middlewares.ClienteValidationMiddleware, userController.CreateCliente)
+	clientes.Post("/importar", userController.ImportarClientes)
 	clientes.Delete("/:id", userController.DeleteCliente)
 
 	// Tags de clientes
@@ -63,6 +166,18 @@ func SetupRoutes(app *fiber.App, userController controller.ControllerInterface)
 	clientes.Delete("/:id/tags", middlewares.RemoverTagsClienteValidationMiddleware, userController.RemoverTagsCliente)
 	clientes.Get("/:id/tags", userController.GetTagsCliente)
 
+	// Crédito na loja
+	clientes.Get("/:id/creditos", userController.GetCreditosCliente)
+
+	// Pontos de fidelidade
+	clientes.Get("/:id/pontos", userController.GetPontosCliente)
+	clientes.Post("/:id/pontos/resgates", middlewares.ResgatarPontosValidationMiddleware, userController.ResgatarPontos)
+
+	// Consentimentos para receber campanhas (LGPD)
+	clientes.Get("/:id/consentimentos", userController.GetConsentimentosCliente)
+	clientes.Put("/:id/consentimentos", middlewares.ConsentimentosClienteValidationMiddleware, userController.SetConsentimentosCliente)
+	clientes.Get("/:id/consentimentos/historico", userController.GetHistoricoConsentimentos)
+
 	// Protected enderecos routes (com autenticação)
 	enderecos := api.Group("/enderecos")
 	enderecos.Get("/", userController.GetAllEnderecos)
@@ -72,14 +187,26 @@ func SetupRoutes(app *fiber.App, userController controller.ControllerInterface)
 	// Protected criterios routes (com autenticação)
 	criterios := api.Group("/criterios")
 	criterios.Get("/", userController.GetAllCriterios)
+	criterios.Post("/", middlewares.CreateCriterioValidationMiddleware, userController.CreateCriterio)
+	criterios.Get("/campos", userController.GetCamposCriterios)
 
 	// Protected publicos routes (com autenticação)
 	publicos := api.Group("/publicos")
 	publicos.Get("/", userController.GetAllPublicos)
 	publicos.Post("/", middlewares.PublicoValidationMiddleware, userController.CreatePublico)
+	publicos.Post("/preview", middlewares.PreviewPublicoValidationMiddleware, userController.PreviewPublico)
+	publicos.Post("/operacoes", middlewares.OperacaoPublicosValidationMiddleware, userController.OperacaoPublicos)
 	publicos.Post("/:id/criterios", userController.AssociarCriteriosPublico)
 	publicos.Get("/:id/criterios", userController.GetCriteriosPublico)
 	publicos.Get("/:id/clientes", userController.GetClientesDoPublico)
+	publicos.Put("/:id/tipo", middlewares.UpdateTipoPublicoValidationMiddleware, userController.UpdateTipoPublico)
+	publicos.Post("/:id/atualizar", userController.AtualizarPublico)
+	publicos.Get("/:id/historico", userController.GetHistoricoPublico)
+	publicos.Post("/:id/membros", middlewares.MembrosPublicoValidationMiddleware, userController.AdicionarMembrosPublico)
+	publicos.Delete("/:id/membros", middlewares.MembrosPublicoValidationMiddleware, userController.RemoverMembrosPublico)
+	publicos.Get("/:id/exclusoes", userController.GetExclusoesPublico)
+	publicos.Post("/:id/exclusoes", middlewares.ExcluirClientesPublicoValidationMiddleware, userController.ExcluirClientesPublico)
+	publicos.Delete("/:id/exclusoes/:id_cliente", userController.RemoverExclusaoPublico)
 
 	// Protected pets routes (com autenticação)
 	pets := api.Group("/pets")
@@ -102,5 +229,22 @@ func SetupRoutes(app *fiber.App, userController controller.ControllerInterface)
 	campanhas.Post("/", middlewares.CampanhaValidationMiddleware, userController.CreateCampanha)
 	campanhas.Post("/:id/publicos", userController.AssociarPublicosCampanha)
 	campanhas.Get("/:id/publicos", userController.GetPublicosCampanha)
+	campanhas.Post("/:id/disparar", middlewares.DispararCampanhaValidationMiddleware, userController.DispararCampanha)
+	campanhas.Get("/:id/envios", userController.GetEnviosCampanha)
+	campanhas.Get("/:id/canais", userController.GetCanaisCampanha)
+	campanhas.Put("/:id/canais", middlewares.CanaisCampanhaValidationMiddleware, userController.SetCanaisCampanha)
+	campanhas.Get("/:id/validacao", userController.ValidarCampanha)
+	campanhas.Post("/:id/pausar", userController.PausarCampanha)
+	campanhas.Post("/:id/retomar", userController.RetomarCampanha)
+
+	// Protected templates de mensagens routes (com autenticação)
+	templates := api.Group("/templates")
+	templates.Get("/", userController.GetTemplatesMensagens)
+	templates.Get("/campos", userController.GetCamposTemplate)
+	templates.Get("/:id", userController.GetTemplateMensagemByID)
+	templates.Get("/:id/preview", userController.PreviewTemplateMensagem)
+	templates.Post("/", middlewares.TemplateMensagemValidationMiddleware, userController.CreateTemplateMensagem)
+	templates.Put("/:id", middlewares.TemplateMensagemValidationMiddleware, userController.UpdateTemplateMensagem)
+	templates.Delete("/:id", userController.DeleteTemplateMensagem)
 
 }
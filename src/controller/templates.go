@@ -0,0 +1,187 @@
+package controller
+
+import (
+	"strings"
+
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE TEMPLATES DE MENSAGENS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetTemplatesMensagens(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get templates controller")
+
+	userID := ctx.Locals("userID").(string)
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarTemplatesMensagensService(userID, ctx.Query("canal"))
+		return exportar(ctx, "templates", formato, dtos.TemplateMensagemResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	templates, err := ctl.service.GetTemplatesMensagensService(userID, ctx.Query("canal"), page, limit)
+	if err != nil {
+		zap.L().Error("Error getting templates", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(templates)
+}
+
+func (ctl *Controller) GetTemplateMensagemByID(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get template by ID controller")
+
+	userID := ctx.Locals("userID").(string)
+	template, err := ctl.service.GetTemplateMensagemByIDService(userID, ctx.Params("id"))
+	if err != nil {
+		zap.L().Error("Error getting template", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(template)
+}
+
+func (ctl *Controller) CreateTemplateMensagem(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create template controller")
+
+	request := ctx.Locals("templateMensagem").(dtos.TemplateMensagemRequest)
+
+	userID := ctx.Locals("userID").(string)
+	template, err := ctl.service.CreateTemplateMensagemService(userID, request)
+	if err != nil {
+		zap.L().Error("Error creating template", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(template)
+}
+
+func (ctl *Controller) UpdateTemplateMensagem(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting update template controller")
+
+	request := ctx.Locals("templateMensagem").(dtos.TemplateMensagemRequest)
+
+	userID := ctx.Locals("userID").(string)
+	template, err := ctl.service.UpdateTemplateMensagemService(userID, ctx.Params("id"), request)
+	if err != nil {
+		zap.L().Error("Error updating template", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(template)
+}
+
+func (ctl *Controller) DeleteTemplateMensagem(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting delete template controller")
+
+	userID := ctx.Locals("userID").(string)
+	_, err := ctl.service.DeleteTemplateMensagemService(userID, ctx.Params("id"))
+	if err != nil {
+		zap.L().Error("Error deleting template", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Template deleted successfully",
+	})
+}
+
+func (ctl *Controller) GetCamposTemplate(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get campos template controller")
+
+	return ctx.Status(fiber.StatusOK).JSON(ctl.service.GetCamposTemplateService())
+}
+
+// PreviewTemplateMensagem renderiza o template para o cliente de ?id_cliente (e a campanha de ?id_campanha, opcional)
+func (ctl *Controller) PreviewTemplateMensagem(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting preview template controller")
+
+	userID := ctx.Locals("userID").(string)
+	preview, err := ctl.service.PreviewTemplateMensagemService(userID, ctx.Params("id"), ctx.Query("id_cliente"), ctx.Query("id_campanha"))
+	if err != nil {
+		zap.L().Error("Error rendering template preview", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(preview)
+}
+
+// FUNÇÕES DE CANAIS DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetCanaisCampanha(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get canais campanha controller")
+
+	userID := ctx.Locals("userID").(string)
+	canais, err := ctl.service.GetCanaisCampanhaService(userID, ctx.Params("id"))
+	if err != nil {
+		zap.L().Error("Error getting canais of campanha", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(canais)
+}
+
+func (ctl *Controller) SetCanaisCampanha(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting set canais campanha controller")
+
+	request := ctx.Locals("canaisCampanha").(dtos.CanaisCampanhaRequest)
+
+	userID := ctx.Locals("userID").(string)
+	canais, err := ctl.service.SetCanaisCampanhaService(userID, ctx.Params("id"), request)
+	if err != nil {
+		zap.L().Error("Error setting canais of campanha", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(canais)
+}
+
+// ValidarCampanha monta as mensagens sem disparar; ?canais=email,sms escolhe os canais
+func (ctl *Controller) ValidarCampanha(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting validar campanha controller")
+
+	var canais []string
+	if valor := ctx.Query("canais"); valor != "" {
+		for _, canal := range strings.Split(valor, ",") {
+			canais = append(canais, strings.TrimSpace(canal))
+		}
+	}
+
+	userID := ctx.Locals("userID").(string)
+	validacao, err := ctl.service.ValidarCampanhaService(userID, ctx.Params("id"), canais)
+	if err != nil {
+		zap.L().Error("Error validating campanha", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(validacao)
+}
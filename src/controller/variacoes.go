@@ -0,0 +1,125 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE VARIAÇÕES E KITS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetVariacoes(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get variacoes controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	variacoes, err := ctl.service.GetVariacoesService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting variacoes", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(variacoes)
+}
+
+func (ctl *Controller) CreateVariacao(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create variacao controller")
+
+	id := ctx.Params("id")
+	request := ctx.Locals("createVariacao").(dtos.CreateVariacaoRequest)
+	userID := ctx.Locals("userID").(string)
+
+	idVariacao, err := ctl.service.CreateVariacaoService(userID, id, request)
+	if err != nil {
+		zap.L().Error("Error creating variacao", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message":    "Variacao created successfully",
+		"id_produto": idVariacao,
+	})
+}
+
+func (ctl *Controller) VincularVariacao(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting vincular variacao controller")
+
+	id := ctx.Params("id")
+	idVariacao := ctx.Params("id_variacao")
+	userID := ctx.Locals("userID").(string)
+
+	success, err := ctl.service.VincularVariacaoService(userID, id, idVariacao)
+	if err != nil {
+		zap.L().Error("Error linking variacao", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if !success {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Error linking variacao",
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Variacao linked successfully",
+	})
+}
+
+func (ctl *Controller) GetKitComponentes(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get kit componentes controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	kit, err := ctl.service.GetKitComponentesService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting kit componentes", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(kit)
+}
+
+func (ctl *Controller) SetKitComponentes(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting set kit componentes controller")
+
+	id := ctx.Params("id")
+	request := ctx.Locals("setKitComponentes").(dtos.SetKitComponentesRequest)
+	userID := ctx.Locals("userID").(string)
+
+	kit, err := ctl.service.SetKitComponentesService(userID, id, request)
+	if err != nil {
+		zap.L().Error("Error setting kit componentes", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(kit)
+}
+
+func (ctl *Controller) CreateSaidaEstoque(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create saida estoque controller")
+
+	request := ctx.Locals("createSaidaEstoque").(dtos.CreateSaidaEstoqueRequest)
+	userID := ctx.Locals("userID").(string)
+
+	saida, err := ctl.service.CreateSaidaEstoqueService(userID, request)
+	if err != nil {
+		zap.L().Error("Error creating saida estoque", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(saida)
+}
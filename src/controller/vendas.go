@@ -0,0 +1,161 @@
+package controller
+
+import (
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE VENDAS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (ctl *Controller) GetAllVendas(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get all vendas controller")
+
+	userID := ctx.Locals("userID").(string)
+	status := ctx.Query("status")
+	idCliente := ctx.Query("id_cliente")
+
+	if formato := formatoExportacao(ctx); formato != "" {
+		exportarFn, err := ctl.service.ExportarVendasService(userID, status, idCliente)
+		return exportar(ctx, "vendas", formato, dtos.VendaResponse{}, exportarFn, err)
+	}
+
+	page := ctx.QueryInt("page", 1)
+	limit := ctx.QueryInt("limit", 10)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	vendas, err := ctl.service.GetAllVendasService(userID, status, idCliente, page, limit)
+	if err != nil {
+		zap.L().Error("Error getting vendas", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(vendas)
+}
+
+func (ctl *Controller) GetVendaByID(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get venda by ID controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	venda, err := ctl.service.GetVendaByIDService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting venda by ID", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(venda)
+}
+
+func (ctl *Controller) GetItensVenda(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting get itens venda controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	itens, err := ctl.service.GetItensVendaService(userID, id)
+	if err != nil {
+		zap.L().Error("Error getting itens venda", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(itens)
+}
+
+func (ctl *Controller) CreateVenda(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting create venda controller")
+
+	createVenda := ctx.Locals("createVenda").(dtos.CreateVendaRequest)
+
+	userID := ctx.Locals("userID").(string)
+	vendaID, err := ctl.service.CreateVendaService(userID, createVenda)
+	if err != nil {
+		zap.L().Error("Error creating venda", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message":  "Venda created successfully",
+		"id_venda": vendaID,
+	})
+}
+
+func (ctl *Controller) AdicionarItemVenda(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting adicionar item venda controller")
+
+	id := ctx.Params("id")
+	itemVenda := ctx.Locals("itemVenda").(dtos.ItemVendaRequest)
+
+	userID := ctx.Locals("userID").(string)
+	venda, err := ctl.service.AdicionarItemVendaService(userID, id, itemVenda)
+	if err != nil {
+		zap.L().Error("Error adding item to venda", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(venda)
+}
+
+func (ctl *Controller) PagarVenda(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting pagar venda controller")
+
+	id := ctx.Params("id")
+	var request dtos.PagarVendaRequest
+
+	// O corpo é opcional; sem ele vale a forma de pagamento informada na criação
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&request); err != nil {
+			zap.L().Error("Error reading request data", zap.Error(err))
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Unable to read request data",
+			})
+		}
+	}
+
+	userID := ctx.Locals("userID").(string)
+	venda, err := ctl.service.PagarVendaService(userID, id, request)
+	if err != nil {
+		zap.L().Error("Error paying venda", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(venda)
+}
+
+func (ctl *Controller) CancelarVenda(ctx *fiber.Ctx) error {
+	zap.L().Info("Starting cancelar venda controller")
+
+	id := ctx.Params("id")
+	userID := ctx.Locals("userID").(string)
+
+	_, err := ctl.service.CancelarVendaService(userID, id)
+	if err != nil {
+		zap.L().Error("Error canceling venda", zap.Error(err))
+		return ctx.Status(err.Code).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Venda canceled successfully",
+	})
+}
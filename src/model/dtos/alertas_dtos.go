@@ -0,0 +1,39 @@
+package dtos
+
+// Para GET api/alertas
+type AlertaEstoqueResponse struct {
+	ID             int    `json:"id"`
+	Tipo           string `json:"tipo"`
+	IDProduto      int    `json:"id_produto"`
+	IDLote         int    `json:"id_lote,omitempty"`
+	NomeProduto    string `json:"nome_produto"`
+	Quantidade     int    `json:"quantidade"`
+	EstoqueMinimo  int    `json:"estoque_minimo,omitempty"`
+	Vencimento     string `json:"vencimento,omitempty"`
+	DiasParaVencer int    `json:"dias_para_vencer,omitempty"`
+	Mensagem       string `json:"mensagem"`
+	DataGeracao    string `json:"data_geracao"`
+	Status         string `json:"status"`
+}
+
+type AlertaEstoqueListResponse struct {
+	Alertas    []AlertaEstoqueResponse `json:"alertas"`
+	Total      int                     `json:"total"`
+	Page       int                     `json:"page"`
+	Limit      int                     `json:"limit"`
+	TotalPages int                     `json:"total_pages"`
+}
+
+// Para POST api/alertas/gerar - Resultado da verificação de vencimentos e estoque mínimo
+type GerarAlertasResponse struct {
+	DiasVencimento       int                     `json:"dias_vencimento"`
+	LotesVencendo        int                     `json:"lotes_vencendo"`
+	ProdutosAbaixoMinimo int                     `json:"produtos_abaixo_minimo"`
+	AlertasCriados       int                     `json:"alertas_criados"`
+	NovosAlertas         []AlertaEstoqueResponse `json:"novos_alertas"`
+}
+
+// Para PUT api/produtos/:id/estoque-minimo
+type UpdateEstoqueMinimoRequest struct {
+	EstoqueMinimo int `json:"estoque_minimo" validate:"gte=0"`
+}
@@ -0,0 +1,84 @@
+package dtos
+
+// Para POST api/caixas
+// Cada operador só pode ter uma sessão aberta
+type AbrirCaixaRequest struct {
+	Operador      string  `json:"operador" validate:"required,max=100"`
+	Identificacao string  `json:"identificacao" validate:"max=50"`
+	ValorAbertura float64 `json:"valor_abertura" validate:"gte=0"`
+}
+
+// Para POST api/caixas/:id/sangrias e api/caixas/:id/suprimentos
+type MovimentoCaixaRequest struct {
+	Valor     float64 `json:"valor" validate:"required,gt=0"`
+	Descricao string  `json:"descricao" validate:"max=255"`
+}
+
+// Para POST api/caixas/:id/fechar
+type FecharCaixaRequest struct {
+	ValorContado *float64 `json:"valor_contado" validate:"required,gte=0"`
+	Observacao   string   `json:"observacao" validate:"max=500"`
+}
+
+// Para GET api/caixas
+type CaixaSessaoResponse struct {
+	ID                   int      `json:"id"`
+	Operador             string   `json:"operador"`
+	Identificacao        string   `json:"identificacao"`
+	Status               string   `json:"status"`
+	ValorAbertura        float64  `json:"valor_abertura"`
+	ValorEsperado        *float64 `json:"valor_esperado,omitempty"`
+	ValorContado         *float64 `json:"valor_contado,omitempty"`
+	Diferenca            *float64 `json:"diferenca,omitempty"`
+	DataAbertura         string   `json:"data_abertura"`
+	DataFechamento       string   `json:"data_fechamento,omitempty"`
+	ObservacaoFechamento string   `json:"observacao_fechamento,omitempty"`
+}
+
+type CaixaSessaoListResponse struct {
+	Caixas     []CaixaSessaoResponse `json:"caixas"`
+	Total      int                   `json:"total"`
+	Page       int                   `json:"page"`
+	Limit      int                   `json:"limit"`
+	TotalPages int                   `json:"total_pages"`
+}
+
+// Para GET api/caixas/:id/movimentos
+type CaixaMovimentoResponse struct {
+	ID             int     `json:"id"`
+	Tipo           string  `json:"tipo"`
+	FormaPagamento string  `json:"forma_pagamento,omitempty"`
+	Valor          float64 `json:"valor"`
+	IDVenda        *int    `json:"id_venda,omitempty"`
+	Descricao      string  `json:"descricao,omitempty"`
+	DataMovimento  string  `json:"data_movimento"`
+}
+
+type CaixaMovimentoListResponse struct {
+	IDSessao   int                      `json:"id_sessao"`
+	Movimentos []CaixaMovimentoResponse `json:"movimentos"`
+	Total      int                      `json:"total"`
+}
+
+// Para GET api/caixas/:id/relatorio e resposta de POST api/caixas/:id/fechar
+// Na sessão aberta é um relatório parcial: valor_contado e diferenca só aparecem depois do fechamento
+type RelatorioCaixaResponse struct {
+	Caixa            CaixaSessaoResponse       `json:"caixa"`
+	FormasPagamento  []TotalFormaCaixaResponse `json:"formas_pagamento"`
+	QuantidadeVendas int                       `json:"quantidade_vendas"`
+	TotalVendas      float64                   `json:"total_vendas"`
+	TotalEstornos    float64                   `json:"total_estornos"`
+	TotalSangrias    float64                   `json:"total_sangrias"`
+	TotalSuprimentos float64                   `json:"total_suprimentos"`
+	DinheiroEsperado float64                   `json:"dinheiro_esperado"`
+	ValorContado     *float64                  `json:"valor_contado,omitempty"`
+	Diferenca        *float64                  `json:"diferenca,omitempty"`
+}
+
+type TotalFormaCaixaResponse struct {
+	FormaPagamento   string  `json:"forma_pagamento"`
+	QuantidadeVendas int     `json:"quantidade_vendas"`
+	ValorVendas      float64 `json:"valor_vendas"`
+	ValorEstornos    float64 `json:"valor_estornos"`
+	ValorLiquido     float64 `json:"valor_liquido"`
+}
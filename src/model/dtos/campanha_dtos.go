@@ -2,13 +2,51 @@ package dtos
 
 // Para GET api/campanhas
 type CampanhaResponse struct {
-	ID             int    `json:"id"`
-	Nome           string `json:"nome"`
-	Desc           string `json:"desc"`
-	DataCriacao    string `json:"data_criacao"`
-	DataLancamento string `json:"data_lancamento"`
-	DataFim        string `json:"data_fim"`
-	Status         string `json:"status"`
+	ID             int     `json:"id"`
+	Nome           string  `json:"nome"`
+	Desc           string  `json:"desc"`
+	DataCriacao    string  `json:"data_criacao"`
+	DataLancamento string  `json:"data_lancamento"`
+	DataFim        string  `json:"data_fim"`
+	Status         string  `json:"status"`
+	DataDisparo    *string `json:"data_disparo"`
+	ErroDisparo    *string `json:"erro_disparo"`
+	// Só no GET api/campanhas/:id
+	Metricas *MetricasCampanhaResponse `json:"metricas,omitempty"`
+}
+
+// Desempenho da campanha: envios por situação, taxas sobre os enviados e vendas atribuídas
+type MetricasEnviosResponse struct {
+	Envios          int     `json:"envios"`
+	Destinatarios   int     `json:"destinatarios"`
+	NaFila          int     `json:"na_fila"`
+	Enviados        int     `json:"enviados"`
+	Entregues       int     `json:"entregues"`
+	Abertos         int     `json:"abertos"`
+	Clicados        int     `json:"clicados"`
+	Falhas          int     `json:"falhas"`
+	Descadastros    int     `json:"descadastros"`
+	Cancelados      int     `json:"cancelados"`
+	TaxaEntrega     float64 `json:"taxa_entrega"`
+	TaxaAbertura    float64 `json:"taxa_abertura"`
+	TaxaClique      float64 `json:"taxa_clique"`
+	TaxaDescadastro float64 `json:"taxa_descadastro"`
+	Vendas          int     `json:"vendas"`
+	Compradores     int     `json:"compradores"`
+	Receita         float64 `json:"receita"`
+	TaxaConversao   float64 `json:"taxa_conversao"`
+}
+
+type MetricasPublicoResponse struct {
+	IDPublico int    `json:"id_publico"`
+	Nome      string `json:"nome"`
+	MetricasEnviosResponse
+}
+
+type MetricasCampanhaResponse struct {
+	JanelaDias int `json:"janela_dias"`
+	MetricasEnviosResponse
+	Publicos []MetricasPublicoResponse `json:"publicos"`
 }
 
 type CampanhaListResponse struct {
@@ -26,7 +64,7 @@ type CreateCampanhaRequest struct {
 	DataCriacao    string `json:"data_criacao" validate:"required,max=20"`
 	DataLancamento string `json:"data_lancamento" validate:"required,max=20"`
 	DataFim        string `json:"data_fim" validate:"required,max=20"`
-	Status         string `json:"status" validate:"required,oneof=ativa inativa pausada finalizada"`
+	Status         string `json:"status" validate:"required,oneof=agendada ativa inativa pausada finalizada"`
 }
 
 // Para POST api/campanhas/:id/publicos - Associar públicos a uma campanha
@@ -48,3 +86,97 @@ type PublicosCampanhaListResponse struct {
 	Total      int                       `json:"total"`
 	IDCampanha int                       `json:"id_campanha"`
 }
+
+// Para POST api/campanhas/:id/disparar - Enfileirar o envio da campanha aos clientes dos públicos. Sem canais,
+// usa os canais configurados na campanha.
+type DispararCampanhaRequest struct {
+	Canais []string `json:"canais" validate:"omitempty,max=3,dive,oneof=email whatsapp sms"`
+}
+
+type DisparoCampanhaResponse struct {
+	IDCampanha       int `json:"id_campanha"`
+	Destinatarios    int `json:"destinatarios"`
+	Enfileirados     int `json:"enfileirados"`
+	JaExistiam       int `json:"ja_existiam"`
+	SemContato       int `json:"sem_contato"`
+	SemConsentimento int `json:"sem_consentimento"`
+}
+
+// Para GET api/campanhas/:id/envios - Listar os envios da campanha
+type CampanhaEnvioResponse struct {
+	ID              int     `json:"id"`
+	IDCliente       int     `json:"id_cliente"`
+	Canal           string  `json:"canal"`
+	Destino         string  `json:"destino"`
+	Status          string  `json:"status"`
+	Tentativas      int     `json:"tentativas"`
+	Erro            *string `json:"erro"`
+	DataCriacao     string  `json:"data_criacao"`
+	DataEnvio       *string `json:"data_envio"`
+	DataEntrega     *string `json:"data_entrega"`
+	DataAbertura    *string `json:"data_abertura"`
+	DataClique      *string `json:"data_clique"`
+	DataDescadastro *string `json:"data_descadastro"`
+	Cliques         int     `json:"cliques"`
+}
+
+type CampanhaEnvioListResponse struct {
+	IDCampanha int                     `json:"id_campanha"`
+	Envios     []CampanhaEnvioResponse `json:"envios"`
+	Total      int                     `json:"total"`
+	Page       int                     `json:"page"`
+	Limit      int                     `json:"limit"`
+	TotalPages int                     `json:"total_pages"`
+}
+
+// Para PUT api/campanhas/:id/canais - Canais da campanha e o template de cada um
+type CanalCampanhaRequest struct {
+	Canal      string `json:"canal" validate:"required,oneof=email whatsapp sms"`
+	IDTemplate *int   `json:"id_template" validate:"omitempty,min=1"`
+}
+
+type CanaisCampanhaRequest struct {
+	Canais []CanalCampanhaRequest `json:"canais" validate:"required,min=1,max=3,dive"`
+}
+
+type CanalCampanhaResponse struct {
+	Canal        string  `json:"canal"`
+	IDTemplate   *int    `json:"id_template"`
+	NomeTemplate *string `json:"nome_template"`
+}
+
+type CanaisCampanhaResponse struct {
+	IDCampanha int                     `json:"id_campanha"`
+	Canais     []CanalCampanhaResponse `json:"canais"`
+}
+
+// Para GET api/campanhas/:id/validacao - Clientes cujos campos do template não têm valor
+type ClienteNaoResolvidoResponse struct {
+	IDCliente   int      `json:"id_cliente"`
+	NomeCliente string   `json:"nome_cliente"`
+	Campos      []string `json:"campos"`
+}
+
+type ValidacaoCanalResponse struct {
+	Canal            string                        `json:"canal"`
+	IDTemplate       *int                          `json:"id_template"`
+	Envios           int                           `json:"envios"`
+	SemContato       int                           `json:"sem_contato"`
+	SemConsentimento int                           `json:"sem_consentimento"`
+	NaoResolvidos    int                           `json:"nao_resolvidos"`
+	Clientes         []ClienteNaoResolvidoResponse `json:"clientes"`
+}
+
+type ValidacaoCampanhaResponse struct {
+	IDCampanha    int                      `json:"id_campanha"`
+	Valida        bool                     `json:"valida"`
+	Destinatarios int                      `json:"destinatarios"`
+	Canais        []ValidacaoCanalResponse `json:"canais"`
+}
+
+// Para POST /rastreio/:token/status - Retorno de status do provedor de WhatsApp ou SMS
+type StatusEnvioRequest struct {
+	Status  string `json:"status" validate:"required,oneof=delivered read failed opted_out reply"`
+	Error   string `json:"error" validate:"max=500"`
+	Message string `json:"message" validate:"max=1000"`
+}
@@ -0,0 +1,69 @@
+package dtos
+
+// Para GET api/categorias
+// As categorias voltam em árvore, cada uma com as subcategorias
+type CategoriaResponse struct {
+	ID             int                 `json:"id"`
+	Nome           string              `json:"nome"`
+	IDCategoriaPai int                 `json:"id_categoria_pai,omitempty"`
+	Caminho        string              `json:"caminho"`
+	TotalProdutos  int                 `json:"total_produtos"`
+	Subcategorias  []CategoriaResponse `json:"subcategorias"`
+}
+
+type CategoriaTreeResponse struct {
+	Categorias []CategoriaResponse `json:"categorias"`
+	Total      int                 `json:"total"`
+}
+
+// Para POST api/categorias
+type CreateCategoriaRequest struct {
+	Nome           string `json:"nome" validate:"required,min=2,max=100"`
+	IDCategoriaPai *int   `json:"id_categoria_pai" validate:"omitempty,gt=0"`
+}
+
+// Para PUT api/categorias/:id
+type UpdateCategoriaRequest struct {
+	Nome string `json:"nome" validate:"required,min=2,max=100"`
+}
+
+// Para POST api/categorias/:id/mover
+// id_categoria_pai nulo ou ausente move a categoria para a raiz
+type MoverCategoriaRequest struct {
+	IDCategoriaPai *int `json:"id_categoria_pai" validate:"omitempty,gt=0"`
+}
+
+// Para POST api/categorias/:id/mesclar
+type MesclarCategoriaRequest struct {
+	IDCategoriaDestino int `json:"id_categoria_destino" validate:"required,gt=0"`
+}
+
+// Para GET api/especies
+type EspeciesResponse struct {
+	Especies      []string `json:"especies"`
+	DestinadoPara []string `json:"destinado_para"`
+}
+
+// Para POST api/categorias/normalizar
+type NormalizacaoCatalogoResponse struct {
+	DryRun                   bool                       `json:"dry_run"`
+	Categorias               []NormalizacaoItemResponse `json:"categorias"`
+	CategoriasCriadas        int                        `json:"categorias_criadas"`
+	DestinadoPara            []NormalizacaoItemResponse `json:"destinado_para"`
+	DestinadoParaNaoMapeados []ValorNaoMapeadoResponse  `json:"destinado_para_nao_mapeados"`
+	Especies                 []NormalizacaoItemResponse `json:"especies"`
+	EspeciesNaoMapeadas      []ValorNaoMapeadoResponse  `json:"especies_nao_mapeadas"`
+}
+
+type NormalizacaoItemResponse struct {
+	Para        string   `json:"para"`
+	IDCategoria int      `json:"id_categoria,omitempty"`
+	Nova        bool     `json:"nova,omitempty"`
+	De          []string `json:"de"`
+	Registros   int      `json:"registros"`
+}
+
+type ValorNaoMapeadoResponse struct {
+	Valor     string `json:"valor"`
+	Registros int    `json:"registros"`
+}
@@ -2,14 +2,15 @@ package dtos
 
 // Para GET api/clientes
 type ClienteResponse struct {
-	ID             int    `json:"id"`
-	TipoCliente    string `json:"tipo_cliente"`
-	NomeCliente    string `json:"nome_cliente"`
-	NumeroCelular  string `json:"numero_celular"`
-	Sexo           string `json:"sexo"`
-	Email          string `json:"email"`
-	DataNascimento string `json:"data_nascimento"`
-	DataCadastro   string `json:"data_cadastro"`
+	ID             int          `json:"id"`
+	TipoCliente    string       `json:"tipo_cliente"`
+	NomeCliente    string       `json:"nome_cliente"`
+	NumeroCelular  string       `json:"numero_celular"`
+	Sexo           string       `json:"sexo"`
+	Email          string       `json:"email"`
+	DataNascimento string       `json:"data_nascimento"`
+	DataCadastro   string       `json:"data_cadastro"`
+	RFM            *RFMResponse `json:"rfm,omitempty"`
 }
 
 type ClienteListResponse struct {
@@ -95,3 +96,31 @@ type ClientesPublicoListResponse struct {
 	TotalPages int                      `json:"total_pages"`
 	IDPublico  int                      `json:"id_publico"`
 }
+
+// RFMResponse resume o comportamento de compra do cliente: recência, frequência, valor e o segmento
+type RFMResponse struct {
+	UltimaCompra *string `json:"ultima_compra"`
+	RecenciaDias *int    `json:"recencia_dias"`
+	Frequencia   int     `json:"frequencia"`
+	Monetario    float64 `json:"monetario"`
+	TicketMedio  float64 `json:"ticket_medio"`
+	NotaR        int     `json:"nota_r"`
+	NotaF        int     `json:"nota_f"`
+	NotaM        int     `json:"nota_m"`
+	Segmento     string  `json:"segmento"`
+}
+
+type RFMClienteResponse struct {
+	IDCliente   int    `json:"id_cliente"`
+	NomeCliente string `json:"nome_cliente"`
+	RFMResponse
+}
+
+type RFMClienteListResponse struct {
+	Clientes   []RFMClienteResponse `json:"clientes"`
+	Segmentos  map[string]int       `json:"segmentos"`
+	Total      int                  `json:"total"`
+	Page       int                  `json:"page"`
+	Limit      int                  `json:"limit"`
+	TotalPages int                  `json:"total_pages"`
+}
@@ -0,0 +1,47 @@
+package dtos
+
+// Para PUT api/clientes/:id/consentimentos - Registrar o consentimento do cliente em um ou mais canais
+type ConsentimentoCanalRequest struct {
+	Canal      string `json:"canal" validate:"required,oneof=email whatsapp sms"`
+	Consentido *bool  `json:"consentido" validate:"required"`
+}
+
+type ConsentimentosClienteRequest struct {
+	Canais  []ConsentimentoCanalRequest `json:"canais" validate:"required,min=1,max=3,dive"`
+	Origem  string                      `json:"origem" validate:"required,oneof=loja site cadastro importacao"`
+	Detalhe string                      `json:"detalhe" validate:"max=255"`
+}
+
+// Para GET api/clientes/:id/consentimentos - Situação atual em cada canal; sem registro, o cliente não consentiu
+type ConsentimentoResponse struct {
+	Canal      string  `json:"canal"`
+	Consentido bool    `json:"consentido"`
+	Origem     *string `json:"origem"`
+	Detalhe    *string `json:"detalhe"`
+	Data       *string `json:"data"`
+}
+
+type ConsentimentosClienteResponse struct {
+	IDCliente int                     `json:"id_cliente"`
+	Canais    []ConsentimentoResponse `json:"canais"`
+}
+
+// Para GET api/clientes/:id/consentimentos/historico - Todas as alterações, para auditoria
+type ConsentimentoHistoricoResponse struct {
+	ID         int     `json:"id"`
+	Canal      string  `json:"canal"`
+	Consentido bool    `json:"consentido"`
+	Origem     string  `json:"origem"`
+	Detalhe    *string `json:"detalhe"`
+	IDEnvio    *int    `json:"id_envio"`
+	Data       string  `json:"data"`
+}
+
+type ConsentimentoHistoricoListResponse struct {
+	IDCliente  int                              `json:"id_cliente"`
+	Historico  []ConsentimentoHistoricoResponse `json:"historico"`
+	Total      int                              `json:"total"`
+	Page       int                              `json:"page"`
+	Limit      int                              `json:"limit"`
+	TotalPages int                              `json:"total_pages"`
+}
@@ -0,0 +1,75 @@
+package dtos
+
+// Para POST api/vendas/:id/devolucoes
+// tipo_reembolso "reembolso" devolve o dinheiro pela forma_reembolso (padrão: a forma de pagamento da venda);
+// "credito" lança o valor como crédito na loja do cliente da venda
+type CreateDevolucaoRequest struct {
+	TipoReembolso  string                 `json:"tipo_reembolso" validate:"required,oneof=reembolso credito"`
+	FormaReembolso string                 `json:"forma_reembolso" validate:"omitempty,oneof=dinheiro pix cartao_credito cartao_debito boleto"`
+	IDCaixa        int                    `json:"id_caixa" validate:"gte=0"`
+	Motivo         string                 `json:"motivo" validate:"max=500"`
+	Itens          []ItemDevolucaoRequest `json:"itens" validate:"required,min=1,dive"`
+}
+
+// Itens de POST api/vendas/:id/devolucoes
+// destino "estoque" devolve as unidades aos lotes; "descarte" registra a perda (padrão: estoque)
+type ItemDevolucaoRequest struct {
+	IDItemVenda int    `json:"id_item_venda" validate:"required,gt=0"`
+	Quantidade  int    `json:"quantidade" validate:"required,gt=0"`
+	Destino     string `json:"destino" validate:"omitempty,oneof=estoque descarte"`
+}
+
+// Para GET api/devolucoes
+type DevolucaoResponse struct {
+	ID             int     `json:"id"`
+	IDVenda        int     `json:"id_venda"`
+	IDCliente      *int    `json:"id_cliente"`
+	DataDevolucao  string  `json:"data_devolucao"`
+	TipoReembolso  string  `json:"tipo_reembolso"`
+	FormaReembolso string  `json:"forma_reembolso,omitempty"`
+	ValorTotal     float64 `json:"valor_total"`
+	Motivo         string  `json:"motivo"`
+	IDCaixaSessao  *int    `json:"id_caixa_sessao,omitempty"`
+	Operador       string  `json:"operador"`
+}
+
+type DevolucaoListResponse struct {
+	Devolucoes []DevolucaoResponse `json:"devolucoes"`
+	Total      int                 `json:"total"`
+	Page       int                 `json:"page"`
+	Limit      int                 `json:"limit"`
+	TotalPages int                 `json:"total_pages"`
+}
+
+type ItemDevolucaoResponse struct {
+	ID          int     `json:"id"`
+	IDItemVenda int     `json:"id_item_venda"`
+	IDProduto   int     `json:"id_produto"`
+	Quantidade  int     `json:"quantidade"`
+	Valor       float64 `json:"valor"`
+	Destino     string  `json:"destino"`
+}
+
+// Para GET api/devolucoes/:id
+type DevolucaoDetalheResponse struct {
+	DevolucaoResponse
+	Itens []ItemDevolucaoResponse `json:"itens"`
+}
+
+// Para GET api/clientes/:id/creditos
+type CreditoClienteResponse struct {
+	ID             int     `json:"id"`
+	Tipo           string  `json:"tipo"`
+	Valor          float64 `json:"valor"`
+	Origem         string  `json:"origem"`
+	IDReferencia   int     `json:"id_referencia"`
+	DataLancamento string  `json:"data_lancamento"`
+	Descricao      string  `json:"descricao"`
+}
+
+type ExtratoCreditoResponse struct {
+	IDCliente   int                      `json:"id_cliente"`
+	Saldo       float64                  `json:"saldo"`
+	Lancamentos []CreditoClienteResponse `json:"lancamentos"`
+	Total       int                      `json:"total"`
+}
@@ -54,3 +54,28 @@ type CreateEstoqueRequest struct {
 	DocumentoReferencia string  `json:"documento_referencia"`
 	Status              string  `json:"status" validate:"required"`
 }
+
+// Para POST api/estoque/saidas
+// Kits são baixados pelos componentes; os lotes que vencem primeiro são consumidos primeiro
+type CreateSaidaEstoqueRequest struct {
+	Itens      []ItemSaidaEstoqueRequest `json:"itens" validate:"required,min=1,dive"`
+	Observacao string                    `json:"observacao" validate:"max=500"`
+}
+
+type ItemSaidaEstoqueRequest struct {
+	IDProduto  int `json:"id_produto" validate:"required,gt=0"`
+	Quantidade int `json:"quantidade" validate:"required,gt=0"`
+}
+
+type MovimentacaoEstoqueResponse struct {
+	IDEstoque     int     `json:"id_estoque"`
+	IDProduto     int     `json:"id_produto"`
+	IDLote        int     `json:"id_lote"`
+	Quantidade    int     `json:"quantidade"`
+	CustoUnitario float64 `json:"custo_unitario"`
+}
+
+type SaidaEstoqueResponse struct {
+	Movimentacoes []MovimentacaoEstoqueResponse `json:"movimentacoes"`
+	CustoTotal    float64                       `json:"custo_total"`
+}
@@ -0,0 +1,65 @@
+package dtos
+
+// Para PUT api/fidelidade/config
+// Com o programa ativo, pontos_por_real e valor_ponto precisam ser maiores que zero
+type UpdateFidelidadeConfigRequest struct {
+	Ativo              *bool   `json:"ativo" validate:"required"`
+	PontosPorReal      float64 `json:"pontos_por_real" validate:"gte=0"`
+	ValorPonto         float64 `json:"valor_ponto" validate:"gte=0"`
+	ValidadeDias       int     `json:"validade_dias" validate:"gte=0"`
+	MinimoResgate      int     `json:"minimo_resgate" validate:"gte=0"`
+	DiasAvisoExpiracao int     `json:"dias_aviso_expiracao" validate:"gte=0"`
+}
+
+// Para GET api/fidelidade/config
+type FidelidadeConfigResponse struct {
+	Ativo              bool    `json:"ativo"`
+	PontosPorReal      float64 `json:"pontos_por_real"`
+	ValorPonto         float64 `json:"valor_ponto"`
+	ValidadeDias       int     `json:"validade_dias"`
+	MinimoResgate      int     `json:"minimo_resgate"`
+	DiasAvisoExpiracao int     `json:"dias_aviso_expiracao"`
+	DataAtualizacao    string  `json:"data_atualizacao,omitempty"`
+}
+
+// Para POST api/clientes/:id/pontos/resgates
+type ResgatarPontosRequest struct {
+	Pontos int `json:"pontos" validate:"required,gt=0"`
+}
+
+type ResgatePontosResponse struct {
+	IDCliente    int     `json:"id_cliente"`
+	Pontos       int     `json:"pontos"`
+	ValorCredito float64 `json:"valor_credito"`
+	SaldoPontos  int     `json:"saldo_pontos"`
+}
+
+// Para GET api/clientes/:id/pontos
+type PontosClienteResponse struct {
+	ID                int     `json:"id"`
+	Tipo              string  `json:"tipo"`
+	Pontos            int     `json:"pontos"`
+	PontosDisponiveis *int    `json:"pontos_disponiveis,omitempty"`
+	Origem            string  `json:"origem"`
+	IDReferencia      int     `json:"id_referencia,omitempty"`
+	DataLancamento    string  `json:"data_lancamento"`
+	DataExpiracao     *string `json:"data_expiracao,omitempty"`
+	Descricao         string  `json:"descricao"`
+}
+
+type ExtratoPontosResponse struct {
+	IDCliente        int                     `json:"id_cliente"`
+	Saldo            int                     `json:"saldo"`
+	ValorSaldo       float64                 `json:"valor_saldo"`
+	PontosAExpirar   int                     `json:"pontos_a_expirar"`
+	ProximaExpiracao *string                 `json:"proxima_expiracao"`
+	Lancamentos      []PontosClienteResponse `json:"lancamentos"`
+	Total            int                     `json:"total"`
+}
+
+// Para POST api/fidelidade/expirar
+type ExpiracaoPontosResponse struct {
+	LancamentosExpirados int `json:"lancamentos_expirados"`
+	PontosExpirados      int `json:"pontos_expirados"`
+	ClientesAvisados     int `json:"clientes_avisados"`
+}
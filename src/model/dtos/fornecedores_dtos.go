@@ -1,9 +1,9 @@
 package dtos
 
 type CreateFornecedorRequest struct {
-	Nome         string `json:"nome"`
+	Nome         string `json:"nome" validate:"required,min=2,max=100"`
 	Telefone     string `json:"telefone"`
-	Email        string `json:"email"`
+	Email        string `json:"email" validate:"omitempty,email,max=100"`
 	Cidade       string `json:"cidade"`
 	Estado       string `json:"estado"`
 	Status       string `json:"status"`
@@ -0,0 +1,62 @@
+package dtos
+
+// Para POST api/produtos/importar, api/clientes/importar e api/fornecedores/importar
+// Montado pelo controller a partir do formulário multipart; mapeamento é "cabeçalho do arquivo" -> campo
+type ImportacaoRequest struct {
+	NomeArquivo         string
+	Conteudo            []byte
+	Mapeamento          map[string]string
+	DryRun              bool
+	AtualizarExistentes bool
+}
+
+type ImportacaoIniciadaResponse struct {
+	ID               int               `json:"id_importacao"`
+	Status           string            `json:"status"`
+	TotalLinhas      int               `json:"total_linhas"`
+	Colunas          map[string]string `json:"colunas"`
+	ColunasIgnoradas []string          `json:"colunas_ignoradas"`
+}
+
+// Para GET api/importacoes
+type ImportacaoResponse struct {
+	ID                  int    `json:"id"`
+	Recurso             string `json:"recurso"`
+	NomeArquivo         string `json:"nome_arquivo"`
+	Formato             string `json:"formato"`
+	Status              string `json:"status"`
+	DryRun              bool   `json:"dry_run"`
+	AtualizarExistentes bool   `json:"atualizar_existentes"`
+	TotalLinhas         int    `json:"total_linhas"`
+	Inseridas           int    `json:"inseridas"`
+	Atualizadas         int    `json:"atualizadas"`
+	Ignoradas           int    `json:"ignoradas"`
+	ComErro             int    `json:"com_erro"`
+	MensagemErro        string `json:"mensagem_erro,omitempty"`
+	CriadoPor           string `json:"criado_por"`
+	DataCriacao         string `json:"data_criacao"`
+	DataConclusao       string `json:"data_conclusao,omitempty"`
+}
+
+type ImportacaoListResponse struct {
+	Importacoes []ImportacaoResponse `json:"importacoes"`
+	Total       int                  `json:"total"`
+	Page        int                  `json:"page"`
+	Limit       int                  `json:"limit"`
+	TotalPages  int                  `json:"total_pages"`
+}
+
+// Para GET api/importacoes/:id/erros
+type ImportacaoErroResponse struct {
+	Linha    int    `json:"linha"`
+	Campo    string `json:"campo,omitempty"`
+	Mensagem string `json:"mensagem"`
+}
+
+type ImportacaoErroListResponse struct {
+	Erros      []ImportacaoErroResponse `json:"erros"`
+	Total      int                      `json:"total"`
+	Page       int                      `json:"page"`
+	Limit      int                      `json:"limit"`
+	TotalPages int                      `json:"total_pages"`
+}
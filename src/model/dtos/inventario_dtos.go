@@ -0,0 +1,80 @@
+package dtos
+
+// Para POST api/inventarios
+// Se produtos for vazio, todos os lotes com cadastro de estoque entram na contagem
+type CreateInventarioRequest struct {
+	Descricao string `json:"descricao" validate:"required,min=3,max=255"`
+	Produtos  []int  `json:"produtos" validate:"omitempty,dive,gt=0"`
+}
+
+// Para GET api/inventarios
+type InventarioResponse struct {
+	ID             int    `json:"id"`
+	Descricao      string `json:"descricao"`
+	Status         string `json:"status"`
+	DataAbertura   string `json:"data_abertura"`
+	DataFechamento string `json:"data_fechamento,omitempty"`
+	TotalItens     int    `json:"total_itens"`
+}
+
+type InventarioListResponse struct {
+	Inventarios []InventarioResponse `json:"inventarios"`
+	Total       int                  `json:"total"`
+	Page        int                  `json:"page"`
+	Limit       int                  `json:"limit"`
+	TotalPages  int                  `json:"total_pages"`
+}
+
+// Para POST api/inventarios/:id/contagens
+// Reenviar a contagem de um lote pelo mesmo dispositivo substitui a anterior
+type RegistrarContagemRequest struct {
+	Dispositivo string                `json:"dispositivo" validate:"required,max=100"`
+	Itens       []ContagemItemRequest `json:"itens" validate:"required,min=1,dive"`
+}
+
+type ContagemItemRequest struct {
+	IDProduto  int `json:"id_produto" validate:"required,gt=0"`
+	IDLote     int `json:"id_lote" validate:"required,gt=0"`
+	Quantidade int `json:"quantidade" validate:"gte=0"`
+}
+
+type RegistrarContagemResponse struct {
+	Registradas int `json:"registradas"`
+}
+
+// Para GET api/inventarios/:id/divergencias
+type InventarioDivergenciaResponse struct {
+	IDItem            int     `json:"id_item"`
+	IDProduto         int     `json:"id_produto"`
+	NomeProduto       string  `json:"nome_produto"`
+	IDLote            int     `json:"id_lote"`
+	QuantidadeSistema int     `json:"quantidade_sistema"`
+	QuantidadeContada *int    `json:"quantidade_contada"`
+	Contagens         int     `json:"contagens"`
+	Diferenca         int     `json:"diferenca"`
+	CustoUnitario     float64 `json:"custo_unitario"`
+	ValorDiferenca    float64 `json:"valor_diferenca"`
+}
+
+type InventarioDivergenciasResponse struct {
+	Inventario       InventarioResponse              `json:"inventario"`
+	Itens            []InventarioDivergenciaResponse `json:"itens"`
+	ItensContados    int                             `json:"itens_contados"`
+	ItensPendentes   int                             `json:"itens_pendentes"`
+	ItensDivergentes int                             `json:"itens_divergentes"`
+	ValorSobras      float64                         `json:"valor_sobras"`
+	ValorFaltas      float64                         `json:"valor_faltas"`
+	ValorLiquido     float64                         `json:"valor_liquido"`
+}
+
+// Para POST api/inventarios/:id/fechar
+// Com zerar_nao_contados, lotes sem nenhuma contagem são ajustados para zero
+type FecharInventarioRequest struct {
+	ZerarNaoContados bool `json:"zerar_nao_contados"`
+}
+
+type FecharInventarioResponse struct {
+	IDInventario int     `json:"id_inventario"`
+	Ajustes      int     `json:"ajustes"`
+	ValorLiquido float64 `json:"valor_liquido"`
+}
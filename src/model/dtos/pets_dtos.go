@@ -25,7 +25,7 @@ type PetListResponse struct {
 type CreatePetRequest struct {
 	ClienteID       int    `json:"cliente_id" validate:"required,gt=0"`
 	NomePet         string `json:"nome_pet" validate:"required,min=2,max=100"`
-	Especie         string `json:"especie" validate:"required,min=2,max=50"`
+	Especie         string `json:"especie" validate:"required,especie"`
 	Raca            string `json:"raca" validate:"max=50"`
 	Porte           string `json:"porte" validate:"required,oneof=Pequeno Médio Grande"`
 	DataAniversario string `json:"data_aniversario" validate:"omitempty"`
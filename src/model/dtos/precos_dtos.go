@@ -0,0 +1,52 @@
+package dtos
+
+// Para GET api/produtos/:id/precos/historico
+type HistoricoPrecoResponse struct {
+	ID            int     `json:"id"`
+	IDProduto     int     `json:"id_produto"`
+	PrecoAnterior float64 `json:"preco_anterior"`
+	PrecoNovo     float64 `json:"preco_novo"`
+	Origem        string  `json:"origem"`
+	IDAgendamento int     `json:"id_agendamento,omitempty"`
+	AlteradoPor   string  `json:"alterado_por"`
+	DataAlteracao string  `json:"data_alteracao"`
+}
+
+type HistoricoPrecoListResponse struct {
+	Historico  []HistoricoPrecoResponse `json:"historico"`
+	Total      int                      `json:"total"`
+	Page       int                      `json:"page"`
+	Limit      int                      `json:"limit"`
+	TotalPages int                      `json:"total_pages"`
+}
+
+// Para POST api/produtos/:id/precos/agendados
+// data_vigencia aceita YYYY-MM-DD (início do dia) ou YYYY-MM-DD HH:MM:SS e deve estar no futuro
+type CreatePrecoAgendadoRequest struct {
+	PrecoVenda   float64 `json:"preco_venda" validate:"required,gt=0"`
+	DataVigencia string  `json:"data_vigencia" validate:"required"`
+}
+
+// Para GET api/produtos/:id/precos/agendados
+type PrecoAgendadoResponse struct {
+	ID            int     `json:"id"`
+	IDProduto     int     `json:"id_produto"`
+	PrecoVenda    float64 `json:"preco_venda"`
+	DataVigencia  string  `json:"data_vigencia"`
+	Status        string  `json:"status"`
+	CriadoPor     string  `json:"criado_por"`
+	DataCriacao   string  `json:"data_criacao"`
+	DataAplicacao string  `json:"data_aplicacao,omitempty"`
+}
+
+type PrecoAgendadoListResponse struct {
+	Agendamentos []PrecoAgendadoResponse `json:"agendamentos"`
+}
+
+// Para GET api/produtos/:id/precos/vigente
+type PrecoVigenteResponse struct {
+	IDProduto  int     `json:"id_produto"`
+	Data       string  `json:"data"`
+	PrecoVenda float64 `json:"preco_venda"`
+	PrecoAtual float64 `json:"preco_atual"`
+}
@@ -8,6 +8,7 @@ type ProductResponse struct {
 	NomeProduto   string  `json:"nome_produto"`
 	SKU           string  `json:"sku"`
 	Categoria     string  `json:"categoria"`
+	IDCategoria   int     `json:"id_categoria,omitempty"`
 	DestinadoPara string  `json:"destinado_para"`
 	Variacao      string  `json:"variacao"`
 	Marca         string  `json:"marca"`
@@ -15,6 +16,9 @@ type ProductResponse struct {
 	Status        string  `json:"status"`
 	PrecoVenda    float64 `json:"preco_venda"`
 	IDFornecedor  int     `json:"id_fornecedor"`
+	EstoqueMinimo int     `json:"estoque_minimo"`
+	Tipo          string  `json:"tipo"`
+	IDProdutoPai  int     `json:"id_produto_pai,omitempty"`
 }
 
 type ProductListResponse struct {
@@ -29,15 +33,93 @@ type ProductListResponse struct {
 
 type CreateProductRequest struct {
 	DataCadastro  string  `json:"data_cadastro" validate:"required"`
-	CodigoBarra   string  `json:"codigo_barra"`
+	CodigoBarra   string  `json:"codigo_barra" validate:"omitempty,gtin"`
 	NomeProduto   string  `json:"nome_produto" validate:"required"`
 	SKU           string  `json:"sku"`
 	Categoria     string  `json:"categoria"`
-	DestinadoPara string  `json:"destinado_para"`
+	IDCategoria   *int    `json:"id_categoria" validate:"omitempty,gt=0"`
+	DestinadoPara string  `json:"destinado_para" validate:"omitempty,destinado_para"`
 	Variacao      string  `json:"variacao"`
 	Marca         string  `json:"marca"`
 	Descricao     string  `json:"descricao"`
 	Status        string  `json:"status" validate:"required"`
 	PrecoVenda    float64 `json:"preco_venda" validate:"required,gt=0"`
 	IDFornecedor  int     `json:"id_fornecedor" validate:"required,gt=0"`
+	EstoqueMinimo int     `json:"estoque_minimo" validate:"gte=0"`
+}
+
+// Para PATCH api/produtos/:id
+// Apenas os campos enviados são alterados
+
+type UpdateProductRequest struct {
+	CodigoBarra   *string  `json:"codigo_barra" validate:"omitempty,gtin"`
+	NomeProduto   *string  `json:"nome_produto" validate:"omitempty,min=1,max=255"`
+	SKU           *string  `json:"sku" validate:"omitempty,max=100"`
+	Categoria     *string  `json:"categoria"`
+	IDCategoria   *int     `json:"id_categoria" validate:"omitempty,gt=0"`
+	DestinadoPara *string  `json:"destinado_para" validate:"omitempty,destinado_para"`
+	Variacao      *string  `json:"variacao"`
+	Marca         *string  `json:"marca"`
+	Descricao     *string  `json:"descricao"`
+	Status        *string  `json:"status" validate:"omitempty,min=1"`
+	PrecoVenda    *float64 `json:"preco_venda" validate:"omitempty,gt=0"`
+	IDFornecedor  *int     `json:"id_fornecedor" validate:"omitempty,gt=0"`
+	EstoqueMinimo *int     `json:"estoque_minimo" validate:"omitempty,gte=0"`
+}
+
+// Para POST api/produtos/:id/variacoes
+// Marca, categoria, destinado_para e id_fornecedor são herdados do produto pai
+
+type CreateVariacaoRequest struct {
+	DataCadastro  string  `json:"data_cadastro"`
+	CodigoBarra   string  `json:"codigo_barra" validate:"omitempty,gtin"`
+	NomeProduto   string  `json:"nome_produto" validate:"omitempty,max=255"`
+	SKU           string  `json:"sku" validate:"omitempty,max=100"`
+	Variacao      string  `json:"variacao" validate:"required,max=100"`
+	Descricao     string  `json:"descricao"`
+	Status        string  `json:"status" validate:"required"`
+	PrecoVenda    float64 `json:"preco_venda" validate:"required,gt=0"`
+	EstoqueMinimo int     `json:"estoque_minimo" validate:"gte=0"`
+}
+
+// Para GET api/produtos/:id/variacoes
+
+type VariacaoResponse struct {
+	ProductResponse
+	Saldo int `json:"saldo"`
+}
+
+type VariacaoListResponse struct {
+	Produto   ProductResponse    `json:"produto"`
+	Variacoes []VariacaoResponse `json:"variacoes"`
+}
+
+// Para PUT api/produtos/:id/componentes
+// Substitui toda a composição do kit
+
+type SetKitComponentesRequest struct {
+	Componentes []KitComponenteRequest `json:"componentes" validate:"required,min=1,dive"`
+}
+
+type KitComponenteRequest struct {
+	IDProduto  int `json:"id_produto" validate:"required,gt=0"`
+	Quantidade int `json:"quantidade" validate:"required,gt=0"`
+}
+
+// Para GET api/produtos/:id/componentes
+
+type KitComponenteResponse struct {
+	IDProduto     int    `json:"id_produto"`
+	NomeProduto   string `json:"nome_produto"`
+	SKU           string `json:"sku"`
+	Quantidade    int    `json:"quantidade"`
+	Saldo         int    `json:"saldo"`
+	KitsPossiveis int    `json:"kits_possiveis"`
+}
+
+type KitComponentesResponse struct {
+	IDKit             int                     `json:"id_kit"`
+	NomeProduto       string                  `json:"nome_produto"`
+	Componentes       []KitComponenteResponse `json:"componentes"`
+	EstoqueDisponivel int                     `json:"estoque_disponivel"`
 }
@@ -2,8 +2,15 @@ package dtos
 
 // DTO para resposta de critérios (GET /api/criterios)
 type CriterioResponse struct {
-	ID           int    `json:"id"`
-	NomeCondicao string `json:"nome_condicao"`
+	ID           int           `json:"id"`
+	NomeCondicao string        `json:"nome_condicao"`
+	Regra        *RegraPublico `json:"regra,omitempty"`
+}
+
+// DTO para criação de critério parametrizado (POST /api/criterios)
+type CreateCriterioRequest struct {
+	NomeCondicao string        `json:"nome_condicao" validate:"required,min=2,max=100"`
+	Regra        *RegraPublico `json:"regra" validate:"required"`
 }
 
 type CriterioListResponse struct {
@@ -13,11 +20,13 @@ type CriterioListResponse struct {
 
 // DTO para resposta de públicos (GET /api/publicos)
 type PublicoResponse struct {
-	ID          int    `json:"id"`
-	Nome        string `json:"nome"`
-	Descricao   string `json:"descricao"`
-	DataCriacao string `json:"data_criacao"`
-	Status      string `json:"status"`
+	ID                int     `json:"id"`
+	Nome              string  `json:"nome"`
+	Descricao         string  `json:"descricao"`
+	DataCriacao       string  `json:"data_criacao"`
+	Status            string  `json:"status"`
+	Tipo              string  `json:"tipo"`
+	UltimaAtualizacao *string `json:"ultima_atualizacao"`
 }
 
 type PublicoListResponse struct {
@@ -34,11 +43,138 @@ type CreatePublicoRequest struct {
 	Descricao   string `json:"descricao" validate:"required,min=2,max=100"`
 	DataCriacao string `json:"data_criacao" validate:"required,max=100"`
 	Status      string `json:"status" validate:"required,max=50"`
+	Tipo        string `json:"tipo" validate:"omitempty,oneof=estatico dinamico"`
+}
+
+// DTO para troca do tipo do público (PUT /api/publicos/:id/tipo)
+type UpdateTipoPublicoRequest struct {
+	Tipo string `json:"tipo" validate:"required,oneof=estatico dinamico"`
+}
+
+// DTO para resposta da reavaliação de um público dinâmico (POST /api/publicos/:id/atualizar)
+type AtualizacaoPublicoResponse struct {
+	IDPublico       int    `json:"id_publico"`
+	Total           int    `json:"total"`
+	Entradas        int    `json:"entradas"`
+	Saidas          int    `json:"saidas"`
+	DataAtualizacao string `json:"data_atualizacao"`
+}
+
+// DTO para o histórico de membros (GET /api/publicos/:id/historico)
+type PublicoMembroEventoResponse struct {
+	ID          int    `json:"id"`
+	IDCliente   int    `json:"id_cliente"`
+	NomeCliente string `json:"nome_cliente"`
+	Tipo        string `json:"tipo"`
+	Origem      string `json:"origem"`
+	DataEvento  string `json:"data_evento"`
+}
+
+type PublicoHistoricoListResponse struct {
+	IDPublico  int                           `json:"id_publico"`
+	Eventos    []PublicoMembroEventoResponse `json:"eventos"`
+	Total      int                           `json:"total"`
+	Page       int                           `json:"page"`
+	Limit      int                           `json:"limit"`
+	TotalPages int                           `json:"total_pages"`
+}
+
+// DTO para adicionar ou remover membros do público pelos IDs dos clientes
+// (POST e DELETE /api/publicos/:id/membros)
+type MembrosPublicoRequest struct {
+	Clientes []int `json:"clientes" validate:"required,min=1,max=1000,dive,min=1"`
+}
+
+type AdicionarMembrosPublicoResponse struct {
+	IDPublico           int   `json:"id_publico"`
+	ClientesAdicionados int   `json:"clientes_adicionados"`
+	ClientesJaExistiam  int   `json:"clientes_ja_existiam"`
+	ClientesExcluidos   []int `json:"clientes_excluidos"`
+}
+
+type RemoverMembrosPublicoResponse struct {
+	IDPublico          int `json:"id_publico"`
+	ClientesRemovidos  int `json:"clientes_removidos"`
+	ClientesNaoMembros int `json:"clientes_nao_membros"`
+}
+
+// DTO para excluir clientes do público (POST /api/publicos/:id/exclusoes)
+type ExcluirClientesPublicoRequest struct {
+	Clientes []int  `json:"clientes" validate:"required,min=1,max=1000,dive,min=1"`
+	Motivo   string `json:"motivo" validate:"omitempty,max=255"`
+}
+
+type ExcluirClientesPublicoResponse struct {
+	IDPublico           int `json:"id_publico"`
+	ClientesExcluidos   int `json:"clientes_excluidos"`
+	ClientesJaExcluidos int `json:"clientes_ja_excluidos"`
+	MembrosRemovidos    int `json:"membros_removidos"`
+}
+
+// DTO para a lista de exclusões (GET /api/publicos/:id/exclusoes)
+type PublicoExclusaoResponse struct {
+	IDCliente    int     `json:"id_cliente"`
+	NomeCliente  string  `json:"nome_cliente"`
+	Motivo       *string `json:"motivo"`
+	DataExclusao string  `json:"data_exclusao"`
+}
+
+type PublicoExclusaoListResponse struct {
+	IDPublico  int                       `json:"id_publico"`
+	Exclusoes  []PublicoExclusaoResponse `json:"exclusoes"`
+	Total      int                       `json:"total"`
+	Page       int                       `json:"page"`
+	Limit      int                       `json:"limit"`
+	TotalPages int                       `json:"total_pages"`
+}
+
+// DTO para criar um público a partir de uma operação entre públicos (POST /api/publicos/operacoes)
+// Na diferença, os membros dos demais públicos são retirados do primeiro
+type OperacaoPublicosRequest struct {
+	Operacao  string `json:"operacao" validate:"required,oneof=uniao intersecao diferenca"`
+	Publicos  []int  `json:"publicos" validate:"required,min=2,max=10,dive,min=1"`
+	Nome      string `json:"nome" validate:"required,min=2,max=100"`
+	Descricao string `json:"descricao" validate:"required,min=2,max=100"`
+}
+
+type OperacaoPublicosResponse struct {
+	Publico  PublicoResponse `json:"publico"`
+	Operacao string          `json:"operacao"`
+	Publicos []int           `json:"publicos"`
+	Total    int             `json:"total"`
 }
 
 // DTO para associar critérios ao público (POST /api/publicos/:id/criterios)
+// Informe os critérios cadastrados, a regra ou os dois; a regra substitui a anterior
 type AssociarCriteriosRequest struct {
-	Criterios []int `json:"criterios" validate:"required,min=1"`
+	Criterios []int         `json:"criterios" validate:"required_without=Regra"`
+	Regra     *RegraPublico `json:"regra" validate:"required_without=Criterios"`
+}
+
+// RegraPublico é um nó da árvore de critérios do público. Grupos usam operador and, or ou not com as regras filhas;
+// condições usam campo, operador e valor, ou referenciam um critério cadastrado
+type RegraPublico struct {
+	Operador string         `json:"operador,omitempty"`
+	Regras   []RegraPublico `json:"regras,omitempty"`
+	Campo    string         `json:"campo,omitempty"`
+	Valor    interface{}    `json:"valor,omitempty"`
+	Criterio int            `json:"criterio,omitempty"`
+}
+
+// DTO para resposta dos campos disponíveis nas regras (GET /api/criterios/campos)
+type CampoCriterioResponse struct {
+	Campo      string   `json:"campo"`
+	Tipo       string   `json:"tipo"`
+	Operadores []string `json:"operadores"`
+	Valores    []string `json:"valores,omitempty"`
+	Minimo     *float64 `json:"minimo,omitempty"`
+	Maximo     *float64 `json:"maximo,omitempty"`
+	Descricao  string   `json:"descricao"`
+}
+
+type CampoCriterioListResponse struct {
+	Campos []CampoCriterioResponse `json:"campos"`
+	Total  int                     `json:"total"`
 }
 
 // DTO para resposta dos critérios de um público (GET /api/publicos/:id/criterios)
@@ -50,5 +186,26 @@ type PublicoCriterioResponse struct {
 
 type PublicoCriterioListResponse struct {
 	Criterios []PublicoCriterioResponse `json:"criterios"`
+	Regra     *RegraPublico             `json:"regra"`
 	Total     int                       `json:"total"`
 }
+
+// DTO para a prévia de um público (POST /api/publicos/preview); nada é gravado
+type PreviewPublicoRequest struct {
+	Criterios []int         `json:"criterios" validate:"required_without=Regra"`
+	Regra     *RegraPublico `json:"regra" validate:"required_without=Criterios"`
+	Amostra   int           `json:"amostra" validate:"omitempty,min=1,max=50"`
+}
+
+type ContagemPublicoResponse struct {
+	Valor string `json:"valor"`
+	Total int    `json:"total"`
+}
+
+type PreviewPublicoResponse struct {
+	Total      int                       `json:"total"`
+	PorSexo    []ContagemPublicoResponse `json:"por_sexo"`
+	PorEspecie []ContagemPublicoResponse `json:"por_especie"`
+	PorCidade  []ContagemPublicoResponse `json:"por_cidade"`
+	Amostra    []ClienteResponse         `json:"amostra"`
+}
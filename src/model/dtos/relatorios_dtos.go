@@ -0,0 +1,42 @@
+package dtos
+
+// Para GET api/relatorios/valorizacao-estoque
+type ValorizacaoEstoqueItemResponse struct {
+	ID              int     `json:"id,omitempty"`
+	Nome            string  `json:"nome"`
+	Quantidade      int     `json:"quantidade"`
+	CustoMedio      float64 `json:"custo_medio"`
+	ValorCustoMedio float64 `json:"valor_custo_medio"`
+	ValorPEPS       float64 `json:"valor_peps"`
+	ValorVenda      float64 `json:"valor_venda"`
+}
+
+type ValorizacaoEstoqueResponse struct {
+	DataCorte       string                           `json:"data_corte"`
+	Agrupamento     string                           `json:"agrupamento"`
+	Itens           []ValorizacaoEstoqueItemResponse `json:"itens"`
+	TotalQuantidade int                              `json:"total_quantidade"`
+	TotalCustoMedio float64                          `json:"total_custo_medio"`
+	TotalPEPS       float64                          `json:"total_peps"`
+	TotalVenda      float64                          `json:"total_venda"`
+}
+
+// Para GET api/relatorios/margem-bruta
+type MargemBrutaItemResponse struct {
+	IDProduto        int     `json:"id_produto"`
+	NomeProduto      string  `json:"nome_produto"`
+	Categoria        string  `json:"categoria"`
+	PrecoVenda       float64 `json:"preco_venda"`
+	CustoMedio       float64 `json:"custo_medio"`
+	MargemUnitaria   float64 `json:"margem_unitaria"`
+	MargemPercentual float64 `json:"margem_percentual"`
+	Quantidade       int     `json:"quantidade"`
+	MargemPotencial  float64 `json:"margem_potencial"`
+}
+
+type MargemBrutaResponse struct {
+	DataCorte             string                    `json:"data_corte"`
+	Produtos              []MargemBrutaItemResponse `json:"produtos"`
+	MargemPotencialTotal  float64                   `json:"margem_potencial_total"`
+	MargemPercentualMedia float64                   `json:"margem_percentual_media"`
+}
@@ -0,0 +1,45 @@
+package dtos
+
+// Para POST e PUT api/templates - Criar ou alterar um template de mensagem
+type TemplateMensagemRequest struct {
+	Nome    string  `json:"nome" validate:"required,min=2,max=100"`
+	Canal   string  `json:"canal" validate:"required,oneof=email whatsapp sms"`
+	Assunto *string `json:"assunto" validate:"omitempty,min=1,max=255"`
+	Corpo   string  `json:"corpo" validate:"required,max=4000"`
+}
+
+// Para GET api/templates
+type TemplateMensagemResponse struct {
+	ID              int      `json:"id"`
+	Nome            string   `json:"nome"`
+	Canal           string   `json:"canal"`
+	Assunto         *string  `json:"assunto"`
+	Corpo           string   `json:"corpo"`
+	Campos          []string `json:"campos"`
+	DataCriacao     string   `json:"data_criacao"`
+	DataAtualizacao string   `json:"data_atualizacao"`
+}
+
+type TemplateMensagemListResponse struct {
+	Templates  []TemplateMensagemResponse `json:"templates"`
+	Total      int                        `json:"total"`
+	Page       int                        `json:"page"`
+	Limit      int                        `json:"limit"`
+	TotalPages int                        `json:"total_pages"`
+}
+
+// Para GET api/templates/campos - Campos disponíveis nos templates
+type CamposTemplateResponse struct {
+	Campos []string `json:"campos"`
+}
+
+// Para GET api/templates/:id/preview - Template renderizado para um cliente
+type PreviewTemplateResponse struct {
+	IDTemplate          int      `json:"id_template"`
+	IDCliente           int      `json:"id_cliente"`
+	Canal               string   `json:"canal"`
+	Destino             string   `json:"destino"`
+	Assunto             string   `json:"assunto,omitempty"`
+	Corpo               string   `json:"corpo"`
+	CamposNaoResolvidos []string `json:"campos_nao_resolvidos"`
+}
@@ -0,0 +1,77 @@
+package dtos
+
+// Para POST api/vendas
+// A venda nasce aberta; o estoque só é baixado no pagamento
+type CreateVendaRequest struct {
+	IDCliente      *int               `json:"id_cliente" validate:"omitempty,gt=0"`
+	FormaPagamento string             `json:"forma_pagamento" validate:"omitempty,oneof=dinheiro pix cartao_credito cartao_debito boleto credito_loja"`
+	Desconto       float64            `json:"desconto" validate:"gte=0"`
+	Observacao     string             `json:"observacao" validate:"max=500"`
+	Itens          []ItemVendaRequest `json:"itens" validate:"required,min=1,dive"`
+}
+
+// Para POST api/vendas/:id/itens e itens de POST api/vendas
+// Sem preco_unitario, vale o preço de venda atual do produto
+type ItemVendaRequest struct {
+	IDProduto     int      `json:"id_produto" validate:"required,gt=0"`
+	Quantidade    int      `json:"quantidade" validate:"required,gt=0"`
+	PrecoUnitario *float64 `json:"preco_unitario" validate:"omitempty,gt=0"`
+	Desconto      float64  `json:"desconto" validate:"gte=0"`
+}
+
+// Para POST api/vendas/:id/pagar
+// forma_pagamento é obrigatória se não foi informada na criação da venda.
+// Com id_caixa, o pagamento entra como movimento na sessão de caixa aberta informada.
+type PagarVendaRequest struct {
+	FormaPagamento string `json:"forma_pagamento"`
+	IDCaixa        int    `json:"id_caixa"`
+}
+
+// Para GET api/vendas
+type VendaResponse struct {
+	ID               int     `json:"id_venda"`
+	IDCliente        *int    `json:"id_cliente"`
+	DataVenda        string  `json:"data_venda"`
+	Subtotal         float64 `json:"subtotal"`
+	Desconto         float64 `json:"desconto"`
+	ValorTotal       float64 `json:"valor_total"`
+	FormaPagamento   string  `json:"forma_pagamento"`
+	Status           string  `json:"status"`
+	Observacao       string  `json:"observacao"`
+	DataPagamento    string  `json:"data_pagamento,omitempty"`
+	DataCancelamento string  `json:"data_cancelamento,omitempty"`
+	IDCaixaSessao    *int    `json:"id_caixa_sessao,omitempty"`
+}
+
+type VendaListResponse struct {
+	Vendas     []VendaResponse `json:"vendas"`
+	Total      int             `json:"total"`
+	Page       int             `json:"page"`
+	Limit      int             `json:"limit"`
+	TotalPages int             `json:"total_pages"`
+}
+
+// Para GET api/vendas/:id/itens
+type ItemVendaResponse struct {
+	ID                  int     `json:"id_item"`
+	IDVenda             int     `json:"id_venda"`
+	IDProduto           int     `json:"id_produto"`
+	NomeProduto         string  `json:"nome_produto"`
+	Quantidade          int     `json:"quantidade"`
+	PrecoUnitario       float64 `json:"preco_unitario"`
+	Desconto            float64 `json:"desconto"`
+	Subtotal            float64 `json:"subtotal"`
+	QuantidadeDevolvida int     `json:"quantidade_devolvida"`
+}
+
+type ItemVendaListResponse struct {
+	IDVenda int                 `json:"id_venda"`
+	Itens   []ItemVendaResponse `json:"itens"`
+	Total   int                 `json:"total"`
+}
+
+// Para GET api/vendas/:id
+type VendaDetalheResponse struct {
+	VendaResponse
+	Itens []ItemVendaResponse `json:"itens"`
+}
@@ -0,0 +1,49 @@
+package entity
+
+// Entidade para a tabela alertas_estoque
+type AlertaEstoque struct {
+	ID             int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	Tipo           string  `gorm:"column:tipo;not null" json:"tipo"`
+	IDProduto      int     `gorm:"column:id_produto;not null" json:"id_produto"`
+	IDLote         int     `gorm:"column:id_lote" json:"id_lote"`
+	NomeProduto    string  `gorm:"column:nome_produto;not null" json:"nome_produto"`
+	Quantidade     int     `gorm:"column:quantidade;not null" json:"quantidade"`
+	EstoqueMinimo  int     `gorm:"column:estoque_minimo" json:"estoque_minimo"`
+	Vencimento     *string `gorm:"column:vencimento" json:"vencimento"`
+	DiasParaVencer int     `gorm:"column:dias_para_vencer" json:"dias_para_vencer"`
+	Mensagem       string  `gorm:"column:mensagem;not null" json:"mensagem"`
+	DataGeracao    string  `gorm:"column:data_geracao;not null" json:"data_geracao"`
+	Status         string  `gorm:"column:status;not null;default:pendente" json:"status"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (AlertaEstoque) TableName() string {
+	return "alertas_estoque"
+}
+
+// Tipos e status de alerta de estoque
+const (
+	AlertaTipoVencimento   = "vencimento"
+	AlertaTipoEstoqueBaixo = "estoque_baixo"
+
+	AlertaStatusPendente  = "pendente"
+	AlertaStatusResolvido = "resolvido"
+)
+
+// Estrutura para consulta SQL de lotes próximos do vencimento
+type LoteVencendo struct {
+	IDProduto      int    `json:"id_produto"`
+	IDLote         int    `json:"id_lote"`
+	NomeProduto    string `json:"nome_produto"`
+	Quantidade     int    `json:"quantidade"`
+	Vencimento     string `json:"vencimento"`
+	DiasParaVencer int    `json:"dias_para_vencer"`
+}
+
+// Estrutura para consulta SQL de produtos abaixo do estoque mínimo
+type ProdutoAbaixoMinimo struct {
+	IDProduto     int    `json:"id_produto"`
+	NomeProduto   string `json:"nome_produto"`
+	Quantidade    int    `json:"quantidade"`
+	EstoqueMinimo int    `json:"estoque_minimo"`
+}
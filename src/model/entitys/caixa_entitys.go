@@ -0,0 +1,54 @@
+package entity
+
+// Entidade para a tabela caixa_sessoes (abertura e fechamento de um caixa do PDV)
+// Os valores de fechamento ficam nulos enquanto a sessão está aberta
+type CaixaSessao struct {
+	ID                   int      `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	Operador             string   `gorm:"column:operador;not null" json:"operador"`
+	Identificacao        string   `gorm:"column:identificacao" json:"identificacao"`
+	Status               string   `gorm:"column:status;not null;default:aberto" json:"status"`
+	ValorAbertura        float64  `gorm:"column:valor_abertura;type:decimal(10,2);not null" json:"valor_abertura"`
+	ValorEsperado        *float64 `gorm:"column:valor_esperado;type:decimal(10,2)" json:"valor_esperado"`
+	ValorContado         *float64 `gorm:"column:valor_contado;type:decimal(10,2)" json:"valor_contado"`
+	Diferenca            *float64 `gorm:"column:diferenca;type:decimal(10,2)" json:"diferenca"`
+	DataAbertura         string   `gorm:"column:data_abertura;not null" json:"data_abertura"`
+	DataFechamento       *string  `gorm:"column:data_fechamento" json:"data_fechamento"`
+	ObservacaoFechamento string   `gorm:"column:observacao_fechamento" json:"observacao_fechamento"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (CaixaSessao) TableName() string {
+	return "caixa_sessoes"
+}
+
+// Status da sessão de caixa
+const (
+	CaixaStatusAberto  = "aberto"
+	CaixaStatusFechado = "fechado"
+)
+
+// Entidade para a tabela caixa_movimentos
+// Valor é sempre positivo; o tipo define se entra ou sai da gaveta
+type CaixaMovimento struct {
+	ID             int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDSessao       int     `gorm:"column:id_sessao;not null" json:"id_sessao"`
+	Tipo           string  `gorm:"column:tipo;not null" json:"tipo"`
+	FormaPagamento string  `gorm:"column:forma_pagamento" json:"forma_pagamento"`
+	Valor          float64 `gorm:"column:valor;type:decimal(10,2);not null" json:"valor"`
+	IDVenda        *int    `gorm:"column:id_venda" json:"id_venda"`
+	Descricao      string  `gorm:"column:descricao" json:"descricao"`
+	DataMovimento  string  `gorm:"column:data_movimento;not null" json:"data_movimento"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (CaixaMovimento) TableName() string {
+	return "caixa_movimentos"
+}
+
+// Tipos de movimento de caixa
+const (
+	CaixaMovimentoVenda      = "venda"
+	CaixaMovimentoEstorno    = "estorno"
+	CaixaMovimentoSangria    = "sangria"
+	CaixaMovimentoSuprimento = "suprimento"
+)
@@ -11,8 +11,20 @@ type Campanha struct {
 	DataLancamento string `gorm:"column:data_lancamento;not null" json:"data_lancamento"`
 	DataFim        string `gorm:"column:data_fim;not null" json:"data_fim"`
 	Status         string `gorm:"column:status;default:ativa" json:"status"`
+	// Quando a campanha foi disparada e, se o disparo automático falhou, o motivo
+	DataDisparo *string `gorm:"column:data_disparo" json:"data_disparo"`
+	ErroDisparo *string `gorm:"column:erro_disparo" json:"erro_disparo"`
 }
 
+// Status da campanha. Agendada vira ativa na data de lançamento; ativa e pausada viram finalizada na data de fim.
+const (
+	CampanhaStatusAgendada   = "agendada"
+	CampanhaStatusAtiva      = "ativa"
+	CampanhaStatusPausada    = "pausada"
+	CampanhaStatusFinalizada = "finalizada"
+	CampanhaStatusInativa    = "inativa"
+)
+
 // TableName especifica o nome da tabela para GORM
 func (Campanha) TableName() string {
 	return "campanhas"
@@ -59,3 +71,110 @@ func BuildCampanhaPublicoEntity(idCampanha, idPublico int) *CampanhaPublico {
 		IDPublico:  idPublico,
 	}
 }
+
+// Situação de cada envio: na fila, reservado por um worker, enviado, com falha definitiva ou cancelado. Depois de
+// enviado, avança conforme o rastreio: entregue, aberto, clicado ou descadastrado.
+const (
+	EnvioStatusNaFila   = "na_fila"
+	EnvioStatusEnviando = "enviando"
+	EnvioStatusEnviado  = "enviado"
+	EnvioStatusFalhou   = "falhou"
+	// Envio que não saiu porque a campanha terminou ou foi desativada
+	EnvioStatusCancelado     = "cancelado"
+	EnvioStatusEntregue      = "entregue"
+	EnvioStatusAberto        = "aberto"
+	EnvioStatusClicado       = "clicado"
+	EnvioStatusDescadastrado = "descadastrado"
+)
+
+// Entidade para a tabela campanhas_envios: uma mensagem da campanha para um cliente em um canal
+type CampanhaEnvio struct {
+	ID         int `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDCampanha int `gorm:"column:id_campanha;not null" json:"id_campanha"`
+	IDCliente  int `gorm:"column:id_cliente;not null" json:"id_cliente"`
+	// Público da campanha pelo qual o cliente recebeu o envio, fixado no disparo para as métricas por público;
+	// nil nos envios criados antes da coluna
+	IDPublico   *int    `gorm:"column:id_publico" json:"id_publico"`
+	Canal       string  `gorm:"column:canal;not null" json:"canal"`
+	Destino     string  `gorm:"column:destino;not null" json:"destino"`
+	Status      string  `gorm:"column:status;not null" json:"status"`
+	Tentativas  int     `gorm:"column:tentativas;not null;default:0" json:"tentativas"`
+	Erro        *string `gorm:"column:erro" json:"erro"`
+	DataCriacao string  `gorm:"column:data_criacao;not null" json:"data_criacao"`
+	DataEnvio   *string `gorm:"column:data_envio" json:"data_envio"`
+	// Última mudança de status, usada para recuperar envios perdidos da fila
+	DataAtualizacao *string `gorm:"column:data_atualizacao" json:"data_atualizacao"`
+	// Mensagem renderizada no disparo; nil nos envios criados antes dos templates
+	Assunto  *string `gorm:"column:assunto" json:"assunto"`
+	Mensagem *string `gorm:"column:mensagem" json:"mensagem"`
+	// Rastreio depois do envio; cada data guarda a primeira ocorrência
+	DataEntrega     *string `gorm:"column:data_entrega" json:"data_entrega"`
+	DataAbertura    *string `gorm:"column:data_abertura" json:"data_abertura"`
+	DataClique      *string `gorm:"column:data_clique" json:"data_clique"`
+	DataDescadastro *string `gorm:"column:data_descadastro" json:"data_descadastro"`
+	Cliques         int     `gorm:"column:cliques;not null;default:0" json:"cliques"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (CampanhaEnvio) TableName() string {
+	return "campanhas_envios"
+}
+
+// DestinatarioCampanha é um cliente dos públicos da campanha com o primeiro desses públicos em que ele está
+type DestinatarioCampanha struct {
+	Cliente
+	IDPublico int `gorm:"column:id_publico" json:"id_publico"`
+}
+
+// CampanhaEnvioDetalhe é o envio com os dados do cliente e da campanha usados na mensagem
+type CampanhaEnvioDetalhe struct {
+	CampanhaEnvio
+	NomeCliente    string `gorm:"column:nome_cliente" json:"nome_cliente"`
+	NomeCampanha   string `gorm:"column:nome_campanha" json:"nome_campanha"`
+	DescCampanha   string `gorm:"column:desc_campanha" json:"desc_campanha"`
+	StatusCampanha string `gorm:"column:status_campanha" json:"status_campanha"`
+	Consentido     bool   `gorm:"column:consentido" json:"consentido"`
+}
+
+// Entidade para a tabela campanhas_canais: canais em que a campanha é enviada e o template de cada um
+type CampanhaCanal struct {
+	IDCampanha int    `gorm:"primaryKey;column:id_campanha" json:"id_campanha"`
+	Canal      string `gorm:"primaryKey;column:canal" json:"canal"`
+	IDTemplate *int   `gorm:"column:id_template" json:"id_template"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (CampanhaCanal) TableName() string {
+	return "campanhas_canais"
+}
+
+// CampanhaCanalJoin é o canal da campanha com o nome do template
+type CampanhaCanalJoin struct {
+	Canal        string  `gorm:"column:canal" json:"canal"`
+	IDTemplate   *int    `gorm:"column:id_template" json:"id_template"`
+	NomeTemplate *string `gorm:"column:nome_template" json:"nome_template"`
+}
+
+// Totais dos envios de uma campanha; com IDPublico, só dos clientes daquele público
+type MetricasEnvios struct {
+	IDPublico     int    `gorm:"column:id_publico" json:"id_publico"`
+	NomePublico   string `gorm:"column:nome_publico" json:"nome_publico"`
+	Envios        int    `gorm:"column:envios" json:"envios"`
+	Destinatarios int    `gorm:"column:destinatarios" json:"destinatarios"`
+	NaFila        int    `gorm:"column:na_fila" json:"na_fila"`
+	Enviados      int    `gorm:"column:enviados" json:"enviados"`
+	Entregues     int    `gorm:"column:entregues" json:"entregues"`
+	Abertos       int    `gorm:"column:abertos" json:"abertos"`
+	Clicados      int    `gorm:"column:clicados" json:"clicados"`
+	Falhas        int    `gorm:"column:falhas" json:"falhas"`
+	Descadastros  int    `gorm:"column:descadastros" json:"descadastros"`
+	Cancelados    int    `gorm:"column:cancelados" json:"cancelados"`
+}
+
+// Vendas pagas dos clientes que receberam a campanha, feitas dentro da janela depois do primeiro envio
+type VendasAtribuidas struct {
+	IDPublico   int     `gorm:"column:id_publico" json:"id_publico"`
+	Vendas      int     `gorm:"column:vendas" json:"vendas"`
+	Compradores int     `gorm:"column:compradores" json:"compradores"`
+	Receita     float64 `gorm:"column:receita" json:"receita"`
+}
@@ -0,0 +1,45 @@
+package entity
+
+// Entidade para a tabela categorias
+// Árvore de categorias de produtos por tenant; nome_normalizado (minúsculo e sem acentos) evita duplicidade entre irmãs
+type Categoria struct {
+	ID              int    `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	Nome            string `gorm:"column:nome;not null" json:"nome"`
+	NomeNormalizado string `gorm:"column:nome_normalizado;not null" json:"nome_normalizado"`
+	IDCategoriaPai  *int   `gorm:"column:id_categoria_pai" json:"id_categoria_pai"`
+	DataCriacao     string `gorm:"column:data_criacao;not null" json:"data_criacao"`
+	TotalProdutos   int    `gorm:"->;column:total_produtos" json:"total_produtos"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (Categoria) TableName() string {
+	return "categorias"
+}
+
+// Estrutura para consulta SQL de valores distintos de uma coluna com a quantidade de registros
+type ValorDistinto struct {
+	Valor string `json:"valor"`
+	Total int    `json:"total"`
+}
+
+// NormalizacaoCategoria associa as grafias encontradas nos produtos a uma categoria (IDCategoria 0 cria uma nova raiz)
+type NormalizacaoCategoria struct {
+	IDCategoria     int
+	Nome            string
+	NomeNormalizado string
+	Valores         []string
+}
+
+// NormalizacaoValor troca um valor livre pelo valor da lista controlada
+type NormalizacaoValor struct {
+	De   string
+	Para string
+}
+
+// PlanoNormalizacaoCatalogo reúne as alterações aplicadas pela normalização de categorias e espécies
+type PlanoNormalizacaoCatalogo struct {
+	Categorias    []NormalizacaoCategoria
+	DestinadoPara []NormalizacaoValor
+	Especies      []NormalizacaoValor
+	DataCriacao   string
+}
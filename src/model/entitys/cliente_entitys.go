@@ -44,3 +44,13 @@ type TagClienteJoin struct {
 	ClienteID int    `json:"cliente_id"`
 	Nome      string `json:"nome"`
 }
+
+// ComprasCliente é o resumo das vendas de um cliente usado no cálculo do RFM (consulta SQL)
+type ComprasCliente struct {
+	IDCliente      int     `json:"id_cliente"`
+	NomeCliente    string  `json:"nome_cliente"`
+	UltimaCompra   *string `json:"ultima_compra"`
+	Frequencia     int     `json:"frequencia"`
+	ValorVendas    float64 `json:"valor_vendas"`
+	ValorDevolvido float64 `json:"valor_devolvido"`
+}
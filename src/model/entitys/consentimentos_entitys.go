@@ -0,0 +1,45 @@
+package entity
+
+// Origem de uma alteração de consentimento: informada pela loja ou registrada pelo próprio sistema
+const (
+	ConsentimentoOrigemLoja            = "loja"
+	ConsentimentoOrigemSite            = "site"
+	ConsentimentoOrigemCadastro        = "cadastro"
+	ConsentimentoOrigemImportacao      = "importacao"
+	ConsentimentoOrigemLinkDescadastro = "link_descadastro"
+	ConsentimentoOrigemPalavraChave    = "palavra_chave"
+	ConsentimentoOrigemProvedor        = "provedor"
+)
+
+// Entidade para a tabela clientes_consentimentos: situação atual do consentimento do cliente para receber
+// campanhas em cada canal. Sem registro, o cliente não consentiu.
+type ConsentimentoCliente struct {
+	IDCliente  int     `gorm:"primaryKey;column:id_cliente" json:"id_cliente"`
+	Canal      string  `gorm:"primaryKey;column:canal" json:"canal"`
+	Consentido bool    `gorm:"column:consentido;not null" json:"consentido"`
+	Origem     string  `gorm:"column:origem;not null" json:"origem"`
+	Detalhe    *string `gorm:"column:detalhe" json:"detalhe"`
+	Data       string  `gorm:"column:data;not null" json:"data"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (ConsentimentoCliente) TableName() string {
+	return "clientes_consentimentos"
+}
+
+// Entidade para a tabela clientes_consentimentos_historico: cada alteração de consentimento, nunca apagada
+type ConsentimentoHistorico struct {
+	ID         int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDCliente  int     `gorm:"column:id_cliente;not null" json:"id_cliente"`
+	Canal      string  `gorm:"column:canal;not null" json:"canal"`
+	Consentido bool    `gorm:"column:consentido;not null" json:"consentido"`
+	Origem     string  `gorm:"column:origem;not null" json:"origem"`
+	Detalhe    *string `gorm:"column:detalhe" json:"detalhe"`
+	IDEnvio    *int    `gorm:"column:id_envio" json:"id_envio"`
+	Data       string  `gorm:"column:data;not null" json:"data"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (ConsentimentoHistorico) TableName() string {
+	return "clientes_consentimentos_historico"
+}
@@ -0,0 +1,84 @@
+package entity
+
+// Entidade para a tabela devolucoes
+// Cada devolução referencia uma venda paga; ValorTotal é o que volta ao cliente, como reembolso ou crédito na loja
+type Devolucao struct {
+	ID             int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDVenda        int     `gorm:"column:id_venda;not null" json:"id_venda"`
+	IDCliente      *int    `gorm:"column:id_cliente" json:"id_cliente"`
+	DataDevolucao  string  `gorm:"column:data_devolucao;not null" json:"data_devolucao"`
+	TipoReembolso  string  `gorm:"column:tipo_reembolso;not null" json:"tipo_reembolso"`
+	FormaReembolso string  `gorm:"column:forma_reembolso" json:"forma_reembolso"`
+	ValorTotal     float64 `gorm:"column:valor_total;type:decimal(10,2);not null" json:"valor_total"`
+	Motivo         string  `gorm:"column:motivo" json:"motivo"`
+	IDCaixaSessao  *int    `gorm:"column:id_caixa_sessao" json:"id_caixa_sessao"`
+	Operador       string  `gorm:"column:operador;not null" json:"operador"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (Devolucao) TableName() string {
+	return "devolucoes"
+}
+
+// Tipos de reembolso da devolução
+const (
+	DevolucaoReembolso = "reembolso"
+	DevolucaoCredito   = "credito"
+)
+
+// Entidade para a tabela itens_devolucao
+// Destino diz o que foi feito com as unidades: voltaram para o estoque ou foram descartadas
+type ItemDevolucao struct {
+	ID          int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDDevolucao int     `gorm:"column:id_devolucao;not null" json:"id_devolucao"`
+	IDItemVenda int     `gorm:"column:id_item_venda;not null" json:"id_item_venda"`
+	IDProduto   int     `gorm:"column:id_produto;not null" json:"id_produto"`
+	Quantidade  int     `gorm:"column:quantidade;not null" json:"quantidade"`
+	Valor       float64 `gorm:"column:valor;type:decimal(10,2);not null" json:"valor"`
+	Destino     string  `gorm:"column:destino;not null" json:"destino"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (ItemDevolucao) TableName() string {
+	return "itens_devolucao"
+}
+
+// Destinos das unidades devolvidas
+const (
+	DevolucaoDestinoEstoque  = "estoque"
+	DevolucaoDestinoDescarte = "descarte"
+)
+
+// DevolucaoEstoque é a quantidade de um produto devolvida aos lotes de onde saiu na venda
+type DevolucaoEstoque struct {
+	IDProduto  int
+	Quantidade int
+	Descartar  bool
+}
+
+// Entidade para a tabela creditos_clientes (extrato do crédito na loja)
+// Valor é sempre positivo; o saldo é a soma dos créditos menos a soma dos débitos
+type CreditoCliente struct {
+	ID             int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDCliente      int     `gorm:"column:id_cliente;not null" json:"id_cliente"`
+	Tipo           string  `gorm:"column:tipo;not null" json:"tipo"`
+	Valor          float64 `gorm:"column:valor;type:decimal(10,2);not null" json:"valor"`
+	Origem         string  `gorm:"column:origem;not null" json:"origem"`
+	IDReferencia   int     `gorm:"column:id_referencia" json:"id_referencia"`
+	DataLancamento string  `gorm:"column:data_lancamento;not null" json:"data_lancamento"`
+	Descricao      string  `gorm:"column:descricao" json:"descricao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (CreditoCliente) TableName() string {
+	return "creditos_clientes"
+}
+
+// Tipos e origens dos lançamentos de crédito
+const (
+	CreditoTipoCredito = "credito"
+	CreditoTipoDebito  = "debito"
+
+	CreditoOrigemDevolucao = "devolucao"
+	CreditoOrigemVenda     = "venda"
+)
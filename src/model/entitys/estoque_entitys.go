@@ -7,6 +7,7 @@ type Estoque struct {
 	IDProduto           int     `gorm:"column:id_produto;not null" json:"id_produto"`
 	IDLote              int     `gorm:"column:id_lote;not null" json:"id_lote"`
 	Quantidade          int     `gorm:"column:quantidade;not null" json:"quantidade"`
+	QuantidadeRecebida  int     `gorm:"column:quantidade_recebida" json:"quantidade_recebida"` // quantidade que entrou no lote; não muda com as saídas
 	Vencimento          string  `gorm:"column:vencimento" json:"vencimento"`
 	CustoUnitario       float64 `gorm:"column:custo_unitario;not null" json:"custo_unitario"`
 	DataEntrada         string  `gorm:"column:data_entrada" json:"data_entrada"`
@@ -20,6 +21,7 @@ func BuildEstoqueEntity(request dtos.CreateEstoqueRequest) *Estoque {
 		IDProduto:           request.IDProduto,
 		IDLote:              request.IDLote,
 		Quantidade:          request.Quantidade,
+		QuantidadeRecebida:  request.Quantidade,
 		Vencimento:          request.Vencimento,
 		CustoUnitario:       request.CustoUnitario,
 		DataEntrada:         request.DataEntrada,
@@ -28,3 +30,60 @@ func BuildEstoqueEntity(request dtos.CreateEstoqueRequest) *Estoque {
 		Status:              request.Status,
 	}
 }
+
+// Entidade para a tabela movimentacoes_estoque
+// Cada alteração de saldo de um lote (entrada, saída ou ajuste) gera uma movimentação.
+// A quantidade é positiva para entradas e negativa para saídas.
+type MovimentacaoEstoque struct {
+	ID            int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDEstoque     int     `gorm:"column:id_estoque;not null" json:"id_estoque"`
+	IDProduto     int     `gorm:"column:id_produto;not null" json:"id_produto"`
+	IDLote        int     `gorm:"column:id_lote;not null" json:"id_lote"`
+	Tipo          string  `gorm:"column:tipo;not null" json:"tipo"`
+	Quantidade    int     `gorm:"column:quantidade;not null" json:"quantidade"`
+	CustoUnitario float64 `gorm:"column:custo_unitario;not null" json:"custo_unitario"`
+	Origem        string  `gorm:"column:origem;not null" json:"origem"`
+	IDReferencia  int     `gorm:"column:id_referencia" json:"id_referencia"`
+	DataMovimento string  `gorm:"column:data_movimento;not null" json:"data_movimento"`
+	Observacao    string  `gorm:"column:observacao" json:"observacao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (MovimentacaoEstoque) TableName() string {
+	return "movimentacoes_estoque"
+}
+
+// Tipos e origens de movimentação de estoque
+const (
+	MovimentacaoTipoEntrada = "entrada"
+	MovimentacaoTipoSaida   = "saida"
+	MovimentacaoTipoAjuste  = "ajuste"
+
+	MovimentacaoOrigemInventario = "inventario"
+	MovimentacaoOrigemManual     = "manual"
+	MovimentacaoOrigemVenda      = "venda"
+	MovimentacaoOrigemDevolucao  = "devolucao"
+)
+
+// Estrutura para consulta SQL dos lotes usados na valorização de estoque
+// Quantidade é o saldo atual do lote; MovimentadoAposCorte soma as movimentações do lote após a data de corte (negativas para saídas)
+type LoteValorizacao struct {
+	IDEstoque            int     `json:"id_estoque"`
+	IDProduto            int     `json:"id_produto"`
+	NomeProduto          string  `json:"nome_produto"`
+	Categoria            string  `json:"categoria"`
+	IDFornecedor         int     `json:"id_fornecedor"`
+	NomeFornecedor       string  `json:"nome_fornecedor"`
+	PrecoVenda           float64 `json:"preco_venda"`
+	DataEntrada          string  `json:"data_entrada"`
+	CustoUnitario        float64 `json:"custo_unitario"`
+	Quantidade           int     `json:"quantidade"`
+	QuantidadeRecebida   int     `json:"quantidade_recebida"`
+	MovimentadoAposCorte int     `json:"movimentado_apos_corte"`
+}
+
+// SaidaEstoque é a quantidade a retirar de um produto; os lotes são escolhidos na baixa
+type SaidaEstoque struct {
+	IDProduto  int
+	Quantidade int
+}
@@ -0,0 +1,67 @@
+package entity
+
+// FidelidadeConfig representa a tabela fidelidade_config; cada tenant tem uma única linha (id 1)
+type FidelidadeConfig struct {
+	ID                 int     `gorm:"primaryKey;column:id" json:"id"`
+	Ativo              bool    `gorm:"column:ativo;not null" json:"ativo"`
+	PontosPorReal      float64 `gorm:"column:pontos_por_real;type:decimal(10,4);not null" json:"pontos_por_real"`
+	ValorPonto         float64 `gorm:"column:valor_ponto;type:decimal(10,4);not null" json:"valor_ponto"`
+	ValidadeDias       int     `gorm:"column:validade_dias;not null" json:"validade_dias"`
+	MinimoResgate      int     `gorm:"column:minimo_resgate;not null" json:"minimo_resgate"`
+	DiasAvisoExpiracao int     `gorm:"column:dias_aviso_expiracao;not null" json:"dias_aviso_expiracao"`
+	DataAtualizacao    string  `gorm:"column:data_atualizacao" json:"data_atualizacao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (FidelidadeConfig) TableName() string {
+	return "fidelidade_config"
+}
+
+// FidelidadeConfigID é o ID da linha de configuração do tenant
+const FidelidadeConfigID = 1
+
+// PontosCliente representa a tabela pontos_clientes, o extrato de pontos do cliente.
+// Nos créditos, PontosDisponiveis é o que ainda não foi resgatado, estornado ou expirado.
+type PontosCliente struct {
+	ID                int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDCliente         int     `gorm:"column:id_cliente;not null" json:"id_cliente"`
+	Tipo              string  `gorm:"column:tipo;not null" json:"tipo"`
+	Pontos            int     `gorm:"column:pontos;not null" json:"pontos"`
+	PontosDisponiveis int     `gorm:"column:pontos_disponiveis;not null" json:"pontos_disponiveis"`
+	Origem            string  `gorm:"column:origem;not null" json:"origem"`
+	IDReferencia      int     `gorm:"column:id_referencia" json:"id_referencia"`
+	DataLancamento    string  `gorm:"column:data_lancamento;not null" json:"data_lancamento"`
+	DataExpiracao     *string `gorm:"column:data_expiracao" json:"data_expiracao"`
+	AvisoEnviado      bool    `gorm:"column:aviso_enviado;not null" json:"aviso_enviado"`
+	Descricao         string  `gorm:"column:descricao" json:"descricao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (PontosCliente) TableName() string {
+	return "pontos_clientes"
+}
+
+// Tipos e origens dos lançamentos de pontos
+const (
+	PontosTipoCredito = "credito"
+	PontosTipoDebito  = "debito"
+
+	PontosOrigemVenda        = "venda"
+	PontosOrigemResgate      = "resgate"
+	PontosOrigemExpiracao    = "expiracao"
+	PontosOrigemCancelamento = "cancelamento"
+	PontosOrigemDevolucao    = "devolucao"
+)
+
+// CreditoOrigemResgatePontos identifica o crédito na loja gerado pelo resgate de pontos
+const CreditoOrigemResgatePontos = "resgate_pontos"
+
+// PontosAExpirar agrupa por cliente os pontos que vencem até a data do aviso (consulta SQL)
+type PontosAExpirar struct {
+	IDCliente        int    `json:"id_cliente"`
+	NomeCliente      string `json:"nome_cliente"`
+	Email            string `json:"email"`
+	NumeroCelular    string `json:"numero_celular"`
+	Pontos           int    `json:"pontos"`
+	ProximaExpiracao string `json:"proxima_expiracao"`
+}
@@ -0,0 +1,55 @@
+package entity
+
+// Entidade para a tabela importacoes (job de importação em lote)
+type Importacao struct {
+	ID                  int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	Recurso             string  `gorm:"column:recurso;not null" json:"recurso"`
+	NomeArquivo         string  `gorm:"column:nome_arquivo;not null" json:"nome_arquivo"`
+	Formato             string  `gorm:"column:formato;not null" json:"formato"`
+	Status              string  `gorm:"column:status;not null;default:processando" json:"status"`
+	DryRun              bool    `gorm:"column:dry_run;not null" json:"dry_run"`
+	AtualizarExistentes bool    `gorm:"column:atualizar_existentes;not null" json:"atualizar_existentes"`
+	TotalLinhas         int     `gorm:"column:total_linhas;not null" json:"total_linhas"`
+	Inseridas           int     `gorm:"column:inseridas;not null" json:"inseridas"`
+	Atualizadas         int     `gorm:"column:atualizadas;not null" json:"atualizadas"`
+	Ignoradas           int     `gorm:"column:ignoradas;not null" json:"ignoradas"`
+	ComErro             int     `gorm:"column:com_erro;not null" json:"com_erro"`
+	MensagemErro        string  `gorm:"column:mensagem_erro" json:"mensagem_erro"`
+	CriadoPor           string  `gorm:"column:criado_por;not null" json:"criado_por"`
+	DataCriacao         string  `gorm:"column:data_criacao;not null" json:"data_criacao"`
+	DataConclusao       *string `gorm:"column:data_conclusao" json:"data_conclusao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (Importacao) TableName() string {
+	return "importacoes"
+}
+
+// Recursos que aceitam importação
+const (
+	ImportacaoRecursoProdutos     = "produtos"
+	ImportacaoRecursoClientes     = "clientes"
+	ImportacaoRecursoFornecedores = "fornecedores"
+)
+
+// Status do job de importação
+const (
+	ImportacaoStatusProcessando = "processando"
+	ImportacaoStatusConcluida   = "concluida"
+	ImportacaoStatusFalhou      = "falhou"
+)
+
+// Entidade para a tabela importacao_erros
+// Linha é a linha do arquivo (o cabeçalho é a linha 1)
+type ImportacaoErro struct {
+	ID           int    `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDImportacao int    `gorm:"column:id_importacao;not null" json:"id_importacao"`
+	Linha        int    `gorm:"column:linha;not null" json:"linha"`
+	Campo        string `gorm:"column:campo" json:"campo"`
+	Mensagem     string `gorm:"column:mensagem;not null" json:"mensagem"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (ImportacaoErro) TableName() string {
+	return "importacao_erros"
+}
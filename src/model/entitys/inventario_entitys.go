@@ -0,0 +1,71 @@
+package entity
+
+// Entidade para a tabela inventarios (sessão de contagem física)
+type Inventario struct {
+	ID             int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	Descricao      string  `gorm:"column:descricao;not null" json:"descricao"`
+	Status         string  `gorm:"column:status;not null;default:aberto" json:"status"`
+	DataAbertura   string  `gorm:"column:data_abertura;not null" json:"data_abertura"`
+	DataFechamento *string `gorm:"column:data_fechamento" json:"data_fechamento"`
+
+	// Calculado nas consultas (não é coluna da tabela)
+	TotalItens int `gorm:"->;column:total_itens" json:"total_itens"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (Inventario) TableName() string {
+	return "inventarios"
+}
+
+// Status da sessão de inventário
+const (
+	InventarioStatusAberto    = "aberto"
+	InventarioStatusFechado   = "fechado"
+	InventarioStatusCancelado = "cancelado"
+)
+
+// Entidade para a tabela inventario_itens
+// Guarda o saldo do lote no momento da abertura do inventário (QuantidadeSistema)
+type InventarioItem struct {
+	ID                int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDInventario      int     `gorm:"column:id_inventario;not null" json:"id_inventario"`
+	IDEstoque         int     `gorm:"column:id_estoque;not null" json:"id_estoque"`
+	IDProduto         int     `gorm:"column:id_produto;not null" json:"id_produto"`
+	IDLote            int     `gorm:"column:id_lote;not null" json:"id_lote"`
+	QuantidadeSistema int     `gorm:"column:quantidade_sistema;not null" json:"quantidade_sistema"`
+	CustoUnitario     float64 `gorm:"column:custo_unitario;not null" json:"custo_unitario"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (InventarioItem) TableName() string {
+	return "inventario_itens"
+}
+
+// Entidade para a tabela inventario_contagens
+// Cada dispositivo registra sua própria contagem do lote; a quantidade contada do item é a soma entre dispositivos
+type InventarioContagem struct {
+	ID           int    `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDInventario int    `gorm:"column:id_inventario;not null" json:"id_inventario"`
+	IDItem       int    `gorm:"column:id_item;not null" json:"id_item"`
+	Dispositivo  string `gorm:"column:dispositivo;not null" json:"dispositivo"`
+	Quantidade   int    `gorm:"column:quantidade;not null" json:"quantidade"`
+	DataContagem string `gorm:"column:data_contagem;not null" json:"data_contagem"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (InventarioContagem) TableName() string {
+	return "inventario_contagens"
+}
+
+// Estrutura para consulta SQL da divergência de cada item do inventário
+type InventarioDivergencia struct {
+	IDItem            int     `json:"id_item"`
+	IDEstoque         int     `json:"id_estoque"`
+	IDProduto         int     `json:"id_produto"`
+	NomeProduto       string  `json:"nome_produto"`
+	IDLote            int     `json:"id_lote"`
+	QuantidadeSistema int     `json:"quantidade_sistema"`
+	QuantidadeContada *int    `json:"quantidade_contada"`
+	Contagens         int     `json:"contagens"`
+	CustoUnitario     float64 `json:"custo_unitario"`
+}
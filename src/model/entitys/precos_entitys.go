@@ -0,0 +1,45 @@
+package entity
+
+// Entidade para a tabela historico_precos
+type HistoricoPreco struct {
+	ID            int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDProduto     int     `gorm:"column:id_produto;not null" json:"id_produto"`
+	PrecoAnterior float64 `gorm:"column:preco_anterior;not null" json:"preco_anterior"`
+	PrecoNovo     float64 `gorm:"column:preco_novo;not null" json:"preco_novo"`
+	Origem        string  `gorm:"column:origem;not null" json:"origem"`
+	IDAgendamento *int    `gorm:"column:id_agendamento" json:"id_agendamento"`
+	AlteradoPor   string  `gorm:"column:alterado_por;not null" json:"alterado_por"`
+	DataAlteracao string  `gorm:"column:data_alteracao;not null" json:"data_alteracao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (HistoricoPreco) TableName() string {
+	return "historico_precos"
+}
+
+// Entidade para a tabela precos_agendados
+type PrecoAgendado struct {
+	ID            int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDProduto     int     `gorm:"column:id_produto;not null" json:"id_produto"`
+	PrecoVenda    float64 `gorm:"column:preco_venda;not null" json:"preco_venda"`
+	DataVigencia  string  `gorm:"column:data_vigencia;not null" json:"data_vigencia"`
+	Status        string  `gorm:"column:status;not null;default:pendente" json:"status"`
+	CriadoPor     string  `gorm:"column:criado_por;not null" json:"criado_por"`
+	DataCriacao   string  `gorm:"column:data_criacao;not null" json:"data_criacao"`
+	DataAplicacao *string `gorm:"column:data_aplicacao" json:"data_aplicacao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (PrecoAgendado) TableName() string {
+	return "precos_agendados"
+}
+
+// Origens de alteração de preço e status de preço agendado
+const (
+	PrecoOrigemManual      = "manual"
+	PrecoOrigemAgendamento = "agendamento"
+
+	PrecoAgendadoStatusPendente  = "pendente"
+	PrecoAgendadoStatusAplicado  = "aplicado"
+	PrecoAgendadoStatusCancelado = "cancelado"
+)
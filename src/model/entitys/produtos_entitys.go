@@ -11,6 +11,7 @@ type Produto struct {
 	NomeProduto   string  `gorm:"column:nome_produto" json:"nome_produto"`
 	SKU           string  `gorm:"column:sku" json:"sku"`
 	Categoria     string  `gorm:"column:categoria" json:"categoria"`
+	IDCategoria   *int    `gorm:"column:id_categoria" json:"id_categoria"`
 	DestinadoPara string  `gorm:"column:destinado_para" json:"destinado_para"`
 	Variacao      string  `gorm:"column:variacao" json:"variacao"`
 	Marca         string  `gorm:"column:marca" json:"marca"`
@@ -18,6 +19,9 @@ type Produto struct {
 	Status        string  `gorm:"column:status" json:"status"`
 	PrecoVenda    float64 `gorm:"column:preco_venda" json:"preco_venda"`
 	IDFornecedor  int     `gorm:"column:id_fornecedor" json:"id_fornecedor"`
+	EstoqueMinimo int     `gorm:"column:estoque_minimo;default:0" json:"estoque_minimo"`
+	Tipo          string  `gorm:"column:tipo;default:simples" json:"tipo"`
+	IDProdutoPai  *int    `gorm:"column:id_produto_pai" json:"id_produto_pai"`
 }
 
 func BuildProductEntity(request dtos.CreateProductRequest) *Produto {
@@ -27,6 +31,7 @@ func BuildProductEntity(request dtos.CreateProductRequest) *Produto {
 		NomeProduto:   request.NomeProduto,
 		SKU:           request.SKU,
 		Categoria:     request.Categoria,
+		IDCategoria:   request.IDCategoria,
 		DestinadoPara: request.DestinadoPara,
 		Variacao:      request.Variacao,
 		Marca:         request.Marca,
@@ -34,5 +39,48 @@ func BuildProductEntity(request dtos.CreateProductRequest) *Produto {
 		Status:        request.Status,
 		PrecoVenda:    request.PrecoVenda,
 		IDFornecedor:  request.IDFornecedor,
+		EstoqueMinimo: request.EstoqueMinimo,
+		Tipo:          ProdutoTipoSimples,
 	}
 }
+
+// Tipos de produto
+// O produto pai agrupa variações (ex.: 1kg, 3kg, 15kg) que compartilham marca, categoria, público e fornecedor,
+// mas têm SKU, código de barras, preço e estoque próprios. O kit não tem estoque próprio: é montado a partir dos componentes.
+const (
+	ProdutoTipoSimples  = "simples"
+	ProdutoTipoPai      = "pai"
+	ProdutoTipoVariacao = "variacao"
+	ProdutoTipoKit      = "kit"
+)
+
+// CamposCompartilhadosVariacao são as colunas herdadas do produto pai pelas variações
+var CamposCompartilhadosVariacao = []string{"marca", "categoria", "id_categoria", "destinado_para", "id_fornecedor"}
+
+// Estrutura para consultas de produtos com o saldo somado dos lotes
+type ProdutoSaldo struct {
+	Produto
+	Saldo int `gorm:"column:saldo" json:"saldo"`
+}
+
+// Entidade para a tabela kit_componentes
+type KitComponente struct {
+	ID         int `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDKit      int `gorm:"column:id_kit;not null" json:"id_kit"`
+	IDProduto  int `gorm:"column:id_produto;not null" json:"id_produto"`
+	Quantidade int `gorm:"column:quantidade;not null" json:"quantidade"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (KitComponente) TableName() string {
+	return "kit_componentes"
+}
+
+// Estrutura para consulta SQL dos componentes de um kit com o saldo de cada componente
+type KitComponenteDetalhe struct {
+	IDProduto   int    `json:"id_produto"`
+	NomeProduto string `json:"nome_produto"`
+	SKU         string `json:"sku"`
+	Quantidade  int    `json:"quantidade"`
+	Saldo       int    `json:"saldo"`
+}
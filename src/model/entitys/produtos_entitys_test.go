@@ -234,6 +234,8 @@ func TestBuildProductEntity_Success(t *testing.T) {
 	assert.Equal(t, request.Descricao, produto.Descricao)
 	assert.Equal(t, request.Status, produto.Status)
 	assert.Equal(t, request.PrecoVenda, produto.PrecoVenda)
+	assert.Equal(t, ProdutoTipoSimples, produto.Tipo)
+	assert.Nil(t, produto.IDProdutoPai)
 	// IDProduto should be 0 (will be set by database)
 	assert.Equal(t, 0, produto.IDProduto)
 }
@@ -6,6 +6,8 @@ import dtos "github.com/betine97/back-project.git/src/model/dtos"
 type Criterio struct {
 	ID           int    `gorm:"primaryKey;autoIncrement;table:criterios" json:"id"`
 	NomeCondicao string `gorm:"column:nome_condicao;not null" json:"nome_condicao"`
+	// Regra é a condição parametrizada em JSON; é nula nos critérios fixos (ex: Possui Gato)
+	Regra *string `gorm:"column:regra;type:text" json:"regra"`
 }
 
 // TableName especifica o nome da tabela para GORM
@@ -13,13 +15,21 @@ func (Criterio) TableName() string {
 	return "criterios"
 }
 
+// Tipos de público: o estático só muda quando clientes são adicionados; o dinâmico é reavaliado pelo job
+const (
+	PublicoTipoEstatico = "estatico"
+	PublicoTipoDinamico = "dinamico"
+)
+
 // Entidade para a tabela publicos_clientes
 type PublicoCliente struct {
-	ID          int    `gorm:"primaryKey;autoIncrement" json:"id"`
-	Nome        string `gorm:"column:nome;not null" json:"nome"`
-	Descricao   string `gorm:"column:descricao;not null" json:"descricao"`
-	DataCriacao string `gorm:"column:data_criacao;not null" json:"data_criacao"`
-	Status      string `gorm:"column:status;not null" json:"status"`
+	ID                int     `gorm:"primaryKey;autoIncrement" json:"id"`
+	Nome              string  `gorm:"column:nome;not null" json:"nome"`
+	Descricao         string  `gorm:"column:descricao;not null" json:"descricao"`
+	DataCriacao       string  `gorm:"column:data_criacao;not null" json:"data_criacao"`
+	Status            string  `gorm:"column:status;not null" json:"status"`
+	Tipo              string  `gorm:"column:tipo;not null;default:estatico" json:"tipo"`
+	UltimaAtualizacao *string `gorm:"column:ultima_atualizacao" json:"ultima_atualizacao"`
 }
 
 // TableName especifica o nome da tabela para GORM
@@ -39,6 +49,18 @@ func (PublicoCriterio) TableName() string {
 	return "publicos_criterios"
 }
 
+// Entidade para a tabela publicos_regras: a árvore de critérios do público, gravada em JSON
+type PublicoRegra struct {
+	IDPublico       int    `gorm:"primaryKey;column:id_publico" json:"id_publico"`
+	Regra           string `gorm:"column:regra;type:text;not null" json:"regra"`
+	DataAtualizacao string `gorm:"column:data_atualizacao;not null" json:"data_atualizacao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (PublicoRegra) TableName() string {
+	return "publicos_regras"
+}
+
 // Estrutura para consulta SQL com JOIN
 type PublicoCriterioJoin struct {
 	IDPublico    int    `json:"id_publico"`
@@ -48,11 +70,16 @@ type PublicoCriterioJoin struct {
 
 // Função para construir entidade PublicoCliente a partir do DTO
 func BuildPublicoClienteEntity(request dtos.CreatePublicoRequest) *PublicoCliente {
+	tipo := request.Tipo
+	if tipo == "" {
+		tipo = PublicoTipoEstatico
+	}
 	return &PublicoCliente{
 		Nome:        request.Nome,
 		Descricao:   request.Descricao,
 		DataCriacao: request.DataCriacao,
 		Status:      request.Status,
+		Tipo:        tipo,
 	}
 }
 
@@ -83,3 +110,80 @@ func BuildAddClientePublicoEntity(idPublico, idCliente int) *AddClientePublico {
 		IDCliente: idCliente,
 	}
 }
+
+// ContagemPublico é uma linha do detalhamento da prévia de um público (ex: quantos clientes por sexo)
+type ContagemPublico struct {
+	Valor string `gorm:"column:valor" json:"valor"`
+	Total int    `gorm:"column:total" json:"total"`
+}
+
+// PreviewPublico reúne o resultado da prévia de uma regra, calculado sem gravar nada
+type PreviewPublico struct {
+	Total      int
+	PorSexo    []ContagemPublico
+	PorEspecie []ContagemPublico
+	PorCidade  []ContagemPublico
+	Amostra    []Cliente
+}
+
+// Eventos do histórico de membros de um público
+const (
+	PublicoEventoEntrada = "entrada"
+	PublicoEventoSaida   = "saida"
+)
+
+// Origens dos eventos: adição ou remoção pelo usuário, reavaliação do público dinâmico, exclusão do cliente ou
+// criação do público por uma operação entre públicos
+const (
+	PublicoEventoOrigemManual      = "manual"
+	PublicoEventoOrigemAtualizacao = "atualizacao"
+	PublicoEventoOrigemExclusao    = "exclusao"
+	PublicoEventoOrigemOperacao    = "operacao"
+)
+
+// Entidade para a tabela publicos_membros_eventos: cada entrada ou saída de um cliente do público
+type PublicoMembroEvento struct {
+	ID         int    `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	IDPublico  int    `gorm:"column:id_publico;not null" json:"id_publico"`
+	IDCliente  int    `gorm:"column:id_cliente;not null" json:"id_cliente"`
+	Tipo       string `gorm:"column:tipo;not null" json:"tipo"`
+	Origem     string `gorm:"column:origem;not null" json:"origem"`
+	DataEvento string `gorm:"column:data_evento;not null" json:"data_evento"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (PublicoMembroEvento) TableName() string {
+	return "publicos_membros_eventos"
+}
+
+// PublicoMembroEventoJoin é o evento com o nome do cliente, para o histórico
+type PublicoMembroEventoJoin struct {
+	PublicoMembroEvento
+	NomeCliente string `gorm:"column:nome_cliente" json:"nome_cliente"`
+}
+
+// Entidade para a tabela publicos_exclusoes: clientes que não podem entrar no público, mesmo atendendo à regra
+type PublicoExclusao struct {
+	IDPublico    int     `gorm:"primaryKey;column:id_publico" json:"id_publico"`
+	IDCliente    int     `gorm:"primaryKey;column:id_cliente" json:"id_cliente"`
+	Motivo       *string `gorm:"column:motivo" json:"motivo"`
+	DataExclusao string  `gorm:"column:data_exclusao;not null" json:"data_exclusao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (PublicoExclusao) TableName() string {
+	return "publicos_exclusoes"
+}
+
+// PublicoExclusaoJoin é a exclusão com o nome do cliente, para a listagem
+type PublicoExclusaoJoin struct {
+	PublicoExclusao
+	NomeCliente string `gorm:"column:nome_cliente" json:"nome_cliente"`
+}
+
+// Operações entre públicos: união, interseção e diferença (o primeiro público sem os membros dos demais)
+const (
+	PublicoOperacaoUniao      = "uniao"
+	PublicoOperacaoIntersecao = "intersecao"
+	PublicoOperacaoDiferenca  = "diferenca"
+)
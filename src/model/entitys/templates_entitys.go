@@ -0,0 +1,32 @@
+package entity
+
+import dtos "github.com/betine97/back-project.git/src/model/dtos"
+
+// Entidade para a tabela templates_mensagens: texto das mensagens de campanha com campos do cliente, pet, endereço e loja
+type TemplateMensagem struct {
+	ID              int     `gorm:"primaryKey;autoIncrement;column:id" json:"id"`
+	Nome            string  `gorm:"column:nome;not null" json:"nome"`
+	Canal           string  `gorm:"column:canal;not null" json:"canal"`
+	Assunto         *string `gorm:"column:assunto" json:"assunto"`
+	Corpo           string  `gorm:"column:corpo;not null" json:"corpo"`
+	DataCriacao     string  `gorm:"column:data_criacao;not null" json:"data_criacao"`
+	DataAtualizacao string  `gorm:"column:data_atualizacao;not null" json:"data_atualizacao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (TemplateMensagem) TableName() string {
+	return "templates_mensagens"
+}
+
+// Função para construir entidade TemplateMensagem a partir do DTO. O assunto só é usado no email.
+func BuildTemplateMensagemEntity(request dtos.TemplateMensagemRequest) *TemplateMensagem {
+	template := &TemplateMensagem{
+		Nome:  request.Nome,
+		Canal: request.Canal,
+		Corpo: request.Corpo,
+	}
+	if request.Canal == "email" {
+		template.Assunto = request.Assunto
+	}
+	return template
+}
@@ -0,0 +1,71 @@
+package entity
+
+// Entidade para a tabela vendas
+// Subtotal é a soma dos itens; ValorTotal é o subtotal menos o desconto da venda.
+// IDCaixaSessao é a sessão de caixa em que a venda foi paga, quando paga pelo PDV
+type Venda struct {
+	IDVenda          int     `gorm:"primaryKey;autoIncrement;column:id_venda" json:"id_venda"`
+	IDCliente        *int    `gorm:"column:id_cliente" json:"id_cliente"`
+	DataVenda        string  `gorm:"column:data_venda;not null" json:"data_venda"`
+	Subtotal         float64 `gorm:"column:subtotal;type:decimal(10,2);not null" json:"subtotal"`
+	Desconto         float64 `gorm:"column:desconto;type:decimal(10,2);not null" json:"desconto"`
+	ValorTotal       float64 `gorm:"column:valor_total;type:decimal(10,2);not null" json:"valor_total"`
+	FormaPagamento   string  `gorm:"column:forma_pagamento" json:"forma_pagamento"`
+	Status           string  `gorm:"column:status;not null;default:aberta" json:"status"`
+	Observacao       string  `gorm:"column:observacao" json:"observacao"`
+	DataPagamento    *string `gorm:"column:data_pagamento" json:"data_pagamento"`
+	DataCancelamento *string `gorm:"column:data_cancelamento" json:"data_cancelamento"`
+	IDCaixaSessao    *int    `gorm:"column:id_caixa_sessao" json:"id_caixa_sessao"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (Venda) TableName() string {
+	return "vendas"
+}
+
+// Status da venda
+const (
+	VendaStatusAberta    = "aberta"
+	VendaStatusPaga      = "paga"
+	VendaStatusCancelada = "cancelada"
+	VendaStatusDevolvida = "devolvida"
+)
+
+// Formas de pagamento aceitas
+const (
+	FormaPagamentoDinheiro      = "dinheiro"
+	FormaPagamentoPix           = "pix"
+	FormaPagamentoCartaoCredito = "cartao_credito"
+	FormaPagamentoCartaoDebito  = "cartao_debito"
+	FormaPagamentoBoleto        = "boleto"
+	FormaPagamentoCreditoLoja   = "credito_loja"
+)
+
+var FormasPagamento = []string{
+	FormaPagamentoDinheiro,
+	FormaPagamentoPix,
+	FormaPagamentoCartaoCredito,
+	FormaPagamentoCartaoDebito,
+	FormaPagamentoBoleto,
+	FormaPagamentoCreditoLoja,
+}
+
+// Entidade para a tabela itens_venda
+// NomeProduto guarda o nome na hora da venda; Subtotal é quantidade × preço menos o desconto do item.
+// QuantidadeDevolvida soma as unidades já devolvidas; os totais da venda não mudam com as devoluções
+type ItemVenda struct {
+	IDItem              int     `gorm:"primaryKey;autoIncrement;column:id_item" json:"id_item"`
+	IDVenda             int     `gorm:"column:id_venda;not null" json:"id_venda"`
+	IDProduto           int     `gorm:"column:id_produto;not null" json:"id_produto"`
+	NomeProduto         string  `gorm:"column:nome_produto;not null" json:"nome_produto"`
+	Quantidade          int     `gorm:"column:quantidade;not null" json:"quantidade"`
+	PrecoUnitario       float64 `gorm:"column:preco_unitario;type:decimal(10,2);not null" json:"preco_unitario"`
+	Desconto            float64 `gorm:"column:desconto;type:decimal(10,2);not null" json:"desconto"`
+	Subtotal            float64 `gorm:"column:subtotal;type:decimal(10,2);not null" json:"subtotal"`
+	QuantidadeDevolvida int     `gorm:"column:quantidade_devolvida;not null;default:0" json:"quantidade_devolvida"`
+}
+
+// TableName especifica o nome da tabela para GORM
+func (ItemVenda) TableName() string {
+	return "itens_venda"
+}
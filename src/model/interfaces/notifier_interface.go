@@ -0,0 +1,66 @@
+package interfaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// NotifierInterface define o envio de notificações de eventos do sistema (ex: alertas de estoque)
+type NotifierInterface interface {
+	Notify(ctx context.Context, evento string, payload interface{}) error
+}
+
+// WebhookNotifier envia as notificações via POST JSON para uma URL configurada
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier cria um notifier de webhook; com URL vazia as notificações são ignoradas
+func NewWebhookNotifier(url string) NotifierInterface {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (w *WebhookNotifier) Notify(ctx context.Context, evento string, payload interface{}) error {
+	if w.url == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"evento":  evento,
+		"payload": payload,
+		"data":    time.Now().Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		zap.L().Error("Error sending webhook notification", zap.String("evento", evento), zap.Error(err))
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		zap.L().Error("Webhook notification rejected", zap.String("evento", evento), zap.Int("status", resp.StatusCode))
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE ALERTAS DE ESTOQUE ------------------------------------------------------------------------------------------------------------------------------------
+
+// GetLotesVencendo busca lotes com saldo que vencem dentro de `dias` dias (ou que já venceram)
+func (repo *DBConnectionDBClient) GetLotesVencendo(userID string, dias int) ([]entity.LoteVencendo, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting lotes vencendo from database", zap.String("userID", userID), zap.Int("dias", dias))
+
+	var lotes []entity.LoteVencendo
+	err := db.Table("estoques e").
+		Select("e.id_produto, e.id_lote, p.nome_produto, e.quantidade, DATE_FORMAT(e.vencimento, '%Y-%m-%d') as vencimento, DATEDIFF(e.vencimento, CURDATE()) as dias_para_vencer").
+		Joins("INNER JOIN produtos p ON p.id_produto = e.id_produto").
+		Where("e.quantidade > 0 AND e.vencimento IS NOT NULL AND e.vencimento <= DATE_ADD(CURDATE(), INTERVAL ? DAY)", dias).
+		Order("e.vencimento ASC").
+		Find(&lotes).Error
+
+	if err != nil {
+		zap.L().Error("Error getting lotes vencendo from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved lotes vencendo", zap.Int("count", len(lotes)))
+	return lotes, nil
+}
+
+// GetProdutosAbaixoMinimo busca produtos com estoque mínimo definido cujo saldo total está abaixo dele
+func (repo *DBConnectionDBClient) GetProdutosAbaixoMinimo(userID string) ([]entity.ProdutoAbaixoMinimo, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting produtos abaixo do minimo from database", zap.String("userID", userID))
+
+	var produtos []entity.ProdutoAbaixoMinimo
+	err := db.Table("produtos p").
+		Select("p.id_produto, p.nome_produto, COALESCE(SUM(e.quantidade), 0) as quantidade, p.estoque_minimo").
+		Joins("LEFT JOIN estoques e ON e.id_produto = p.id_produto").
+		Where("p.estoque_minimo > 0").
+		Group("p.id_produto, p.nome_produto, p.estoque_minimo").
+		Having("COALESCE(SUM(e.quantidade), 0) < p.estoque_minimo").
+		Order("p.nome_produto ASC").
+		Find(&produtos).Error
+
+	if err != nil {
+		zap.L().Error("Error getting produtos abaixo do minimo from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved produtos abaixo do minimo", zap.Int("count", len(produtos)))
+	return produtos, nil
+}
+
+// CreateAlertasEstoque grava os alertas que ainda não possuem um alerta pendente equivalente e retorna apenas os novos.
+// A deduplicação fica com o índice único uk_alertas_pendentes: com INSERT IGNORE, execuções simultâneas do job não
+// gravam o mesmo alerta duas vezes.
+func (repo *DBConnectionDBClient) CreateAlertasEstoque(alertas []entity.AlertaEstoque, userID string) ([]entity.AlertaEstoque, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating alertas estoque in the database", zap.Int("alertas_count", len(alertas)), zap.String("userID", userID))
+
+	var novos []entity.AlertaEstoque
+	for _, alerta := range alertas {
+		result := db.Clauses(clause.Insert{Modifier: "IGNORE"}).Create(&alerta)
+		if result.Error != nil {
+			zap.L().Error("Error creating alerta estoque", zap.Error(result.Error), zap.String("tipo", alerta.Tipo), zap.Int("id_produto", alerta.IDProduto))
+			return novos, result.Error
+		}
+
+		// Sem linha afetada já existe um alerta pendente para o mesmo produto/lote
+		if result.RowsAffected > 0 {
+			novos = append(novos, alerta)
+		}
+	}
+
+	zap.L().Info("Successfully created alertas estoque", zap.Int("novos", len(novos)))
+	return novos, nil
+}
+
+func (repo *DBConnectionDBClient) GetAlertasEstoquePaginated(userID string, tipo, status string, limit, offset int) ([]entity.AlertaEstoque, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting paginated alertas estoque from database", zap.String("userID", userID), zap.String("tipo", tipo), zap.String("status", status), zap.Int("limit", limit), zap.Int("offset", offset))
+
+	var alertas []entity.AlertaEstoque
+	var total int64
+
+	query := db.Model(&entity.AlertaEstoque{})
+	if tipo != "" {
+		query = query.Where("tipo = ?", tipo)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	// Contar total de registros
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting alertas estoque", zap.Error(err))
+		return nil, 0, err
+	}
+
+	// Buscar registros paginados
+	err := query.Limit(limit).Offset(offset).Order("id DESC").Find(&alertas).Error
+	if err != nil {
+		zap.L().Error("Error getting paginated alertas estoque from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved paginated alertas estoque", zap.Int("count", len(alertas)), zap.Int64("total", total))
+	return alertas, int(total), nil
+}
+
+func (repo *DBConnectionDBClient) ResolverAlertaEstoque(id string, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Resolving alerta estoque in the database", zap.String("id", id), zap.String("userID", userID))
+	result := db.Model(&entity.AlertaEstoque{}).Where("id = ?", id).Update("status", entity.AlertaStatusResolvido)
+	if result.Error != nil {
+		zap.L().Error("Error resolving alerta estoque in database", zap.Error(result.Error))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package persistence
+
+import (
+	"errors"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE CAIXA ------------------------------------------------------------------------------------------------------------------------------------
+
+// ErrCaixaJaAberto indica que o operador já tem uma sessão de caixa aberta
+var ErrCaixaJaAberto = errors.New("operador já tem uma sessão de caixa aberta")
+
+// ErrCaixaFechado indica que a sessão de caixa não está mais aberta
+var ErrCaixaFechado = errors.New("sessão de caixa não está aberta")
+
+// ErrCaixaAlterado indica que a sessão recebeu movimentos depois da conferência feita pelo serviço
+var ErrCaixaAlterado = errors.New("sessão de caixa recebeu novos movimentos")
+
+// AbrirCaixaSessao grava a sessão aberta, se o operador ainda não tiver outra aberta.
+// O índice único uk_caixa_sessoes_aberto barra a segunda sessão; retorna ErrCaixaJaAberto nesse caso.
+func (repo *DBConnectionDBClient) AbrirCaixaSessao(sessao *entity.CaixaSessao, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Opening caixa sessao in the database", zap.String("operador", sessao.Operador), zap.Float64("valor_abertura", sessao.ValorAbertura), zap.String("userID", userID))
+
+	err := db.Create(sessao).Error
+	if indice, duplicado := IndiceDuplicado(err); duplicado && indice == IndiceCaixaAberto {
+		err = ErrCaixaJaAberto
+	}
+
+	if err != nil {
+		zap.L().Error("Error opening caixa sessao in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully opened caixa sessao", zap.Int("id", sessao.ID))
+	return nil
+}
+
+func (repo *DBConnectionDBClient) GetCaixaSessaoByID(id int, userID string) (*entity.CaixaSessao, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting caixa sessao by ID from database", zap.Int("id", id), zap.String("userID", userID))
+
+	var sessao entity.CaixaSessao
+	err := db.Where("id = ?", id).First(&sessao).Error
+	if err != nil {
+		zap.L().Error("Error getting caixa sessao by ID from database", zap.Error(err))
+		return nil, err
+	}
+	return &sessao, nil
+}
+
+// GetCaixaSessaoAberta retorna a sessão aberta do operador ou gorm.ErrRecordNotFound
+func (repo *DBConnectionDBClient) GetCaixaSessaoAberta(operador string, userID string) (*entity.CaixaSessao, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting open caixa sessao from database", zap.String("operador", operador), zap.String("userID", userID))
+
+	var sessao entity.CaixaSessao
+	err := db.Where("operador = ? AND status = ?", operador, entity.CaixaStatusAberto).First(&sessao).Error
+	if err != nil {
+		return nil, err
+	}
+	return &sessao, nil
+}
+
+func (repo *DBConnectionDBClient) GetCaixaSessoesPaginated(userID string, status string, limit, offset int) ([]entity.CaixaSessao, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting paginated caixa sessoes from database", zap.String("userID", userID), zap.String("status", status), zap.Int("limit", limit), zap.Int("offset", offset))
+
+	var sessoes []entity.CaixaSessao
+	var total int64
+
+	query := filtrarCaixaSessoes(db.Model(&entity.CaixaSessao{}), status)
+
+	// Contar total de registros
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting caixa sessoes", zap.Error(err))
+		return nil, 0, err
+	}
+
+	// Buscar registros paginados
+	err := query.Limit(limit).Offset(offset).Order("id DESC").Find(&sessoes).Error
+	if err != nil {
+		zap.L().Error("Error getting paginated caixa sessoes from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved paginated caixa sessoes", zap.Int("count", len(sessoes)), zap.Int64("total", total))
+	return sessoes, int(total), nil
+}
+
+func filtrarCaixaSessoes(query *gorm.DB, status string) *gorm.DB {
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	return query
+}
+
+func (repo *DBConnectionDBClient) GetCaixaMovimentos(idSessao int, userID string) ([]entity.CaixaMovimento, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting caixa movimentos from database", zap.Int("id_sessao", idSessao), zap.String("userID", userID))
+
+	var movimentos []entity.CaixaMovimento
+	err := db.Where("id_sessao = ?", idSessao).Order("id ASC").Find(&movimentos).Error
+	if err != nil {
+		zap.L().Error("Error getting caixa movimentos from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved caixa movimentos", zap.Int("count", len(movimentos)))
+	return movimentos, nil
+}
+
+// RegistrarMovimentoCaixa grava uma sangria ou suprimento na sessão aberta.
+// movimentosConferidos é quantos movimentos a sessão tinha quando o serviço conferiu o saldo da gaveta;
+// se mudou, retorna ErrCaixaAlterado. Retorna ErrCaixaFechado se a sessão não estiver mais aberta.
+func (repo *DBConnectionDBClient) RegistrarMovimentoCaixa(movimento *entity.CaixaMovimento, movimentosConferidos int, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Registering caixa movimento in the database", zap.Int("id_sessao", movimento.IDSessao), zap.String("tipo", movimento.Tipo), zap.Float64("valor", movimento.Valor), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := travarCaixaConferido(tx, movimento.IDSessao, movimentosConferidos); err != nil {
+			return err
+		}
+		return tx.Create(movimento).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error registering caixa movimento in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully registered caixa movimento", zap.Int("id", movimento.ID))
+	return nil
+}
+
+// FecharCaixaSessao grava os valores de fechamento e fecha a sessão.
+// Retorna ErrCaixaAlterado se a sessão recebeu movimentos depois de movimentosConferidos e ErrCaixaFechado se já foi fechada.
+func (repo *DBConnectionDBClient) FecharCaixaSessao(sessao *entity.CaixaSessao, movimentosConferidos int, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Closing caixa sessao in the database", zap.Int("id", sessao.ID), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := travarCaixaConferido(tx, sessao.ID, movimentosConferidos); err != nil {
+			return err
+		}
+		return tx.Model(&entity.CaixaSessao{}).
+			Where("id = ?", sessao.ID).
+			Updates(map[string]interface{}{
+				"status":                entity.CaixaStatusFechado,
+				"valor_esperado":        sessao.ValorEsperado,
+				"valor_contado":         sessao.ValorContado,
+				"diferenca":             sessao.Diferenca,
+				"data_fechamento":       sessao.DataFechamento,
+				"observacao_fechamento": sessao.ObservacaoFechamento,
+			}).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error closing caixa sessao in database", zap.Error(err))
+		return err
+	}
+
+	sessao.Status = entity.CaixaStatusFechado
+	zap.L().Info("Successfully closed caixa sessao", zap.Int("id", sessao.ID))
+	return nil
+}
+
+// travarCaixaAberto trava a sessão até o fim da transação, para que vendas, sangrias e o fechamento não se cruzem
+func travarCaixaAberto(tx *gorm.DB, idSessao int) error {
+	var sessao entity.CaixaSessao
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND status = ?", idSessao, entity.CaixaStatusAberto).
+		First(&sessao).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrCaixaFechado
+	}
+	return err
+}
+
+// travarCaixaConferido trava a sessão aberta e confere se ela ainda tem os movimentos que o serviço considerou
+func travarCaixaConferido(tx *gorm.DB, idSessao int, movimentosConferidos int) error {
+	if err := travarCaixaAberto(tx, idSessao); err != nil {
+		return err
+	}
+
+	var movimentos int64
+	if err := tx.Model(&entity.CaixaMovimento{}).Where("id_sessao = ?", idSessao).Count(&movimentos).Error; err != nil {
+		return err
+	}
+	if int(movimentos) != movimentosConferidos {
+		return ErrCaixaAlterado
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE AGENDA DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+// GetCampanhasEmAndamento lista as campanhas que ainda podem mudar de status: agendadas, ativas e pausadas
+func (repo *DBConnectionDBClient) GetCampanhasEmAndamento(userID string) ([]entity.Campanha, error) {
+	db := repo.getClientDB(userID)
+
+	var campanhas []entity.Campanha
+	err := db.Where("status IN ?", []string{entity.CampanhaStatusAgendada, entity.CampanhaStatusAtiva, entity.CampanhaStatusPausada}).
+		Order("id ASC").
+		Find(&campanhas).Error
+	if err != nil {
+		zap.L().Error("Error getting campanhas em andamento from database", zap.String("userID", userID), zap.Error(err))
+		return nil, err
+	}
+	return campanhas, nil
+}
+
+// AtualizarStatusCampanha troca o status apenas se a campanha ainda estiver no status esperado. Retorna false quando
+// outra requisição ou réplica já o alterou.
+func (repo *DBConnectionDBClient) AtualizarStatusCampanha(userID string, id int, de, para string) (bool, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Updating status of campanha", zap.String("userID", userID), zap.Int("id", id), zap.String("de", de), zap.String("para", para))
+
+	result := db.Model(&entity.Campanha{}).
+		Where("id = ? AND status = ?", id, de).
+		Update("status", para)
+	if result.Error != nil {
+		zap.L().Error("Error updating status of campanha", zap.Int("id", id), zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// GetCampanhasParaDisparo lista as campanhas ativas, com canais configurados, que ainda não foram disparadas (nem
+// têm envios de um disparo manual anterior) e cujo disparo automático não falhou antes
+func (repo *DBConnectionDBClient) GetCampanhasParaDisparo(userID string) ([]entity.Campanha, error) {
+	db := repo.getClientDB(userID)
+
+	var campanhas []entity.Campanha
+	err := db.Where("status = ? AND data_disparo IS NULL AND erro_disparo IS NULL", entity.CampanhaStatusAtiva).
+		Where("EXISTS (SELECT 1 FROM campanhas_canais cc WHERE cc.id_campanha = campanhas.id)").
+		Where("NOT EXISTS (SELECT 1 FROM campanhas_envios e WHERE e.id_campanha = campanhas.id)").
+		Order("id ASC").
+		Find(&campanhas).Error
+	if err != nil {
+		zap.L().Error("Error getting campanhas para disparo from database", zap.String("userID", userID), zap.Error(err))
+		return nil, err
+	}
+	return campanhas, nil
+}
+
+// RegistrarDisparoCampanha grava a data do disparo ou, quando ele falhou, o motivo. Uma campanha com erro não é
+// disparada de novo automaticamente; o disparo manual limpa o erro.
+func (repo *DBConnectionDBClient) RegistrarDisparoCampanha(userID string, id int, dataDisparo, erro *string) error {
+	db := repo.getClientDB(userID)
+
+	err := db.Model(&entity.Campanha{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"data_disparo": dataDisparo, "erro_disparo": erro}).Error
+	if err != nil {
+		zap.L().Error("Error registering disparo of campanha", zap.Int("id", id), zap.Error(err))
+	}
+	return err
+}
@@ -0,0 +1,205 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE CATEGORIAS ------------------------------------------------------------------------------------------------------------------------------------
+
+const selectCategoriaComTotalProdutos = "categorias.*, (SELECT COUNT(*) FROM produtos p WHERE p.id_categoria = categorias.id) as total_produtos"
+
+func (repo *DBConnectionDBClient) GetAllCategorias(userID string) ([]entity.Categoria, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting all categorias from database", zap.String("userID", userID))
+
+	var categorias []entity.Categoria
+	err := db.Model(&entity.Categoria{}).Select(selectCategoriaComTotalProdutos).Order("nome ASC").Find(&categorias).Error
+	if err != nil {
+		zap.L().Error("Error getting categorias from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved categorias", zap.Int("count", len(categorias)))
+	return categorias, nil
+}
+
+func (repo *DBConnectionDBClient) GetCategoriaByID(id int, userID string) (*entity.Categoria, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting categoria by ID from database", zap.Int("id", id), zap.String("userID", userID))
+
+	var categoria entity.Categoria
+	err := db.Model(&entity.Categoria{}).Select(selectCategoriaComTotalProdutos).Where("id = ?", id).First(&categoria).Error
+	if err != nil {
+		zap.L().Error("Error getting categoria by ID from database", zap.Error(err))
+		return nil, err
+	}
+	return &categoria, nil
+}
+
+func (repo *DBConnectionDBClient) CreateCategoria(categoria *entity.Categoria, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating categoria in the database", zap.String("nome", categoria.Nome), zap.String("userID", userID))
+	err := db.Create(categoria).Error
+	if err != nil {
+		zap.L().Error("Error creating categoria in database", zap.Error(err))
+	}
+	return err
+}
+
+// RenomearCategoria altera o nome da categoria e o nome gravado nos produtos dela numa única transação
+func (repo *DBConnectionDBClient) RenomearCategoria(id int, nome string, nomeNormalizado string, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Renaming categoria in the database", zap.Int("id", id), zap.String("nome", nome), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entity.Categoria{}).Where("id = ?", id).
+			Updates(map[string]interface{}{"nome": nome, "nome_normalizado": nomeNormalizado}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&entity.Produto{}).Where("id_categoria = ?", id).Update("categoria", nome).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error renaming categoria in database", zap.Error(err))
+	}
+	return err
+}
+
+// MoverCategoria troca o pai da categoria; idPai nil move para a raiz
+func (repo *DBConnectionDBClient) MoverCategoria(id int, idPai *int, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Moving categoria in the database", zap.Int("id", id), zap.Any("id_categoria_pai", idPai), zap.String("userID", userID))
+	err := db.Model(&entity.Categoria{}).Where("id = ?", id).Update("id_categoria_pai", idPai).Error
+	if err != nil {
+		zap.L().Error("Error moving categoria in database", zap.Error(err))
+	}
+	return err
+}
+
+// MesclarCategorias move os produtos e as subcategorias da origem para o destino e remove a origem numa única transação
+func (repo *DBConnectionDBClient) MesclarCategorias(idOrigem int, destino entity.Categoria, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Merging categorias in the database", zap.Int("id_origem", idOrigem), zap.Int("id_destino", destino.ID), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entity.Produto{}).Where("id_categoria = ?", idOrigem).
+			Updates(map[string]interface{}{"id_categoria": destino.ID, "categoria": destino.Nome}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&entity.Categoria{}).Where("id_categoria_pai = ?", idOrigem).Update("id_categoria_pai", destino.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Categoria{}, idOrigem).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error merging categorias in database", zap.Error(err))
+	}
+	return err
+}
+
+func (repo *DBConnectionDBClient) DeleteCategoria(id int, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Deleting categoria from database", zap.Int("id", id), zap.String("userID", userID))
+	err := db.Delete(&entity.Categoria{}, id).Error
+	if err != nil {
+		zap.L().Error("Error deleting categoria from database", zap.Error(err))
+	}
+	return err
+}
+
+// GetValoresCatalogo busca os valores distintos de categoria e destinado_para dos produtos e de espécie dos pets
+func (repo *DBConnectionDBClient) GetValoresCatalogo(userID string) (categorias, destinados, especies []entity.ValorDistinto, err error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting valores catalogo from database", zap.String("userID", userID))
+
+	if categorias, err = contarValoresDistintos(db.Model(&entity.Produto{}), "categoria"); err != nil {
+		zap.L().Error("Error getting categorias distintas", zap.Error(err))
+		return nil, nil, nil, err
+	}
+	if destinados, err = contarValoresDistintos(db.Model(&entity.Produto{}), "destinado_para"); err != nil {
+		zap.L().Error("Error getting destinado_para distintos", zap.Error(err))
+		return nil, nil, nil, err
+	}
+	if especies, err = contarValoresDistintos(db.Model(&entity.Pet{}), "especie"); err != nil {
+		zap.L().Error("Error getting especies distintas", zap.Error(err))
+		return nil, nil, nil, err
+	}
+
+	return categorias, destinados, especies, nil
+}
+
+// contarValoresDistintos agrupa pelo valor exato (BINARY) para que grafias diferentes apareçam separadas
+func contarValoresDistintos(query *gorm.DB, coluna string) ([]entity.ValorDistinto, error) {
+	var valores []entity.ValorDistinto
+	err := query.
+		Select("MIN(" + coluna + ") as valor, COUNT(*) as total").
+		Where(coluna + " IS NOT NULL AND " + coluna + " <> ''").
+		Group("BINARY " + coluna).
+		Scan(&valores).Error
+	return valores, err
+}
+
+// AplicarNormalizacaoCatalogo cria as categorias que faltam e troca os valores livres pelos controlados numa única transação
+func (repo *DBConnectionDBClient) AplicarNormalizacaoCatalogo(plano *entity.PlanoNormalizacaoCatalogo, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Applying normalizacao catalogo in the database", zap.Int("categorias", len(plano.Categorias)), zap.Int("destinado_para", len(plano.DestinadoPara)), zap.Int("especies", len(plano.Especies)), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for i := range plano.Categorias {
+			normalizacao := &plano.Categorias[i]
+			if normalizacao.IDCategoria == 0 {
+				categoria := entity.Categoria{
+					Nome:            normalizacao.Nome,
+					NomeNormalizado: normalizacao.NomeNormalizado,
+					DataCriacao:     plano.DataCriacao,
+				}
+				if err := tx.Create(&categoria).Error; err != nil {
+					return err
+				}
+				normalizacao.IDCategoria = categoria.ID
+			}
+
+			err := tx.Model(&entity.Produto{}).Where("BINARY categoria IN ?", normalizacao.Valores).
+				Updates(map[string]interface{}{"id_categoria": normalizacao.IDCategoria, "categoria": normalizacao.Nome}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, valor := range plano.DestinadoPara {
+			if err := tx.Model(&entity.Produto{}).Where("BINARY destinado_para = ?", valor.De).Update("destinado_para", valor.Para).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, valor := range plano.Especies {
+			if err := tx.Model(&entity.Pet{}).Where("BINARY especie = ?", valor.De).Update("especie", valor.Para).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		zap.L().Error("Error applying normalizacao catalogo in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully applied normalizacao catalogo")
+	return nil
+}
@@ -0,0 +1,164 @@
+package persistence
+
+import (
+	"errors"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE CONSENTIMENTOS DE CLIENTES ------------------------------------------------------------------------------------------------------------------------------------
+
+// GetConsentimentosCliente retorna a situação atual do consentimento do cliente nos canais em que há registro
+func (repo *DBConnectionDBClient) GetConsentimentosCliente(userID string, idCliente int) ([]entity.ConsentimentoCliente, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting consentimentos of cliente from database", zap.String("userID", userID), zap.Int("idCliente", idCliente))
+
+	var consentimentos []entity.ConsentimentoCliente
+	err := db.Model(&entity.ConsentimentoCliente{}).
+		Select("id_cliente, canal, consentido, origem, detalhe, DATE_FORMAT(data, '%Y-%m-%d %H:%i:%s') as data").
+		Where("id_cliente = ?", idCliente).
+		Order("canal ASC").
+		Find(&consentimentos).Error
+	if err != nil {
+		zap.L().Error("Error getting consentimentos of cliente from database", zap.Error(err))
+		return nil, err
+	}
+	return consentimentos, nil
+}
+
+// GetClientesConsentidos lista, entre os clientes informados, os canais em que cada um consentiu
+func (repo *DBConnectionDBClient) GetClientesConsentidos(userID string, clienteIDs []int) ([]entity.ConsentimentoCliente, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting consentimentos of clientes from database", zap.String("userID", userID), zap.Int("clientes_count", len(clienteIDs)))
+
+	var consentimentos []entity.ConsentimentoCliente
+	for inicio := 0; inicio < len(clienteIDs); inicio += tamanhoLoteEnvios {
+		lote := clienteIDs[inicio:min(inicio+tamanhoLoteEnvios, len(clienteIDs))]
+
+		var consentimentosLote []entity.ConsentimentoCliente
+		err := db.Select("id_cliente, canal, consentido").
+			Where("id_cliente IN ? AND consentido = ?", lote, true).
+			Find(&consentimentosLote).Error
+		if err != nil {
+			zap.L().Error("Error getting consentimentos of clientes from database", zap.Error(err))
+			return nil, err
+		}
+		consentimentos = append(consentimentos, consentimentosLote...)
+	}
+	return consentimentos, nil
+}
+
+// RegistrarConsentimentos grava as alterações de consentimento e retorna quantas mudaram a situação do cliente.
+// Uma alteração igual à situação atual é ignorada. A situação atual e o histórico são gravados na mesma transação.
+func (repo *DBConnectionDBClient) RegistrarConsentimentos(userID string, alteracoes []entity.ConsentimentoHistorico) (int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Registering consentimentos", zap.String("userID", userID), zap.Int("alteracoes_count", len(alteracoes)))
+
+	registradas := 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, alteracao := range alteracoes {
+			var atual entity.ConsentimentoCliente
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("id_cliente = ? AND canal = ?", alteracao.IDCliente, alteracao.Canal).
+				Take(&atual).Error
+			if err == nil && atual.Consentido == alteracao.Consentido {
+				continue
+			}
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+
+			situacao := entity.ConsentimentoCliente{
+				IDCliente:  alteracao.IDCliente,
+				Canal:      alteracao.Canal,
+				Consentido: alteracao.Consentido,
+				Origem:     alteracao.Origem,
+				Detalhe:    alteracao.Detalhe,
+				Data:       alteracao.Data,
+			}
+			if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&situacao).Error; err != nil {
+				return err
+			}
+			if err := tx.Create(&alteracao).Error; err != nil {
+				return err
+			}
+			registradas++
+		}
+		return nil
+	})
+	if err != nil {
+		zap.L().Error("Error registering consentimentos", zap.Error(err))
+		return 0, err
+	}
+
+	zap.L().Info("Successfully registered consentimentos", zap.Int("registradas", registradas))
+	return registradas, nil
+}
+
+func (repo *DBConnectionDBClient) GetHistoricoConsentimentosPaginated(userID string, idCliente int, canal string, limit, offset int) ([]entity.ConsentimentoHistorico, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting historico of consentimentos paginated from database", zap.String("userID", userID), zap.Int("idCliente", idCliente), zap.String("canal", canal))
+
+	query := filtrarHistoricoConsentimentos(db.Model(&entity.ConsentimentoHistorico{}), idCliente, canal)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting historico of consentimentos", zap.Error(err))
+		return nil, 0, err
+	}
+
+	var historico []entity.ConsentimentoHistorico
+	err := query.
+		Select(colunasConsentimentoHistorico).
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&historico).Error
+	if err != nil {
+		zap.L().Error("Error getting historico of consentimentos from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved historico of consentimentos", zap.Int("count", len(historico)), zap.Int64("total", total))
+	return historico, int(total), nil
+}
+
+const colunasConsentimentoHistorico = "id, id_cliente, canal, consentido, origem, detalhe, id_envio, DATE_FORMAT(data, '%Y-%m-%d %H:%i:%s') as data"
+
+func filtrarHistoricoConsentimentos(query *gorm.DB, idCliente int, canal string) *gorm.DB {
+	query = query.Where("id_cliente = ?", idCliente)
+	if canal != "" {
+		query = query.Where("canal = ?", canal)
+	}
+	return query
+}
+
+// StreamClientesPublicoConsentidos percorre os membros do público que consentiram no canal informado ou, sem canal,
+// em ao menos um canal
+func (repo *DBConnectionDBClient) StreamClientesPublicoConsentidos(userID string, idPublico int, canal string, fn func(entity.Cliente) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming clientes consentidos of publico from database", zap.String("userID", userID), zap.Int("idPublico", idPublico), zap.String("canal", canal))
+
+	consentimento := db.Table("clientes_consentimentos cc").
+		Select("1").
+		Where("cc.id_cliente = c.id AND cc.consentido = ?", true)
+	if canal != "" {
+		consentimento = consentimento.Where("cc.canal = ?", canal)
+	}
+
+	query := db.Table("clientes c").
+		Select("c.*").
+		Joins("INNER JOIN addclientes_publicos acp ON c.id = acp.id_cliente").
+		Where("acp.id_publico = ?", idPublico).
+		Where("EXISTS (?)", consentimento).
+		Order("c.id ASC")
+	return streamRows(query, "clientes_publico", fn)
+}
@@ -0,0 +1,57 @@
+package persistence
+
+import (
+	"errors"
+	"math"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE CRÉDITO NA LOJA ------------------------------------------------------------------------------------------------------------------------------------
+
+// ErrCreditoInsuficiente indica que o saldo de crédito do cliente não cobre o débito
+var ErrCreditoInsuficiente = errors.New("saldo de crédito do cliente insuficiente")
+
+func (repo *DBConnectionDBClient) GetCreditosCliente(idCliente int, userID string) ([]entity.CreditoCliente, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting creditos cliente from database", zap.Int("id_cliente", idCliente), zap.String("userID", userID))
+
+	var creditos []entity.CreditoCliente
+	err := db.Where("id_cliente = ?", idCliente).Order("id ASC").Find(&creditos).Error
+	if err != nil {
+		zap.L().Error("Error getting creditos cliente from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved creditos cliente", zap.Int("count", len(creditos)))
+	return creditos, nil
+}
+
+// debitarCredito trava o cliente, confere o saldo e grava o débito; retorna ErrCreditoInsuficiente se faltar saldo
+func debitarCredito(tx *gorm.DB, debito *entity.CreditoCliente) error {
+	var cliente entity.Cliente
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", debito.IDCliente).First(&cliente).Error
+	if err != nil {
+		return err
+	}
+
+	var saldo float64
+	err = tx.Model(&entity.CreditoCliente{}).
+		Select("COALESCE(SUM(CASE WHEN tipo = ? THEN valor ELSE -valor END), 0)", entity.CreditoTipoCredito).
+		Where("id_cliente = ?", debito.IDCliente).
+		Scan(&saldo).Error
+	if err != nil {
+		return err
+	}
+	// Compara em centavos para não recusar por diferença de arredondamento
+	if math.Round(saldo*100) < math.Round(debito.Valor*100) {
+		return ErrCreditoInsuficiente
+	}
+
+	return tx.Create(debito).Error
+}
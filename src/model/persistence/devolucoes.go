@@ -0,0 +1,292 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE DEVOLUÇÕES ------------------------------------------------------------------------------------------------------------------------------------
+
+// ErrItemDevolucaoIndisponivel indica que o item da venda não tem mais unidades para devolver
+var ErrItemDevolucaoIndisponivel = errors.New("quantidade devolvida maior que a disponível no item da venda")
+
+// ErrDevolucaoSemBaixa indica que a venda não baixou do estoque as unidades que estão sendo devolvidas
+var ErrDevolucaoSemBaixa = errors.New("unidades devolvidas sem baixa correspondente na venda")
+
+// RegistrarDevolucao grava a devolução numa única transação: soma as unidades devolvidas aos itens da venda,
+// devolve os produtos aos lotes de onde saíram (ou registra o descarte), lança o crédito ou o estorno no caixa
+// e marca a venda como devolvida quando todos os itens voltaram. Os pontos de fidelidade ganhos na venda são
+// estornados na proporção do valor devolvido.
+// Retorna gorm.ErrRecordNotFound se a venda não estiver paga, ErrItemDevolucaoIndisponivel, ErrDevolucaoSemBaixa
+// ou ErrCaixaFechado.
+func (repo *DBConnectionDBClient) RegistrarDevolucao(devolucao *entity.Devolucao, itens []entity.ItemDevolucao, retornos []entity.DevolucaoEstoque, credito *entity.CreditoCliente, estornoCaixa *entity.CaixaMovimento, estornoPontos *entity.PontosCliente, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Registering devolucao in the database", zap.Int("id_venda", devolucao.IDVenda), zap.Int("itens", len(itens)), zap.Float64("valor_total", devolucao.ValorTotal), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Trava a venda para que devoluções e cancelamento simultâneos não devolvam as mesmas unidades
+		var venda entity.Venda
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id_venda = ? AND status = ?", devolucao.IDVenda, entity.VendaStatusPaga).
+			First(&venda).Error
+		if err != nil {
+			return err
+		}
+
+		for _, item := range itens {
+			result := tx.Model(&entity.ItemVenda{}).
+				Where("id_item = ? AND id_venda = ? AND quantidade - quantidade_devolvida >= ?", item.IDItemVenda, devolucao.IDVenda, item.Quantidade).
+				Update("quantidade_devolvida", gorm.Expr("quantidade_devolvida + ?", item.Quantidade))
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return ErrItemDevolucaoIndisponivel
+			}
+		}
+
+		if estornoCaixa != nil {
+			if err := travarCaixaAberto(tx, estornoCaixa.IDSessao); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Create(devolucao).Error; err != nil {
+			return err
+		}
+		for i := range itens {
+			itens[i].IDDevolucao = devolucao.ID
+		}
+		if err := tx.Create(&itens).Error; err != nil {
+			return err
+		}
+
+		if err := devolverLotes(tx, *devolucao, retornos); err != nil {
+			return err
+		}
+
+		var pendentes int64
+		err = tx.Model(&entity.ItemVenda{}).
+			Where("id_venda = ? AND quantidade_devolvida < quantidade", devolucao.IDVenda).
+			Count(&pendentes).Error
+		if err != nil {
+			return err
+		}
+		if pendentes == 0 {
+			err := tx.Model(&entity.Venda{}).Where("id_venda = ?", devolucao.IDVenda).
+				Update("status", entity.VendaStatusDevolvida).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if credito != nil {
+			credito.IDReferencia = devolucao.ID
+			if err := tx.Create(credito).Error; err != nil {
+				return err
+			}
+		}
+		if estornoPontos != nil {
+			var valorDevolvido float64
+			err := tx.Model(&entity.Devolucao{}).
+				Select("COALESCE(SUM(valor_total), 0)").
+				Where("id_venda = ?", devolucao.IDVenda).
+				Scan(&valorDevolvido).Error
+			if err != nil {
+				return err
+			}
+			if err := estornarPontosVenda(tx, estornoPontos, venda.ValorTotal, valorDevolvido); err != nil {
+				return err
+			}
+		}
+		if estornoCaixa != nil {
+			return tx.Create(estornoCaixa).Error
+		}
+		return nil
+	})
+
+	if err != nil {
+		zap.L().Error("Error registering devolucao in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully registered devolucao", zap.Int("id", devolucao.ID))
+	return nil
+}
+
+// devolverLotes devolve cada produto aos lotes de onde ele saiu na venda, descontando o que já voltou em devoluções
+// anteriores. No descarte, a entrada é seguida de uma saída no mesmo lote: o saldo não muda, mas a perda fica registrada.
+func devolverLotes(tx *gorm.DB, devolucao entity.Devolucao, retornos []entity.DevolucaoEstoque) error {
+	var idsDevolucoes []int
+	err := tx.Model(&entity.Devolucao{}).Where("id_venda = ?", devolucao.IDVenda).Pluck("id", &idsDevolucoes).Error
+	if err != nil {
+		return err
+	}
+
+	observacao := fmt.Sprintf("Devolução %d da venda %d", devolucao.ID, devolucao.IDVenda)
+	for _, retorno := range retornos {
+		var saidas []entity.MovimentacaoEstoque
+		err := tx.Where("origem = ? AND id_referencia = ? AND tipo = ? AND id_produto = ?", entity.MovimentacaoOrigemVenda, devolucao.IDVenda, entity.MovimentacaoTipoSaida, retorno.IDProduto).
+			Order("id ASC").
+			Find(&saidas).Error
+		if err != nil {
+			return err
+		}
+
+		var entradas []struct {
+			IDEstoque  int
+			Quantidade int
+		}
+		err = tx.Model(&entity.MovimentacaoEstoque{}).
+			Select("id_estoque, SUM(quantidade) AS quantidade").
+			Where("origem = ? AND tipo = ? AND id_produto = ? AND id_referencia IN ?", entity.MovimentacaoOrigemDevolucao, entity.MovimentacaoTipoEntrada, retorno.IDProduto, idsDevolucoes).
+			Group("id_estoque").
+			Scan(&entradas).Error
+		if err != nil {
+			return err
+		}
+		devolvido := map[int]int{}
+		for _, entrada := range entradas {
+			devolvido[entrada.IDEstoque] = entrada.Quantidade
+		}
+
+		var movimentacoes []entity.MovimentacaoEstoque
+		restante := retorno.Quantidade
+		for _, saida := range saidas {
+			if restante == 0 {
+				break
+			}
+
+			disponivel := -saida.Quantidade
+			jaDevolvido := devolvido[saida.IDEstoque]
+			if jaDevolvido > disponivel {
+				jaDevolvido = disponivel
+			}
+			devolvido[saida.IDEstoque] -= jaDevolvido
+			disponivel -= jaDevolvido
+
+			voltar := disponivel
+			if voltar > restante {
+				voltar = restante
+			}
+			if voltar == 0 {
+				continue
+			}
+
+			if !retorno.Descartar {
+				err := tx.Model(&entity.Estoque{}).
+					Where("id_estoque = ?", saida.IDEstoque).
+					Update("quantidade", gorm.Expr("quantidade + ?", voltar)).Error
+				if err != nil {
+					return err
+				}
+			}
+
+			entrada := entity.MovimentacaoEstoque{
+				IDEstoque:     saida.IDEstoque,
+				IDProduto:     saida.IDProduto,
+				IDLote:        saida.IDLote,
+				Tipo:          entity.MovimentacaoTipoEntrada,
+				Quantidade:    voltar,
+				CustoUnitario: saida.CustoUnitario,
+				Origem:        entity.MovimentacaoOrigemDevolucao,
+				IDReferencia:  devolucao.ID,
+				DataMovimento: devolucao.DataDevolucao,
+				Observacao:    observacao,
+			}
+			movimentacoes = append(movimentacoes, entrada)
+			if retorno.Descartar {
+				descarte := entrada
+				descarte.Tipo = entity.MovimentacaoTipoSaida
+				descarte.Quantidade = -voltar
+				descarte.Observacao = observacao + " (descarte)"
+				movimentacoes = append(movimentacoes, descarte)
+			}
+			restante -= voltar
+		}
+
+		if restante > 0 {
+			return ErrDevolucaoSemBaixa
+		}
+		if len(movimentacoes) > 0 {
+			if err := tx.Create(&movimentacoes).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (repo *DBConnectionDBClient) GetDevolucoesPaginated(userID string, idVenda int, idCliente int, limit, offset int) ([]entity.Devolucao, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting paginated devolucoes from database", zap.String("userID", userID), zap.Int("id_venda", idVenda), zap.Int("id_cliente", idCliente), zap.Int("limit", limit), zap.Int("offset", offset))
+
+	var devolucoes []entity.Devolucao
+	var total int64
+
+	query := filtrarDevolucoes(db.Model(&entity.Devolucao{}), idVenda, idCliente)
+
+	// Contar total de registros
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting devolucoes", zap.Error(err))
+		return nil, 0, err
+	}
+
+	// Buscar registros paginados
+	err := query.Limit(limit).Offset(offset).Order("id DESC").Find(&devolucoes).Error
+	if err != nil {
+		zap.L().Error("Error getting paginated devolucoes from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved paginated devolucoes", zap.Int("count", len(devolucoes)), zap.Int64("total", total))
+	return devolucoes, int(total), nil
+}
+
+func filtrarDevolucoes(query *gorm.DB, idVenda int, idCliente int) *gorm.DB {
+	if idVenda > 0 {
+		query = query.Where("id_venda = ?", idVenda)
+	}
+	if idCliente > 0 {
+		query = query.Where("id_cliente = ?", idCliente)
+	}
+	return query
+}
+
+func (repo *DBConnectionDBClient) GetDevolucaoByID(id int, userID string) (*entity.Devolucao, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting devolucao by ID from database", zap.Int("id", id), zap.String("userID", userID))
+
+	var devolucao entity.Devolucao
+	err := db.Where("id = ?", id).First(&devolucao).Error
+	if err != nil {
+		zap.L().Error("Error getting devolucao by ID from database", zap.Error(err))
+		return nil, err
+	}
+	return &devolucao, nil
+}
+
+func (repo *DBConnectionDBClient) GetItensDevolucao(idDevolucao int, userID string) ([]entity.ItemDevolucao, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting itens devolucao from database", zap.Int("id_devolucao", idDevolucao), zap.String("userID", userID))
+
+	var itens []entity.ItemDevolucao
+	err := db.Where("id_devolucao = ?", idDevolucao).Order("id ASC").Find(&itens).Error
+	if err != nil {
+		zap.L().Error("Error getting itens devolucao from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved itens devolucao", zap.Int("count", len(itens)))
+	return itens, nil
+}
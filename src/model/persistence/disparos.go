@@ -0,0 +1,244 @@
+package persistence
+
+import (
+	"strconv"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE DISPAROS DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+// Quantidade de envios gravados por INSERT
+const tamanhoLoteEnvios = 500
+
+// Quantidade máxima de envios parados recuperados por varredura
+const limiteEnviosParados = 1000
+
+// GetDestinatariosCampanha lista os clientes dos públicos da campanha, sem repetição. Um cliente em mais de um
+// público vem com o de menor id.
+func (repo *DBConnectionDBClient) GetDestinatariosCampanha(userID string, idCampanha int) ([]entity.DestinatarioCampanha, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting destinatarios of campanha from database", zap.String("userID", userID), zap.Int("idCampanha", idCampanha))
+
+	var clientes []entity.DestinatarioCampanha
+	err := db.Table("clientes c").
+		Select("c.id, c.nome_cliente, c.email, c.numero_celular, c.data_nascimento, MIN(acp.id_publico) as id_publico").
+		Joins("INNER JOIN addclientes_publicos acp ON acp.id_cliente = c.id").
+		Joins("INNER JOIN campanhas_publicos cp ON cp.id_publico = acp.id_publico").
+		Where("cp.id_campanha = ?", idCampanha).
+		Group("c.id").
+		Order("c.id ASC").
+		Find(&clientes).Error
+	if err != nil {
+		zap.L().Error("Error getting destinatarios of campanha from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved destinatarios of campanha", zap.Int("count", len(clientes)))
+	return clientes, nil
+}
+
+// CriarEnviosCampanha grava os envios que ainda não existem para o cliente e o canal e retorna quantos foram criados
+// e todos os envios da campanha que aguardam a primeira tentativa, para serem colocados na fila. A campanha fica
+// travada durante a gravação para que dois disparos simultâneos não dupliquem os envios.
+func (repo *DBConnectionDBClient) CriarEnviosCampanha(userID string, idCampanha int, envios []entity.CampanhaEnvio) (int, []entity.CampanhaEnvio, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating envios of campanha", zap.String("userID", userID), zap.Int("idCampanha", idCampanha), zap.Int("envios_count", len(envios)))
+
+	criados := 0
+	var pendentes []entity.CampanhaEnvio
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var campanha entity.Campanha
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", idCampanha).First(&campanha).Error; err != nil {
+			return err
+		}
+
+		var existentes []entity.CampanhaEnvio
+		if err := tx.Select("id_cliente, canal").Where("id_campanha = ?", idCampanha).Find(&existentes).Error; err != nil {
+			return err
+		}
+		jaExistem := make(map[string]bool, len(existentes))
+		for _, envio := range existentes {
+			jaExistem[envio.Canal+":"+strconv.Itoa(envio.IDCliente)] = true
+		}
+
+		var novos []entity.CampanhaEnvio
+		for _, envio := range envios {
+			chave := envio.Canal + ":" + strconv.Itoa(envio.IDCliente)
+			if jaExistem[chave] {
+				continue
+			}
+			jaExistem[chave] = true
+			novos = append(novos, envio)
+		}
+		if len(novos) > 0 {
+			if err := tx.CreateInBatches(&novos, tamanhoLoteEnvios).Error; err != nil {
+				return err
+			}
+		}
+		criados = len(novos)
+
+		return tx.Select("id, id_campanha, id_cliente, canal").
+			Where("id_campanha = ? AND status = ? AND tentativas = 0", idCampanha, entity.EnvioStatusNaFila).
+			Order("id ASC").
+			Find(&pendentes).Error
+	})
+	if err != nil {
+		zap.L().Error("Error creating envios of campanha", zap.Error(err))
+		return 0, nil, err
+	}
+
+	zap.L().Info("Successfully created envios of campanha", zap.Int("idCampanha", idCampanha), zap.Int("criados", criados), zap.Int("pendentes", len(pendentes)))
+	return criados, pendentes, nil
+}
+
+// GetEnvioCampanha retorna o envio com o nome do cliente, o conteúdo da campanha e se o cliente consente no canal
+func (repo *DBConnectionDBClient) GetEnvioCampanha(userID string, id int) (*entity.CampanhaEnvioDetalhe, error) {
+	db := repo.getClientDB(userID)
+
+	var envio entity.CampanhaEnvioDetalhe
+	err := db.Table("campanhas_envios e").
+		Select("e.id, e.id_campanha, e.id_cliente, e.canal, e.destino, e.status, e.tentativas, e.assunto, e.mensagem, c.nome_cliente, ca.nome as nome_campanha, ca.`desc` as desc_campanha, ca.status as status_campanha, COALESCE(cc.consentido, 0) as consentido").
+		Joins("LEFT JOIN clientes c ON c.id = e.id_cliente").
+		Joins("LEFT JOIN clientes_consentimentos cc ON cc.id_cliente = e.id_cliente AND cc.canal = e.canal").
+		Joins("INNER JOIN campanhas ca ON ca.id = e.id_campanha").
+		Where("e.id = ?", id).
+		Take(&envio).Error
+	if err != nil {
+		zap.L().Error("Error getting envio of campanha from database", zap.Int("id", id), zap.Error(err))
+		return nil, err
+	}
+	return &envio, nil
+}
+
+// ReservarEnvioCampanha marca o envio como em andamento se ele ainda estiver na fila. Retorna false quando outro
+// worker já o reservou ou ele já foi concluído, para que a mesma mensagem não seja enviada duas vezes.
+func (repo *DBConnectionDBClient) ReservarEnvioCampanha(userID string, id int, data string) (bool, error) {
+	db := repo.getClientDB(userID)
+
+	result := db.Model(&entity.CampanhaEnvio{}).
+		Where("id = ? AND status = ?", id, entity.EnvioStatusNaFila).
+		Updates(map[string]interface{}{"status": entity.EnvioStatusEnviando, "data_atualizacao": data})
+	if result.Error != nil {
+		zap.L().Error("Error reserving envio of campanha", zap.Int("id", id), zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ConcluirEnvioCampanha grava o resultado da tentativa: status, tentativas, erro, data do envio e data da atualização
+func (repo *DBConnectionDBClient) ConcluirEnvioCampanha(userID string, envio entity.CampanhaEnvio) error {
+	db := repo.getClientDB(userID)
+
+	err := db.Model(&entity.CampanhaEnvio{}).
+		Where("id = ?", envio.ID).
+		Select("status", "tentativas", "erro", "data_envio", "data_atualizacao").
+		Updates(&envio).Error
+	if err != nil {
+		zap.L().Error("Error updating envio of campanha", zap.Int("id", envio.ID), zap.Error(err))
+	}
+	return err
+}
+
+// LiberarEnvioCampanha devolve à fila um envio reservado cuja tentativa não pôde ser concluída
+func (repo *DBConnectionDBClient) LiberarEnvioCampanha(userID string, id int, data string) error {
+	db := repo.getClientDB(userID)
+
+	err := db.Model(&entity.CampanhaEnvio{}).
+		Where("id = ? AND status = ?", id, entity.EnvioStatusEnviando).
+		Updates(map[string]interface{}{"status": entity.EnvioStatusNaFila, "data_atualizacao": data}).Error
+	if err != nil {
+		zap.L().Error("Error releasing envio of campanha", zap.Int("id", id), zap.Error(err))
+	}
+	return err
+}
+
+// RecuperarEnviosParados devolve à fila os envios esquecidos: os presos em 'enviando' sem atualização desde
+// limiteEnviando (a réplica caiu no meio da tentativa) e os em 'na_fila' sem atualização desde limiteNaFila (o item se
+// perdeu da fila do Redis). A data dos envios recuperados passa a ser data, para a próxima varredura não os pegar de novo.
+func (repo *DBConnectionDBClient) RecuperarEnviosParados(userID string, limiteEnviando, limiteNaFila, data string) ([]entity.CampanhaEnvio, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Recovering stale envios of campanhas", zap.String("userID", userID), zap.String("limiteEnviando", limiteEnviando), zap.String("limiteNaFila", limiteNaFila))
+
+	var envios []entity.CampanhaEnvio
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Select("id, id_campanha, id_cliente, canal, tentativas").
+			Where("(status = ? AND COALESCE(data_atualizacao, data_criacao) < ?) OR (status = ? AND COALESCE(data_atualizacao, data_criacao) < ?)",
+				entity.EnvioStatusEnviando, limiteEnviando, entity.EnvioStatusNaFila, limiteNaFila).
+			Order("id ASC").
+			Limit(limiteEnviosParados).
+			Find(&envios).Error
+		if err != nil || len(envios) == 0 {
+			return err
+		}
+
+		ids := make([]int, len(envios))
+		for i, envio := range envios {
+			ids[i] = envio.ID
+		}
+		return tx.Model(&entity.CampanhaEnvio{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{"status": entity.EnvioStatusNaFila, "data_atualizacao": data}).Error
+	})
+	if err != nil {
+		zap.L().Error("Error recovering stale envios of campanhas", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully recovered stale envios of campanhas", zap.Int("count", len(envios)))
+	return envios, nil
+}
+
+func (repo *DBConnectionDBClient) GetEnviosCampanhaPaginated(userID string, idCampanha int, canal, status string, limit, offset int) ([]entity.CampanhaEnvio, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting envios of campanha paginated from database", zap.String("userID", userID), zap.Int("idCampanha", idCampanha), zap.String("canal", canal), zap.String("status", status))
+
+	query := filtrarEnviosCampanha(db.Model(&entity.CampanhaEnvio{}), idCampanha, canal, status)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting envios of campanha", zap.Error(err))
+		return nil, 0, err
+	}
+
+	var envios []entity.CampanhaEnvio
+	err := query.
+		Select(colunasEnvioCampanha).
+		Order("id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&envios).Error
+	if err != nil {
+		zap.L().Error("Error getting envios of campanha from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved envios of campanha", zap.Int("count", len(envios)), zap.Int64("total", total))
+	return envios, int(total), nil
+}
+
+// colunasEnvioCampanha lista os campos do envio com as datas já formatadas
+const colunasEnvioCampanha = "id, id_campanha, id_cliente, canal, destino, status, tentativas, erro, cliques, " +
+	"DATE_FORMAT(data_criacao, '%Y-%m-%d %H:%i:%s') as data_criacao, DATE_FORMAT(data_envio, '%Y-%m-%d %H:%i:%s') as data_envio, " +
+	"DATE_FORMAT(data_entrega, '%Y-%m-%d %H:%i:%s') as data_entrega, DATE_FORMAT(data_abertura, '%Y-%m-%d %H:%i:%s') as data_abertura, " +
+	"DATE_FORMAT(data_clique, '%Y-%m-%d %H:%i:%s') as data_clique, DATE_FORMAT(data_descadastro, '%Y-%m-%d %H:%i:%s') as data_descadastro"
+
+func filtrarEnviosCampanha(query *gorm.DB, idCampanha int, canal, status string) *gorm.DB {
+	query = query.Where("id_campanha = ?", idCampanha)
+	if canal != "" {
+		query = query.Where("canal = ?", canal)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	return query
+}
@@ -0,0 +1,185 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE EXPORTAÇÃO ------------------------------------------------------------------------------------------------------------------------------------
+// Cada Stream* percorre a mesma consulta da listagem paginada, sem paginação, entregando um registro por vez
+
+// streamRows lê o resultado linha a linha em vez de carregar tudo em memória
+func streamRows[T any](query *gorm.DB, recurso string, fn func(T) error) error {
+	rows, err := query.Rows()
+	if err != nil {
+		zap.L().Error("Error streaming rows from database", zap.String("recurso", recurso), zap.Error(err))
+		return err
+	}
+	defer rows.Close()
+
+	total := 0
+	for rows.Next() {
+		var item T
+		if err := query.ScanRows(rows, &item); err != nil {
+			zap.L().Error("Error scanning streamed row", zap.String("recurso", recurso), zap.Error(err))
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+		total++
+	}
+	if err := rows.Err(); err != nil {
+		zap.L().Error("Error streaming rows from database", zap.String("recurso", recurso), zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully streamed rows", zap.String("recurso", recurso), zap.Int("count", total))
+	return nil
+}
+
+func (repo *DBConnectionDBClient) StreamFornecedores(userID string, fn func(entity.Fornecedores) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming fornecedores from database", zap.String("userID", userID))
+	return streamRows(db.Model(&entity.Fornecedores{}), "fornecedores", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamProducts(userID string, fn func(entity.Produto) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming products from database", zap.String("userID", userID))
+	return streamRows(db.Model(&entity.Produto{}), "produtos", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamPedidos(userID string, fn func(entity.Pedido) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming pedidos from database", zap.String("userID", userID))
+	return streamRows(db.Table("pedidos"), "pedidos", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamDetalhesEstoque(userID string, fn func(entity.ViewDetalhesEstoque) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming detalhes estoque from view", zap.String("userID", userID))
+	return streamRows(db.Table("view_detalhes_estoque"), "estoque", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamAlertasEstoque(userID string, tipo, status string, fn func(entity.AlertaEstoque) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming alertas estoque from database", zap.String("userID", userID), zap.String("tipo", tipo), zap.String("status", status))
+
+	query := db.Model(&entity.AlertaEstoque{})
+	if tipo != "" {
+		query = query.Where("tipo = ?", tipo)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	return streamRows(query.Order("id DESC"), "alertas", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamInventarios(userID string, status string, fn func(entity.Inventario) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming inventarios from database", zap.String("userID", userID), zap.String("status", status))
+
+	query := db.Model(&entity.Inventario{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	return streamRows(query.Select(selectInventarioComTotalItens).Order("id DESC"), "inventarios", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamClientes(userID string, fn func(entity.Cliente) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming clientes from database", zap.String("userID", userID))
+	return streamRows(db.Model(&entity.Cliente{}), "clientes", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamEnderecos(userID string, fn func(entity.Endereco) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming enderecos from database", zap.String("userID", userID))
+	return streamRows(db.Model(&entity.Endereco{}), "enderecos", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamPets(userID string, fn func(entity.Pet) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming pets from database", zap.String("userID", userID))
+	return streamRows(db.Model(&entity.Pet{}), "pets", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamTags(userID string, fn func(entity.Tag) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming tags from database", zap.String("userID", userID))
+	return streamRows(db.Model(&entity.Tag{}).Order("id_tag DESC"), "tags", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamPublicos(userID string, fn func(entity.PublicoCliente) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming publicos from database", zap.String("userID", userID))
+	return streamRows(db.Model(&entity.PublicoCliente{}), "publicos", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamCampanhas(userID string, fn func(entity.Campanha) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming campanhas from database", zap.String("userID", userID))
+	return streamRows(db.Model(&entity.Campanha{}).Order("id DESC"), "campanhas", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamVendas(userID string, status string, idCliente int, fn func(entity.Venda) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming vendas from database", zap.String("userID", userID), zap.String("status", status), zap.Int("id_cliente", idCliente))
+	return streamRows(filtrarVendas(db.Model(&entity.Venda{}), status, idCliente).Order("id_venda DESC"), "vendas", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamHistoricoPrecos(userID string, idProduto int, fn func(entity.HistoricoPreco) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming historico precos from database", zap.String("userID", userID), zap.Int("id_produto", idProduto))
+	query := db.Model(&entity.HistoricoPreco{}).Where("id_produto = ?", idProduto).Order("data_alteracao DESC, id DESC")
+	return streamRows(query, "historico_precos", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamImportacoes(userID string, recurso string, fn func(entity.Importacao) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming importacoes from database", zap.String("userID", userID), zap.String("recurso", recurso))
+	return streamRows(filtrarImportacoes(db.Model(&entity.Importacao{}), recurso).Order("id DESC"), "importacoes", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamImportacaoErros(userID string, idImportacao int, fn func(entity.ImportacaoErro) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming importacao erros from database", zap.String("userID", userID), zap.Int("id_importacao", idImportacao))
+	query := db.Model(&entity.ImportacaoErro{}).Where("id_importacao = ?", idImportacao).Order("linha ASC, id ASC")
+	return streamRows(query, "importacao_erros", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamCaixaSessoes(userID string, status string, fn func(entity.CaixaSessao) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming caixa sessoes from database", zap.String("userID", userID), zap.String("status", status))
+	return streamRows(filtrarCaixaSessoes(db.Model(&entity.CaixaSessao{}), status).Order("id DESC"), "caixas", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamDevolucoes(userID string, idVenda int, idCliente int, fn func(entity.Devolucao) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming devolucoes from database", zap.String("userID", userID), zap.Int("id_venda", idVenda), zap.Int("id_cliente", idCliente))
+	return streamRows(filtrarDevolucoes(db.Model(&entity.Devolucao{}), idVenda, idCliente).Order("id DESC"), "devolucoes", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamEnviosCampanha(userID string, idCampanha int, canal, status string, fn func(entity.CampanhaEnvio) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming envios of campanha from database", zap.String("userID", userID), zap.Int("idCampanha", idCampanha), zap.String("canal", canal), zap.String("status", status))
+	query := filtrarEnviosCampanha(db.Model(&entity.CampanhaEnvio{}), idCampanha, canal, status).Select(colunasEnvioCampanha).Order("id ASC")
+	return streamRows(query, "envios", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamTemplatesMensagens(userID string, canal string, fn func(entity.TemplateMensagem) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming templates from database", zap.String("userID", userID), zap.String("canal", canal))
+	query := filtrarTemplatesMensagens(db.Model(&entity.TemplateMensagem{}), canal).Select(colunasTemplateMensagem).Order("id ASC")
+	return streamRows(query, "templates", fn)
+}
+
+func (repo *DBConnectionDBClient) StreamHistoricoConsentimentos(userID string, idCliente int, canal string, fn func(entity.ConsentimentoHistorico) error) error {
+	db := repo.getClientDB(userID)
+	zap.L().Info("Streaming historico of consentimentos from database", zap.String("userID", userID), zap.Int("idCliente", idCliente), zap.String("canal", canal))
+	query := filtrarHistoricoConsentimentos(db.Model(&entity.ConsentimentoHistorico{}), idCliente, canal).Select(colunasConsentimentoHistorico).Order("id DESC")
+	return streamRows(query, "consentimentos_historico", fn)
+}
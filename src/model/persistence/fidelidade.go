@@ -0,0 +1,306 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE FIDELIDADE ------------------------------------------------------------------------------------------------------------------------------------
+
+// ErrPontosInsuficientes indica que o saldo de pontos do cliente não cobre o resgate
+var ErrPontosInsuficientes = errors.New("saldo de pontos do cliente insuficiente")
+
+func (repo *DBConnectionDBClient) GetFidelidadeConfig(userID string) (*entity.FidelidadeConfig, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting fidelidade config from database", zap.String("userID", userID))
+
+	var config entity.FidelidadeConfig
+	err := db.Where("id = ?", entity.FidelidadeConfigID).First(&config).Error
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// SalvarFidelidadeConfig grava a configuração do tenant, criando a linha na primeira vez
+func (repo *DBConnectionDBClient) SalvarFidelidadeConfig(config *entity.FidelidadeConfig, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Saving fidelidade config in the database", zap.Bool("ativo", config.Ativo), zap.String("userID", userID))
+
+	config.ID = entity.FidelidadeConfigID
+	if err := db.Save(config).Error; err != nil {
+		zap.L().Error("Error saving fidelidade config in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully saved fidelidade config")
+	return nil
+}
+
+func (repo *DBConnectionDBClient) GetPontosCliente(idCliente int, userID string) ([]entity.PontosCliente, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting pontos cliente from database", zap.Int("id_cliente", idCliente), zap.String("userID", userID))
+
+	var pontos []entity.PontosCliente
+	err := db.Where("id_cliente = ?", idCliente).Order("id ASC").Find(&pontos).Error
+	if err != nil {
+		zap.L().Error("Error getting pontos cliente from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved pontos cliente", zap.Int("count", len(pontos)))
+	return pontos, nil
+}
+
+// ResgatarPontos debita os pontos e lança o crédito na loja correspondente numa única transação.
+// Retorna ErrPontosInsuficientes se o saldo válido não cobrir o resgate.
+func (repo *DBConnectionDBClient) ResgatarPontos(debito *entity.PontosCliente, credito *entity.CreditoCliente, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Redeeming pontos in the database", zap.Int("id_cliente", debito.IDCliente), zap.Int("pontos", debito.Pontos), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := debitarPontos(tx, debito, false); err != nil {
+			return err
+		}
+		credito.IDReferencia = debito.ID
+		return tx.Create(credito).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error redeeming pontos in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully redeemed pontos", zap.Int("id", debito.ID))
+	return nil
+}
+
+// ExpirarPontos zera os créditos vencidos até a data, lançando um débito de expiração para cada um.
+// Cada cliente é processado na sua própria transação, travando o cliente antes dos créditos como nos resgates.
+// Retorna a quantidade de lançamentos de expiração e o total de pontos expirados.
+func (repo *DBConnectionDBClient) ExpirarPontos(data string, userID string) (int, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Expiring pontos in the database", zap.String("data", data), zap.String("userID", userID))
+
+	var idsClientes []int
+	err := db.Model(&entity.PontosCliente{}).
+		Where("tipo = ? AND pontos_disponiveis > 0 AND data_expiracao <= ?", entity.PontosTipoCredito, data).
+		Distinct().
+		Order("id_cliente ASC").
+		Pluck("id_cliente", &idsClientes).Error
+	if err != nil {
+		zap.L().Error("Error getting clientes with expired pontos from database", zap.Error(err))
+		return 0, 0, err
+	}
+
+	var lancamentos, pontos int
+	for _, idCliente := range idsClientes {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			var cliente entity.Cliente
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", idCliente).First(&cliente).Error; err != nil {
+				return err
+			}
+
+			var vencidos []entity.PontosCliente
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("id_cliente = ? AND tipo = ? AND pontos_disponiveis > 0 AND data_expiracao <= ?", idCliente, entity.PontosTipoCredito, data).
+				Order("id ASC").
+				Find(&vencidos).Error
+			if err != nil {
+				return err
+			}
+
+			for _, credito := range vencidos {
+				if err := tx.Model(&entity.PontosCliente{}).Where("id = ?", credito.ID).Update("pontos_disponiveis", 0).Error; err != nil {
+					return err
+				}
+				expiracao := entity.PontosCliente{
+					IDCliente:      idCliente,
+					Tipo:           entity.PontosTipoDebito,
+					Pontos:         credito.PontosDisponiveis,
+					Origem:         entity.PontosOrigemExpiracao,
+					IDReferencia:   credito.ID,
+					DataLancamento: data,
+					Descricao:      fmt.Sprintf("Expiração dos pontos do lançamento %d", credito.ID),
+				}
+				if err := tx.Create(&expiracao).Error; err != nil {
+					return err
+				}
+				lancamentos++
+				pontos += credito.PontosDisponiveis
+			}
+			return nil
+		})
+		if err != nil {
+			zap.L().Error("Error expiring pontos in database", zap.Int("id_cliente", idCliente), zap.Error(err))
+			return lancamentos, pontos, err
+		}
+	}
+
+	zap.L().Info("Successfully expired pontos", zap.Int("lancamentos", lancamentos), zap.Int("pontos", pontos))
+	return lancamentos, pontos, nil
+}
+
+// ReservarAvisoPontos marca como avisados os créditos válidos que vencem até a data limite e ainda não foram avisados,
+// e retorna o resumo desses créditos por cliente, com os IDs reservados. A marcação vem antes do envio para que duas
+// execuções não avisem o mesmo crédito; se o envio falhar, LiberarAvisoPontos devolve os créditos à fila.
+func (repo *DBConnectionDBClient) ReservarAvisoPontos(data string, limite string, userID string) ([]entity.PontosAExpirar, []int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Reserving aviso of pontos a expirar in the database", zap.String("data", data), zap.String("limite", limite), zap.String("userID", userID))
+
+	var pontos []entity.PontosAExpirar
+	var ids []int
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entity.PontosCliente{}).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("tipo = ? AND pontos_disponiveis > 0 AND aviso_enviado = ?", entity.PontosTipoCredito, false).
+			Where("data_expiracao > ? AND data_expiracao <= ?", data, limite).
+			Order("id ASC").
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		if err := tx.Model(&entity.PontosCliente{}).Where("id IN ?", ids).Update("aviso_enviado", true).Error; err != nil {
+			return err
+		}
+
+		return tx.Table("pontos_clientes p").
+			Select(`p.id_cliente, c.nome_cliente, c.email, c.numero_celular, SUM(p.pontos_disponiveis) as pontos,
+				DATE_FORMAT(MIN(p.data_expiracao), '%Y-%m-%d %H:%i:%s') as proxima_expiracao`).
+			Joins("INNER JOIN clientes c ON c.id = p.id_cliente").
+			Where("p.id IN ?", ids).
+			Group("p.id_cliente, c.nome_cliente, c.email, c.numero_celular").
+			Order("p.id_cliente ASC").
+			Find(&pontos).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error reserving aviso of pontos a expirar in database", zap.Error(err))
+		return nil, nil, err
+	}
+
+	zap.L().Info("Successfully reserved aviso of pontos a expirar", zap.Int("creditos", len(ids)), zap.Int("clientes", len(pontos)))
+	return pontos, ids, nil
+}
+
+// LiberarAvisoPontos desfaz a reserva dos créditos cujo aviso não foi enviado, para a próxima execução tentar de novo
+func (repo *DBConnectionDBClient) LiberarAvisoPontos(ids []int, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Releasing aviso of pontos in the database", zap.Int("creditos", len(ids)), zap.String("userID", userID))
+
+	err := db.Model(&entity.PontosCliente{}).Where("id IN ?", ids).Update("aviso_enviado", false).Error
+	if err != nil {
+		zap.L().Error("Error releasing aviso of pontos in database", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// debitarPontos trava o cliente e consome os créditos válidos, começando pelos que vencem primeiro.
+// Com parcial, debita só o que houver de saldo (estornos); sem parcial, retorna ErrPontosInsuficientes.
+func debitarPontos(tx *gorm.DB, debito *entity.PontosCliente, parcial bool) error {
+	var cliente entity.Cliente
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", debito.IDCliente).First(&cliente).Error
+	if err != nil {
+		return err
+	}
+
+	var creditos []entity.PontosCliente
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id_cliente = ? AND tipo = ? AND pontos_disponiveis > 0", debito.IDCliente, entity.PontosTipoCredito).
+		Where("(data_expiracao IS NULL OR data_expiracao > ?)", debito.DataLancamento).
+		Order("data_expiracao IS NULL, data_expiracao ASC, id ASC").
+		Find(&creditos).Error
+	if err != nil {
+		return err
+	}
+
+	saldo := 0
+	for _, credito := range creditos {
+		saldo += credito.PontosDisponiveis
+	}
+	if saldo < debito.Pontos {
+		if !parcial {
+			return ErrPontosInsuficientes
+		}
+		debito.Pontos = saldo
+	}
+	if debito.Pontos == 0 {
+		return nil
+	}
+
+	restante := debito.Pontos
+	for _, credito := range creditos {
+		if restante == 0 {
+			break
+		}
+		consumir := credito.PontosDisponiveis
+		if consumir > restante {
+			consumir = restante
+		}
+		err := tx.Model(&entity.PontosCliente{}).
+			Where("id = ?", credito.ID).
+			Update("pontos_disponiveis", gorm.Expr("pontos_disponiveis - ?", consumir)).Error
+		if err != nil {
+			return err
+		}
+		restante -= consumir
+	}
+
+	return tx.Create(debito).Error
+}
+
+// estornarPontosVenda retira os pontos ganhos na venda na proporção do valor devolvido. O cálculo é acumulado:
+// o alvo é a fração dos pontos creditados que corresponde a tudo o que já foi devolvido, menos o que já foi estornado.
+// Sem saldo suficiente, estorna o que houver.
+func estornarPontosVenda(tx *gorm.DB, estorno *entity.PontosCliente, valorVenda float64, valorDevolvido float64) error {
+	var creditados int
+	err := tx.Model(&entity.PontosCliente{}).
+		Select("COALESCE(SUM(pontos), 0)").
+		Where("tipo = ? AND origem = ? AND id_referencia = ?", entity.PontosTipoCredito, entity.PontosOrigemVenda, estorno.IDReferencia).
+		Scan(&creditados).Error
+	if err != nil {
+		return err
+	}
+	if creditados == 0 {
+		return nil
+	}
+
+	var estornados int
+	err = tx.Model(&entity.PontosCliente{}).
+		Select("COALESCE(SUM(pontos), 0)").
+		Where("tipo = ? AND origem IN ? AND id_referencia = ?", entity.PontosTipoDebito, []string{entity.PontosOrigemCancelamento, entity.PontosOrigemDevolucao}, estorno.IDReferencia).
+		Scan(&estornados).Error
+	if err != nil {
+		return err
+	}
+
+	// Proporção em centavos para que a devolução total estorne exatamente o que foi creditado
+	alvo := creditados
+	centavosVenda := int64(math.Round(valorVenda * 100))
+	centavosDevolvidos := int64(math.Round(valorDevolvido * 100))
+	if centavosDevolvidos < centavosVenda {
+		alvo = int(int64(creditados) * centavosDevolvidos / centavosVenda)
+	}
+
+	estorno.Pontos = alvo - estornados
+	if estorno.Pontos <= 0 {
+		return nil
+	}
+	return debitarPontos(tx, estorno, true)
+}
@@ -0,0 +1,156 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE IMPORTAÇÃO ------------------------------------------------------------------------------------------------------------------------------------
+
+func (repo *DBConnectionDBClient) CreateImportacao(importacao *entity.Importacao, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating importacao in database", zap.String("recurso", importacao.Recurso), zap.String("userID", userID))
+
+	if err := db.Create(importacao).Error; err != nil {
+		zap.L().Error("Error creating importacao in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Importacao created successfully", zap.Int("id", importacao.ID))
+	return nil
+}
+
+// ConcluirImportacao grava os contadores e o status final do job junto com os erros por linha
+func (repo *DBConnectionDBClient) ConcluirImportacao(importacao *entity.Importacao, erros []entity.ImportacaoErro, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Concluding importacao in database", zap.Int("id", importacao.ID), zap.Int("erros", len(erros)), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if len(erros) > 0 {
+			if err := tx.CreateInBatches(erros, 500).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&entity.Importacao{}).Where("id = ?", importacao.ID).Updates(map[string]interface{}{
+			"status":         importacao.Status,
+			"inseridas":      importacao.Inseridas,
+			"atualizadas":    importacao.Atualizadas,
+			"ignoradas":      importacao.Ignoradas,
+			"com_erro":       importacao.ComErro,
+			"mensagem_erro":  importacao.MensagemErro,
+			"data_conclusao": importacao.DataConclusao,
+		}).Error
+	})
+	if err != nil {
+		zap.L().Error("Error concluding importacao in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Importacao concluded successfully", zap.Int("id", importacao.ID), zap.String("status", importacao.Status))
+	return nil
+}
+
+func (repo *DBConnectionDBClient) GetImportacoesPaginated(userID string, recurso string, limit, offset int) ([]entity.Importacao, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting paginated importacoes from database", zap.String("userID", userID), zap.String("recurso", recurso), zap.Int("limit", limit), zap.Int("offset", offset))
+
+	var importacoes []entity.Importacao
+	var total int64
+
+	query := filtrarImportacoes(db.Model(&entity.Importacao{}), recurso)
+
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting importacoes", zap.Error(err))
+		return nil, 0, err
+	}
+
+	err := query.Limit(limit).Offset(offset).Order("id DESC").Find(&importacoes).Error
+	if err != nil {
+		zap.L().Error("Error getting paginated importacoes from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved paginated importacoes", zap.Int("count", len(importacoes)), zap.Int64("total", total))
+	return importacoes, int(total), nil
+}
+
+func filtrarImportacoes(query *gorm.DB, recurso string) *gorm.DB {
+	if recurso != "" {
+		query = query.Where("recurso = ?", recurso)
+	}
+	return query
+}
+
+func (repo *DBConnectionDBClient) GetImportacaoByID(id int, userID string) (*entity.Importacao, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting importacao by ID from database", zap.Int("id", id), zap.String("userID", userID))
+
+	var importacao entity.Importacao
+	if err := db.Where("id = ?", id).First(&importacao).Error; err != nil {
+		zap.L().Error("Error getting importacao by ID from database", zap.Error(err))
+		return nil, err
+	}
+
+	return &importacao, nil
+}
+
+func (repo *DBConnectionDBClient) GetImportacaoErrosPaginated(idImportacao int, userID string, limit, offset int) ([]entity.ImportacaoErro, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting paginated importacao erros from database", zap.Int("id_importacao", idImportacao), zap.Int("limit", limit), zap.Int("offset", offset))
+
+	var erros []entity.ImportacaoErro
+	var total int64
+
+	query := db.Model(&entity.ImportacaoErro{}).Where("id_importacao = ?", idImportacao)
+
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting importacao erros", zap.Error(err))
+		return nil, 0, err
+	}
+
+	err := query.Limit(limit).Offset(offset).Order("linha ASC, id ASC").Find(&erros).Error
+	if err != nil {
+		zap.L().Error("Error getting paginated importacao erros from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved paginated importacao erros", zap.Int("count", len(erros)), zap.Int64("total", total))
+	return erros, int(total), nil
+}
+
+// UpdateCliente altera apenas os campos informados do cliente
+func (repo *DBConnectionDBClient) UpdateCliente(id int, campos map[string]interface{}, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Updating cliente in database", zap.Int("id", id), zap.String("userID", userID))
+
+	if err := db.Model(&entity.Cliente{}).Where("id = ?", id).Updates(campos).Error; err != nil {
+		zap.L().Error("Error updating cliente in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Cliente updated successfully", zap.Int("id", id))
+	return nil
+}
+
+func (repo *DBConnectionDBClient) GetFornecedorByNome(nome string, userID string) (*entity.Fornecedores, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting fornecedor by nome from database", zap.String("nome", nome), zap.String("userID", userID))
+
+	var fornecedor entity.Fornecedores
+	if err := db.Where("nome = ?", nome).Order("id_fornecedor ASC").First(&fornecedor).Error; err != nil {
+		zap.L().Error("Fornecedor not found by nome", zap.Error(err))
+		return nil, err
+	}
+
+	return &fornecedor, nil
+}
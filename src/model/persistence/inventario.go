@@ -0,0 +1,280 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE INVENTÁRIO ------------------------------------------------------------------------------------------------------------------------------------
+
+const selectInventarioComTotalItens = "inventarios.*, (SELECT COUNT(*) FROM inventario_itens i WHERE i.id_inventario = inventarios.id) as total_itens"
+
+// CreateInventario abre a sessão e registra o saldo atual de cada lote dos produtos informados (todos se vazio)
+func (repo *DBConnectionDBClient) CreateInventario(inventario *entity.Inventario, produtos []int, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating inventario in the database", zap.String("descricao", inventario.Descricao), zap.Ints("produtos", produtos), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(inventario).Error; err != nil {
+			return err
+		}
+
+		query := tx.Model(&entity.Estoque{})
+		if len(produtos) > 0 {
+			query = query.Where("id_produto IN ?", produtos)
+		}
+
+		var lotes []entity.Estoque
+		if err := query.Find(&lotes).Error; err != nil {
+			return err
+		}
+
+		if len(lotes) == 0 {
+			return nil
+		}
+
+		itens := make([]entity.InventarioItem, 0, len(lotes))
+		for _, lote := range lotes {
+			itens = append(itens, entity.InventarioItem{
+				IDInventario:      inventario.ID,
+				IDEstoque:         lote.IDEstoque,
+				IDProduto:         lote.IDProduto,
+				IDLote:            lote.IDLote,
+				QuantidadeSistema: lote.Quantidade,
+				CustoUnitario:     lote.CustoUnitario,
+			})
+		}
+
+		if err := tx.CreateInBatches(itens, 500).Error; err != nil {
+			return err
+		}
+		inventario.TotalItens = len(itens)
+		return nil
+	})
+
+	if err != nil {
+		zap.L().Error("Error creating inventario in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully created inventario", zap.Int("id", inventario.ID), zap.Int("itens", inventario.TotalItens))
+	return nil
+}
+
+func (repo *DBConnectionDBClient) GetInventarioByID(id string, userID string) (*entity.Inventario, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting inventario by ID from database", zap.String("id", id), zap.String("userID", userID))
+
+	var inventario entity.Inventario
+	err := db.Model(&entity.Inventario{}).Select(selectInventarioComTotalItens).Where("id = ?", id).First(&inventario).Error
+	if err != nil {
+		zap.L().Error("Error getting inventario by ID from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved inventario by ID", zap.String("id", id))
+	return &inventario, nil
+}
+
+func (repo *DBConnectionDBClient) GetInventariosPaginated(userID string, status string, limit, offset int) ([]entity.Inventario, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting paginated inventarios from database", zap.String("userID", userID), zap.String("status", status), zap.Int("limit", limit), zap.Int("offset", offset))
+
+	var inventarios []entity.Inventario
+	var total int64
+
+	query := db.Model(&entity.Inventario{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	// Contar total de registros
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting inventarios", zap.Error(err))
+		return nil, 0, err
+	}
+
+	// Buscar registros paginados
+	err := query.Select(selectInventarioComTotalItens).Limit(limit).Offset(offset).Order("id DESC").Find(&inventarios).Error
+	if err != nil {
+		zap.L().Error("Error getting paginated inventarios from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved paginated inventarios", zap.Int("count", len(inventarios)), zap.Int64("total", total))
+	return inventarios, int(total), nil
+}
+
+func (repo *DBConnectionDBClient) GetInventarioItens(idInventario int, userID string) ([]entity.InventarioItem, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting inventario itens from database", zap.Int("id_inventario", idInventario), zap.String("userID", userID))
+
+	var itens []entity.InventarioItem
+	err := db.Where("id_inventario = ?", idInventario).Find(&itens).Error
+	if err != nil {
+		zap.L().Error("Error getting inventario itens from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved inventario itens", zap.Int("count", len(itens)))
+	return itens, nil
+}
+
+// RegistrarContagensInventario grava as contagens de um dispositivo; uma nova contagem do mesmo item e dispositivo substitui a anterior
+func (repo *DBConnectionDBClient) RegistrarContagensInventario(contagens []entity.InventarioContagem, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Registering contagens inventario in the database", zap.Int("contagens_count", len(contagens)), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, contagem := range contagens {
+			err := tx.Where("id_item = ? AND dispositivo = ?", contagem.IDItem, contagem.Dispositivo).
+				Assign(entity.InventarioContagem{Quantidade: contagem.Quantidade, DataContagem: contagem.DataContagem}).
+				FirstOrCreate(&contagem).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		zap.L().Error("Error registering contagens inventario in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully registered contagens inventario", zap.Int("contagens_count", len(contagens)))
+	return nil
+}
+
+// GetInventarioDivergencias retorna cada item com o saldo da abertura e a contagem mais recente (nil se não contado)
+func (repo *DBConnectionDBClient) GetInventarioDivergencias(idInventario int, userID string) ([]entity.InventarioDivergencia, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting inventario divergencias from database", zap.Int("id_inventario", idInventario), zap.String("userID", userID))
+
+	// Vale a contagem mais recente do lote: um segundo dispositivo que reconta o lote substitui a contagem anterior,
+	// em vez de somar a ela
+	var divergencias []entity.InventarioDivergencia
+	err := db.Table("inventario_itens i").
+		Select("i.id as id_item, i.id_estoque, i.id_produto, p.nome_produto, i.id_lote, i.quantidade_sistema, c.quantidade as quantidade_contada, "+
+			"(SELECT COUNT(*) FROM inventario_contagens ct WHERE ct.id_item = i.id) as contagens, i.custo_unitario").
+		Joins("INNER JOIN produtos p ON p.id_produto = i.id_produto").
+		Joins("LEFT JOIN inventario_contagens c ON c.id = (SELECT cu.id FROM inventario_contagens cu WHERE cu.id_item = i.id ORDER BY cu.data_contagem DESC, cu.id DESC LIMIT 1)").
+		Where("i.id_inventario = ?", idInventario).
+		Order("p.nome_produto ASC, i.id_lote ASC").
+		Find(&divergencias).Error
+
+	if err != nil {
+		zap.L().Error("Error getting inventario divergencias from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved inventario divergencias", zap.Int("count", len(divergencias)))
+	return divergencias, nil
+}
+
+// FecharInventario leva cada lote contado à quantidade contada, grava as movimentações e fecha a sessão numa única
+// transação. Os lotes são travados (SELECT ... FOR UPDATE) e a diferença é calculada pelo `ajustar` sobre o saldo atual,
+// e não sobre o da abertura: vendas, devoluções e entradas feitas durante a contagem não são aplicadas de novo.
+// Retorna os ajustes gravados, ou gorm.ErrRecordNotFound se a sessão não estiver mais aberta.
+func (repo *DBConnectionDBClient) FecharInventario(idInventario int, contagens []entity.InventarioDivergencia, ajustar func(item entity.InventarioDivergencia, saldoAtual int) (entity.MovimentacaoEstoque, bool), dataFechamento string, userID string) ([]entity.MovimentacaoEstoque, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Closing inventario in the database", zap.Int("id_inventario", idInventario), zap.Int("contagens", len(contagens)), zap.String("userID", userID))
+
+	var ajustes []entity.MovimentacaoEstoque
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Garante que apenas uma requisição consiga fechar a sessão
+		result := tx.Model(&entity.Inventario{}).
+			Where("id = ? AND status = ?", idInventario, entity.InventarioStatusAberto).
+			Updates(map[string]interface{}{"status": entity.InventarioStatusFechado, "data_fechamento": dataFechamento})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if len(contagens) == 0 {
+			return nil
+		}
+
+		idsEstoque := make([]int, len(contagens))
+		for i, item := range contagens {
+			idsEstoque[i] = item.IDEstoque
+		}
+
+		// Trava os lotes na ordem do id para não entrar em deadlock com as baixas de venda
+		var lotes []entity.Estoque
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id_estoque, quantidade").
+			Where("id_estoque IN ?", idsEstoque).
+			Order("id_estoque ASC").
+			Find(&lotes).Error
+		if err != nil {
+			return err
+		}
+		saldos := make(map[int]int, len(lotes))
+		for _, lote := range lotes {
+			saldos[lote.IDEstoque] = lote.Quantidade
+		}
+
+		for _, item := range contagens {
+			saldoAtual, existe := saldos[item.IDEstoque]
+			if !existe {
+				continue
+			}
+			ajuste, ajustado := ajustar(item, saldoAtual)
+			if !ajustado {
+				continue
+			}
+
+			err := tx.Model(&entity.Estoque{}).
+				Where("id_estoque = ?", item.IDEstoque).
+				Update("quantidade", saldoAtual+ajuste.Quantidade).Error
+			if err != nil {
+				return err
+			}
+			ajustes = append(ajustes, ajuste)
+		}
+
+		if len(ajustes) > 0 {
+			if err := tx.Create(&ajustes).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		zap.L().Error("Error closing inventario in database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully closed inventario", zap.Int("id_inventario", idInventario), zap.Int("ajustes", len(ajustes)))
+	return ajustes, nil
+}
+
+func (repo *DBConnectionDBClient) CancelarInventario(idInventario int, dataFechamento string, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Canceling inventario in the database", zap.Int("id_inventario", idInventario), zap.String("userID", userID))
+	result := db.Model(&entity.Inventario{}).
+		Where("id = ? AND status = ?", idInventario, entity.InventarioStatusAberto).
+		Updates(map[string]interface{}{"status": entity.InventarioStatusCancelado, "data_fechamento": dataFechamento})
+	if result.Error != nil {
+		zap.L().Error("Error canceling inventario in database", zap.Error(result.Error))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
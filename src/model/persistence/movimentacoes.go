@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"fmt"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE MOVIMENTAÇÕES DE ESTOQUE ------------------------------------------------------------------------------------------------------------------------------------
+
+// EstoqueInsuficienteError indica que o saldo dos lotes do produto não cobre a saída solicitada
+type EstoqueInsuficienteError struct {
+	IDProduto  int
+	Solicitado int
+	Disponivel int
+}
+
+func (e *EstoqueInsuficienteError) Error() string {
+	return fmt.Sprintf("estoque insuficiente para o produto %d: solicitado %d, disponível %d", e.IDProduto, e.Solicitado, e.Disponivel)
+}
+
+// RegistrarSaidaEstoque baixa as quantidades dos lotes e grava as movimentações de saída numa única transação.
+// Os lotes que vencem primeiro são consumidos primeiro (lotes sem vencimento por último, por data de entrada).
+// Se algum produto não tiver saldo suficiente nada é baixado e um *EstoqueInsuficienteError é retornado.
+func (repo *DBConnectionDBClient) RegistrarSaidaEstoque(saidas []entity.SaidaEstoque, origem string, idReferencia int, observacao string, dataMovimento string, userID string) ([]entity.MovimentacaoEstoque, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Registering saida estoque in the database", zap.Int("saidas", len(saidas)), zap.String("origem", origem), zap.Int("id_referencia", idReferencia), zap.String("userID", userID))
+
+	var movimentacoes []entity.MovimentacaoEstoque
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		movimentacoes, err = baixarLotes(tx, saidas, origem, idReferencia, observacao, dataMovimento)
+		return err
+	})
+
+	if err != nil {
+		zap.L().Error("Error registering saida estoque in database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully registered saida estoque", zap.Int("movimentacoes", len(movimentacoes)))
+	return movimentacoes, nil
+}
+
+// baixarLotes retira as quantidades dos lotes dentro da transação e grava as movimentações de saída
+func baixarLotes(tx *gorm.DB, saidas []entity.SaidaEstoque, origem string, idReferencia int, observacao string, dataMovimento string) ([]entity.MovimentacaoEstoque, error) {
+	var movimentacoes []entity.MovimentacaoEstoque
+	for _, saida := range saidas {
+		var lotes []entity.Estoque
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id_produto = ? AND quantidade > 0", saida.IDProduto).
+			Order("vencimento IS NULL, vencimento ASC, data_entrada ASC, id_estoque ASC").
+			Find(&lotes).Error
+		if err != nil {
+			return nil, err
+		}
+
+		restante := saida.Quantidade
+		for _, lote := range lotes {
+			if restante == 0 {
+				break
+			}
+			retirar := lote.Quantidade
+			if retirar > restante {
+				retirar = restante
+			}
+
+			err := tx.Model(&entity.Estoque{}).
+				Where("id_estoque = ?", lote.IDEstoque).
+				Update("quantidade", gorm.Expr("quantidade - ?", retirar)).Error
+			if err != nil {
+				return nil, err
+			}
+
+			movimentacoes = append(movimentacoes, entity.MovimentacaoEstoque{
+				IDEstoque:     lote.IDEstoque,
+				IDProduto:     lote.IDProduto,
+				IDLote:        lote.IDLote,
+				Tipo:          entity.MovimentacaoTipoSaida,
+				Quantidade:    -retirar,
+				CustoUnitario: lote.CustoUnitario,
+				Origem:        origem,
+				IDReferencia:  idReferencia,
+				DataMovimento: dataMovimento,
+				Observacao:    observacao,
+			})
+			restante -= retirar
+		}
+
+		if restante > 0 {
+			return nil, &EstoqueInsuficienteError{
+				IDProduto:  saida.IDProduto,
+				Solicitado: saida.Quantidade,
+				Disponivel: saida.Quantidade - restante,
+			}
+		}
+	}
+
+	if len(movimentacoes) > 0 {
+		if err := tx.Create(&movimentacoes).Error; err != nil {
+			return nil, err
+		}
+	}
+	return movimentacoes, nil
+}
@@ -1,11 +1,15 @@
 package persistence
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	entity "github.com/betine97/back-project.git/src/model/entitys"
 
+	"github.com/go-sql-driver/mysql"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
@@ -15,6 +19,7 @@ type PersistenceInterfaceDBMaster interface {
 	VerifyExist(email string) (bool, error)
 	GetUser(email string) *entity.User
 	GetTenantByUserID(userID uint) *entity.Tenants
+	GetUserByID(id uint) *entity.User
 }
 
 type PersistenceInterfaceDBClient interface {
@@ -24,6 +29,7 @@ type PersistenceInterfaceDBClient interface {
 	GetFornecedorById(id string, userID string) (*entity.Fornecedores, error)
 	UpdateFornecedor(fornecedor entity.Fornecedores, userID string) error
 	UpdateFornecedorField(id string, campo string, valor string, userID string) error
+	GetFornecedorByNome(nome string, userID string) (*entity.Fornecedores, error)
 	DeleteFornecedor(id string, userID string) error
 
 	GetAllProducts(userID string) ([]entity.Produto, error)
@@ -31,6 +37,73 @@ type PersistenceInterfaceDBClient interface {
 	GetProductByBarcode(barcode string, userID string) *entity.Produto
 	CreateProduct(product entity.Produto, userID string) error
 	DeleteProduct(id string, userID string) error
+	UpdateEstoqueMinimo(id string, estoqueMinimo int, userID string) error
+	GetProductByID(id string, userID string) (*entity.Produto, error)
+	GetProductBySKU(sku string, userID string) *entity.Produto
+	UpdateProduct(id string, campos map[string]interface{}, historico *entity.HistoricoPreco, userID string) error
+
+	// Variações e Kits
+	GetVariacoes(idPai int, userID string) ([]entity.ProdutoSaldo, error)
+	GetSaldoProdutos(idsProdutos []int, userID string) (map[int]int, error)
+	GetProdutosByIDs(idsProdutos []int, userID string) ([]entity.Produto, error)
+	CreateVariacao(idPai int, variacao *entity.Produto, userID string) error
+	VincularVariacao(idPai int, idVariacao int, compartilhados map[string]interface{}, userID string) error
+	GetKitComponentes(idKit int, userID string) ([]entity.KitComponenteDetalhe, error)
+	GetComponentesDosKits(idsKits []int, userID string) ([]entity.KitComponente, error)
+	SetKitComponentes(idKit int, componentes []entity.KitComponente, userID string) error
+	ProdutoTemVariacoes(idProduto int, userID string) (bool, error)
+	ProdutoEmKit(idProduto int, userID string) (bool, error)
+	RegistrarSaidaEstoque(saidas []entity.SaidaEstoque, origem string, idReferencia int, observacao string, dataMovimento string, userID string) ([]entity.MovimentacaoEstoque, error)
+
+	// Categorias
+	GetAllCategorias(userID string) ([]entity.Categoria, error)
+	GetCategoriaByID(id int, userID string) (*entity.Categoria, error)
+	CreateCategoria(categoria *entity.Categoria, userID string) error
+	RenomearCategoria(id int, nome string, nomeNormalizado string, userID string) error
+	MoverCategoria(id int, idPai *int, userID string) error
+	MesclarCategorias(idOrigem int, destino entity.Categoria, userID string) error
+	DeleteCategoria(id int, userID string) error
+	GetValoresCatalogo(userID string) (categorias, destinados, especies []entity.ValorDistinto, err error)
+	AplicarNormalizacaoCatalogo(plano *entity.PlanoNormalizacaoCatalogo, userID string) error
+
+	// Exportações
+	StreamFornecedores(userID string, fn func(entity.Fornecedores) error) error
+	StreamProducts(userID string, fn func(entity.Produto) error) error
+	StreamPedidos(userID string, fn func(entity.Pedido) error) error
+	StreamDetalhesEstoque(userID string, fn func(entity.ViewDetalhesEstoque) error) error
+	StreamAlertasEstoque(userID string, tipo, status string, fn func(entity.AlertaEstoque) error) error
+	StreamInventarios(userID string, status string, fn func(entity.Inventario) error) error
+	StreamClientes(userID string, fn func(entity.Cliente) error) error
+	StreamEnderecos(userID string, fn func(entity.Endereco) error) error
+	StreamPets(userID string, fn func(entity.Pet) error) error
+	StreamTags(userID string, fn func(entity.Tag) error) error
+	StreamPublicos(userID string, fn func(entity.PublicoCliente) error) error
+	StreamCampanhas(userID string, fn func(entity.Campanha) error) error
+	StreamVendas(userID string, status string, idCliente int, fn func(entity.Venda) error) error
+	StreamCaixaSessoes(userID string, status string, fn func(entity.CaixaSessao) error) error
+	StreamDevolucoes(userID string, idVenda int, idCliente int, fn func(entity.Devolucao) error) error
+	StreamHistoricoPrecos(userID string, idProduto int, fn func(entity.HistoricoPreco) error) error
+	StreamImportacoes(userID string, recurso string, fn func(entity.Importacao) error) error
+	StreamImportacaoErros(userID string, idImportacao int, fn func(entity.ImportacaoErro) error) error
+	StreamEnviosCampanha(userID string, idCampanha int, canal, status string, fn func(entity.CampanhaEnvio) error) error
+	StreamTemplatesMensagens(userID string, canal string, fn func(entity.TemplateMensagem) error) error
+	StreamHistoricoConsentimentos(userID string, idCliente int, canal string, fn func(entity.ConsentimentoHistorico) error) error
+
+	// Importações
+	CreateImportacao(importacao *entity.Importacao, userID string) error
+	ConcluirImportacao(importacao *entity.Importacao, erros []entity.ImportacaoErro, userID string) error
+	GetImportacoesPaginated(userID string, recurso string, limit, offset int) ([]entity.Importacao, int, error)
+	GetImportacaoByID(id int, userID string) (*entity.Importacao, error)
+	GetImportacaoErrosPaginated(idImportacao int, userID string, limit, offset int) ([]entity.ImportacaoErro, int, error)
+
+	// Preços
+	GetHistoricoPrecosPaginated(idProduto int, userID string, limit, offset int) ([]entity.HistoricoPreco, int, error)
+	GetHistoricoPrecos(idProduto int, userID string) ([]entity.HistoricoPreco, error)
+	CreatePrecoAgendado(agendamento *entity.PrecoAgendado, userID string) error
+	GetPrecosAgendados(idProduto int, status string, userID string) ([]entity.PrecoAgendado, error)
+	CancelarPrecoAgendado(idProduto int, idAgendamento int, userID string) error
+	GetPrecosAgendadosVencidos(agora string, userID string) ([]entity.PrecoAgendado, error)
+	AplicarPrecoAgendado(agendamento entity.PrecoAgendado, dataAplicacao string, userID string) error
 
 	GetAllPedidos(userID string) ([]entity.Pedido, error)
 	GetAllPedidosPaginated(userID string, limit, offset int) ([]entity.Pedido, int, error)
@@ -44,6 +117,44 @@ type PersistenceInterfaceDBClient interface {
 	GetDetalhesPedido(idPedido string, userID string) ([]entity.ViewDetalhesPedido, error)
 	GetDetalhesPedidoPaginated(idPedido string, userID string, limit, offset int) ([]entity.ViewDetalhesPedido, int, error)
 
+	// Vendas
+	CreateVenda(venda *entity.Venda, itens []entity.ItemVenda, userID string) error
+	GetVendasPaginated(userID string, status string, idCliente int, limit, offset int) ([]entity.Venda, int, error)
+	GetVendaByID(id int, userID string) (*entity.Venda, error)
+	GetItensVenda(idVenda int, userID string) ([]entity.ItemVenda, error)
+	AdicionarItemVenda(item *entity.ItemVenda, userID string) error
+	PagarVenda(idVenda int, formaPagamento string, saidas []entity.SaidaEstoque, dataPagamento string, movimentoCaixa *entity.CaixaMovimento, debitoCredito *entity.CreditoCliente, creditoPontos *entity.PontosCliente, userID string) ([]entity.MovimentacaoEstoque, error)
+	CancelarVenda(idVenda int, statusAtual string, dataCancelamento string, estornoCaixa *entity.CaixaMovimento, estornoCredito *entity.CreditoCliente, estornoPontos *entity.PontosCliente, userID string) error
+
+	// Devoluções e crédito na loja
+	RegistrarDevolucao(devolucao *entity.Devolucao, itens []entity.ItemDevolucao, retornos []entity.DevolucaoEstoque, credito *entity.CreditoCliente, estornoCaixa *entity.CaixaMovimento, estornoPontos *entity.PontosCliente, userID string) error
+	GetDevolucoesPaginated(userID string, idVenda int, idCliente int, limit, offset int) ([]entity.Devolucao, int, error)
+	GetDevolucaoByID(id int, userID string) (*entity.Devolucao, error)
+	GetItensDevolucao(idDevolucao int, userID string) ([]entity.ItemDevolucao, error)
+	GetCreditosCliente(idCliente int, userID string) ([]entity.CreditoCliente, error)
+
+	// RFM de clientes
+	GetComprasClientes(userID string) ([]entity.ComprasCliente, error)
+	GetComprasCliente(idCliente int, userID string) (*entity.ComprasCliente, error)
+
+	// Fidelidade
+	GetFidelidadeConfig(userID string) (*entity.FidelidadeConfig, error)
+	SalvarFidelidadeConfig(config *entity.FidelidadeConfig, userID string) error
+	GetPontosCliente(idCliente int, userID string) ([]entity.PontosCliente, error)
+	ResgatarPontos(debito *entity.PontosCliente, credito *entity.CreditoCliente, userID string) error
+	ExpirarPontos(data string, userID string) (int, int, error)
+	ReservarAvisoPontos(data string, limite string, userID string) ([]entity.PontosAExpirar, []int, error)
+	LiberarAvisoPontos(ids []int, userID string) error
+
+	// Caixa
+	AbrirCaixaSessao(sessao *entity.CaixaSessao, userID string) error
+	GetCaixaSessaoByID(id int, userID string) (*entity.CaixaSessao, error)
+	GetCaixaSessaoAberta(operador string, userID string) (*entity.CaixaSessao, error)
+	GetCaixaSessoesPaginated(userID string, status string, limit, offset int) ([]entity.CaixaSessao, int, error)
+	GetCaixaMovimentos(idSessao int, userID string) ([]entity.CaixaMovimento, error)
+	RegistrarMovimentoCaixa(movimento *entity.CaixaMovimento, movimentosConferidos int, userID string) error
+	FecharCaixaSessao(sessao *entity.CaixaSessao, movimentosConferidos int, userID string) error
+
 	// Estoque
 	GetAllEstoque(userID string) ([]entity.Estoque, error)
 	GetAllEstoquePaginated(userID string, limit, offset int) ([]entity.Estoque, int, error)
@@ -51,15 +162,36 @@ type PersistenceInterfaceDBClient interface {
 	GetAllDetalhesEstoquePaginated(userID string, limit, offset int) ([]entity.ViewDetalhesEstoque, int, error)
 	CreateEstoque(estoque entity.Estoque, userID string) error
 
+	// Alertas de Estoque
+	GetLotesVencendo(userID string, dias int) ([]entity.LoteVencendo, error)
+	GetProdutosAbaixoMinimo(userID string) ([]entity.ProdutoAbaixoMinimo, error)
+	CreateAlertasEstoque(alertas []entity.AlertaEstoque, userID string) ([]entity.AlertaEstoque, error)
+	GetAlertasEstoquePaginated(userID string, tipo, status string, limit, offset int) ([]entity.AlertaEstoque, int, error)
+	ResolverAlertaEstoque(id string, userID string) error
+
+	// Inventários
+	CreateInventario(inventario *entity.Inventario, produtos []int, userID string) error
+	GetInventarioByID(id string, userID string) (*entity.Inventario, error)
+	GetInventariosPaginated(userID string, status string, limit, offset int) ([]entity.Inventario, int, error)
+	GetInventarioItens(idInventario int, userID string) ([]entity.InventarioItem, error)
+	RegistrarContagensInventario(contagens []entity.InventarioContagem, userID string) error
+	GetInventarioDivergencias(idInventario int, userID string) ([]entity.InventarioDivergencia, error)
+	FecharInventario(idInventario int, contagens []entity.InventarioDivergencia, ajustar func(item entity.InventarioDivergencia, saldoAtual int) (entity.MovimentacaoEstoque, bool), dataFechamento string, userID string) ([]entity.MovimentacaoEstoque, error)
+	CancelarInventario(idInventario int, dataFechamento string, userID string) error
+
+	// Valorização de Estoque
+	GetLotesValorizacao(userID string, dataCorte string) ([]entity.LoteValorizacao, error)
+
 	// Clientes
 	GetAllClientes(userID string) ([]entity.Cliente, error)
 	GetAllClientesPaginated(userID string, limit, offset int) ([]entity.Cliente, int, error)
 	BuscarClientesCriterios(userID string) ([]entity.Cliente, error)
-	BuscarClientesPorCriterios(userID string, criterios []entity.PublicoCriterioJoin) ([]entity.Cliente, error)
+	BuscarClientesPorRegra(userID string, condicao string, args []interface{}, idPublico int) ([]entity.Cliente, error)
 	GetClienteByID(id string, userID string) *entity.Cliente
 	GetClienteByEmail(email string, userID string) *entity.Cliente
 	GetClienteByTelefone(telefone string, userID string) *entity.Cliente
 	CreateCliente(cliente entity.Cliente, userID string) error
+	UpdateCliente(id int, campos map[string]interface{}, userID string) error
 	DeleteCliente(id string, userID string) error
 
 	// Tags de Clientes
@@ -80,14 +212,30 @@ type PersistenceInterfaceDBClient interface {
 
 	// Critérios
 	GetAllCriterios(userID string) ([]entity.Criterio, error)
+	CreateCriterio(criterio *entity.Criterio, userID string) error
 
 	// Públicos
 	GetAllPublicos(userID string) ([]entity.PublicoCliente, error)
 	GetAllPublicosPaginated(userID string, limit, offset int) ([]entity.PublicoCliente, int, error)
 	CreatePublico(publico *entity.PublicoCliente, userID string) error
-	AssociarCriteriosPublico(idPublico int, criterios []int, userID string) error
+	AssociarCriteriosPublico(idPublico int, criterios []int, regra *entity.PublicoRegra, userID string) error
 	GetCriteriosPublico(idPublico string, userID string) ([]entity.PublicoCriterioJoin, error)
-	AdicionarClientesAoPublico(userID string, idPublico int, clientes []entity.Cliente) (int, int, error)
+	GetRegraPublico(idPublico int, userID string) (*entity.PublicoRegra, error)
+	PreviewPublico(userID string, condicao string, args []interface{}, amostra int) (*entity.PreviewPublico, error)
+	GetPublicoByID(id int, userID string) (*entity.PublicoCliente, error)
+	UpdateTipoPublico(id int, tipo string, userID string) error
+	GetPublicosDinamicos(userID string) ([]entity.PublicoCliente, error)
+	SincronizarMembrosPublico(userID string, idPublico int, clienteIDs []int, data string) (int, int, error)
+	GetHistoricoPublicoPaginated(userID string, idPublico, idCliente int, tipo string, limit, offset int) ([]entity.PublicoMembroEventoJoin, int, error)
+	AdicionarMembrosPublico(userID string, idPublico int, clienteIDs []int, origem string, data string) (int, int, error)
+	RemoverMembrosPublico(userID string, idPublico int, clienteIDs []int, data string) (int, error)
+	GetIDsClientesExistentes(userID string, clienteIDs []int) ([]int, error)
+	GetMembrosPublicos(userID string, idsPublicos []int) ([]entity.AddClientePublico, error)
+	CreatePublicoComMembros(userID string, publico *entity.PublicoCliente, clienteIDs []int, data string) error
+	GetIDsExclusoesPublico(userID string, idPublico int) ([]int, error)
+	ExcluirClientesPublico(userID string, idPublico int, clienteIDs []int, motivo *string, data string) (int, int, int, error)
+	RemoverExclusaoPublico(userID string, idPublico, idCliente int) error
+	GetExclusoesPublicoPaginated(userID string, idPublico int, limit, offset int) ([]entity.PublicoExclusaoJoin, int, error)
 	GetClientesDoPublico(userID string, idPublico int, limit, offset int) ([]entity.Cliente, int, error)
 
 	// Pets
@@ -105,6 +253,48 @@ type PersistenceInterfaceDBClient interface {
 	CreateCampanha(campanha *entity.Campanha, userID string) error
 	AssociarPublicosCampanha(idCampanha int, publicos []int, userID string) error
 	GetPublicosCampanha(idCampanha string, userID string) ([]entity.CampanhaPublicoJoin, error)
+	GetDestinatariosCampanha(userID string, idCampanha int) ([]entity.DestinatarioCampanha, error)
+	CriarEnviosCampanha(userID string, idCampanha int, envios []entity.CampanhaEnvio) (int, []entity.CampanhaEnvio, error)
+	GetEnvioCampanha(userID string, id int) (*entity.CampanhaEnvioDetalhe, error)
+	ReservarEnvioCampanha(userID string, id int, data string) (bool, error)
+	ConcluirEnvioCampanha(userID string, envio entity.CampanhaEnvio) error
+	LiberarEnvioCampanha(userID string, id int, data string) error
+	RecuperarEnviosParados(userID string, limiteEnviando, limiteNaFila, data string) ([]entity.CampanhaEnvio, error)
+	GetEnviosCampanhaPaginated(userID string, idCampanha int, canal, status string, limit, offset int) ([]entity.CampanhaEnvio, int, error)
+	GetCanaisCampanha(userID string, idCampanha int) ([]entity.CampanhaCanalJoin, error)
+	SetCanaisCampanha(userID string, idCampanha int, canais []entity.CampanhaCanal) error
+	GetPetsEnderecosClientes(userID string, clienteIDs []int) ([]entity.Pet, []entity.Endereco, error)
+
+	// Agenda de campanhas
+	GetCampanhasEmAndamento(userID string) ([]entity.Campanha, error)
+	AtualizarStatusCampanha(userID string, id int, de, para string) (bool, error)
+	GetCampanhasParaDisparo(userID string) ([]entity.Campanha, error)
+	RegistrarDisparoCampanha(userID string, id int, dataDisparo, erro *string) error
+
+	// Rastreio e métricas de campanhas
+	RegistrarEventoEnvio(userID string, id int, aplicar func(entity.CampanhaEnvio) (entity.CampanhaEnvio, bool)) (bool, error)
+	GetMetricasEnviosCampanha(userID string, idCampanha int) (*entity.MetricasEnvios, error)
+	GetMetricasEnviosPublicos(userID string, idCampanha int) ([]entity.MetricasEnvios, error)
+	GetVendasAtribuidasCampanha(userID string, idCampanha int, janelaDias int) (*entity.VendasAtribuidas, error)
+	GetVendasAtribuidasPublicos(userID string, idCampanha int, janelaDias int) ([]entity.VendasAtribuidas, error)
+
+	// Consentimentos de clientes
+	GetConsentimentosCliente(userID string, idCliente int) ([]entity.ConsentimentoCliente, error)
+	GetClientesConsentidos(userID string, clienteIDs []int) ([]entity.ConsentimentoCliente, error)
+	RegistrarConsentimentos(userID string, alteracoes []entity.ConsentimentoHistorico) (int, error)
+	GetHistoricoConsentimentosPaginated(userID string, idCliente int, canal string, limit, offset int) ([]entity.ConsentimentoHistorico, int, error)
+	StreamClientesPublicoConsentidos(userID string, idPublico int, canal string, fn func(entity.Cliente) error) error
+
+	// Templates de mensagens
+	GetTemplatesMensagensPaginated(userID string, canal string, limit, offset int) ([]entity.TemplateMensagem, int, error)
+	GetTemplateMensagemByID(userID string, id int) (*entity.TemplateMensagem, error)
+	CreateTemplateMensagem(userID string, template *entity.TemplateMensagem) error
+	UpdateTemplateMensagem(userID string, template entity.TemplateMensagem) error
+	DeleteTemplateMensagem(userID string, id int) error
+	CountCampanhasTemplate(userID string, idTemplate int) (int, error)
+
+	// Tenants
+	GetClientIDs() []string
 }
 
 type DBConnectionDBMaster struct {
@@ -141,6 +331,16 @@ func (repo *DBConnectionDBClient) getClientDB(userID string) *gorm.DB {
 	return db
 }
 
+// GetClientIDs retorna os userIDs de todos os bancos de clientes configurados, usado pelos jobs em background
+func (repo *DBConnectionDBClient) GetClientIDs() []string {
+	var userIDs []string
+	for key := range repo.dbclient {
+		userIDs = append(userIDs, strings.TrimPrefix(key, "db_"))
+	}
+	sort.Strings(userIDs)
+	return userIDs
+}
+
 // FUNÇÕES DE USUÁRIO DBMASTER ------------------------------------------------------------------------------------------------------------------------------------
 
 func (repo *DBConnectionDBMaster) CreateUser(user entity.User) error {
@@ -172,6 +372,16 @@ func (repo *DBConnectionDBMaster) GetUser(email string) *entity.User {
 	return &user
 }
 
+func (repo *DBConnectionDBMaster) GetUserByID(id uint) *entity.User {
+	zap.L().Info("Getting user by id from database", zap.Uint("id", id))
+	var user entity.User
+	err := repo.dbmaster.Table("users").Where("id = ?", id).First(&user).Error
+	if err != nil {
+		zap.L().Error("User not found in database", zap.Uint("id", id), zap.Error(err))
+	}
+	return &user
+}
+
 func (repo *DBConnectionDBMaster) GetTenantByUserID(userID uint) *entity.Tenants {
 	zap.L().Info("Getting tenant by user id from database", zap.Uint("user_id", userID))
 	var tenant entity.Tenants
@@ -344,6 +554,30 @@ func (repo *DBConnectionDBClient) GetProductByBarcode(barcode string, userID str
 	return &product
 }
 
+// Índices únicos de produtos; ignoram código de barras e SKU vazios
+const (
+	IndiceProdutoCodigoBarra = "uk_produtos_codigo_barra"
+	IndiceProdutoSKU         = "uk_produtos_sku"
+)
+
+// Índice único da sessão aberta de cada operador; a coluna gerada aberto é nula nas sessões fechadas
+const IndiceCaixaAberto = "uk_caixa_sessoes_aberto"
+
+// IndiceDuplicado informa se a gravação violou um índice único (erro 1062 do MySQL) e qual foi,
+// quando for um dos índices conhecidos
+func IndiceDuplicado(err error) (string, bool) {
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != 1062 {
+		return "", false
+	}
+	for _, indice := range []string{IndiceProdutoCodigoBarra, IndiceProdutoSKU, IndiceCaixaAberto} {
+		if strings.Contains(mysqlErr.Message, indice) {
+			return indice, true
+		}
+	}
+	return "", true
+}
+
 func (repo *DBConnectionDBClient) CreateProduct(product entity.Produto, userID string) error {
 	db := repo.getClientDB(userID)
 
@@ -359,13 +593,93 @@ func (repo *DBConnectionDBClient) DeleteProduct(id string, userID string) error
 	db := repo.getClientDB(userID)
 
 	zap.L().Info("Deleting product from database", zap.String("id", id), zap.String("userID", userID))
-	err := db.Delete(&entity.Produto{}, id).Error
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Remove a composição quando o produto é um kit
+		if err := tx.Where("id_kit = ?", id).Delete(&entity.KitComponente{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Produto{}, id).Error
+	})
 	if err != nil {
 		zap.L().Error("Error deleting product from database", zap.Error(err))
 	}
 	return err
 }
 
+func (repo *DBConnectionDBClient) UpdateEstoqueMinimo(id string, estoqueMinimo int, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Updating estoque minimo in the database", zap.String("id", id), zap.Int("estoque_minimo", estoqueMinimo), zap.String("userID", userID))
+	err := db.Model(&entity.Produto{}).Where("id_produto = ?", id).Update("estoque_minimo", estoqueMinimo).Error
+	if err != nil {
+		zap.L().Error("Error updating estoque minimo in database", zap.Error(err))
+	}
+	return err
+}
+
+func (repo *DBConnectionDBClient) GetProductByID(id string, userID string) (*entity.Produto, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting product by ID from database", zap.String("id", id), zap.String("userID", userID))
+
+	var product entity.Produto
+	err := db.Where("id_produto = ?", id).First(&product).Error
+	if err != nil {
+		zap.L().Error("Error getting product by ID from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved product by ID", zap.String("id", id))
+	return &product, nil
+}
+
+func (repo *DBConnectionDBClient) GetProductBySKU(sku string, userID string) *entity.Produto {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting product by SKU from database", zap.String("sku", sku), zap.String("userID", userID))
+	var product entity.Produto
+	err := db.Where("sku = ?", sku).First(&product).Error
+	if err != nil {
+		zap.L().Error("Product not found by SKU", zap.Error(err))
+	}
+	return &product
+}
+
+// UpdateProduct altera apenas as colunas informadas em campos e repassa os campos compartilhados às variações.
+// Quando historico não é nil (mudança de preco_venda), a alteração é registrada na mesma transação.
+func (repo *DBConnectionDBClient) UpdateProduct(id string, campos map[string]interface{}, historico *entity.HistoricoPreco, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Updating product in the database", zap.String("id", id), zap.Int("campos", len(campos)), zap.Bool("historico_preco", historico != nil), zap.String("userID", userID))
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Produto{}).Where("id_produto = ?", id).Updates(campos).Error; err != nil {
+			return err
+		}
+
+		// Variações herdam os campos compartilhados do produto pai
+		compartilhados := map[string]interface{}{}
+		for _, campo := range entity.CamposCompartilhadosVariacao {
+			if valor, ok := campos[campo]; ok {
+				compartilhados[campo] = valor
+			}
+		}
+		if len(compartilhados) > 0 {
+			if err := tx.Model(&entity.Produto{}).Where("id_produto_pai = ?", id).Updates(compartilhados).Error; err != nil {
+				return err
+			}
+		}
+
+		if historico != nil {
+			return tx.Create(historico).Error
+		}
+		return nil
+	})
+	if err != nil {
+		zap.L().Error("Error updating product in database", zap.Error(err))
+	}
+	return err
+}
+
 // FUNÇÕES DE PEDIDOS ------------------------------------------------------------------------------------------------------------------------------------
 
 func (repo *DBConnectionDBClient) GetAllPedidos(userID string) ([]entity.Pedido, error) {
@@ -650,65 +964,29 @@ func (repo *DBConnectionDBClient) BuscarClientesCriterios(userID string) ([]enti
 	return clientes, nil
 }
 
-func (repo *DBConnectionDBClient) BuscarClientesPorCriterios(userID string, criterios []entity.PublicoCriterioJoin) ([]entity.Cliente, error) {
+// BuscarClientesPorRegra busca os clientes que atendem à condição compilada da regra do público
+// (ver service/segmentacao). A condição é parametrizada e usa a tabela clientes sem alias. Com idPublico, os clientes
+// excluídos do público ficam de fora.
+func (repo *DBConnectionDBClient) BuscarClientesPorRegra(userID string, condicao string, args []interface{}, idPublico int) ([]entity.Cliente, error) {
 	db := repo.getClientDB(userID)
 
-	zap.L().Info("Getting clientes by criterios from database", zap.String("userID", userID), zap.Int("criterios_count", len(criterios)))
+	zap.L().Info("Getting clientes by regra from database", zap.String("userID", userID), zap.Int("args_count", len(args)))
 
 	var clientes []entity.Cliente
-
-	// Construir condições OR para os critérios
-	var conditions []string
-	var args []interface{}
-
-	for _, criterio := range criterios {
-		switch criterio.NomeCondicao {
-		case "Pessoa Física":
-			conditions = append(conditions, "clientes.tipo_cliente = ?")
-			args = append(args, "PF")
-		case "Pessoa Jurídica":
-			conditions = append(conditions, "clientes.tipo_cliente = ?")
-			args = append(args, "PJ")
-		case "Sexo Masculino":
-			conditions = append(conditions, "clientes.sexo = ?")
-			args = append(args, "M")
-		case "Sexo Feminino":
-			conditions = append(conditions, "clientes.sexo = ?")
-			args = append(args, "F")
-		case "Possui Gato":
-			conditions = append(conditions, "EXISTS (SELECT 1 FROM pets WHERE pets.cliente_id = clientes.id AND pets.especie = ?)")
-			args = append(args, "Gato")
-		case "Possui Cachorro":
-			conditions = append(conditions, "EXISTS (SELECT 1 FROM pets WHERE pets.cliente_id = clientes.id AND pets.especie = ?)")
-			args = append(args, "Cachorro")
-		default:
-			zap.L().Warn("Critério não reconhecido", zap.String("criterio", criterio.NomeCondicao))
-		}
-	}
-
-	if len(conditions) == 0 {
-		zap.L().Warn("No valid criterios found")
-		return []entity.Cliente{}, nil
-	}
-
-	// Construir query com OR entre as condições
-	whereClause := "(" + conditions[0]
-	for i := 1; i < len(conditions); i++ {
-		whereClause += " OR " + conditions[i]
-	}
-	whereClause += ")"
-
-	query := db.Select("DISTINCT clientes.id, clientes.tipo_cliente, clientes.sexo").
+	query := db.Select("clientes.id, clientes.tipo_cliente, clientes.sexo").
 		Table("clientes").
-		Where(whereClause, args...)
-
-	err := query.Find(&clientes).Error
+		Where(condicao, args...)
+	if idPublico > 0 {
+		query = query.Where("clientes.id NOT IN (SELECT id_cliente FROM publicos_exclusoes WHERE id_publico = ?)", idPublico)
+	}
+	err := query.Order("clientes.id ASC").
+		Find(&clientes).Error
 	if err != nil {
-		zap.L().Error("Error getting clientes by criterios from database", zap.Error(err))
+		zap.L().Error("Error getting clientes by regra from database", zap.Error(err))
 		return nil, err
 	}
 
-	zap.L().Info("Successfully retrieved clientes by criterios", zap.Int("count", len(clientes)))
+	zap.L().Info("Successfully retrieved clientes by regra", zap.Int("count", len(clientes)))
 	return clientes, nil
 }
 
@@ -968,6 +1246,17 @@ func (repo *DBConnectionDBClient) GetAllCriterios(userID string) ([]entity.Crite
 	return criterios, nil
 }
 
+func (repo *DBConnectionDBClient) CreateCriterio(criterio *entity.Criterio, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating criterio in database", zap.String("nome", criterio.NomeCondicao), zap.String("userID", userID))
+	err := db.Create(criterio).Error
+	if err != nil {
+		zap.L().Error("Error creating criterio in database", zap.Error(err))
+	}
+	return err
+}
+
 // FUNÇÕES DE PÚBLICOS ------------------------------------------------------------------------------------------------------------------------------------
 
 func (repo *DBConnectionDBClient) GetAllPublicos(userID string) ([]entity.PublicoCliente, error) {
@@ -1022,21 +1311,34 @@ func (repo *DBConnectionDBClient) CreatePublico(publico *entity.PublicoCliente,
 	return err
 }
 
-func (repo *DBConnectionDBClient) AssociarCriteriosPublico(idPublico int, criterios []int, userID string) error {
+// AssociarCriteriosPublico associa os critérios cadastrados ao público e, se informada, grava a regra do público,
+// substituindo a anterior. As duas gravações acontecem na mesma transação.
+func (repo *DBConnectionDBClient) AssociarCriteriosPublico(idPublico int, criterios []int, regra *entity.PublicoRegra, userID string) error {
 	db := repo.getClientDB(userID)
 
-	zap.L().Info("Associating criterios to publico", zap.Int("idPublico", idPublico), zap.Ints("criterios", criterios), zap.String("userID", userID))
-
-	// Criar as associações em lote
-	var publicoCriterios []entity.PublicoCriterio
-	for _, idCriterio := range criterios {
-		publicoCriterios = append(publicoCriterios, entity.PublicoCriterio{
-			IDPublico:  idPublico,
-			IDCriterio: idCriterio,
-		})
-	}
+	zap.L().Info("Associating criterios to publico", zap.Int("idPublico", idPublico), zap.Ints("criterios", criterios), zap.Bool("regra", regra != nil), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Criar as associações em lote
+		if len(criterios) > 0 {
+			var publicoCriterios []entity.PublicoCriterio
+			for _, idCriterio := range criterios {
+				publicoCriterios = append(publicoCriterios, entity.PublicoCriterio{
+					IDPublico:  idPublico,
+					IDCriterio: idCriterio,
+				})
+			}
+			if err := tx.Create(&publicoCriterios).Error; err != nil {
+				return err
+			}
+		}
 
-	err := db.Create(&publicoCriterios).Error
+		if regra != nil {
+			regra.IDPublico = idPublico
+			return tx.Save(regra).Error
+		}
+		return nil
+	})
 	if err != nil {
 		zap.L().Error("Error associating criterios to publico", zap.Error(err))
 		return err
@@ -1046,6 +1348,19 @@ func (repo *DBConnectionDBClient) AssociarCriteriosPublico(idPublico int, criter
 	return nil
 }
 
+func (repo *DBConnectionDBClient) GetRegraPublico(idPublico int, userID string) (*entity.PublicoRegra, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting regra of publico from database", zap.Int("idPublico", idPublico), zap.String("userID", userID))
+
+	var regra entity.PublicoRegra
+	err := db.Where("id_publico = ?", idPublico).First(&regra).Error
+	if err != nil {
+		return nil, err
+	}
+	return &regra, nil
+}
+
 func (repo *DBConnectionDBClient) GetCriteriosPublico(idPublico string, userID string) ([]entity.PublicoCriterioJoin, error) {
 	db := repo.getClientDB(userID)
 
@@ -1067,56 +1382,6 @@ func (repo *DBConnectionDBClient) GetCriteriosPublico(idPublico string, userID s
 	return criterios, nil
 }
 
-func (repo *DBConnectionDBClient) AdicionarClientesAoPublico(userID string, idPublico int, clientes []entity.Cliente) (int, int, error) {
-	db := repo.getClientDB(userID)
-
-	zap.L().Info("Adding clientes to publico", zap.String("userID", userID), zap.Int("idPublico", idPublico), zap.Int("clientes_count", len(clientes)))
-
-	clientesAdicionados := 0
-	clientesJaExistiam := 0
-
-	for _, cliente := range clientes {
-		// Verificar se a associação já existe
-		var count int64
-		err := db.Table("addclientes_publicos").
-			Where("id_publico = ? AND id_cliente = ?", idPublico, cliente.ID).
-			Count(&count).Error
-
-		if err != nil {
-			zap.L().Error("Error checking existing association", zap.Error(err))
-			return clientesAdicionados, clientesJaExistiam, err
-		}
-
-		if count > 0 {
-			clientesJaExistiam++
-			zap.L().Debug("Cliente already associated with publico", zap.Int("clienteID", cliente.ID), zap.Int("publicoID", idPublico))
-			continue
-		}
-
-		// Criar nova associação
-		addClientePublico := entity.AddClientePublico{
-			IDPublico: idPublico,
-			IDCliente: cliente.ID,
-		}
-
-		err = db.Create(&addClientePublico).Error
-		if err != nil {
-			zap.L().Error("Error creating cliente-publico association", zap.Error(err))
-			return clientesAdicionados, clientesJaExistiam, err
-		}
-
-		clientesAdicionados++
-		zap.L().Debug("Cliente added to publico", zap.Int("clienteID", cliente.ID), zap.Int("publicoID", idPublico))
-	}
-
-	zap.L().Info("Successfully processed clientes for publico",
-		zap.Int("idPublico", idPublico),
-		zap.Int("adicionados", clientesAdicionados),
-		zap.Int("ja_existiam", clientesJaExistiam))
-
-	return clientesAdicionados, clientesJaExistiam, nil
-}
-
 func (repo *DBConnectionDBClient) GetClientesDoPublico(userID string, idPublico int, limit, offset int) ([]entity.Cliente, int, error) {
 	db := repo.getClientDB(userID)
 
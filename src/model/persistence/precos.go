@@ -0,0 +1,183 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE PREÇOS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (repo *DBConnectionDBClient) GetHistoricoPrecosPaginated(idProduto int, userID string, limit, offset int) ([]entity.HistoricoPreco, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting paginated historico precos from database", zap.Int("id_produto", idProduto), zap.String("userID", userID), zap.Int("limit", limit), zap.Int("offset", offset))
+
+	var historico []entity.HistoricoPreco
+	var total int64
+
+	query := db.Model(&entity.HistoricoPreco{}).Where("id_produto = ?", idProduto)
+
+	// Contar total de registros
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting historico precos", zap.Error(err))
+		return nil, 0, err
+	}
+
+	// Buscar registros paginados, mais recentes primeiro
+	err := query.Limit(limit).Offset(offset).Order("data_alteracao DESC, id DESC").Find(&historico).Error
+	if err != nil {
+		zap.L().Error("Error getting paginated historico precos from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved paginated historico precos", zap.Int("count", len(historico)), zap.Int64("total", total))
+	return historico, int(total), nil
+}
+
+// GetHistoricoPrecos busca todas as alterações de preço do produto em ordem cronológica
+func (repo *DBConnectionDBClient) GetHistoricoPrecos(idProduto int, userID string) ([]entity.HistoricoPreco, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting historico precos from database", zap.Int("id_produto", idProduto), zap.String("userID", userID))
+
+	var historico []entity.HistoricoPreco
+	err := db.Select("id, id_produto, preco_anterior, preco_novo, origem, id_agendamento, alterado_por, DATE_FORMAT(data_alteracao, '%Y-%m-%d %H:%i:%s') as data_alteracao").
+		Where("id_produto = ?", idProduto).
+		Order("data_alteracao ASC, id ASC").
+		Find(&historico).Error
+	if err != nil {
+		zap.L().Error("Error getting historico precos from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved historico precos", zap.Int("count", len(historico)))
+	return historico, nil
+}
+
+func (repo *DBConnectionDBClient) CreatePrecoAgendado(agendamento *entity.PrecoAgendado, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating preco agendado in the database", zap.Int("id_produto", agendamento.IDProduto), zap.String("data_vigencia", agendamento.DataVigencia), zap.String("userID", userID))
+	err := db.Create(agendamento).Error
+	if err != nil {
+		zap.L().Error("Error creating preco agendado in database", zap.Error(err))
+	}
+	return err
+}
+
+func (repo *DBConnectionDBClient) GetPrecosAgendados(idProduto int, status string, userID string) ([]entity.PrecoAgendado, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting precos agendados from database", zap.Int("id_produto", idProduto), zap.String("status", status), zap.String("userID", userID))
+
+	var agendamentos []entity.PrecoAgendado
+	query := db.Where("id_produto = ?", idProduto)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	err := query.Order("data_vigencia ASC, id ASC").Find(&agendamentos).Error
+	if err != nil {
+		zap.L().Error("Error getting precos agendados from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved precos agendados", zap.Int("count", len(agendamentos)))
+	return agendamentos, nil
+}
+
+// CancelarPrecoAgendado cancela um agendamento pendente do produto.
+// Retorna gorm.ErrRecordNotFound se o agendamento não existir ou não estiver mais pendente.
+func (repo *DBConnectionDBClient) CancelarPrecoAgendado(idProduto int, idAgendamento int, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Canceling preco agendado in the database", zap.Int("id_produto", idProduto), zap.Int("id_agendamento", idAgendamento), zap.String("userID", userID))
+	result := db.Model(&entity.PrecoAgendado{}).
+		Where("id = ? AND id_produto = ? AND status = ?", idAgendamento, idProduto, entity.PrecoAgendadoStatusPendente).
+		Update("status", entity.PrecoAgendadoStatusCancelado)
+	if result.Error != nil {
+		zap.L().Error("Error canceling preco agendado in database", zap.Error(result.Error))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetPrecosAgendadosVencidos busca os agendamentos pendentes cuja vigência já começou, do mais antigo para o mais novo
+func (repo *DBConnectionDBClient) GetPrecosAgendadosVencidos(agora string, userID string) ([]entity.PrecoAgendado, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting precos agendados vencidos from database", zap.String("agora", agora), zap.String("userID", userID))
+
+	var agendamentos []entity.PrecoAgendado
+	err := db.Where("status = ? AND data_vigencia <= ?", entity.PrecoAgendadoStatusPendente, agora).
+		Order("data_vigencia ASC, id ASC").
+		Find(&agendamentos).Error
+	if err != nil {
+		zap.L().Error("Error getting precos agendados vencidos from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved precos agendados vencidos", zap.Int("count", len(agendamentos)))
+	return agendamentos, nil
+}
+
+// AplicarPrecoAgendado altera o preço do produto, registra o histórico e marca o agendamento como aplicado numa única transação.
+// Retorna gorm.ErrRecordNotFound se o agendamento não estiver mais pendente.
+func (repo *DBConnectionDBClient) AplicarPrecoAgendado(agendamento entity.PrecoAgendado, dataAplicacao string, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Applying preco agendado in the database", zap.Int("id_agendamento", agendamento.ID), zap.Int("id_produto", agendamento.IDProduto), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Garante que o agendamento seja aplicado uma única vez, mesmo com mais de uma instância rodando o job
+		result := tx.Model(&entity.PrecoAgendado{}).
+			Where("id = ? AND status = ?", agendamento.ID, entity.PrecoAgendadoStatusPendente).
+			Updates(map[string]interface{}{"status": entity.PrecoAgendadoStatusAplicado, "data_aplicacao": dataAplicacao})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		var produto entity.Produto
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id_produto = ?", agendamento.IDProduto).
+			First(&produto).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&entity.Produto{}).
+			Where("id_produto = ?", agendamento.IDProduto).
+			Update("preco_venda", agendamento.PrecoVenda).Error
+		if err != nil {
+			return err
+		}
+
+		idAgendamento := agendamento.ID
+		return tx.Create(&entity.HistoricoPreco{
+			IDProduto:     agendamento.IDProduto,
+			PrecoAnterior: produto.PrecoVenda,
+			PrecoNovo:     agendamento.PrecoVenda,
+			Origem:        entity.PrecoOrigemAgendamento,
+			IDAgendamento: &idAgendamento,
+			AlteradoPor:   agendamento.CriadoPor,
+			DataAlteracao: dataAplicacao,
+		}).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error applying preco agendado in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully applied preco agendado", zap.Int("id_agendamento", agendamento.ID))
+	return nil
+}
@@ -0,0 +1,116 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE EXCLUSÕES DE PÚBLICOS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (repo *DBConnectionDBClient) GetIDsExclusoesPublico(userID string, idPublico int) ([]int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting exclusoes of publico from database", zap.String("userID", userID), zap.Int("idPublico", idPublico))
+
+	var ids []int
+	if err := db.Model(&entity.PublicoExclusao{}).Where("id_publico = ?", idPublico).Pluck("id_cliente", &ids).Error; err != nil {
+		zap.L().Error("Error getting exclusoes of publico from database", zap.Error(err))
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ExcluirClientesPublico grava a exclusão dos clientes e, na mesma transação, retira do público os que eram membros,
+// registrando a saída no histórico. Retorna as exclusões novas, as que já existiam e os membros removidos.
+func (repo *DBConnectionDBClient) ExcluirClientesPublico(userID string, idPublico int, clienteIDs []int, motivo *string, data string) (int, int, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Excluding clientes from publico", zap.String("userID", userID), zap.Int("idPublico", idPublico), zap.Int("clientes_count", len(clienteIDs)))
+
+	novas, removidos := 0, 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Trava o público para que uma reavaliação simultânea não devolva o cliente ao público
+		var publico entity.PublicoCliente
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", idPublico).First(&publico).Error; err != nil {
+			return err
+		}
+
+		exclusoes := make([]entity.PublicoExclusao, 0, len(clienteIDs))
+		vistos := make(map[int]bool, len(clienteIDs))
+		for _, id := range clienteIDs {
+			if vistos[id] {
+				continue
+			}
+			vistos[id] = true
+			exclusoes = append(exclusoes, entity.PublicoExclusao{IDPublico: idPublico, IDCliente: id, Motivo: motivo, DataExclusao: data})
+		}
+
+		result := tx.Clauses(clause.Insert{Modifier: "IGNORE"}).Create(&exclusoes)
+		if result.Error != nil {
+			return result.Error
+		}
+		novas = int(result.RowsAffected)
+
+		var err error
+		removidos, err = removerMembros(tx, idPublico, clienteIDs, entity.PublicoEventoOrigemExclusao, data)
+		return err
+	})
+	if err != nil {
+		zap.L().Error("Error excluding clientes from publico", zap.Error(err))
+		return 0, 0, 0, err
+	}
+
+	jaExcluidos := len(clienteIDs) - novas
+
+	zap.L().Info("Successfully excluded clientes from publico", zap.Int("idPublico", idPublico), zap.Int("novas", novas), zap.Int("ja_excluidos", jaExcluidos), zap.Int("removidos", removidos))
+	return novas, jaExcluidos, removidos, nil
+}
+
+func (repo *DBConnectionDBClient) RemoverExclusaoPublico(userID string, idPublico, idCliente int) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Removing exclusao of publico from database", zap.String("userID", userID), zap.Int("idPublico", idPublico), zap.Int("idCliente", idCliente))
+
+	result := db.Where("id_publico = ? AND id_cliente = ?", idPublico, idCliente).Delete(&entity.PublicoExclusao{})
+	if result.Error != nil {
+		zap.L().Error("Error removing exclusao of publico from database", zap.Error(result.Error))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (repo *DBConnectionDBClient) GetExclusoesPublicoPaginated(userID string, idPublico int, limit, offset int) ([]entity.PublicoExclusaoJoin, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting exclusoes of publico paginated from database", zap.String("userID", userID), zap.Int("idPublico", idPublico))
+
+	query := db.Table("publicos_exclusoes x").Where("x.id_publico = ?", idPublico)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting exclusoes of publico", zap.Error(err))
+		return nil, 0, err
+	}
+
+	var exclusoes []entity.PublicoExclusaoJoin
+	err := query.
+		Select("x.id_publico, x.id_cliente, x.motivo, DATE_FORMAT(x.data_exclusao, '%Y-%m-%d %H:%i:%s') as data_exclusao, c.nome_cliente").
+		Joins("LEFT JOIN clientes c ON c.id = x.id_cliente").
+		Order("x.data_exclusao DESC, x.id_cliente ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&exclusoes).Error
+	if err != nil {
+		zap.L().Error("Error getting exclusoes of publico from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved exclusoes of publico", zap.Int("count", len(exclusoes)), zap.Int64("total", total))
+	return exclusoes, int(total), nil
+}
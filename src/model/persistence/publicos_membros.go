@@ -0,0 +1,352 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE MEMBROS DE PÚBLICOS ------------------------------------------------------------------------------------------------------------------------------------
+
+// Quantidade de membros gravados por INSERT
+const tamanhoLoteMembros = 500
+
+func (repo *DBConnectionDBClient) GetPublicoByID(id int, userID string) (*entity.PublicoCliente, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting publico by ID from database", zap.Int("id", id), zap.String("userID", userID))
+
+	var publico entity.PublicoCliente
+	if err := db.Where("id = ?", id).First(&publico).Error; err != nil {
+		zap.L().Error("Error getting publico by ID from database", zap.Error(err))
+		return nil, err
+	}
+	return &publico, nil
+}
+
+func (repo *DBConnectionDBClient) UpdateTipoPublico(id int, tipo string, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Updating tipo of publico in database", zap.Int("id", id), zap.String("tipo", tipo), zap.String("userID", userID))
+
+	result := db.Model(&entity.PublicoCliente{}).Where("id = ?", id).Update("tipo", tipo)
+	if result.Error != nil {
+		zap.L().Error("Error updating tipo of publico in database", zap.Error(result.Error))
+		return result.Error
+	}
+	return nil
+}
+
+// GetPublicosDinamicos lista os públicos dinâmicos, reavaliados pelo job
+func (repo *DBConnectionDBClient) GetPublicosDinamicos(userID string) ([]entity.PublicoCliente, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting dynamic publicos from database", zap.String("userID", userID))
+
+	var publicos []entity.PublicoCliente
+	err := db.Where("tipo = ?", entity.PublicoTipoDinamico).
+		Order("id ASC").
+		Find(&publicos).Error
+	if err != nil {
+		zap.L().Error("Error getting dynamic publicos from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved dynamic publicos", zap.Int("count", len(publicos)))
+	return publicos, nil
+}
+
+// SincronizarMembrosPublico deixa no público exatamente os clientes informados: adiciona os que faltam, remove os que
+// não atendem mais e registra um evento de entrada ou saída para cada um. O público fica travado durante a troca para
+// que duas reavaliações simultâneas não gerem eventos duplicados. Retorna a quantidade de entradas e saídas.
+func (repo *DBConnectionDBClient) SincronizarMembrosPublico(userID string, idPublico int, clienteIDs []int, data string) (int, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Synchronizing members of publico", zap.String("userID", userID), zap.Int("idPublico", idPublico), zap.Int("clientes_count", len(clienteIDs)))
+
+	entradas, saidas := 0, 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var publico entity.PublicoCliente
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", idPublico).First(&publico).Error; err != nil {
+			return err
+		}
+
+		var atuais []int
+		if err := tx.Table("addclientes_publicos").Where("id_publico = ?", idPublico).Pluck("id_cliente", &atuais).Error; err != nil {
+			return err
+		}
+
+		membros := make(map[int]bool, len(atuais))
+		for _, id := range atuais {
+			membros[id] = true
+		}
+		selecionados := make(map[int]bool, len(clienteIDs))
+		for _, id := range clienteIDs {
+			selecionados[id] = true
+		}
+
+		var novos []entity.AddClientePublico
+		var eventos []entity.PublicoMembroEvento
+		for _, id := range clienteIDs {
+			if membros[id] {
+				continue
+			}
+			membros[id] = true
+			novos = append(novos, entity.AddClientePublico{IDPublico: idPublico, IDCliente: id})
+			eventos = append(eventos, entity.PublicoMembroEvento{IDPublico: idPublico, IDCliente: id, Tipo: entity.PublicoEventoEntrada, Origem: entity.PublicoEventoOrigemAtualizacao, DataEvento: data})
+		}
+
+		var removidos []int
+		for _, id := range atuais {
+			if selecionados[id] || !membros[id] {
+				continue
+			}
+			// Desmarca para não repetir o cliente associado mais de uma vez ao público
+			membros[id] = false
+			removidos = append(removidos, id)
+			eventos = append(eventos, entity.PublicoMembroEvento{IDPublico: idPublico, IDCliente: id, Tipo: entity.PublicoEventoSaida, Origem: entity.PublicoEventoOrigemAtualizacao, DataEvento: data})
+		}
+
+		if len(removidos) > 0 {
+			if err := tx.Where("id_publico = ? AND id_cliente IN ?", idPublico, removidos).Delete(&entity.AddClientePublico{}).Error; err != nil {
+				return err
+			}
+		}
+		if len(novos) > 0 {
+			if err := tx.CreateInBatches(&novos, tamanhoLoteMembros).Error; err != nil {
+				return err
+			}
+		}
+		if len(eventos) > 0 {
+			if err := tx.CreateInBatches(&eventos, tamanhoLoteMembros).Error; err != nil {
+				return err
+			}
+		}
+
+		entradas, saidas = len(novos), len(removidos)
+		return tx.Model(&entity.PublicoCliente{}).Where("id = ?", idPublico).Update("ultima_atualizacao", data).Error
+	})
+	if err != nil {
+		zap.L().Error("Error synchronizing members of publico", zap.Error(err))
+		return 0, 0, err
+	}
+
+	zap.L().Info("Successfully synchronized members of publico", zap.Int("idPublico", idPublico), zap.Int("entradas", entradas), zap.Int("saidas", saidas))
+	return entradas, saidas, nil
+}
+
+// AdicionarMembrosPublico associa os clientes ao público em uma única transação: se algo falhar, nenhum cliente é
+// associado. clienteIDs não deve ter repetidos. As associações são gravadas em lotes com INSERT IGNORE sobre o índice
+// único (id_publico, id_cliente), e os registros afetados dão a quantidade de clientes adicionados; os demais já
+// faziam parte do público. Cada novo membro ganha um evento de entrada com a origem informada.
+func (repo *DBConnectionDBClient) AdicionarMembrosPublico(userID string, idPublico int, clienteIDs []int, origem string, data string) (int, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Adding clientes to publico", zap.String("userID", userID), zap.Int("idPublico", idPublico), zap.Int("clientes_count", len(clienteIDs)), zap.String("origem", origem))
+
+	clientesAdicionados := 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Trava o público para não concorrer com a reavaliação dos públicos dinâmicos; com a trava, as associações
+		// do público com ID acima do último existente são exatamente as gravadas nesta transação
+		var publico entity.PublicoCliente
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", idPublico).First(&publico).Error; err != nil {
+			return err
+		}
+		var ultimoID int
+		if err := tx.Table("addclientes_publicos").Where("id_publico = ?", idPublico).Select("COALESCE(MAX(id), 0)").Scan(&ultimoID).Error; err != nil {
+			return err
+		}
+
+		for inicio := 0; inicio < len(clienteIDs); inicio += tamanhoLoteMembros {
+			lote := clienteIDs[inicio:min(inicio+tamanhoLoteMembros, len(clienteIDs))]
+
+			novos := make([]entity.AddClientePublico, len(lote))
+			for i, id := range lote {
+				novos[i] = entity.AddClientePublico{IDPublico: idPublico, IDCliente: id}
+			}
+			result := tx.Clauses(clause.Insert{Modifier: "IGNORE"}).Create(&novos)
+			if result.Error != nil {
+				return result.Error
+			}
+			clientesAdicionados += int(result.RowsAffected)
+		}
+		if clientesAdicionados == 0 {
+			return nil
+		}
+
+		return tx.Exec(`INSERT INTO publicos_membros_eventos (id_publico, id_cliente, tipo, origem, data_evento)
+			SELECT id_publico, id_cliente, ?, ?, ? FROM addclientes_publicos WHERE id_publico = ? AND id > ? ORDER BY id`,
+			entity.PublicoEventoEntrada, origem, data, idPublico, ultimoID).Error
+	})
+	if err != nil {
+		zap.L().Error("Error adding clientes to publico", zap.Error(err))
+		return 0, 0, err
+	}
+
+	clientesJaExistiam := len(clienteIDs) - clientesAdicionados
+
+	zap.L().Info("Successfully processed clientes for publico",
+		zap.Int("idPublico", idPublico),
+		zap.Int("adicionados", clientesAdicionados),
+		zap.Int("ja_existiam", clientesJaExistiam))
+
+	return clientesAdicionados, clientesJaExistiam, nil
+}
+
+// RemoverMembrosPublico retira os clientes do público e registra a saída de cada um no histórico. Clientes que não
+// eram membros são ignorados. Retorna a quantidade de clientes removidos.
+func (repo *DBConnectionDBClient) RemoverMembrosPublico(userID string, idPublico int, clienteIDs []int, data string) (int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Removing clientes from publico", zap.String("userID", userID), zap.Int("idPublico", idPublico), zap.Int("clientes_count", len(clienteIDs)))
+
+	removidos := 0
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var publico entity.PublicoCliente
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", idPublico).First(&publico).Error; err != nil {
+			return err
+		}
+
+		var err error
+		removidos, err = removerMembros(tx, idPublico, clienteIDs, entity.PublicoEventoOrigemManual, data)
+		return err
+	})
+	if err != nil {
+		zap.L().Error("Error removing clientes from publico", zap.Error(err))
+		return 0, err
+	}
+
+	zap.L().Info("Successfully removed clientes from publico", zap.Int("idPublico", idPublico), zap.Int("removidos", removidos))
+	return removidos, nil
+}
+
+// removerMembros apaga as associações dos clientes que são membros do público e grava os eventos de saída
+func removerMembros(tx *gorm.DB, idPublico int, clienteIDs []int, origem string, data string) (int, error) {
+	var membros []int
+	if err := tx.Table("addclientes_publicos").Where("id_publico = ? AND id_cliente IN ?", idPublico, clienteIDs).Distinct().Pluck("id_cliente", &membros).Error; err != nil {
+		return 0, err
+	}
+	if len(membros) == 0 {
+		return 0, nil
+	}
+
+	if err := tx.Where("id_publico = ? AND id_cliente IN ?", idPublico, membros).Delete(&entity.AddClientePublico{}).Error; err != nil {
+		return 0, err
+	}
+
+	eventos := make([]entity.PublicoMembroEvento, len(membros))
+	for i, id := range membros {
+		eventos[i] = entity.PublicoMembroEvento{IDPublico: idPublico, IDCliente: id, Tipo: entity.PublicoEventoSaida, Origem: origem, DataEvento: data}
+	}
+	if err := tx.CreateInBatches(&eventos, tamanhoLoteMembros).Error; err != nil {
+		return 0, err
+	}
+	return len(membros), nil
+}
+
+// GetIDsClientesExistentes retorna, dentre os IDs informados, os que existem na tabela clientes
+func (repo *DBConnectionDBClient) GetIDsClientesExistentes(userID string, clienteIDs []int) ([]int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting existing clientes IDs from database", zap.String("userID", userID), zap.Int("clientes_count", len(clienteIDs)))
+
+	var ids []int
+	if err := db.Table("clientes").Where("id IN ?", clienteIDs).Pluck("id", &ids).Error; err != nil {
+		zap.L().Error("Error getting existing clientes IDs from database", zap.Error(err))
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetMembrosPublicos lista as associações dos públicos informados, para as operações entre públicos
+func (repo *DBConnectionDBClient) GetMembrosPublicos(userID string, idsPublicos []int) ([]entity.AddClientePublico, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting members of publicos from database", zap.String("userID", userID), zap.Ints("idsPublicos", idsPublicos))
+
+	var membros []entity.AddClientePublico
+	err := db.Select("id_publico, id_cliente").
+		Where("id_publico IN ?", idsPublicos).
+		Order("id_cliente ASC").
+		Find(&membros).Error
+	if err != nil {
+		zap.L().Error("Error getting members of publicos from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved members of publicos", zap.Int("count", len(membros)))
+	return membros, nil
+}
+
+// CreatePublicoComMembros cria o público já com os membros e os eventos de entrada, na mesma transação
+func (repo *DBConnectionDBClient) CreatePublicoComMembros(userID string, publico *entity.PublicoCliente, clienteIDs []int, data string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating publico with members in the database", zap.String("nome", publico.Nome), zap.Int("clientes_count", len(clienteIDs)), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(publico).Error; err != nil {
+			return err
+		}
+		if len(clienteIDs) == 0 {
+			return nil
+		}
+
+		membros := make([]entity.AddClientePublico, len(clienteIDs))
+		eventos := make([]entity.PublicoMembroEvento, len(clienteIDs))
+		for i, id := range clienteIDs {
+			membros[i] = entity.AddClientePublico{IDPublico: publico.ID, IDCliente: id}
+			eventos[i] = entity.PublicoMembroEvento{IDPublico: publico.ID, IDCliente: id, Tipo: entity.PublicoEventoEntrada, Origem: entity.PublicoEventoOrigemOperacao, DataEvento: data}
+		}
+		if err := tx.CreateInBatches(&membros, tamanhoLoteMembros).Error; err != nil {
+			return err
+		}
+		return tx.CreateInBatches(&eventos, tamanhoLoteMembros).Error
+	})
+	if err != nil {
+		zap.L().Error("Error creating publico with members in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully created publico with members", zap.Int("id", publico.ID))
+	return nil
+}
+
+// GetHistoricoPublicoPaginated lista os eventos de entrada e saída do público, do mais recente para o mais antigo
+func (repo *DBConnectionDBClient) GetHistoricoPublicoPaginated(userID string, idPublico, idCliente int, tipo string, limit, offset int) ([]entity.PublicoMembroEventoJoin, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting historico of publico from database", zap.String("userID", userID), zap.Int("idPublico", idPublico), zap.Int("idCliente", idCliente), zap.String("tipo", tipo))
+
+	query := db.Table("publicos_membros_eventos e").Where("e.id_publico = ?", idPublico)
+	if idCliente > 0 {
+		query = query.Where("e.id_cliente = ?", idCliente)
+	}
+	if tipo != "" {
+		query = query.Where("e.tipo = ?", tipo)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting historico of publico", zap.Error(err))
+		return nil, 0, err
+	}
+
+	var eventos []entity.PublicoMembroEventoJoin
+	err := query.
+		Select("e.id, e.id_publico, e.id_cliente, e.tipo, e.origem, DATE_FORMAT(e.data_evento, '%Y-%m-%d %H:%i:%s') as data_evento, c.nome_cliente").
+		Joins("LEFT JOIN clientes c ON c.id = e.id_cliente").
+		Order("e.data_evento DESC, e.id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&eventos).Error
+	if err != nil {
+		zap.L().Error("Error getting historico of publico from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved historico of publico", zap.Int("count", len(eventos)), zap.Int64("total", total))
+	return eventos, int(total), nil
+}
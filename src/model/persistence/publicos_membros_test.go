@@ -0,0 +1,104 @@
+package persistence
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// esperarTravaPublico prepara a trava do público e a leitura do último ID de associação do início da transação
+func esperarTravaPublico(mock sqlmock.Sqlmock, idPublico int, ultimoID int) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `publicos_clientes` WHERE id = ?")).
+		WithArgs(idPublico, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "nome"}).AddRow(idPublico, "Cães"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(id), 0) FROM `addclientes_publicos` WHERE id_publico = ?")).
+		WithArgs(idPublico).
+		WillReturnRows(sqlmock.NewRows([]string{"ultimo"}).AddRow(ultimoID))
+}
+
+// Teste para AdicionarMembrosPublico: contagem pelo INSERT IGNORE e eventos só das associações novas
+func TestDBConnectionDBClient_AdicionarMembrosPublico_ContaPeloInsertIgnore(t *testing.T) {
+	// Arrange
+	gormDB, mock, _ := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := gormDB.DB()
+		sqlDB.Close()
+	}()
+
+	repo := NewDBConnectionDBClient(map[string]*gorm.DB{"db_1": gormDB})
+
+	esperarTravaPublico(mock, 4, 10)
+	// Dos três clientes, um já era membro: o INSERT IGNORE afeta só duas linhas
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `addclientes_publicos`")).
+		WillReturnResult(sqlmock.NewResult(11, 2))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO publicos_membros_eventos")).
+		WithArgs(entity.PublicoEventoEntrada, entity.PublicoEventoOrigemManual, "2025-03-01 10:00:00", 4, 10).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	// Act
+	adicionados, jaExistiam, err := repo.AdicionarMembrosPublico("1", 4, []int{1, 2, 3}, entity.PublicoEventoOrigemManual, "2025-03-01 10:00:00")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, adicionados)
+	assert.Equal(t, 1, jaExistiam)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDBConnectionDBClient_AdicionarMembrosPublico_TodosJaMembros(t *testing.T) {
+	// Arrange
+	gormDB, mock, _ := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := gormDB.DB()
+		sqlDB.Close()
+	}()
+
+	repo := NewDBConnectionDBClient(map[string]*gorm.DB{"db_1": gormDB})
+
+	esperarTravaPublico(mock, 4, 10)
+	// Nenhuma linha afetada: nenhum evento de entrada é gravado
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `addclientes_publicos`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// Act
+	adicionados, jaExistiam, err := repo.AdicionarMembrosPublico("1", 4, []int{1, 2}, entity.PublicoEventoOrigemManual, "2025-03-01 10:00:00")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, adicionados)
+	assert.Equal(t, 2, jaExistiam)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDBConnectionDBClient_AdicionarMembrosPublico_PublicoNotFound(t *testing.T) {
+	// Arrange
+	gormDB, mock, _ := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := gormDB.DB()
+		sqlDB.Close()
+	}()
+
+	repo := NewDBConnectionDBClient(map[string]*gorm.DB{"db_1": gormDB})
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `publicos_clientes` WHERE id = ?")).
+		WithArgs(4, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	// Act
+	adicionados, jaExistiam, err := repo.AdicionarMembrosPublico("1", 4, []int{1}, entity.PublicoEventoOrigemManual, "2025-03-01 10:00:00")
+
+	// Assert
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.Equal(t, 0, adicionados)
+	assert.Equal(t, 0, jaExistiam)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,78 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE PRÉVIA DE PÚBLICOS ------------------------------------------------------------------------------------------------------------------------------------
+
+// PreviewPublico conta os clientes que atendem à condição compilada da regra, detalha a contagem por sexo,
+// espécie dos pets e cidade dos endereços e retorna os primeiros clientes como amostra. Só faz leituras.
+func (repo *DBConnectionDBClient) PreviewPublico(userID string, condicao string, args []interface{}, amostra int) (*entity.PreviewPublico, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting publico preview from database", zap.String("userID", userID), zap.Int("amostra", amostra))
+
+	clientes := func() *gorm.DB {
+		return db.Table("clientes").Where(condicao, args...)
+	}
+
+	preview := &entity.PreviewPublico{}
+
+	var total int64
+	if err := clientes().Count(&total).Error; err != nil {
+		zap.L().Error("Error counting clientes for publico preview", zap.Error(err))
+		return nil, err
+	}
+	preview.Total = int(total)
+
+	err := clientes().
+		Select("clientes.sexo AS valor, COUNT(*) AS total").
+		Group("clientes.sexo").
+		Order("total DESC, valor ASC").
+		Scan(&preview.PorSexo).Error
+	if err != nil {
+		zap.L().Error("Error counting clientes by sexo for publico preview", zap.Error(err))
+		return nil, err
+	}
+
+	// Um cliente com pets de espécies diferentes (ou endereços em cidades diferentes) entra em cada uma delas
+	err = clientes().
+		Joins("INNER JOIN pets pp ON pp.cliente_id = clientes.id").
+		Select("pp.especie AS valor, COUNT(DISTINCT clientes.id) AS total").
+		Group("pp.especie").
+		Order("total DESC, valor ASC").
+		Scan(&preview.PorEspecie).Error
+	if err != nil {
+		zap.L().Error("Error counting clientes by especie for publico preview", zap.Error(err))
+		return nil, err
+	}
+
+	err = clientes().
+		Joins("INNER JOIN enderecos pe ON pe.id_cliente = clientes.id").
+		Select("pe.cidade AS valor, COUNT(DISTINCT clientes.id) AS total").
+		Group("pe.cidade").
+		Order("total DESC, valor ASC").
+		Scan(&preview.PorCidade).Error
+	if err != nil {
+		zap.L().Error("Error counting clientes by cidade for publico preview", zap.Error(err))
+		return nil, err
+	}
+
+	if amostra > 0 {
+		err = clientes().
+			Order("clientes.id ASC").
+			Limit(amostra).
+			Find(&preview.Amostra).Error
+		if err != nil {
+			zap.L().Error("Error getting sample of clientes for publico preview", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	zap.L().Info("Successfully retrieved publico preview", zap.Int("total", preview.Total))
+	return preview, nil
+}
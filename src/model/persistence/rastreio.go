@@ -0,0 +1,141 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE RASTREIO E MÉTRICAS DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+// Totais de envios por situação; data_envio preenchida indica que o envio saiu, mesmo que depois tenha falhado
+const selectMetricasEnvios = "COUNT(e.id) as envios, " +
+	"COUNT(DISTINCT CASE WHEN e.data_envio IS NOT NULL THEN e.id_cliente END) as destinatarios, " +
+	"COALESCE(SUM(e.status IN ('na_fila', 'enviando')), 0) as na_fila, " +
+	"COALESCE(SUM(e.data_envio IS NOT NULL), 0) as enviados, " +
+	"COALESCE(SUM(e.data_entrega IS NOT NULL), 0) as entregues, " +
+	"COALESCE(SUM(e.data_abertura IS NOT NULL), 0) as abertos, " +
+	"COALESCE(SUM(e.data_clique IS NOT NULL), 0) as clicados, " +
+	"COALESCE(SUM(e.status = 'falhou'), 0) as falhas, " +
+	"COALESCE(SUM(e.data_descadastro IS NOT NULL), 0) as descadastros, " +
+	"COALESCE(SUM(e.status = 'cancelado'), 0) as cancelados"
+
+// Primeiro envio de cada cliente da campanha; as vendas são atribuídas a partir dele
+const subqueryPrimeiroEnvio = "(SELECT id_cliente, MIN(data_envio) as data_envio FROM campanhas_envios " +
+	"WHERE id_campanha = ? AND data_envio IS NOT NULL GROUP BY id_cliente) e"
+
+// Primeiro envio a cada cliente por público gravado no envio
+const subqueryPrimeiroEnvioPublico = "(SELECT id_cliente, id_publico, MIN(data_envio) as data_envio FROM campanhas_envios " +
+	"WHERE id_campanha = ? AND data_envio IS NOT NULL AND id_publico IS NOT NULL GROUP BY id_cliente, id_publico) e"
+
+const selectVendasAtribuidas = "COUNT(DISTINCT v.id_venda) as vendas, COUNT(DISTINCT v.id_cliente) as compradores, COALESCE(SUM(v.valor_total), 0) as receita"
+
+const joinVendasAtribuidas = "INNER JOIN vendas v ON v.id_cliente = e.id_cliente AND v.status = ? " +
+	"AND v.data_venda >= e.data_envio AND v.data_venda < DATE_ADD(e.data_envio, INTERVAL ? DAY)"
+
+// RegistrarEventoEnvio aplica um evento de rastreio ao envio. O envio fica travado entre a leitura e a gravação
+// para que eventos simultâneos (ex: entrega e clique) não se sobrescrevam. Retorna false quando o evento não se
+// aplica e gorm.ErrRecordNotFound quando o envio não existe.
+func (repo *DBConnectionDBClient) RegistrarEventoEnvio(userID string, id int, aplicar func(entity.CampanhaEnvio) (entity.CampanhaEnvio, bool)) (bool, error) {
+	db := repo.getClientDB(userID)
+
+	aplicado := false
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var envio entity.CampanhaEnvio
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id, id_campanha, id_cliente, canal, status, erro, cliques, "+
+				"DATE_FORMAT(data_entrega, '%Y-%m-%d %H:%i:%s') as data_entrega, DATE_FORMAT(data_abertura, '%Y-%m-%d %H:%i:%s') as data_abertura, "+
+				"DATE_FORMAT(data_clique, '%Y-%m-%d %H:%i:%s') as data_clique, DATE_FORMAT(data_descadastro, '%Y-%m-%d %H:%i:%s') as data_descadastro").
+			Where("id = ?", id).
+			Take(&envio).Error
+		if err != nil {
+			return err
+		}
+
+		envio, aplicado = aplicar(envio)
+		if !aplicado {
+			return nil
+		}
+		return tx.Model(&entity.CampanhaEnvio{}).
+			Where("id = ?", id).
+			Select("status", "erro", "cliques", "data_entrega", "data_abertura", "data_clique", "data_descadastro").
+			Updates(&envio).Error
+	})
+	if err != nil {
+		zap.L().Error("Error registering evento of envio", zap.String("userID", userID), zap.Int("id", id), zap.Error(err))
+		return false, err
+	}
+	return aplicado, nil
+}
+
+// GetMetricasEnviosCampanha soma os envios da campanha por situação
+func (repo *DBConnectionDBClient) GetMetricasEnviosCampanha(userID string, idCampanha int) (*entity.MetricasEnvios, error) {
+	db := repo.getClientDB(userID)
+
+	var metricas entity.MetricasEnvios
+	err := db.Table("campanhas_envios e").
+		Select(selectMetricasEnvios).
+		Where("e.id_campanha = ?", idCampanha).
+		Take(&metricas).Error
+	if err != nil {
+		zap.L().Error("Error getting metricas of campanha from database", zap.Int("idCampanha", idCampanha), zap.Error(err))
+		return nil, err
+	}
+	return &metricas, nil
+}
+
+// GetMetricasEnviosPublicos soma os envios da campanha pelo público gravado em cada envio no disparo, para que as
+// mudanças posteriores nos membros (públicos dinâmicos) não alterem os números.
+func (repo *DBConnectionDBClient) GetMetricasEnviosPublicos(userID string, idCampanha int) ([]entity.MetricasEnvios, error) {
+	db := repo.getClientDB(userID)
+
+	var metricas []entity.MetricasEnvios
+	err := db.Table("campanhas_publicos cp").
+		Select("cp.id_publico, p.nome as nome_publico, "+selectMetricasEnvios).
+		Joins("INNER JOIN publicos_clientes p ON p.id = cp.id_publico").
+		Joins("LEFT JOIN campanhas_envios e ON e.id_campanha = cp.id_campanha AND e.id_publico = cp.id_publico").
+		Where("cp.id_campanha = ?", idCampanha).
+		Group("cp.id_publico, p.nome").
+		Order("cp.id_publico ASC").
+		Find(&metricas).Error
+	if err != nil {
+		zap.L().Error("Error getting metricas of publicos of campanha from database", zap.Int("idCampanha", idCampanha), zap.Error(err))
+		return nil, err
+	}
+	return metricas, nil
+}
+
+// GetVendasAtribuidasCampanha soma as vendas pagas feitas até janelaDias depois do primeiro envio a cada cliente
+func (repo *DBConnectionDBClient) GetVendasAtribuidasCampanha(userID string, idCampanha int, janelaDias int) (*entity.VendasAtribuidas, error) {
+	db := repo.getClientDB(userID)
+
+	var vendas entity.VendasAtribuidas
+	err := db.Table(subqueryPrimeiroEnvio, idCampanha).
+		Select(selectVendasAtribuidas).
+		Joins(joinVendasAtribuidas, entity.VendaStatusPaga, janelaDias).
+		Take(&vendas).Error
+	if err != nil {
+		zap.L().Error("Error getting vendas atribuidas of campanha from database", zap.Int("idCampanha", idCampanha), zap.Error(err))
+		return nil, err
+	}
+	return &vendas, nil
+}
+
+// GetVendasAtribuidasPublicos soma as vendas atribuídas à campanha pelo público gravado nos envios
+func (repo *DBConnectionDBClient) GetVendasAtribuidasPublicos(userID string, idCampanha int, janelaDias int) ([]entity.VendasAtribuidas, error) {
+	db := repo.getClientDB(userID)
+
+	var vendas []entity.VendasAtribuidas
+	err := db.Table(subqueryPrimeiroEnvioPublico, idCampanha).
+		Select("e.id_publico, "+selectVendasAtribuidas).
+		Joins(joinVendasAtribuidas, entity.VendaStatusPaga, janelaDias).
+		Group("e.id_publico").
+		Find(&vendas).Error
+	if err != nil {
+		zap.L().Error("Error getting vendas atribuidas of publicos of campanha from database", zap.Int("idCampanha", idCampanha), zap.Error(err))
+		return nil, err
+	}
+	return vendas, nil
+}
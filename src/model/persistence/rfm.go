@@ -0,0 +1,59 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE RFM DE CLIENTES ------------------------------------------------------------------------------------------------------------------------------------
+
+// GetComprasClientes resume as vendas pagas ou devolvidas de todos os clientes: data da última compra,
+// quantidade de vendas, valor vendido e valor devolvido. Clientes sem vendas vêm com frequência zero.
+func (repo *DBConnectionDBClient) GetComprasClientes(userID string) ([]entity.ComprasCliente, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting compras of clientes from database", zap.String("userID", userID))
+
+	var compras []entity.ComprasCliente
+	err := consultarComprasClientes(db).
+		Order("c.id ASC").
+		Find(&compras).Error
+
+	if err != nil {
+		zap.L().Error("Error getting compras of clientes from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved compras of clientes", zap.Int("count", len(compras)))
+	return compras, nil
+}
+
+// GetComprasCliente resume as compras de um cliente, como em GetComprasClientes
+func (repo *DBConnectionDBClient) GetComprasCliente(idCliente int, userID string) (*entity.ComprasCliente, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting compras of cliente from database", zap.Int("id_cliente", idCliente), zap.String("userID", userID))
+
+	var compras entity.ComprasCliente
+	err := consultarComprasClientes(db).
+		Where("c.id = ?", idCliente).
+		Take(&compras).Error
+	if err != nil {
+		zap.L().Error("Error getting compras of cliente from database", zap.Error(err))
+		return nil, err
+	}
+	return &compras, nil
+}
+
+func consultarComprasClientes(db *gorm.DB) *gorm.DB {
+	return db.Table("clientes c").
+		Select(`c.id as id_cliente, c.nome_cliente,
+			DATE_FORMAT(MAX(v.data_venda), '%Y-%m-%d %H:%i:%s') as ultima_compra,
+			COUNT(v.id_venda) as frequencia,
+			COALESCE(SUM(v.valor_total), 0) as valor_vendas,
+			COALESCE((SELECT SUM(d.valor_total) FROM devolucoes d WHERE d.id_cliente = c.id), 0) as valor_devolvido`).
+		Joins("LEFT JOIN vendas v ON v.id_cliente = c.id AND v.status IN ?", []string{entity.VendaStatusPaga, entity.VendaStatusDevolvida}).
+		Group("c.id, c.nome_cliente")
+}
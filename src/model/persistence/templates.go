@@ -0,0 +1,188 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE TEMPLATES DE MENSAGENS ------------------------------------------------------------------------------------------------------------------------------------
+
+// Colunas do template com as datas no formato usado pela API
+const colunasTemplateMensagem = "id, nome, canal, assunto, corpo, " +
+	"DATE_FORMAT(data_criacao, '%Y-%m-%d %H:%i:%s') as data_criacao, DATE_FORMAT(data_atualizacao, '%Y-%m-%d %H:%i:%s') as data_atualizacao"
+
+func (repo *DBConnectionDBClient) GetTemplatesMensagensPaginated(userID string, canal string, limit, offset int) ([]entity.TemplateMensagem, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting templates paginated from database", zap.String("userID", userID), zap.String("canal", canal), zap.Int("limit", limit), zap.Int("offset", offset))
+
+	query := filtrarTemplatesMensagens(db.Model(&entity.TemplateMensagem{}), canal)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting templates", zap.Error(err))
+		return nil, 0, err
+	}
+
+	var templates []entity.TemplateMensagem
+	err := query.Select(colunasTemplateMensagem).Order("id ASC").Limit(limit).Offset(offset).Find(&templates).Error
+	if err != nil {
+		zap.L().Error("Error getting templates from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved templates", zap.Int("count", len(templates)), zap.Int64("total", total))
+	return templates, int(total), nil
+}
+
+func filtrarTemplatesMensagens(query *gorm.DB, canal string) *gorm.DB {
+	if canal != "" {
+		query = query.Where("canal = ?", canal)
+	}
+	return query
+}
+
+func (repo *DBConnectionDBClient) GetTemplateMensagemByID(userID string, id int) (*entity.TemplateMensagem, error) {
+	db := repo.getClientDB(userID)
+
+	var template entity.TemplateMensagem
+	if err := db.Select(colunasTemplateMensagem).Where("id = ?", id).First(&template).Error; err != nil {
+		zap.L().Error("Error getting template from database", zap.Int("id", id), zap.Error(err))
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (repo *DBConnectionDBClient) CreateTemplateMensagem(userID string, template *entity.TemplateMensagem) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating template in the database", zap.String("nome", template.Nome), zap.String("canal", template.Canal), zap.String("userID", userID))
+
+	if err := db.Create(template).Error; err != nil {
+		zap.L().Error("Error creating template in the database", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// UpdateTemplateMensagem grava nome, canal, assunto e corpo; retorna gorm.ErrRecordNotFound se o template não existe
+func (repo *DBConnectionDBClient) UpdateTemplateMensagem(userID string, template entity.TemplateMensagem) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Updating template in the database", zap.Int("id", template.ID), zap.String("userID", userID))
+
+	result := db.Model(&entity.TemplateMensagem{}).
+		Where("id = ?", template.ID).
+		Select("nome", "canal", "assunto", "corpo", "data_atualizacao").
+		Updates(&template)
+	if result.Error != nil {
+		zap.L().Error("Error updating template in the database", zap.Error(result.Error))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteTemplateMensagem remove o template; retorna gorm.ErrRecordNotFound se ele não existe
+func (repo *DBConnectionDBClient) DeleteTemplateMensagem(userID string, id int) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Deleting template from database", zap.Int("id", id), zap.String("userID", userID))
+
+	result := db.Where("id = ?", id).Delete(&entity.TemplateMensagem{})
+	if result.Error != nil {
+		zap.L().Error("Error deleting template from database", zap.Error(result.Error))
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CountCampanhasTemplate conta as campanhas que usam o template em algum canal
+func (repo *DBConnectionDBClient) CountCampanhasTemplate(userID string, idTemplate int) (int, error) {
+	db := repo.getClientDB(userID)
+
+	var total int64
+	if err := db.Model(&entity.CampanhaCanal{}).Where("id_template = ?", idTemplate).Count(&total).Error; err != nil {
+		zap.L().Error("Error counting campanhas of template", zap.Int("idTemplate", idTemplate), zap.Error(err))
+		return 0, err
+	}
+	return int(total), nil
+}
+
+// FUNÇÕES DE CANAIS DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (repo *DBConnectionDBClient) GetCanaisCampanha(userID string, idCampanha int) ([]entity.CampanhaCanalJoin, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting canais of campanha from database", zap.String("userID", userID), zap.Int("idCampanha", idCampanha))
+
+	var canais []entity.CampanhaCanalJoin
+	err := db.Table("campanhas_canais cc").
+		Select("cc.canal, cc.id_template, t.nome as nome_template").
+		Joins("LEFT JOIN templates_mensagens t ON t.id = cc.id_template").
+		Where("cc.id_campanha = ?", idCampanha).
+		Order("cc.canal ASC").
+		Find(&canais).Error
+	if err != nil {
+		zap.L().Error("Error getting canais of campanha from database", zap.Error(err))
+		return nil, err
+	}
+	return canais, nil
+}
+
+// SetCanaisCampanha substitui os canais da campanha
+func (repo *DBConnectionDBClient) SetCanaisCampanha(userID string, idCampanha int, canais []entity.CampanhaCanal) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Setting canais of campanha", zap.String("userID", userID), zap.Int("idCampanha", idCampanha), zap.Int("canais_count", len(canais)))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id_campanha = ?", idCampanha).Delete(&entity.CampanhaCanal{}).Error; err != nil {
+			return err
+		}
+		if len(canais) == 0 {
+			return nil
+		}
+		return tx.Create(&canais).Error
+	})
+	if err != nil {
+		zap.L().Error("Error setting canais of campanha", zap.Error(err))
+	}
+	return err
+}
+
+// GetPetsEnderecosClientes busca os pets e endereços dos clientes, ordenados pelo cadastro, para montar as mensagens
+func (repo *DBConnectionDBClient) GetPetsEnderecosClientes(userID string, clienteIDs []int) ([]entity.Pet, []entity.Endereco, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting pets and enderecos of clientes from database", zap.String("userID", userID), zap.Int("clientes_count", len(clienteIDs)))
+
+	var pets []entity.Pet
+	var enderecos []entity.Endereco
+	for inicio := 0; inicio < len(clienteIDs); inicio += tamanhoLoteEnvios {
+		lote := clienteIDs[inicio:min(inicio+tamanhoLoteEnvios, len(clienteIDs))]
+
+		var petsLote []entity.Pet
+		if err := db.Where("cliente_id IN ?", lote).Order("id_pet ASC").Find(&petsLote).Error; err != nil {
+			zap.L().Error("Error getting pets of clientes from database", zap.Error(err))
+			return nil, nil, err
+		}
+		pets = append(pets, petsLote...)
+
+		var enderecosLote []entity.Endereco
+		if err := db.Where("id_cliente IN ?", lote).Order("id_endereco ASC").Find(&enderecosLote).Error; err != nil {
+			zap.L().Error("Error getting enderecos of clientes from database", zap.Error(err))
+			return nil, nil, err
+		}
+		enderecos = append(enderecos, enderecosLote...)
+	}
+
+	return pets, enderecos, nil
+}
@@ -0,0 +1,36 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE VALORIZAÇÃO DE ESTOQUE ------------------------------------------------------------------------------------------------------------------------------------
+
+// GetLotesValorizacao busca os lotes que entraram até a data de corte, com as movimentações posteriores ao corte
+// necessárias para reconstruir o saldo na data
+func (repo *DBConnectionDBClient) GetLotesValorizacao(userID string, dataCorte string) ([]entity.LoteValorizacao, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting lotes for valorizacao from database", zap.String("userID", userID), zap.String("data_corte", dataCorte))
+
+	var lotes []entity.LoteValorizacao
+	err := db.Table("estoques e").
+		Select(`e.id_estoque, e.id_produto, p.nome_produto, p.categoria, p.id_fornecedor, COALESCE(f.nome, '') as nome_fornecedor, p.preco_venda,
+			DATE_FORMAT(e.data_entrada, '%Y-%m-%d %H:%i:%s') as data_entrada, e.custo_unitario, e.quantidade, e.quantidade_recebida,
+			COALESCE((SELECT SUM(m.quantidade) FROM movimentacoes_estoque m WHERE m.id_estoque = e.id_estoque AND DATE(m.data_movimento) > ?), 0) as movimentado_apos_corte`, dataCorte).
+		Joins("INNER JOIN produtos p ON p.id_produto = e.id_produto").
+		Joins("LEFT JOIN fornecedores f ON f.id_fornecedor = p.id_fornecedor").
+		Where("DATE(e.data_entrada) <= ?", dataCorte).
+		Order("e.id_produto ASC, e.data_entrada ASC").
+		Find(&lotes).Error
+
+	if err != nil {
+		zap.L().Error("Error getting lotes for valorizacao from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved lotes for valorizacao", zap.Int("count", len(lotes)))
+	return lotes, nil
+}
@@ -0,0 +1,203 @@
+package persistence
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE VARIAÇÕES E KITS ------------------------------------------------------------------------------------------------------------------------------------
+
+const selectProdutoComSaldo = "p.*, COALESCE((SELECT SUM(e.quantidade) FROM estoques e WHERE e.id_produto = p.id_produto), 0) as saldo"
+
+// GetVariacoes busca as variações do produto pai com o saldo de cada uma
+func (repo *DBConnectionDBClient) GetVariacoes(idPai int, userID string) ([]entity.ProdutoSaldo, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting variacoes from database", zap.Int("id_produto_pai", idPai), zap.String("userID", userID))
+
+	var variacoes []entity.ProdutoSaldo
+	err := db.Table("produtos p").
+		Select(selectProdutoComSaldo).
+		Where("p.id_produto_pai = ?", idPai).
+		Order("p.id_produto ASC").
+		Scan(&variacoes).Error
+	if err != nil {
+		zap.L().Error("Error getting variacoes from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved variacoes", zap.Int("count", len(variacoes)))
+	return variacoes, nil
+}
+
+// GetSaldoProdutos soma o saldo dos lotes de cada produto; produtos sem lote ficam fora do mapa
+func (repo *DBConnectionDBClient) GetSaldoProdutos(idsProdutos []int, userID string) (map[int]int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting saldo produtos from database", zap.Ints("produtos", idsProdutos), zap.String("userID", userID))
+
+	var saldos []struct {
+		IDProduto int
+		Saldo     int
+	}
+	err := db.Model(&entity.Estoque{}).
+		Select("id_produto, COALESCE(SUM(quantidade), 0) as saldo").
+		Where("id_produto IN ?", idsProdutos).
+		Group("id_produto").
+		Scan(&saldos).Error
+	if err != nil {
+		zap.L().Error("Error getting saldo produtos from database", zap.Error(err))
+		return nil, err
+	}
+
+	resultado := make(map[int]int, len(saldos))
+	for _, saldo := range saldos {
+		resultado[saldo.IDProduto] = saldo.Saldo
+	}
+	return resultado, nil
+}
+
+func (repo *DBConnectionDBClient) GetProdutosByIDs(idsProdutos []int, userID string) ([]entity.Produto, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting produtos by IDs from database", zap.Ints("produtos", idsProdutos), zap.String("userID", userID))
+
+	var produtos []entity.Produto
+	err := db.Where("id_produto IN ?", idsProdutos).Find(&produtos).Error
+	if err != nil {
+		zap.L().Error("Error getting produtos by IDs from database", zap.Error(err))
+		return nil, err
+	}
+	return produtos, nil
+}
+
+// CreateVariacao cadastra a variação e marca o produto como pai numa única transação
+func (repo *DBConnectionDBClient) CreateVariacao(idPai int, variacao *entity.Produto, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating variacao in the database", zap.Int("id_produto_pai", idPai), zap.String("sku", variacao.SKU), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Produto{}).Where("id_produto = ?", idPai).Update("tipo", entity.ProdutoTipoPai).Error; err != nil {
+			return err
+		}
+		return tx.Create(variacao).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error creating variacao in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully created variacao", zap.Int("id_produto", variacao.IDProduto))
+	return nil
+}
+
+// VincularVariacao transforma um produto existente em variação do pai, copiando os campos compartilhados
+func (repo *DBConnectionDBClient) VincularVariacao(idPai int, idVariacao int, compartilhados map[string]interface{}, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Linking variacao in the database", zap.Int("id_produto_pai", idPai), zap.Int("id_produto", idVariacao), zap.String("userID", userID))
+
+	campos := map[string]interface{}{"tipo": entity.ProdutoTipoVariacao, "id_produto_pai": idPai}
+	for campo, valor := range compartilhados {
+		campos[campo] = valor
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Produto{}).Where("id_produto = ?", idPai).Update("tipo", entity.ProdutoTipoPai).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entity.Produto{}).Where("id_produto = ?", idVariacao).Updates(campos).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error linking variacao in database", zap.Error(err))
+	}
+	return err
+}
+
+// GetKitComponentes busca os componentes do kit com o saldo de cada produto componente
+func (repo *DBConnectionDBClient) GetKitComponentes(idKit int, userID string) ([]entity.KitComponenteDetalhe, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting kit componentes from database", zap.Int("id_kit", idKit), zap.String("userID", userID))
+
+	var componentes []entity.KitComponenteDetalhe
+	err := db.Table("kit_componentes k").
+		Select("k.id_produto, p.nome_produto, p.sku, k.quantidade, COALESCE((SELECT SUM(e.quantidade) FROM estoques e WHERE e.id_produto = k.id_produto), 0) as saldo").
+		Joins("INNER JOIN produtos p ON p.id_produto = k.id_produto").
+		Where("k.id_kit = ?", idKit).
+		Order("k.id ASC").
+		Scan(&componentes).Error
+	if err != nil {
+		zap.L().Error("Error getting kit componentes from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved kit componentes", zap.Int("count", len(componentes)))
+	return componentes, nil
+}
+
+// GetComponentesDosKits busca a composição de vários kits de uma vez, usada na baixa de estoque
+func (repo *DBConnectionDBClient) GetComponentesDosKits(idsKits []int, userID string) ([]entity.KitComponente, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting componentes dos kits from database", zap.Ints("kits", idsKits), zap.String("userID", userID))
+
+	var componentes []entity.KitComponente
+	err := db.Where("id_kit IN ?", idsKits).Order("id_kit ASC, id ASC").Find(&componentes).Error
+	if err != nil {
+		zap.L().Error("Error getting componentes dos kits from database", zap.Error(err))
+		return nil, err
+	}
+	return componentes, nil
+}
+
+// SetKitComponentes substitui a composição do kit e marca o produto como kit numa única transação
+func (repo *DBConnectionDBClient) SetKitComponentes(idKit int, componentes []entity.KitComponente, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Setting kit componentes in the database", zap.Int("id_kit", idKit), zap.Int("componentes", len(componentes)), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Produto{}).Where("id_produto = ?", idKit).Update("tipo", entity.ProdutoTipoKit).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id_kit = ?", idKit).Delete(&entity.KitComponente{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&componentes).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error setting kit componentes in database", zap.Error(err))
+	}
+	return err
+}
+
+// ProdutoTemVariacoes informa se o produto é pai de alguma variação
+func (repo *DBConnectionDBClient) ProdutoTemVariacoes(idProduto int, userID string) (bool, error) {
+	db := repo.getClientDB(userID)
+
+	var variacoes int64
+	if err := db.Model(&entity.Produto{}).Where("id_produto_pai = ?", idProduto).Count(&variacoes).Error; err != nil {
+		zap.L().Error("Error counting variacoes", zap.Error(err))
+		return false, err
+	}
+	return variacoes > 0, nil
+}
+
+// ProdutoEmKit informa se o produto é componente de algum kit
+func (repo *DBConnectionDBClient) ProdutoEmKit(idProduto int, userID string) (bool, error) {
+	db := repo.getClientDB(userID)
+
+	var kits int64
+	if err := db.Model(&entity.KitComponente{}).Where("id_produto = ?", idProduto).Count(&kits).Error; err != nil {
+		zap.L().Error("Error counting kits with produto", zap.Error(err))
+		return false, err
+	}
+	return kits > 0, nil
+}
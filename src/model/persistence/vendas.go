@@ -0,0 +1,311 @@
+package persistence
+
+import (
+	"errors"
+	"fmt"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FUNÇÕES DE VENDAS ------------------------------------------------------------------------------------------------------------------------------------
+
+// CreateVenda grava a venda e os itens numa única transação
+func (repo *DBConnectionDBClient) CreateVenda(venda *entity.Venda, itens []entity.ItemVenda, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Creating venda in the database", zap.Int("itens", len(itens)), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(venda).Error; err != nil {
+			return err
+		}
+		for i := range itens {
+			itens[i].IDVenda = venda.IDVenda
+		}
+		return tx.Create(&itens).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error creating venda in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully created venda", zap.Int("id", venda.IDVenda))
+	return nil
+}
+
+func (repo *DBConnectionDBClient) GetVendasPaginated(userID string, status string, idCliente int, limit, offset int) ([]entity.Venda, int, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting paginated vendas from database", zap.String("userID", userID), zap.String("status", status), zap.Int("id_cliente", idCliente), zap.Int("limit", limit), zap.Int("offset", offset))
+
+	var vendas []entity.Venda
+	var total int64
+
+	query := filtrarVendas(db.Model(&entity.Venda{}), status, idCliente)
+
+	// Contar total de registros
+	if err := query.Count(&total).Error; err != nil {
+		zap.L().Error("Error counting vendas", zap.Error(err))
+		return nil, 0, err
+	}
+
+	// Buscar registros paginados
+	err := query.Limit(limit).Offset(offset).Order("id_venda DESC").Find(&vendas).Error
+	if err != nil {
+		zap.L().Error("Error getting paginated vendas from database", zap.Error(err))
+		return nil, 0, err
+	}
+
+	zap.L().Info("Successfully retrieved paginated vendas", zap.Int("count", len(vendas)), zap.Int64("total", total))
+	return vendas, int(total), nil
+}
+
+func filtrarVendas(query *gorm.DB, status string, idCliente int) *gorm.DB {
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if idCliente > 0 {
+		query = query.Where("id_cliente = ?", idCliente)
+	}
+	return query
+}
+
+func (repo *DBConnectionDBClient) GetVendaByID(id int, userID string) (*entity.Venda, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting venda by ID from database", zap.Int("id", id), zap.String("userID", userID))
+
+	var venda entity.Venda
+	err := db.Where("id_venda = ?", id).First(&venda).Error
+	if err != nil {
+		zap.L().Error("Error getting venda by ID from database", zap.Error(err))
+		return nil, err
+	}
+	return &venda, nil
+}
+
+func (repo *DBConnectionDBClient) GetItensVenda(idVenda int, userID string) ([]entity.ItemVenda, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Getting itens venda from database", zap.Int("id_venda", idVenda), zap.String("userID", userID))
+
+	var itens []entity.ItemVenda
+	err := db.Where("id_venda = ?", idVenda).Order("id_item ASC").Find(&itens).Error
+	if err != nil {
+		zap.L().Error("Error getting itens venda from database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully retrieved itens venda", zap.Int("count", len(itens)))
+	return itens, nil
+}
+
+// AdicionarItemVenda grava o item e soma o subtotal dele aos totais da venda numa única transação.
+// Retorna gorm.ErrRecordNotFound se a venda não estiver mais aberta.
+func (repo *DBConnectionDBClient) AdicionarItemVenda(item *entity.ItemVenda, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Adding item to venda in the database", zap.Int("id_venda", item.IDVenda), zap.Int("id_produto", item.IDProduto), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Trava a venda para que o pagamento não aconteça entre a conferência do status e a gravação do item
+		var venda entity.Venda
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id_venda = ? AND status = ?", item.IDVenda, entity.VendaStatusAberta).
+			First(&venda).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&entity.Venda{}).Where("id_venda = ?", item.IDVenda).
+			Updates(map[string]interface{}{
+				"subtotal":    gorm.Expr("subtotal + ?", item.Subtotal),
+				"valor_total": gorm.Expr("valor_total + ?", item.Subtotal),
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(item).Error
+	})
+
+	if err != nil {
+		zap.L().Error("Error adding item to venda in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully added item to venda", zap.Int("id_item", item.IDItem))
+	return nil
+}
+
+// PagarVenda marca a venda como paga e baixa o estoque numa única transação.
+// Com movimentoCaixa, o pagamento também é lançado na sessão de caixa, que precisa estar aberta;
+// com debitoCredito, o valor é debitado do crédito na loja do cliente.
+// Retorna gorm.ErrRecordNotFound se a venda não estiver mais aberta, *EstoqueInsuficienteError se faltar saldo,
+// ErrCaixaFechado se a sessão de caixa foi fechada e ErrCreditoInsuficiente se faltar crédito.
+func (repo *DBConnectionDBClient) PagarVenda(idVenda int, formaPagamento string, saidas []entity.SaidaEstoque, dataPagamento string, movimentoCaixa *entity.CaixaMovimento, debitoCredito *entity.CreditoCliente, creditoPontos *entity.PontosCliente, userID string) ([]entity.MovimentacaoEstoque, error) {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Paying venda in the database", zap.Int("id_venda", idVenda), zap.String("forma_pagamento", formaPagamento), zap.Int("saidas", len(saidas)), zap.String("userID", userID))
+
+	var movimentacoes []entity.MovimentacaoEstoque
+	err := db.Transaction(func(tx *gorm.DB) error {
+		campos := map[string]interface{}{"status": entity.VendaStatusPaga, "forma_pagamento": formaPagamento, "data_pagamento": dataPagamento}
+		if movimentoCaixa != nil {
+			if err := travarCaixaAberto(tx, movimentoCaixa.IDSessao); err != nil {
+				return err
+			}
+			campos["id_caixa_sessao"] = movimentoCaixa.IDSessao
+		}
+
+		// Garante que apenas uma requisição consiga pagar a venda
+		result := tx.Model(&entity.Venda{}).
+			Where("id_venda = ? AND status = ?", idVenda, entity.VendaStatusAberta).
+			Updates(campos)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		var err error
+		movimentacoes, err = baixarLotes(tx, saidas, entity.MovimentacaoOrigemVenda, idVenda, fmt.Sprintf("Venda %d", idVenda), dataPagamento)
+		if err != nil {
+			return err
+		}
+
+		if debitoCredito != nil {
+			if err := debitarCredito(tx, debitoCredito); err != nil {
+				return err
+			}
+		}
+		if creditoPontos != nil {
+			if err := tx.Create(creditoPontos).Error; err != nil {
+				return err
+			}
+		}
+		if movimentoCaixa != nil {
+			return tx.Create(movimentoCaixa).Error
+		}
+		return nil
+	})
+
+	if err != nil {
+		zap.L().Error("Error paying venda in database", zap.Error(err))
+		return nil, err
+	}
+
+	zap.L().Info("Successfully paid venda", zap.Int("id_venda", idVenda), zap.Int("movimentacoes", len(movimentacoes)))
+	return movimentacoes, nil
+}
+
+// CancelarVenda cancela a venda que ainda está no status informado; se ela já estava paga, devolve aos lotes
+// as quantidades baixadas no pagamento, com movimentações de entrada, na mesma transação.
+// Com estornoCaixa, o estorno é lançado na sessão de caixa se ela ainda estiver aberta; com estornoCredito,
+// o valor volta ao crédito na loja do cliente.
+// Retorna gorm.ErrRecordNotFound se o status mudou nesse meio tempo ou se a venda recebeu uma devolução.
+func (repo *DBConnectionDBClient) CancelarVenda(idVenda int, statusAtual string, dataCancelamento string, estornoCaixa *entity.CaixaMovimento, estornoCredito *entity.CreditoCliente, estornoPontos *entity.PontosCliente, userID string) error {
+	db := repo.getClientDB(userID)
+
+	zap.L().Info("Canceling venda in the database", zap.Int("id_venda", idVenda), zap.String("status_atual", statusAtual), zap.String("userID", userID))
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Venda com devolução não pode ser cancelada: o estorno devolveria de novo as unidades já devolvidas
+		result := tx.Model(&entity.Venda{}).
+			Where("id_venda = ? AND status = ?", idVenda, statusAtual).
+			Where("NOT EXISTS (SELECT 1 FROM devolucoes WHERE devolucoes.id_venda = vendas.id_venda)").
+			Updates(map[string]interface{}{"status": entity.VendaStatusCancelada, "data_cancelamento": dataCancelamento})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if statusAtual != entity.VendaStatusPaga {
+			return nil
+		}
+		if err := estornarSaidas(tx, entity.MovimentacaoOrigemVenda, idVenda, fmt.Sprintf("Cancelamento da venda %d", idVenda), dataCancelamento); err != nil {
+			return err
+		}
+		if estornoCredito != nil {
+			if err := tx.Create(estornoCredito).Error; err != nil {
+				return err
+			}
+		}
+		if estornoPontos != nil {
+			// Venda com devolução não chega aqui, então o cancelamento estorna todos os pontos ganhos
+			if err := estornarPontosVenda(tx, estornoPontos, 0, 0); err != nil {
+				return err
+			}
+		}
+		return lancarEstornoCaixa(tx, estornoCaixa)
+	})
+
+	if err != nil {
+		zap.L().Error("Error canceling venda in database", zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("Successfully canceled venda", zap.Int("id_venda", idVenda))
+	return nil
+}
+
+// estornarSaidas devolve a cada lote o que saiu dele pela origem/referência, gravando uma entrada para cada saída
+func estornarSaidas(tx *gorm.DB, origem string, idReferencia int, observacao string, dataMovimento string) error {
+	var saidas []entity.MovimentacaoEstoque
+	err := tx.Where("origem = ? AND id_referencia = ? AND tipo = ?", origem, idReferencia, entity.MovimentacaoTipoSaida).
+		Order("id ASC").
+		Find(&saidas).Error
+	if err != nil {
+		return err
+	}
+
+	entradas := make([]entity.MovimentacaoEstoque, 0, len(saidas))
+	for _, saida := range saidas {
+		err := tx.Model(&entity.Estoque{}).
+			Where("id_estoque = ?", saida.IDEstoque).
+			Update("quantidade", gorm.Expr("quantidade + ?", -saida.Quantidade)).Error
+		if err != nil {
+			return err
+		}
+
+		entradas = append(entradas, entity.MovimentacaoEstoque{
+			IDEstoque:     saida.IDEstoque,
+			IDProduto:     saida.IDProduto,
+			IDLote:        saida.IDLote,
+			Tipo:          entity.MovimentacaoTipoEntrada,
+			Quantidade:    -saida.Quantidade,
+			CustoUnitario: saida.CustoUnitario,
+			Origem:        origem,
+			IDReferencia:  idReferencia,
+			DataMovimento: dataMovimento,
+			Observacao:    observacao,
+		})
+	}
+
+	if len(entradas) > 0 {
+		return tx.Create(&entradas).Error
+	}
+	return nil
+}
+
+// lancarEstornoCaixa grava o estorno na sessão de caixa da venda; se a sessão já foi fechada, o estorno não entra no caixa
+func lancarEstornoCaixa(tx *gorm.DB, estorno *entity.CaixaMovimento) error {
+	if estorno == nil {
+		return nil
+	}
+	err := travarCaixaAberto(tx, estorno.IDSessao)
+	if errors.Is(err, ErrCaixaFechado) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Create(estorno).Error
+}
@@ -0,0 +1,72 @@
+package agenda
+
+import (
+	"fmt"
+	"time"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+)
+
+const (
+	formatoDataHora = "2006-01-02 15:04:05"
+	formatoData     = "2006-01-02"
+)
+
+// ParseData lê uma data de campanha no horário local. Aceita data e hora, RFC3339 ou só a data.
+func ParseData(valor string) (time.Time, bool, error) {
+	if data, err := time.ParseInLocation(formatoDataHora, valor, time.Local); err == nil {
+		return data, false, nil
+	}
+	if data, err := time.Parse(time.RFC3339, valor); err == nil {
+		return data.In(time.Local), false, nil
+	}
+	if data, err := time.ParseInLocation(formatoData, valor, time.Local); err == nil {
+		return data, true, nil
+	}
+	return time.Time{}, false, fmt.Errorf("invalid date '%s'", valor)
+}
+
+// Periodo converte as datas de lançamento e fim da campanha. Um fim informado só com a data vale até o fim do dia.
+func Periodo(lancamento, fim string) (time.Time, time.Time, error) {
+	inicio, _, err := ParseData(lancamento)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("data_lancamento: %w", err)
+	}
+	termino, soData, err := ParseData(fim)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("data_fim: %w", err)
+	}
+	if soData {
+		termino = termino.AddDate(0, 0, 1)
+	}
+	if termino.Before(inicio) {
+		return time.Time{}, time.Time{}, fmt.Errorf("data_fim must be after data_lancamento")
+	}
+	return inicio, termino, nil
+}
+
+// StatusNoMomento calcula o status da campanha no instante informado. O status só avança: agendada vira ativa no
+// lançamento e agendada, ativa ou pausada viram finalizada no fim. Inativa e finalizada não mudam.
+func StatusNoMomento(status string, inicio, fim, agora time.Time) string {
+	switch status {
+	case entity.CampanhaStatusAgendada, entity.CampanhaStatusAtiva, entity.CampanhaStatusPausada:
+		if !agora.Before(fim) {
+			return entity.CampanhaStatusFinalizada
+		}
+		if status == entity.CampanhaStatusAgendada && !agora.Before(inicio) {
+			return entity.CampanhaStatusAtiva
+		}
+	}
+	return status
+}
+
+// Retomar retorna o status de uma campanha pausada que volta a rodar: agendada antes do lançamento, ativa durante
+// o período e finalizada depois do fim
+func Retomar(inicio, fim, agora time.Time) string {
+	return StatusNoMomento(entity.CampanhaStatusAgendada, inicio, fim, agora)
+}
+
+// PodePausar indica se a campanha pode ser pausada
+func PodePausar(status string) bool {
+	return status == entity.CampanhaStatusAgendada || status == entity.CampanhaStatusAtiva
+}
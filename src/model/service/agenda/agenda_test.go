@@ -0,0 +1,96 @@
+package agenda
+
+import (
+	"testing"
+	"time"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func data(valor string) time.Time {
+	d, _ := time.ParseInLocation("2006-01-02 15:04:05", valor, time.Local)
+	return d
+}
+
+func TestParseData(t *testing.T) {
+	tests := []struct {
+		name   string
+		valor  string
+		soData bool
+	}{
+		{"data e hora", "2025-03-01 10:00:00", false},
+		{"RFC3339", "2025-03-01T10:00:00-03:00", false},
+		{"só a data", "2025-03-01", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, soData, err := ParseData(tt.valor)
+			require.NoError(t, err)
+			assert.Equal(t, tt.soData, soData)
+		})
+	}
+
+	_, _, err := ParseData("01/03/2025")
+	assert.EqualError(t, err, "invalid date '01/03/2025'")
+}
+
+func TestPeriodo(t *testing.T) {
+	inicio, fim, err := Periodo("2025-03-01 10:00:00", "2025-03-10")
+	require.NoError(t, err)
+	assert.Equal(t, data("2025-03-01 10:00:00"), inicio)
+	assert.Equal(t, data("2025-03-11 00:00:00"), fim)
+
+	_, _, err = Periodo("2025-03-10", "2025-03-01 23:00:00")
+	assert.EqualError(t, err, "data_fim must be after data_lancamento")
+
+	_, _, err = Periodo("amanhã", "2025-03-01")
+	assert.ErrorContains(t, err, "data_lancamento")
+}
+
+func TestStatusNoMomento(t *testing.T) {
+	inicio := data("2025-03-01 10:00:00")
+	fim := data("2025-03-10 00:00:00")
+
+	tests := []struct {
+		name     string
+		status   string
+		agora    string
+		esperado string
+	}{
+		{"agendada antes do lançamento", entity.CampanhaStatusAgendada, "2025-03-01 09:59:59", entity.CampanhaStatusAgendada},
+		{"agendada no lançamento", entity.CampanhaStatusAgendada, "2025-03-01 10:00:00", entity.CampanhaStatusAtiva},
+		{"agendada depois do fim", entity.CampanhaStatusAgendada, "2025-03-12 00:00:00", entity.CampanhaStatusFinalizada},
+		{"ativa antes do lançamento não volta", entity.CampanhaStatusAtiva, "2025-02-01 00:00:00", entity.CampanhaStatusAtiva},
+		{"ativa no fim", entity.CampanhaStatusAtiva, "2025-03-10 00:00:00", entity.CampanhaStatusFinalizada},
+		{"pausada durante o período", entity.CampanhaStatusPausada, "2025-03-05 00:00:00", entity.CampanhaStatusPausada},
+		{"pausada depois do fim", entity.CampanhaStatusPausada, "2025-03-10 00:00:01", entity.CampanhaStatusFinalizada},
+		{"inativa não muda", entity.CampanhaStatusInativa, "2025-03-05 00:00:00", entity.CampanhaStatusInativa},
+		{"finalizada não muda", entity.CampanhaStatusFinalizada, "2025-03-05 00:00:00", entity.CampanhaStatusFinalizada},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.esperado, StatusNoMomento(tt.status, inicio, fim, data(tt.agora)))
+		})
+	}
+}
+
+func TestRetomar(t *testing.T) {
+	inicio := data("2025-03-01 10:00:00")
+	fim := data("2025-03-10 00:00:00")
+
+	assert.Equal(t, entity.CampanhaStatusAgendada, Retomar(inicio, fim, data("2025-02-20 00:00:00")))
+	assert.Equal(t, entity.CampanhaStatusAtiva, Retomar(inicio, fim, data("2025-03-05 00:00:00")))
+	assert.Equal(t, entity.CampanhaStatusFinalizada, Retomar(inicio, fim, data("2025-03-20 00:00:00")))
+}
+
+func TestPodePausar(t *testing.T) {
+	assert.True(t, PodePausar(entity.CampanhaStatusAgendada))
+	assert.True(t, PodePausar(entity.CampanhaStatusAtiva))
+	assert.False(t, PodePausar(entity.CampanhaStatusPausada))
+	assert.False(t, PodePausar(entity.CampanhaStatusFinalizada))
+	assert.False(t, PodePausar(entity.CampanhaStatusInativa))
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// DiasVencimentoPadrao é a janela usada quando nenhum valor é informado para a verificação de vencimentos
+const DiasVencimentoPadrao = 30
+
+// EventoAlertasEstoque é o nome do evento enviado ao notifier quando novos alertas são gerados
+const EventoAlertasEstoque = "alertas_estoque"
+
+// FUNÇÕES DE ALERTAS DE ESTOQUE ------------------------------------------------------------------------------------------------------------------------------------
+
+func (srv *Service) GetAlertasEstoqueService(userID string, tipo, status string, page, limit int) (*dtos.AlertaEstoqueListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get alertas estoque service", zap.String("tipo", tipo), zap.String("status", status), zap.Int("page", page), zap.Int("limit", limit))
+
+	if restErr := validarFiltrosAlertas(tipo, status); restErr != nil {
+		return nil, restErr
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	alertas, total, dbErr := srv.dbClient.GetAlertasEstoquePaginated(userID, tipo, status, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting alertas estoque from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	response := &dtos.AlertaEstoqueListResponse{
+		Alertas:    buildAlertasEstoqueResponse(alertas),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+
+	zap.L().Info("Alertas estoque service completed successfully", zap.Int("total", total))
+	return response, nil
+}
+
+// GerarAlertasEstoqueService verifica lotes vencendo e produtos abaixo do mínimo, grava os alertas novos e dispara a notificação
+func (srv *Service) GerarAlertasEstoqueService(userID string, dias int) (*dtos.GerarAlertasResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting gerar alertas estoque service", zap.String("userID", userID), zap.Int("dias", dias))
+
+	if dias < 0 {
+		return nil, exceptions.NewBadRequestError("dias must be greater than or equal to zero")
+	}
+	if dias == 0 {
+		dias = DiasVencimentoPadrao
+	}
+
+	lotes, dbErr := srv.dbClient.GetLotesVencendo(userID, dias)
+	if dbErr != nil {
+		zap.L().Error("Error getting lotes vencendo", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	produtos, dbErr := srv.dbClient.GetProdutosAbaixoMinimo(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting produtos abaixo do minimo", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	agora := time.Now().Format("2006-01-02 15:04:05")
+
+	var alertas []entity.AlertaEstoque
+	for _, lote := range lotes {
+		vencimento := lote.Vencimento
+		alertas = append(alertas, entity.AlertaEstoque{
+			Tipo:           entity.AlertaTipoVencimento,
+			IDProduto:      lote.IDProduto,
+			IDLote:         lote.IDLote,
+			NomeProduto:    lote.NomeProduto,
+			Quantidade:     lote.Quantidade,
+			Vencimento:     &vencimento,
+			DiasParaVencer: lote.DiasParaVencer,
+			Mensagem:       mensagemVencimento(lote),
+			DataGeracao:    agora,
+			Status:         entity.AlertaStatusPendente,
+		})
+	}
+	for _, produto := range produtos {
+		alertas = append(alertas, entity.AlertaEstoque{
+			Tipo:          entity.AlertaTipoEstoqueBaixo,
+			IDProduto:     produto.IDProduto,
+			NomeProduto:   produto.NomeProduto,
+			Quantidade:    produto.Quantidade,
+			EstoqueMinimo: produto.EstoqueMinimo,
+			Mensagem:      fmt.Sprintf("Estoque de %s abaixo do mínimo: %d de %d unidades", produto.NomeProduto, produto.Quantidade, produto.EstoqueMinimo),
+			DataGeracao:   agora,
+			Status:        entity.AlertaStatusPendente,
+		})
+	}
+
+	novos, dbErr := srv.dbClient.CreateAlertasEstoque(alertas, userID)
+	if dbErr != nil {
+		zap.L().Error("Error creating alertas estoque", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.GerarAlertasResponse{
+		DiasVencimento:       dias,
+		LotesVencendo:        len(lotes),
+		ProdutosAbaixoMinimo: len(produtos),
+		AlertasCriados:       len(novos),
+		NovosAlertas:         buildAlertasEstoqueResponse(novos),
+	}
+
+	// A notificação é opcional e não deve impedir a geração dos alertas
+	if len(novos) > 0 && srv.notifier != nil {
+		payload := map[string]interface{}{
+			"tenant":  userID,
+			"alertas": response.NovosAlertas,
+		}
+		if err := srv.notifier.Notify(ctx, EventoAlertasEstoque, payload); err != nil {
+			zap.L().Warn("Failed to notify alertas estoque", zap.String("userID", userID), zap.Error(err))
+		}
+	}
+
+	zap.L().Info("Gerar alertas estoque service completed successfully", zap.Int("lotes_vencendo", len(lotes)), zap.Int("produtos_abaixo_minimo", len(produtos)), zap.Int("novos", len(novos)))
+	return response, nil
+}
+
+func (srv *Service) ResolverAlertaEstoqueService(userID string, id string) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting resolver alerta estoque service", zap.String("id", id))
+
+	dbErr := srv.dbClient.ResolverAlertaEstoque(id, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return false, exceptions.NewNotFoundError("Alerta not found")
+		}
+		zap.L().Error("Error resolving alerta estoque in database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Alerta estoque resolved successfully", zap.String("id", id))
+	return true, nil
+}
+
+func (srv *Service) UpdateEstoqueMinimoService(userID string, id string, request dtos.UpdateEstoqueMinimoRequest) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting update estoque minimo service", zap.String("id", id), zap.Int("estoque_minimo", request.EstoqueMinimo))
+
+	if _, dbErr := srv.dbClient.GetProductByID(id, userID); dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return false, exceptions.NewNotFoundError("Product not found")
+		}
+		zap.L().Error("Error getting product by ID", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	dbErr := srv.dbClient.UpdateEstoqueMinimo(id, request.EstoqueMinimo, userID)
+	if dbErr != nil {
+		zap.L().Error("Error updating estoque minimo in database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Estoque minimo updated successfully", zap.String("id", id))
+	return true, nil
+}
+
+// ExecutarAlertasEstoqueJob roda a geração de alertas para todos os tenants; usado pelo job diário
+func (srv *Service) ExecutarAlertasEstoqueJob(dias int) {
+	for _, userID := range srv.dbClient.GetClientIDs() {
+		if _, err := srv.GerarAlertasEstoqueService(userID, dias); err != nil {
+			zap.L().Error("Error running alertas estoque job", zap.String("userID", userID), zap.String("error", err.Error()))
+		}
+	}
+}
+
+func validarFiltrosAlertas(tipo, status string) *exceptions.RestErr {
+	if tipo != "" && tipo != entity.AlertaTipoVencimento && tipo != entity.AlertaTipoEstoqueBaixo {
+		return exceptions.NewBadRequestError("Invalid tipo, expected 'vencimento' or 'estoque_baixo'")
+	}
+	if status != "" && status != entity.AlertaStatusPendente && status != entity.AlertaStatusResolvido {
+		return exceptions.NewBadRequestError("Invalid status, expected 'pendente' or 'resolvido'")
+	}
+	return nil
+}
+
+func buildAlertasEstoqueResponse(alertas []entity.AlertaEstoque) []dtos.AlertaEstoqueResponse {
+	response := make([]dtos.AlertaEstoqueResponse, 0, len(alertas))
+	for _, alerta := range alertas {
+		var vencimento string
+		if alerta.Vencimento != nil {
+			vencimento = *alerta.Vencimento
+		}
+		response = append(response, dtos.AlertaEstoqueResponse{
+			ID:             alerta.ID,
+			Tipo:           alerta.Tipo,
+			IDProduto:      alerta.IDProduto,
+			IDLote:         alerta.IDLote,
+			NomeProduto:    alerta.NomeProduto,
+			Quantidade:     alerta.Quantidade,
+			EstoqueMinimo:  alerta.EstoqueMinimo,
+			Vencimento:     vencimento,
+			DiasParaVencer: alerta.DiasParaVencer,
+			Mensagem:       alerta.Mensagem,
+			DataGeracao:    alerta.DataGeracao,
+			Status:         alerta.Status,
+		})
+	}
+	return response
+}
+
+func mensagemVencimento(lote entity.LoteVencendo) string {
+	if lote.DiasParaVencer < 0 {
+		return fmt.Sprintf("Lote %d de %s vencido há %d dia(s) com %d unidades em estoque", lote.IDLote, lote.NomeProduto, -lote.DiasParaVencer, lote.Quantidade)
+	}
+	if lote.DiasParaVencer == 0 {
+		return fmt.Sprintf("Lote %d de %s vence hoje com %d unidades em estoque", lote.IDLote, lote.NomeProduto, lote.Quantidade)
+	}
+	return fmt.Sprintf("Lote %d de %s vence em %d dia(s) com %d unidades em estoque", lote.IDLote, lote.NomeProduto, lote.DiasParaVencer, lote.Quantidade)
+}
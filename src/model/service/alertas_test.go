@@ -0,0 +1,265 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// TESTES PARA GerarAlertasEstoqueService
+func TestService_GerarAlertasEstoqueService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	mockNotifier := new(MockNotifier)
+
+	lotes := []entity.LoteVencendo{
+		{IDProduto: 1, IDLote: 10, NomeProduto: "Ração", Quantidade: 5, Vencimento: "2025-03-10", DiasParaVencer: 3},
+	}
+	produtos := []entity.ProdutoAbaixoMinimo{
+		{IDProduto: 2, NomeProduto: "Areia", Quantidade: 1, EstoqueMinimo: 4},
+	}
+
+	mockDBClient.On("GetLotesVencendo", "1", DiasVencimentoPadrao).Return(lotes, nil)
+	mockDBClient.On("GetProdutosAbaixoMinimo", "1").Return(produtos, nil)
+	mockDBClient.On("CreateAlertasEstoque", mock.MatchedBy(func(alertas []entity.AlertaEstoque) bool {
+		return len(alertas) == 2 &&
+			alertas[0].Tipo == entity.AlertaTipoVencimento && alertas[0].IDLote == 10 && *alertas[0].Vencimento == "2025-03-10" &&
+			alertas[1].Tipo == entity.AlertaTipoEstoqueBaixo && alertas[1].EstoqueMinimo == 4
+	}), "1").Return(func(alertas []entity.AlertaEstoque, _ string) []entity.AlertaEstoque {
+		return alertas[:1]
+	}, nil)
+	mockNotifier.On("Notify", mock.Anything, EventoAlertasEstoque, mock.Anything).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+		notifier: mockNotifier,
+	}
+
+	// Act
+	result, err := service.GerarAlertasEstoqueService("1", 0)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, DiasVencimentoPadrao, result.DiasVencimento)
+	assert.Equal(t, 1, result.LotesVencendo)
+	assert.Equal(t, 1, result.ProdutosAbaixoMinimo)
+	assert.Equal(t, 1, result.AlertasCriados)
+	assert.Equal(t, "Lote 10 de Ração vence em 3 dia(s) com 5 unidades em estoque", result.NovosAlertas[0].Mensagem)
+
+	mockDBClient.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestService_GerarAlertasEstoqueService_NotifierErrorIgnored(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	mockNotifier := new(MockNotifier)
+
+	produtos := []entity.ProdutoAbaixoMinimo{
+		{IDProduto: 2, NomeProduto: "Areia", Quantidade: 1, EstoqueMinimo: 4},
+	}
+
+	mockDBClient.On("GetLotesVencendo", "1", 7).Return([]entity.LoteVencendo{}, nil)
+	mockDBClient.On("GetProdutosAbaixoMinimo", "1").Return(produtos, nil)
+	mockDBClient.On("CreateAlertasEstoque", mock.Anything, "1").Return([]entity.AlertaEstoque{{ID: 1, Tipo: entity.AlertaTipoEstoqueBaixo}}, nil)
+	mockNotifier.On("Notify", mock.Anything, EventoAlertasEstoque, mock.Anything).Return(errors.New("webhook down"))
+
+	service := &Service{
+		dbClient: mockDBClient,
+		notifier: mockNotifier,
+	}
+
+	// Act
+	result, err := service.GerarAlertasEstoqueService("1", 7)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.AlertasCriados)
+
+	mockDBClient.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestService_GerarAlertasEstoqueService_InvalidDias(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GerarAlertasEstoqueService("1", -1)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_GerarAlertasEstoqueService_DBError(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetLotesVencendo", "1", DiasVencimentoPadrao).Return(nil, errors.New("database error"))
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GerarAlertasEstoqueService("1", 0)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Internal server error", err.Message)
+	assert.Equal(t, 500, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA GetAlertasEstoqueService
+func TestService_GetAlertasEstoqueService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	vencimento := "2025-03-10"
+	alertas := []entity.AlertaEstoque{
+		{ID: 1, Tipo: entity.AlertaTipoVencimento, Vencimento: &vencimento, Status: entity.AlertaStatusPendente},
+		{ID: 2, Tipo: entity.AlertaTipoEstoqueBaixo, Status: entity.AlertaStatusPendente},
+	}
+
+	mockDBClient.On("GetAlertasEstoquePaginated", "1", "", entity.AlertaStatusPendente, 30, 0).Return(alertas, 2, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetAlertasEstoqueService("1", "", entity.AlertaStatusPendente, 0, 0)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, result.Alertas, 2)
+	assert.Equal(t, "2025-03-10", result.Alertas[0].Vencimento)
+	assert.Equal(t, "", result.Alertas[1].Vencimento)
+	assert.Equal(t, 1, result.Page)
+	assert.Equal(t, 1, result.TotalPages)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_GetAlertasEstoqueService_InvalidTipo(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetAlertasEstoqueService("1", "validade", "", 1, 30)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA ResolverAlertaEstoqueService
+func TestService_ResolverAlertaEstoqueService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("ResolverAlertaEstoque", "5", "1").Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.ResolverAlertaEstoqueService("1", "5")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, success)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_ResolverAlertaEstoqueService_NotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("ResolverAlertaEstoque", "5", "1").Return(gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.ResolverAlertaEstoqueService("1", "5")
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Alerta not found", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA UpdateEstoqueMinimoService
+func TestService_UpdateEstoqueMinimoService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(&entity.Produto{IDProduto: 3}, nil)
+	mockDBClient.On("UpdateEstoqueMinimo", "3", 8, "1").Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.UpdateEstoqueMinimoService("1", "3", dtos.UpdateEstoqueMinimoRequest{EstoqueMinimo: 8})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, success)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_UpdateEstoqueMinimoService_ProductNotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(nil, gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.UpdateEstoqueMinimoService("1", "3", dtos.UpdateEstoqueMinimoRequest{EstoqueMinimo: 8})
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Product not found", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
@@ -0,0 +1,112 @@
+package caixa
+
+import (
+	"math"
+	"sort"
+)
+
+// Tipos de movimento de caixa; todos os valores são gravados positivos e o tipo define o sentido
+const (
+	TipoVenda      = "venda"
+	TipoEstorno    = "estorno"
+	TipoSangria    = "sangria"
+	TipoSuprimento = "suprimento"
+)
+
+// FormaDinheiro é a única forma de pagamento que entra na gaveta e é conferida no fechamento
+const FormaDinheiro = "dinheiro"
+
+// Movimento é um lançamento da sessão de caixa
+type Movimento struct {
+	Tipo           string
+	FormaPagamento string
+	Valor          float64
+}
+
+// TotalForma soma as vendas e os estornos de uma forma de pagamento
+type TotalForma struct {
+	FormaPagamento string
+	Vendas         int
+	ValorVendas    float64
+	ValorEstornos  float64
+	ValorLiquido   float64
+}
+
+// Resumo da sessão, com valores arredondados para centavos
+type Resumo struct {
+	Formas           []TotalForma // em ordem alfabética da forma de pagamento
+	Vendas           int
+	ValorVendas      float64
+	ValorEstornos    float64
+	Sangrias         float64
+	Suprimentos      float64
+	DinheiroEsperado float64 // abertura + vendas em dinheiro + suprimentos − sangrias − estornos em dinheiro
+}
+
+// Resumir totaliza os movimentos por forma de pagamento e calcula o dinheiro esperado na gaveta
+func Resumir(valorAbertura float64, movimentos []Movimento) Resumo {
+	var resumo Resumo
+	porForma := map[string]*TotalForma{}
+	forma := func(nome string) *TotalForma {
+		total, ok := porForma[nome]
+		if !ok {
+			total = &TotalForma{FormaPagamento: nome}
+			porForma[nome] = total
+		}
+		return total
+	}
+
+	dinheiro := valorAbertura
+	for _, movimento := range movimentos {
+		switch movimento.Tipo {
+		case TipoVenda:
+			total := forma(movimento.FormaPagamento)
+			total.Vendas++
+			total.ValorVendas += movimento.Valor
+			resumo.Vendas++
+			resumo.ValorVendas += movimento.Valor
+			if movimento.FormaPagamento == FormaDinheiro {
+				dinheiro += movimento.Valor
+			}
+		case TipoEstorno:
+			forma(movimento.FormaPagamento).ValorEstornos += movimento.Valor
+			resumo.ValorEstornos += movimento.Valor
+			if movimento.FormaPagamento == FormaDinheiro {
+				dinheiro -= movimento.Valor
+			}
+		case TipoSangria:
+			resumo.Sangrias += movimento.Valor
+			dinheiro -= movimento.Valor
+		case TipoSuprimento:
+			resumo.Suprimentos += movimento.Valor
+			dinheiro += movimento.Valor
+		}
+	}
+
+	resumo.Formas = make([]TotalForma, 0, len(porForma))
+	for _, total := range porForma {
+		total.ValorVendas = arredondar(total.ValorVendas)
+		total.ValorEstornos = arredondar(total.ValorEstornos)
+		total.ValorLiquido = arredondar(total.ValorVendas - total.ValorEstornos)
+		resumo.Formas = append(resumo.Formas, *total)
+	}
+	sort.Slice(resumo.Formas, func(i, j int) bool {
+		return resumo.Formas[i].FormaPagamento < resumo.Formas[j].FormaPagamento
+	})
+
+	resumo.ValorVendas = arredondar(resumo.ValorVendas)
+	resumo.ValorEstornos = arredondar(resumo.ValorEstornos)
+	resumo.Sangrias = arredondar(resumo.Sangrias)
+	resumo.Suprimentos = arredondar(resumo.Suprimentos)
+	resumo.DinheiroEsperado = arredondar(dinheiro)
+	return resumo
+}
+
+// Diferenca é o contado menos o esperado: positiva é sobra, negativa é falta
+func Diferenca(contado, esperado float64) float64 {
+	return arredondar(contado - esperado)
+}
+
+func arredondar(valor float64) float64 {
+	return math.Round(valor*100) / 100
+}
@@ -0,0 +1,47 @@
+package caixa
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumir(t *testing.T) {
+	movimentos := []Movimento{
+		{Tipo: TipoVenda, FormaPagamento: "pix", Valor: 129.9},
+		{Tipo: TipoVenda, FormaPagamento: FormaDinheiro, Valor: 45.5},
+		{Tipo: TipoVenda, FormaPagamento: FormaDinheiro, Valor: 20},
+		{Tipo: TipoEstorno, FormaPagamento: FormaDinheiro, Valor: 20},
+		{Tipo: TipoSuprimento, Valor: 50},
+		{Tipo: TipoSangria, Valor: 100},
+	}
+
+	resumo := Resumir(200, movimentos)
+
+	assert.Equal(t, 3, resumo.Vendas)
+	assert.Equal(t, 195.4, resumo.ValorVendas)
+	assert.Equal(t, 20.0, resumo.ValorEstornos)
+	assert.Equal(t, 100.0, resumo.Sangrias)
+	assert.Equal(t, 50.0, resumo.Suprimentos)
+	// 200 + 45,50 + 20 − 20 + 50 − 100
+	assert.Equal(t, 195.5, resumo.DinheiroEsperado)
+
+	assert.Equal(t, []TotalForma{
+		{FormaPagamento: FormaDinheiro, Vendas: 2, ValorVendas: 65.5, ValorEstornos: 20, ValorLiquido: 45.5},
+		{FormaPagamento: "pix", Vendas: 1, ValorVendas: 129.9, ValorLiquido: 129.9},
+	}, resumo.Formas)
+}
+
+func TestResumir_SemMovimentos(t *testing.T) {
+	resumo := Resumir(150, nil)
+
+	assert.Equal(t, 150.0, resumo.DinheiroEsperado)
+	assert.Empty(t, resumo.Formas)
+	assert.Equal(t, 0, resumo.Vendas)
+}
+
+func TestDiferenca(t *testing.T) {
+	assert.Equal(t, -0.3, Diferenca(195.2, 195.5))
+	assert.Equal(t, 10.0, Diferenca(205.5, 195.5))
+	assert.Equal(t, 0.0, Diferenca(195.5, 195.5))
+}
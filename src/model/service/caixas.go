@@ -0,0 +1,372 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/betine97/back-project.git/src/model/service/caixa"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE CAIXA ------------------------------------------------------------------------------------------------------------------------------------
+
+// AbrirCaixaService abre uma sessão de caixa para o operador com o troco inicial
+func (srv *Service) AbrirCaixaService(userID string, request dtos.AbrirCaixaRequest) (*dtos.CaixaSessaoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting abrir caixa service", zap.String("operador", request.Operador), zap.String("identificacao", request.Identificacao), zap.Float64("valor_abertura", request.ValorAbertura))
+
+	sessao := &entity.CaixaSessao{
+		Operador:      request.Operador,
+		Identificacao: request.Identificacao,
+		Status:        entity.CaixaStatusAberto,
+		ValorAbertura: request.ValorAbertura,
+		DataAbertura:  time.Now().Format(formatoDataHora),
+	}
+
+	if dbErr := srv.dbClient.AbrirCaixaSessao(sessao, userID); dbErr != nil {
+		if errors.Is(dbErr, persistence.ErrCaixaJaAberto) {
+			return nil, exceptions.NewConflictError("There is already an open caixa for this operador")
+		}
+		zap.L().Error("Error opening caixa in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := buildCaixaSessaoResponse(*sessao)
+
+	zap.L().Info("Caixa opened successfully", zap.Int("id", sessao.ID))
+	return &response, nil
+}
+
+func (srv *Service) GetAllCaixasService(userID string, status string, page, limit int) (*dtos.CaixaSessaoListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get all caixas service", zap.String("status", status), zap.Int("page", page), zap.Int("limit", limit))
+
+	if restErr := validarStatusCaixa(status); restErr != nil {
+		return nil, restErr
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	sessoes, total, dbErr := srv.dbClient.GetCaixaSessoesPaginated(userID, status, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting caixas from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	caixaResponses := make([]dtos.CaixaSessaoResponse, len(sessoes))
+	for i, sessao := range sessoes {
+		caixaResponses[i] = buildCaixaSessaoResponse(sessao)
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	response := &dtos.CaixaSessaoListResponse{
+		Caixas:     caixaResponses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+
+	zap.L().Info("Caixas service completed successfully", zap.Int("total", total))
+	return response, nil
+}
+
+func validarStatusCaixa(status string) *exceptions.RestErr {
+	if status != "" && status != entity.CaixaStatusAberto && status != entity.CaixaStatusFechado {
+		return exceptions.NewBadRequestError("Invalid status, expected 'aberto' or 'fechado'")
+	}
+	return nil
+}
+
+// GetCaixaAtualService retorna a sessão aberta do operador
+func (srv *Service) GetCaixaAtualService(userID string, operador string) (*dtos.CaixaSessaoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get caixa atual service", zap.String("operador", operador))
+
+	if operador == "" {
+		return nil, exceptions.NewBadRequestError("operador is required")
+	}
+
+	sessao, dbErr := srv.dbClient.GetCaixaSessaoAberta(operador, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("No open caixa for this operador")
+		}
+		zap.L().Error("Error getting open caixa from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := buildCaixaSessaoResponse(*sessao)
+
+	zap.L().Info("Successfully retrieved caixa atual", zap.Int("id", sessao.ID))
+	return &response, nil
+}
+
+func (srv *Service) GetCaixaByIDService(userID string, id string) (*dtos.CaixaSessaoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get caixa by ID service", zap.String("id", id))
+
+	sessao, restErr := srv.getCaixaSessao(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := buildCaixaSessaoResponse(*sessao)
+
+	zap.L().Info("Successfully retrieved caixa by ID", zap.String("id", id))
+	return &response, nil
+}
+
+func (srv *Service) GetCaixaMovimentosService(userID string, id string) (*dtos.CaixaMovimentoListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get caixa movimentos service", zap.String("id", id))
+
+	sessao, restErr := srv.getCaixaSessao(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	movimentos, restErr := srv.getCaixaMovimentos(userID, sessao.ID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	movimentoResponses := make([]dtos.CaixaMovimentoResponse, len(movimentos))
+	for i, movimento := range movimentos {
+		movimentoResponses[i] = buildCaixaMovimentoResponse(movimento)
+	}
+
+	response := &dtos.CaixaMovimentoListResponse{
+		IDSessao:   sessao.ID,
+		Movimentos: movimentoResponses,
+		Total:      len(movimentos),
+	}
+
+	zap.L().Info("Caixa movimentos service completed successfully", zap.Int("total", response.Total))
+	return response, nil
+}
+
+// RegistrarMovimentoCaixaService lança uma sangria (retirada) ou um suprimento (reforço de troco) na sessão aberta.
+// A sangria não pode ser maior que o dinheiro esperado na gaveta.
+func (srv *Service) RegistrarMovimentoCaixaService(userID string, id string, tipo string, request dtos.MovimentoCaixaRequest) (*dtos.CaixaMovimentoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting registrar movimento caixa service", zap.String("id", id), zap.String("tipo", tipo), zap.Float64("valor", request.Valor))
+
+	if tipo != entity.CaixaMovimentoSangria && tipo != entity.CaixaMovimentoSuprimento {
+		return nil, exceptions.NewBadRequestError("Invalid tipo, expected 'sangria' or 'suprimento'")
+	}
+
+	sessao, restErr := srv.getCaixaSessao(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if sessao.Status != entity.CaixaStatusAberto {
+		return nil, exceptions.NewConflictError("Caixa is not open")
+	}
+
+	movimentos, restErr := srv.getCaixaMovimentos(userID, sessao.ID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	if tipo == entity.CaixaMovimentoSangria {
+		resumo := resumirCaixa(*sessao, movimentos)
+		if request.Valor > resumo.DinheiroEsperado {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Sangria of %.2f exceeds the cash in the caixa (%.2f)", request.Valor, resumo.DinheiroEsperado))
+		}
+	}
+
+	movimento := &entity.CaixaMovimento{
+		IDSessao:       sessao.ID,
+		Tipo:           tipo,
+		FormaPagamento: caixa.FormaDinheiro,
+		Valor:          request.Valor,
+		Descricao:      request.Descricao,
+		DataMovimento:  time.Now().Format(formatoDataHora),
+	}
+
+	if dbErr := srv.dbClient.RegistrarMovimentoCaixa(movimento, len(movimentos), userID); dbErr != nil {
+		return nil, erroCaixa(dbErr)
+	}
+
+	response := buildCaixaMovimentoResponse(*movimento)
+
+	zap.L().Info("Movimento caixa registered successfully", zap.Int("id", movimento.ID), zap.String("tipo", tipo))
+	return &response, nil
+}
+
+// FecharCaixaService fecha a sessão com o valor contado na gaveta e devolve o relatório de fechamento
+func (srv *Service) FecharCaixaService(userID string, id string, request dtos.FecharCaixaRequest) (*dtos.RelatorioCaixaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting fechar caixa service", zap.String("id", id))
+
+	sessao, restErr := srv.getCaixaSessao(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if sessao.Status != entity.CaixaStatusAberto {
+		return nil, exceptions.NewConflictError("Caixa is not open")
+	}
+	if request.ValorContado == nil {
+		return nil, exceptions.NewBadRequestError("valor_contado is required")
+	}
+
+	movimentos, restErr := srv.getCaixaMovimentos(userID, sessao.ID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	resumo := resumirCaixa(*sessao, movimentos)
+	esperado := resumo.DinheiroEsperado
+	contado := *request.ValorContado
+	diferenca := caixa.Diferenca(contado, esperado)
+	dataFechamento := time.Now().Format(formatoDataHora)
+
+	sessao.ValorEsperado = &esperado
+	sessao.ValorContado = &contado
+	sessao.Diferenca = &diferenca
+	sessao.DataFechamento = &dataFechamento
+	sessao.ObservacaoFechamento = request.Observacao
+
+	if dbErr := srv.dbClient.FecharCaixaSessao(sessao, len(movimentos), userID); dbErr != nil {
+		return nil, erroCaixa(dbErr)
+	}
+
+	response := buildRelatorioCaixaResponse(*sessao, resumo)
+
+	zap.L().Info("Caixa closed successfully", zap.Int("id", sessao.ID), zap.Float64("esperado", esperado), zap.Float64("contado", contado), zap.Float64("diferenca", diferenca))
+	return &response, nil
+}
+
+// GetRelatorioCaixaService totaliza a sessão por forma de pagamento; na sessão aberta é um relatório parcial
+func (srv *Service) GetRelatorioCaixaService(userID string, id string) (*dtos.RelatorioCaixaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get relatorio caixa service", zap.String("id", id))
+
+	sessao, restErr := srv.getCaixaSessao(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	movimentos, restErr := srv.getCaixaMovimentos(userID, sessao.ID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := buildRelatorioCaixaResponse(*sessao, resumirCaixa(*sessao, movimentos))
+
+	zap.L().Info("Relatorio caixa service completed successfully", zap.Int("id", sessao.ID), zap.Int("movimentos", len(movimentos)))
+	return &response, nil
+}
+
+func (srv *Service) getCaixaSessao(userID string, id string) (*entity.CaixaSessao, *exceptions.RestErr) {
+	idInt := 0
+	if _, err := fmt.Sscanf(id, "%d", &idInt); err != nil {
+		zap.L().Error("Error converting caixa id to int", zap.Error(err))
+		return nil, exceptions.NewBadRequestError("Invalid caixa ID")
+	}
+
+	sessao, dbErr := srv.dbClient.GetCaixaSessaoByID(idInt, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Caixa not found")
+		}
+		zap.L().Error("Error getting caixa by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	return sessao, nil
+}
+
+func (srv *Service) getCaixaMovimentos(userID string, idSessao int) ([]entity.CaixaMovimento, *exceptions.RestErr) {
+	movimentos, dbErr := srv.dbClient.GetCaixaMovimentos(idSessao, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting caixa movimentos from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	return movimentos, nil
+}
+
+// erroCaixa traduz os erros de gravação na sessão de caixa
+func erroCaixa(dbErr error) *exceptions.RestErr {
+	switch {
+	case errors.Is(dbErr, persistence.ErrCaixaFechado):
+		return exceptions.NewConflictError("Caixa is not open")
+	case errors.Is(dbErr, persistence.ErrCaixaAlterado):
+		return exceptions.NewConflictError("Caixa received new movimentos, try again")
+	}
+	zap.L().Error("Error writing caixa in database", zap.Error(dbErr))
+	return exceptions.NewInternalServerError("Internal server error")
+}
+
+func resumirCaixa(sessao entity.CaixaSessao, movimentos []entity.CaixaMovimento) caixa.Resumo {
+	lancamentos := make([]caixa.Movimento, len(movimentos))
+	for i, movimento := range movimentos {
+		lancamentos[i] = caixa.Movimento{Tipo: movimento.Tipo, FormaPagamento: movimento.FormaPagamento, Valor: movimento.Valor}
+	}
+	return caixa.Resumir(sessao.ValorAbertura, lancamentos)
+}
+
+func buildCaixaSessaoResponse(sessao entity.CaixaSessao) dtos.CaixaSessaoResponse {
+	response := dtos.CaixaSessaoResponse{
+		ID:                   sessao.ID,
+		Operador:             sessao.Operador,
+		Identificacao:        sessao.Identificacao,
+		Status:               sessao.Status,
+		ValorAbertura:        sessao.ValorAbertura,
+		ValorEsperado:        sessao.ValorEsperado,
+		ValorContado:         sessao.ValorContado,
+		Diferenca:            sessao.Diferenca,
+		DataAbertura:         sessao.DataAbertura,
+		ObservacaoFechamento: sessao.ObservacaoFechamento,
+	}
+	if sessao.DataFechamento != nil {
+		response.DataFechamento = *sessao.DataFechamento
+	}
+	return response
+}
+
+func buildCaixaMovimentoResponse(movimento entity.CaixaMovimento) dtos.CaixaMovimentoResponse {
+	return dtos.CaixaMovimentoResponse{
+		ID:             movimento.ID,
+		Tipo:           movimento.Tipo,
+		FormaPagamento: movimento.FormaPagamento,
+		Valor:          movimento.Valor,
+		IDVenda:        movimento.IDVenda,
+		Descricao:      movimento.Descricao,
+		DataMovimento:  movimento.DataMovimento,
+	}
+}
+
+func buildRelatorioCaixaResponse(sessao entity.CaixaSessao, resumo caixa.Resumo) dtos.RelatorioCaixaResponse {
+	formas := make([]dtos.TotalFormaCaixaResponse, len(resumo.Formas))
+	for i, forma := range resumo.Formas {
+		formas[i] = dtos.TotalFormaCaixaResponse{
+			FormaPagamento:   forma.FormaPagamento,
+			QuantidadeVendas: forma.Vendas,
+			ValorVendas:      forma.ValorVendas,
+			ValorEstornos:    forma.ValorEstornos,
+			ValorLiquido:     forma.ValorLiquido,
+		}
+	}
+
+	return dtos.RelatorioCaixaResponse{
+		Caixa:            buildCaixaSessaoResponse(sessao),
+		FormasPagamento:  formas,
+		QuantidadeVendas: resumo.Vendas,
+		TotalVendas:      resumo.ValorVendas,
+		TotalEstornos:    resumo.ValorEstornos,
+		TotalSangrias:    resumo.Sangrias,
+		TotalSuprimentos: resumo.Suprimentos,
+		DinheiroEsperado: resumo.DinheiroEsperado,
+		ValorContado:     sessao.ValorContado,
+		Diferenca:        sessao.Diferenca,
+	}
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func caixaAberto() *entity.CaixaSessao {
+	return &entity.CaixaSessao{ID: 2, Operador: "ana", Status: entity.CaixaStatusAberto, ValorAbertura: 100, DataAbertura: "2025-03-01 08:00:00"}
+}
+
+func movimentosCaixa() []entity.CaixaMovimento {
+	return []entity.CaixaMovimento{
+		{ID: 1, IDSessao: 2, Tipo: entity.CaixaMovimentoVenda, FormaPagamento: entity.FormaPagamentoDinheiro, Valor: 50},
+		{ID: 2, IDSessao: 2, Tipo: entity.CaixaMovimentoVenda, FormaPagamento: entity.FormaPagamentoPix, Valor: 80},
+		{ID: 3, IDSessao: 2, Tipo: entity.CaixaMovimentoSangria, FormaPagamento: entity.FormaPagamentoDinheiro, Valor: 30},
+	}
+}
+
+// TESTES PARA AbrirCaixaService
+func TestService_AbrirCaixaService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("AbrirCaixaSessao", mock.MatchedBy(func(sessao *entity.CaixaSessao) bool {
+		return sessao.Operador == "ana" && sessao.Status == entity.CaixaStatusAberto && sessao.ValorAbertura == 100
+	}), "1").Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.CaixaSessao).ID = 2
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.AbrirCaixaService("1", dtos.AbrirCaixaRequest{Operador: "ana", ValorAbertura: 100})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 2, result.ID)
+	assert.Equal(t, entity.CaixaStatusAberto, result.Status)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_AbrirCaixaService_JaAberto(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("AbrirCaixaSessao", mock.AnythingOfType("*entity.CaixaSessao"), "1").Return(persistence.ErrCaixaJaAberto)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.AbrirCaixaService("1", dtos.AbrirCaixaRequest{Operador: "ana"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "There is already an open caixa for this operador", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA GetCaixaAtualService
+func TestService_GetCaixaAtualService_NotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetCaixaSessaoAberta", "ana", "1").Return(nil, gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetCaixaAtualService("1", "ana")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "No open caixa for this operador", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA RegistrarMovimentoCaixaService
+func TestService_RegistrarMovimentoCaixaService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetCaixaSessaoByID", 2, "1").Return(caixaAberto(), nil)
+	mockDBClient.On("GetCaixaMovimentos", 2, "1").Return(movimentosCaixa(), nil)
+	mockDBClient.On("RegistrarMovimentoCaixa", mock.MatchedBy(func(movimento *entity.CaixaMovimento) bool {
+		return movimento.IDSessao == 2 && movimento.Tipo == entity.CaixaMovimentoSangria &&
+			movimento.FormaPagamento == entity.FormaPagamentoDinheiro && movimento.Valor == 120
+	}), 3, "1").Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.CaixaMovimento).ID = 4
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.RegistrarMovimentoCaixaService("1", "2", entity.CaixaMovimentoSangria, dtos.MovimentoCaixaRequest{Valor: 120})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result.ID)
+	assert.Equal(t, 120.0, result.Valor)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarMovimentoCaixaService_SangriaMaiorQueGaveta(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetCaixaSessaoByID", 2, "1").Return(caixaAberto(), nil)
+	mockDBClient.On("GetCaixaMovimentos", 2, "1").Return(movimentosCaixa(), nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.RegistrarMovimentoCaixaService("1", "2", entity.CaixaMovimentoSangria, dtos.MovimentoCaixaRequest{Valor: 121})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Sangria of 121.00 exceeds the cash in the caixa (120.00)", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarMovimentoCaixaService_CaixaAlterado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetCaixaSessaoByID", 2, "1").Return(caixaAberto(), nil)
+	mockDBClient.On("GetCaixaMovimentos", 2, "1").Return([]entity.CaixaMovimento{}, nil)
+	mockDBClient.On("RegistrarMovimentoCaixa", mock.AnythingOfType("*entity.CaixaMovimento"), 0, "1").Return(persistence.ErrCaixaAlterado)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.RegistrarMovimentoCaixaService("1", "2", entity.CaixaMovimentoSuprimento, dtos.MovimentoCaixaRequest{Valor: 50})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Caixa received new movimentos, try again", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA FecharCaixaService
+func TestService_FecharCaixaService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	contado := 115.0
+
+	mockDBClient.On("GetCaixaSessaoByID", 2, "1").Return(caixaAberto(), nil)
+	mockDBClient.On("GetCaixaMovimentos", 2, "1").Return(movimentosCaixa(), nil)
+	mockDBClient.On("FecharCaixaSessao", mock.MatchedBy(func(sessao *entity.CaixaSessao) bool {
+		return *sessao.ValorEsperado == 120 && *sessao.ValorContado == 115 && *sessao.Diferenca == -5 && sessao.DataFechamento != nil
+	}), 3, "1").Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.FecharCaixaService("1", "2", dtos.FecharCaixaRequest{ValorContado: &contado})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 2, result.QuantidadeVendas)
+	assert.Equal(t, 130.0, result.TotalVendas)
+	assert.Equal(t, 120.0, result.DinheiroEsperado)
+	assert.Equal(t, -5.0, *result.Diferenca)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_FecharCaixaService_JaFechado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	sessao := caixaAberto()
+	sessao.Status = entity.CaixaStatusFechado
+	contado := 100.0
+
+	mockDBClient.On("GetCaixaSessaoByID", 2, "1").Return(sessao, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.FecharCaixaService("1", "2", dtos.FecharCaixaRequest{ValorContado: &contado})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Caixa is not open", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA GetAllCaixasService
+func TestService_GetAllCaixasService_InvalidStatus(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetAllCaixasService("1", "pendente", 1, 30)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/agenda"
+	"go.uber.org/zap"
+)
+
+// Tamanho da coluna erro_disparo de campanhas
+const tamanhoErroDisparo = 500
+
+// FUNÇÕES DE AGENDA DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+// PausarCampanhaService pausa uma campanha agendada ou ativa. Os envios que estão na fila esperam a campanha ser
+// retomada e o job não muda o status até lá, exceto para finalizar quando a data de fim passa.
+func (srv *Service) PausarCampanhaService(userID string, idCampanha string) (*dtos.CampanhaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting pausar campanha service", zap.String("idCampanha", idCampanha))
+
+	campanha := srv.dbClient.GetCampanhaByID(idCampanha, userID)
+	if campanha.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Campanha not found")
+	}
+	if !agenda.PodePausar(campanha.Status) {
+		return nil, exceptions.NewConflictError("Only scheduled or active campanhas can be paused")
+	}
+
+	if restErr := srv.mudarStatusCampanha(userID, campanha, entity.CampanhaStatusPausada); restErr != nil {
+		return nil, restErr
+	}
+
+	response := buildCampanhaResponse(*campanha)
+	zap.L().Info("Campanha paused successfully", zap.Int("idCampanha", campanha.ID))
+	return &response, nil
+}
+
+// RetomarCampanhaService volta a campanha pausada para o status do período: agendada antes do lançamento, ativa
+// durante e finalizada depois do fim
+func (srv *Service) RetomarCampanhaService(userID string, idCampanha string) (*dtos.CampanhaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting retomar campanha service", zap.String("idCampanha", idCampanha))
+
+	campanha := srv.dbClient.GetCampanhaByID(idCampanha, userID)
+	if campanha.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Campanha not found")
+	}
+	if campanha.Status != entity.CampanhaStatusPausada {
+		return nil, exceptions.NewConflictError("Only paused campanhas can be resumed")
+	}
+
+	inicio, fim, err := agenda.Periodo(campanha.DataLancamento, campanha.DataFim)
+	if err != nil {
+		return nil, exceptions.NewConflictError("Campanha has an invalid period: " + err.Error())
+	}
+	if restErr := srv.mudarStatusCampanha(userID, campanha, agenda.Retomar(inicio, fim, time.Now())); restErr != nil {
+		return nil, restErr
+	}
+
+	response := buildCampanhaResponse(*campanha)
+	zap.L().Info("Campanha resumed successfully", zap.Int("idCampanha", campanha.ID), zap.String("status", campanha.Status))
+	return &response, nil
+}
+
+// mudarStatusCampanha grava o novo status se ninguém o alterou desde a leitura
+func (srv *Service) mudarStatusCampanha(userID string, campanha *entity.Campanha, status string) *exceptions.RestErr {
+	alterado, dbErr := srv.dbClient.AtualizarStatusCampanha(userID, campanha.ID, campanha.Status, status)
+	if dbErr != nil {
+		zap.L().Error("Error updating status of campanha", zap.Error(dbErr))
+		return exceptions.NewInternalServerError("Internal server error")
+	}
+	if !alterado {
+		return exceptions.NewConflictError("Campanha status was changed by another request, try again")
+	}
+	campanha.Status = status
+	return nil
+}
+
+// ExecutarCampanhasJob avança o status das campanhas de todos os tenants conforme as datas de lançamento e fim e
+// dispara as campanhas que acabaram de ser lançadas; usado pelo job periódico
+func (srv *Service) ExecutarCampanhasJob() {
+	agora := time.Now()
+	for _, userID := range srv.dbClient.GetClientIDs() {
+		srv.atualizarStatusCampanhas(userID, agora)
+		srv.dispararCampanhasLancadas(userID)
+	}
+}
+
+func (srv *Service) atualizarStatusCampanhas(userID string, agora time.Time) {
+	campanhas, dbErr := srv.dbClient.GetCampanhasEmAndamento(userID)
+	if dbErr != nil {
+		zap.L().Error("Error running campanhas job", zap.String("userID", userID), zap.Error(dbErr))
+		return
+	}
+
+	for _, campanha := range campanhas {
+		inicio, fim, err := agenda.Periodo(campanha.DataLancamento, campanha.DataFim)
+		if err != nil {
+			zap.L().Warn("Campanha with invalid period ignored by scheduler", zap.String("userID", userID), zap.Int("idCampanha", campanha.ID), zap.Error(err))
+			continue
+		}
+
+		status := agenda.StatusNoMomento(campanha.Status, inicio, fim, agora)
+		if status == campanha.Status {
+			continue
+		}
+		if _, dbErr := srv.dbClient.AtualizarStatusCampanha(userID, campanha.ID, campanha.Status, status); dbErr != nil {
+			continue
+		}
+		zap.L().Info("Campanha status updated by scheduler", zap.String("userID", userID), zap.Int("idCampanha", campanha.ID), zap.String("de", campanha.Status), zap.String("para", status))
+	}
+}
+
+// dispararCampanhasLancadas dispara, nos canais configurados, as campanhas ativas que ainda não foram disparadas.
+// A falha fica gravada na campanha e ela não é disparada de novo automaticamente.
+func (srv *Service) dispararCampanhasLancadas(userID string) {
+	if srv.disparador == nil {
+		return
+	}
+
+	campanhas, dbErr := srv.dbClient.GetCampanhasParaDisparo(userID)
+	if dbErr != nil {
+		zap.L().Error("Error running campanhas job", zap.String("userID", userID), zap.Error(dbErr))
+		return
+	}
+
+	for _, campanha := range campanhas {
+		response, restErr := srv.dispararCampanha(userID, &campanha, nil)
+		if restErr != nil {
+			erro := restErr.Error()
+			if runes := []rune(erro); len(runes) > tamanhoErroDisparo {
+				erro = string(runes[:tamanhoErroDisparo])
+			}
+			zap.L().Error("Error dispatching launched campanha", zap.String("userID", userID), zap.Int("idCampanha", campanha.ID), zap.String("error", erro))
+			// Sem o registro, a campanha continua elegível e o job tenta de novo na próxima execução
+			if dbErr := srv.dbClient.RegistrarDisparoCampanha(userID, campanha.ID, nil, &erro); dbErr != nil {
+				zap.L().Error("Error registering failed dispatch of campanha", zap.String("userID", userID), zap.Int("idCampanha", campanha.ID), zap.Error(dbErr))
+			}
+			continue
+		}
+		zap.L().Info("Launched campanha dispatched", zap.String("userID", userID), zap.Int("idCampanha", campanha.ID), zap.Int("enfileirados", response.Enfileirados))
+	}
+}
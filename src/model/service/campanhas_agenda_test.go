@@ -0,0 +1,172 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/disparo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// dataCampanha formata a data relativa ao momento do teste como gravada em campanhas
+func dataCampanha(deslocamento time.Duration) string {
+	return time.Now().Add(deslocamento).Format(formatoDataHora)
+}
+
+// TESTES PARA PausarCampanhaService
+func TestService_PausarCampanhaService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetCampanhaByID", "5", "1").Return(&entity.Campanha{ID: 5, Status: entity.CampanhaStatusAtiva})
+	mockDBClient.On("AtualizarStatusCampanha", "1", 5, entity.CampanhaStatusAtiva, entity.CampanhaStatusPausada).Return(true, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.PausarCampanhaService("1", "5")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, entity.CampanhaStatusPausada, result.Status)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_PausarCampanhaService_Finalizada(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetCampanhaByID", "5", "1").Return(&entity.Campanha{ID: 5, Status: entity.CampanhaStatusFinalizada})
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.PausarCampanhaService("1", "5")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertNotCalled(t, "AtualizarStatusCampanha", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_PausarCampanhaService_StatusAlteradoPorOutraRequisicao(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetCampanhaByID", "5", "1").Return(&entity.Campanha{ID: 5, Status: entity.CampanhaStatusAgendada})
+	mockDBClient.On("AtualizarStatusCampanha", "1", 5, entity.CampanhaStatusAgendada, entity.CampanhaStatusPausada).Return(false, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.PausarCampanhaService("1", "5")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+	assert.Equal(t, "Campanha status was changed by another request, try again", err.Message)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA RetomarCampanhaService
+func TestService_RetomarCampanhaService_VoltaParaStatusDoPeriodo(t *testing.T) {
+	tests := []struct {
+		name       string
+		lancamento string
+		fim        string
+		status     string
+	}{
+		{"antes do lançamento", dataCampanha(24 * time.Hour), dataCampanha(48 * time.Hour), entity.CampanhaStatusAgendada},
+		{"durante o período", dataCampanha(-24 * time.Hour), dataCampanha(24 * time.Hour), entity.CampanhaStatusAtiva},
+		{"depois do fim", dataCampanha(-48 * time.Hour), dataCampanha(-24 * time.Hour), entity.CampanhaStatusFinalizada},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDBClient := new(MockDBClient)
+
+			mockDBClient.On("GetCampanhaByID", "5", "1").Return(&entity.Campanha{ID: 5, Status: entity.CampanhaStatusPausada, DataLancamento: tt.lancamento, DataFim: tt.fim})
+			mockDBClient.On("AtualizarStatusCampanha", "1", 5, entity.CampanhaStatusPausada, tt.status).Return(true, nil)
+
+			service := &Service{
+				dbClient: mockDBClient,
+			}
+
+			// Act
+			result, err := service.RetomarCampanhaService("1", "5")
+
+			// Assert
+			assert.Nil(t, err)
+			assert.Equal(t, tt.status, result.Status)
+
+			mockDBClient.AssertExpectations(t)
+		})
+	}
+}
+
+// TESTES PARA ExecutarCampanhasJob
+func TestService_ExecutarCampanhasJob_AvancaStatus(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetClientIDs").Return([]string{"1"})
+	mockDBClient.On("GetCampanhasEmAndamento", "1").Return([]entity.Campanha{
+		{ID: 1, Status: entity.CampanhaStatusAgendada, DataLancamento: dataCampanha(-time.Hour), DataFim: dataCampanha(24 * time.Hour)},
+		{ID: 2, Status: entity.CampanhaStatusAtiva, DataLancamento: dataCampanha(-48 * time.Hour), DataFim: dataCampanha(-time.Hour)},
+		{ID: 3, Status: entity.CampanhaStatusAgendada, DataLancamento: dataCampanha(time.Hour), DataFim: dataCampanha(24 * time.Hour)},
+		{ID: 4, Status: entity.CampanhaStatusAgendada, DataLancamento: "amanhã", DataFim: dataCampanha(24 * time.Hour)},
+	}, nil)
+	mockDBClient.On("AtualizarStatusCampanha", "1", 1, entity.CampanhaStatusAgendada, entity.CampanhaStatusAtiva).Return(true, nil)
+	mockDBClient.On("AtualizarStatusCampanha", "1", 2, entity.CampanhaStatusAtiva, entity.CampanhaStatusFinalizada).Return(true, nil)
+
+	// Sem disparador configurado, o job só avança os status
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	service.ExecutarCampanhasJob()
+
+	// Assert
+	mockDBClient.AssertNumberOfCalls(t, "AtualizarStatusCampanha", 2)
+	mockDBClient.AssertNotCalled(t, "GetCampanhasParaDisparo", mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_ExecutarCampanhasJob_RegistraFalhaDoDisparo(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetClientIDs").Return([]string{"1"})
+	mockDBClient.On("GetCampanhasEmAndamento", "1").Return([]entity.Campanha{}, nil)
+	mockDBClient.On("GetCampanhasParaDisparo", "1").Return([]entity.Campanha{{ID: 5, Status: entity.CampanhaStatusAtiva}}, nil)
+	mockDBClient.On("GetCanaisCampanha", "1", 5).Return([]entity.CampanhaCanalJoin{}, nil)
+	mockDBClient.On("RegistrarDisparoCampanha", "1", 5, (*string)(nil), mock.MatchedBy(func(erro *string) bool {
+		return erro != nil && *erro == "No channels informed or configured for the campanha"
+	})).Return(nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparo.NewDisparador(new(MockFila), nil, disparo.NewFakeChannel(disparo.CanalEmail)),
+	}
+
+	// Act
+	service.ExecutarCampanhasJob()
+
+	// Assert
+	mockDBClient.AssertExpectations(t)
+}
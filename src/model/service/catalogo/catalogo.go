@@ -0,0 +1,112 @@
+package catalogo
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Especies é a lista controlada de espécies usada em Pet.Especie e Produto.DestinadoPara
+var Especies = []string{"Cachorro", "Gato", "Pássaro", "Peixe", "Roedor", "Réptil"}
+
+// DestinadoTodos indica um produto que serve para qualquer espécie
+const DestinadoTodos = "Todos"
+
+var semAcentos = strings.NewReplacer(
+	"á", "a", "à", "a", "ã", "a", "â", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "õ", "o", "ô", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// sinonimosEspecie mapeia grafias comuns (já na forma de Chave) para a espécie da lista controlada
+var sinonimosEspecie = map[string]string{
+	"cachorro": "Cachorro", "cachorros": "Cachorro", "cao": "Cachorro", "caes": "Cachorro", "canino": "Cachorro", "caninos": "Cachorro", "dog": "Cachorro",
+	"gato": "Gato", "gatos": "Gato", "felino": "Gato", "felinos": "Gato", "cat": "Gato",
+	"passaro": "Pássaro", "passaros": "Pássaro", "ave": "Pássaro", "aves": "Pássaro",
+	"peixe": "Peixe", "peixes": "Peixe",
+	"roedor": "Roedor", "roedores": "Roedor",
+	"reptil": "Réptil", "repteis": "Réptil",
+}
+
+var sinonimosTodos = map[string]bool{"todos": true, "todas": true, "geral": true, "qualquer": true}
+
+// Chave normaliza um texto para comparação: sem espaços extras, minúsculo e sem acentos.
+// "  Ração ", "racao" e "RAÇÃO" têm a mesma chave.
+func Chave(valor string) string {
+	return semAcentos.Replace(strings.ToLower(strings.Join(strings.Fields(valor), " ")))
+}
+
+// NormalizarEspecie retorna a espécie da lista controlada correspondente ao valor
+func NormalizarEspecie(valor string) (string, bool) {
+	especie, ok := sinonimosEspecie[Chave(valor)]
+	return especie, ok
+}
+
+// NormalizarDestinadoPara retorna o valor controlado de DestinadoPara: uma espécie ou "Todos"
+func NormalizarDestinadoPara(valor string) (string, bool) {
+	if sinonimosTodos[Chave(valor)] {
+		return DestinadoTodos, true
+	}
+	return NormalizarEspecie(valor)
+}
+
+// EscolherNome escolhe, entre as grafias de uma mesma chave, a que será usada como nome da categoria:
+// a mais usada; no empate, a que começa com maiúscula e depois a que tem mais acentos.
+func EscolherNome(variantes map[string]int) string {
+	var escolhido string
+	for variante, total := range variantes {
+		if escolhido == "" || melhorNome(variante, total, escolhido, variantes[escolhido]) {
+			escolhido = variante
+		}
+	}
+	return strings.Join(strings.Fields(escolhido), " ")
+}
+
+func melhorNome(nome string, total int, atual string, totalAtual int) bool {
+	if total != totalAtual {
+		return total > totalAtual
+	}
+	if maiuscula(nome) != maiuscula(atual) {
+		return maiuscula(nome)
+	}
+	if acentos(nome) != acentos(atual) {
+		return acentos(nome) > acentos(atual)
+	}
+	return nome < atual
+}
+
+func maiuscula(valor string) bool {
+	r, _ := utf8.DecodeRuneInString(strings.TrimSpace(valor))
+	return unicode.IsUpper(r)
+}
+
+func acentos(valor string) int {
+	total := 0
+	for _, r := range valor {
+		if r > unicode.MaxASCII {
+			total++
+		}
+	}
+	return total
+}
+
+// EhDescendente informa se candidato está abaixo de id na árvore (ou é o próprio id).
+// pais mapeia cada categoria para o seu pai (nil na raiz).
+func EhDescendente(pais map[int]*int, id int, candidato int) bool {
+	visitados := map[int]bool{}
+	for atual := candidato; !visitados[atual]; {
+		if atual == id {
+			return true
+		}
+		visitados[atual] = true
+		pai, ok := pais[atual]
+		if !ok || pai == nil {
+			return false
+		}
+		atual = *pai
+	}
+	return false
+}
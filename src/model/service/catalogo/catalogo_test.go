@@ -0,0 +1,70 @@
+package catalogo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChave(t *testing.T) {
+	assert.Equal(t, "racao", Chave("Ração"))
+	assert.Equal(t, "racao", Chave("  racao "))
+	assert.Equal(t, "racao umida", Chave("RAÇÃO   Úmida"))
+}
+
+func TestNormalizarEspecie(t *testing.T) {
+	tests := []struct {
+		valor    string
+		esperado string
+		ok       bool
+	}{
+		{"cachorro", "Cachorro", true},
+		{"Cães", "Cachorro", true},
+		{"GATOS", "Gato", true},
+		{"passaro", "Pássaro", true},
+		{"Répteis", "Réptil", true},
+		{"Dinossauro", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.valor, func(t *testing.T) {
+			especie, ok := NormalizarEspecie(tt.valor)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.esperado, especie)
+		})
+	}
+}
+
+func TestNormalizarDestinadoPara(t *testing.T) {
+	destinado, ok := NormalizarDestinadoPara("todos")
+	assert.True(t, ok)
+	assert.Equal(t, DestinadoTodos, destinado)
+
+	destinado, ok = NormalizarDestinadoPara("Felinos")
+	assert.True(t, ok)
+	assert.Equal(t, "Gato", destinado)
+
+	_, ok = NormalizarDestinadoPara("Cães e gatos")
+	assert.False(t, ok)
+}
+
+func TestEscolherNome(t *testing.T) {
+	assert.Equal(t, "Ração", EscolherNome(map[string]int{"racao": 3, "Ração": 5}))
+	assert.Equal(t, "Ração", EscolherNome(map[string]int{"Racao": 2, "Ração": 2, "ração": 2}))
+	assert.Equal(t, "Higiene", EscolherNome(map[string]int{" Higiene  ": 1}))
+}
+
+func TestEhDescendente(t *testing.T) {
+	um, dois := 1, 2
+	pais := map[int]*int{
+		1: nil,
+		2: &um,
+		3: &dois,
+		4: nil,
+	}
+
+	assert.True(t, EhDescendente(pais, 1, 3))
+	assert.True(t, EhDescendente(pais, 2, 2))
+	assert.False(t, EhDescendente(pais, 3, 1))
+	assert.False(t, EhDescendente(pais, 1, 4))
+}
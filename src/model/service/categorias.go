@@ -0,0 +1,515 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/catalogo"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE CATEGORIAS ------------------------------------------------------------------------------------------------------------------------------------
+
+// GetCategoriasService retorna a árvore de categorias do tenant
+func (srv *Service) GetCategoriasService(userID string) (*dtos.CategoriaTreeResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get categorias service")
+
+	categorias, dbErr := srv.dbClient.GetAllCategorias(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting categorias from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.CategoriaTreeResponse{
+		Categorias: buildCategoriaTree(categorias, nil),
+		Total:      len(categorias),
+	}
+
+	zap.L().Info("Get categorias service completed successfully", zap.Int("total", response.Total))
+	return response, nil
+}
+
+func (srv *Service) GetCategoriaByIDService(userID string, id string) (*dtos.CategoriaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get categoria by ID service", zap.String("id", id))
+
+	categoria, restErr := srv.getCategoria(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	categorias, dbErr := srv.dbClient.GetAllCategorias(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting categorias from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := buildCategoriaResponse(*categoria, categorias)
+
+	zap.L().Info("Get categoria by ID service completed successfully", zap.Int("id", categoria.ID))
+	return &response, nil
+}
+
+func (srv *Service) CreateCategoriaService(userID string, request dtos.CreateCategoriaRequest) (int, *exceptions.RestErr) {
+	zap.L().Info("Starting create categoria service", zap.String("nome", request.Nome))
+
+	categorias, dbErr := srv.dbClient.GetAllCategorias(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting categorias from database", zap.Error(dbErr))
+		return 0, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	if request.IDCategoriaPai != nil && !existeCategoria(categorias, *request.IDCategoriaPai) {
+		return 0, exceptions.NewNotFoundError("Parent categoria not found")
+	}
+
+	nome := strings.Join(strings.Fields(request.Nome), " ")
+	chave := catalogo.Chave(nome)
+	if categoriaIrma(categorias, request.IDCategoriaPai, chave, 0) != nil {
+		return 0, exceptions.NewConflictError(fmt.Sprintf("Categoria '%s' already exists at this level", nome))
+	}
+
+	categoria := &entity.Categoria{
+		Nome:            nome,
+		NomeNormalizado: chave,
+		IDCategoriaPai:  request.IDCategoriaPai,
+		DataCriacao:     time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	if dbErr := srv.dbClient.CreateCategoria(categoria, userID); dbErr != nil {
+		zap.L().Error("Error creating categoria in database", zap.Error(dbErr))
+		return 0, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Categoria created successfully", zap.Int("id", categoria.ID), zap.String("nome", categoria.Nome))
+	return categoria.ID, nil
+}
+
+// UpdateCategoriaService renomeia a categoria; o nome gravado nos produtos dela acompanha
+func (srv *Service) UpdateCategoriaService(userID string, id string, request dtos.UpdateCategoriaRequest) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting update categoria service", zap.String("id", id), zap.String("nome", request.Nome))
+
+	categoria, restErr := srv.getCategoria(userID, id)
+	if restErr != nil {
+		return false, restErr
+	}
+
+	categorias, dbErr := srv.dbClient.GetAllCategorias(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting categorias from database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	nome := strings.Join(strings.Fields(request.Nome), " ")
+	chave := catalogo.Chave(nome)
+	if categoriaIrma(categorias, categoria.IDCategoriaPai, chave, categoria.ID) != nil {
+		return false, exceptions.NewConflictError(fmt.Sprintf("Categoria '%s' already exists at this level; use merge instead", nome))
+	}
+
+	if dbErr := srv.dbClient.RenomearCategoria(categoria.ID, nome, chave, userID); dbErr != nil {
+		zap.L().Error("Error renaming categoria in database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Categoria updated successfully", zap.Int("id", categoria.ID), zap.String("nome", nome))
+	return true, nil
+}
+
+// MoverCategoriaService troca o pai da categoria, impedindo ciclos na árvore
+func (srv *Service) MoverCategoriaService(userID string, id string, request dtos.MoverCategoriaRequest) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting mover categoria service", zap.String("id", id), zap.Any("id_categoria_pai", request.IDCategoriaPai))
+
+	categoria, restErr := srv.getCategoria(userID, id)
+	if restErr != nil {
+		return false, restErr
+	}
+
+	categorias, dbErr := srv.dbClient.GetAllCategorias(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting categorias from database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	if request.IDCategoriaPai != nil {
+		if !existeCategoria(categorias, *request.IDCategoriaPai) {
+			return false, exceptions.NewNotFoundError("Parent categoria not found")
+		}
+		if catalogo.EhDescendente(paisCategorias(categorias), categoria.ID, *request.IDCategoriaPai) {
+			return false, exceptions.NewBadRequestError("A categoria cannot be moved under itself or one of its subcategorias")
+		}
+	}
+
+	if categoriaIrma(categorias, request.IDCategoriaPai, categoria.NomeNormalizado, categoria.ID) != nil {
+		return false, exceptions.NewConflictError(fmt.Sprintf("Categoria '%s' already exists at the destination; use merge instead", categoria.Nome))
+	}
+
+	if dbErr := srv.dbClient.MoverCategoria(categoria.ID, request.IDCategoriaPai, userID); dbErr != nil {
+		zap.L().Error("Error moving categoria in database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Categoria moved successfully", zap.Int("id", categoria.ID))
+	return true, nil
+}
+
+// MesclarCategoriaService move os produtos e subcategorias da categoria para o destino e a remove
+func (srv *Service) MesclarCategoriaService(userID string, id string, request dtos.MesclarCategoriaRequest) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting mesclar categoria service", zap.String("id", id), zap.Int("id_categoria_destino", request.IDCategoriaDestino))
+
+	origem, restErr := srv.getCategoria(userID, id)
+	if restErr != nil {
+		return false, restErr
+	}
+
+	categorias, dbErr := srv.dbClient.GetAllCategorias(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting categorias from database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	var destino *entity.Categoria
+	for i := range categorias {
+		if categorias[i].ID == request.IDCategoriaDestino {
+			destino = &categorias[i]
+		}
+	}
+	if destino == nil {
+		return false, exceptions.NewNotFoundError("Destination categoria not found")
+	}
+	if catalogo.EhDescendente(paisCategorias(categorias), origem.ID, destino.ID) {
+		return false, exceptions.NewBadRequestError("A categoria cannot be merged into itself or one of its subcategorias")
+	}
+
+	// As subcategorias da origem não podem repetir nomes de subcategorias do destino
+	for _, filha := range categorias {
+		if filha.IDCategoriaPai == nil || *filha.IDCategoriaPai != origem.ID {
+			continue
+		}
+		if conflito := categoriaIrma(categorias, &destino.ID, filha.NomeNormalizado, filha.ID); conflito != nil {
+			return false, exceptions.NewConflictError(fmt.Sprintf("Subcategoria '%s' exists in both categorias; merge it first", filha.Nome))
+		}
+	}
+
+	if dbErr := srv.dbClient.MesclarCategorias(origem.ID, *destino, userID); dbErr != nil {
+		zap.L().Error("Error merging categorias in database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Categorias merged successfully", zap.Int("id_origem", origem.ID), zap.Int("id_destino", destino.ID))
+	return true, nil
+}
+
+func (srv *Service) DeleteCategoriaService(userID string, id string) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting delete categoria service", zap.String("id", id))
+
+	categoria, restErr := srv.getCategoria(userID, id)
+	if restErr != nil {
+		return false, restErr
+	}
+	if categoria.TotalProdutos > 0 {
+		return false, exceptions.NewConflictError("Categoria has produtos; move or merge them first")
+	}
+
+	categorias, dbErr := srv.dbClient.GetAllCategorias(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting categorias from database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+	for _, filha := range categorias {
+		if filha.IDCategoriaPai != nil && *filha.IDCategoriaPai == categoria.ID {
+			return false, exceptions.NewConflictError("Categoria has subcategorias; move or delete them first")
+		}
+	}
+
+	if dbErr := srv.dbClient.DeleteCategoria(categoria.ID, userID); dbErr != nil {
+		zap.L().Error("Error deleting categoria in database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Categoria deleted successfully", zap.Int("id", categoria.ID))
+	return true, nil
+}
+
+// GetEspeciesService retorna as listas controladas de espécies e de público-alvo dos produtos
+func (srv *Service) GetEspeciesService() *dtos.EspeciesResponse {
+	return &dtos.EspeciesResponse{
+		Especies:      append([]string{}, catalogo.Especies...),
+		DestinadoPara: append(append([]string{}, catalogo.Especies...), catalogo.DestinadoTodos),
+	}
+}
+
+// NormalizarCatalogoService converte os valores livres de categoria, destinado_para e espécie para as referências controladas.
+// Grafias com a mesma chave (ex.: "Racao", "Ração", "ração") viram uma única categoria; com dryRun nada é gravado.
+func (srv *Service) NormalizarCatalogoService(userID string, dryRun bool) (*dtos.NormalizacaoCatalogoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting normalizar catalogo service", zap.Bool("dry_run", dryRun))
+
+	valoresCategorias, valoresDestinados, valoresEspecies, dbErr := srv.dbClient.GetValoresCatalogo(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting valores catalogo from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	categorias, dbErr := srv.dbClient.GetAllCategorias(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting categorias from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	plano := &entity.PlanoNormalizacaoCatalogo{DataCriacao: time.Now().Format("2006-01-02 15:04:05")}
+	response := &dtos.NormalizacaoCatalogoResponse{
+		DryRun:                   dryRun,
+		Categorias:               []dtos.NormalizacaoItemResponse{},
+		DestinadoPara:            []dtos.NormalizacaoItemResponse{},
+		DestinadoParaNaoMapeados: []dtos.ValorNaoMapeadoResponse{},
+		Especies:                 []dtos.NormalizacaoItemResponse{},
+		EspeciesNaoMapeadas:      []dtos.ValorNaoMapeadoResponse{},
+	}
+
+	// Categorias: agrupar as grafias pela chave e reaproveitar a categoria existente com a mesma chave (raiz primeiro)
+	grupos := map[string]map[string]int{}
+	var chaves []string
+	for _, valor := range valoresCategorias {
+		chave := catalogo.Chave(valor.Valor)
+		if chave == "" {
+			continue
+		}
+		if _, ok := grupos[chave]; !ok {
+			grupos[chave] = map[string]int{}
+			chaves = append(chaves, chave)
+		}
+		grupos[chave][valor.Valor] += valor.Total
+	}
+	sort.Strings(chaves)
+
+	for _, chave := range chaves {
+		normalizacao := entity.NormalizacaoCategoria{NomeNormalizado: chave}
+		if existente := categoriaPorChave(categorias, chave); existente != nil {
+			normalizacao.IDCategoria = existente.ID
+			normalizacao.Nome = existente.Nome
+		} else {
+			normalizacao.Nome = catalogo.EscolherNome(grupos[chave])
+			response.CategoriasCriadas++
+		}
+
+		item := dtos.NormalizacaoItemResponse{Para: normalizacao.Nome, IDCategoria: normalizacao.IDCategoria, Nova: normalizacao.IDCategoria == 0}
+		for valor, total := range grupos[chave] {
+			normalizacao.Valores = append(normalizacao.Valores, valor)
+			item.De = append(item.De, valor)
+			item.Registros += total
+		}
+		sort.Strings(item.De)
+
+		plano.Categorias = append(plano.Categorias, normalizacao)
+		response.Categorias = append(response.Categorias, item)
+	}
+
+	plano.DestinadoPara, response.DestinadoPara, response.DestinadoParaNaoMapeados = planejarValoresControlados(valoresDestinados, catalogo.NormalizarDestinadoPara)
+	plano.Especies, response.Especies, response.EspeciesNaoMapeadas = planejarValoresControlados(valoresEspecies, catalogo.NormalizarEspecie)
+
+	if !dryRun {
+		if dbErr := srv.dbClient.AplicarNormalizacaoCatalogo(plano, userID); dbErr != nil {
+			zap.L().Error("Error applying normalizacao catalogo", zap.Error(dbErr))
+			return nil, exceptions.NewInternalServerError("Internal server error")
+		}
+		// Os IDs das categorias novas só existem depois de gravar
+		for i := range plano.Categorias {
+			response.Categorias[i].IDCategoria = plano.Categorias[i].IDCategoria
+		}
+	}
+
+	zap.L().Info("Normalizar catalogo service completed successfully",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("categorias", len(response.Categorias)),
+		zap.Int("categorias_criadas", response.CategoriasCriadas),
+		zap.Int("destinado_para_nao_mapeados", len(response.DestinadoParaNaoMapeados)),
+		zap.Int("especies_nao_mapeadas", len(response.EspeciesNaoMapeadas)))
+	return response, nil
+}
+
+// resolverCategoria encontra a categoria do produto pelo ID ou, sem ID, pelo nome (ignorando maiúsculas e acentos)
+func (srv *Service) resolverCategoria(userID string, idCategoria *int, nome string) (*entity.Categoria, *exceptions.RestErr) {
+	if idCategoria != nil {
+		categoria, dbErr := srv.dbClient.GetCategoriaByID(*idCategoria, userID)
+		if dbErr != nil {
+			if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+				return nil, exceptions.NewBadRequestError(fmt.Sprintf("Categoria %d not found", *idCategoria))
+			}
+			zap.L().Error("Error getting categoria by ID", zap.Error(dbErr))
+			return nil, exceptions.NewInternalServerError("Internal server error")
+		}
+		return categoria, nil
+	}
+
+	categorias, dbErr := srv.dbClient.GetAllCategorias(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting categorias from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	chave := catalogo.Chave(nome)
+	var encontradas []entity.Categoria
+	for _, categoria := range categorias {
+		if categoria.NomeNormalizado == chave {
+			encontradas = append(encontradas, categoria)
+		}
+	}
+
+	switch len(encontradas) {
+	case 0:
+		return nil, exceptions.NewBadRequestError(fmt.Sprintf("Categoria '%s' is not registered; create it in /api/categorias or send id_categoria", nome))
+	case 1:
+		return &encontradas[0], nil
+	default:
+		return nil, exceptions.NewBadRequestError(fmt.Sprintf("Categoria '%s' exists in more than one level; send id_categoria", nome))
+	}
+}
+
+func (srv *Service) getCategoria(userID string, id string) (*entity.Categoria, *exceptions.RestErr) {
+	idInt := 0
+	if _, err := fmt.Sscanf(id, "%d", &idInt); err != nil {
+		zap.L().Error("Error converting categoria id to int", zap.Error(err))
+		return nil, exceptions.NewBadRequestError("Invalid categoria ID")
+	}
+
+	categoria, dbErr := srv.dbClient.GetCategoriaByID(idInt, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Categoria not found")
+		}
+		zap.L().Error("Error getting categoria by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	return categoria, nil
+}
+
+// planejarValoresControlados separa os valores livres entre os que têm correspondente na lista controlada e os que não têm
+func planejarValoresControlados(valores []entity.ValorDistinto, normalizar func(string) (string, bool)) ([]entity.NormalizacaoValor, []dtos.NormalizacaoItemResponse, []dtos.ValorNaoMapeadoResponse) {
+	var trocas []entity.NormalizacaoValor
+	itens := []dtos.NormalizacaoItemResponse{}
+	naoMapeados := []dtos.ValorNaoMapeadoResponse{}
+	posicao := map[string]int{}
+
+	for _, valor := range valores {
+		controlado, ok := normalizar(valor.Valor)
+		if !ok {
+			naoMapeados = append(naoMapeados, dtos.ValorNaoMapeadoResponse{Valor: valor.Valor, Registros: valor.Total})
+			continue
+		}
+		if controlado == valor.Valor {
+			continue
+		}
+
+		trocas = append(trocas, entity.NormalizacaoValor{De: valor.Valor, Para: controlado})
+		i, ok := posicao[controlado]
+		if !ok {
+			i = len(itens)
+			posicao[controlado] = i
+			itens = append(itens, dtos.NormalizacaoItemResponse{Para: controlado})
+		}
+		itens[i].De = append(itens[i].De, valor.Valor)
+		itens[i].Registros += valor.Total
+	}
+
+	return trocas, itens, naoMapeados
+}
+
+func buildCategoriaTree(categorias []entity.Categoria, idPai *int) []dtos.CategoriaResponse {
+	arvore := []dtos.CategoriaResponse{}
+	for _, categoria := range categorias {
+		if (idPai == nil && categoria.IDCategoriaPai == nil) || (idPai != nil && categoria.IDCategoriaPai != nil && *categoria.IDCategoriaPai == *idPai) {
+			arvore = append(arvore, buildCategoriaResponse(categoria, categorias))
+		}
+	}
+	return arvore
+}
+
+func buildCategoriaResponse(categoria entity.Categoria, categorias []entity.Categoria) dtos.CategoriaResponse {
+	response := dtos.CategoriaResponse{
+		ID:            categoria.ID,
+		Nome:          categoria.Nome,
+		Caminho:       caminhoCategoria(categoria, categorias),
+		TotalProdutos: categoria.TotalProdutos,
+		Subcategorias: buildCategoriaTree(categorias, &categoria.ID),
+	}
+	if categoria.IDCategoriaPai != nil {
+		response.IDCategoriaPai = *categoria.IDCategoriaPai
+	}
+	return response
+}
+
+// caminhoCategoria monta o caminho da raiz até a categoria, ex.: "Rações > Cães > Filhotes"
+func caminhoCategoria(categoria entity.Categoria, categorias []entity.Categoria) string {
+	porID := make(map[int]entity.Categoria, len(categorias))
+	for _, c := range categorias {
+		porID[c.ID] = c
+	}
+
+	nomes := []string{categoria.Nome}
+	visitados := map[int]bool{categoria.ID: true}
+	for atual := categoria; atual.IDCategoriaPai != nil && !visitados[*atual.IDCategoriaPai]; {
+		pai, ok := porID[*atual.IDCategoriaPai]
+		if !ok {
+			break
+		}
+		visitados[pai.ID] = true
+		nomes = append([]string{pai.Nome}, nomes...)
+		atual = pai
+	}
+	return strings.Join(nomes, " > ")
+}
+
+func paisCategorias(categorias []entity.Categoria) map[int]*int {
+	pais := make(map[int]*int, len(categorias))
+	for _, categoria := range categorias {
+		pais[categoria.ID] = categoria.IDCategoriaPai
+	}
+	return pais
+}
+
+func existeCategoria(categorias []entity.Categoria, id int) bool {
+	for _, categoria := range categorias {
+		if categoria.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// categoriaIrma retorna a categoria com a mesma chave no nível informado, ignorando a própria categoria
+func categoriaIrma(categorias []entity.Categoria, idPai *int, chave string, ignorarID int) *entity.Categoria {
+	for i, categoria := range categorias {
+		if categoria.ID == ignorarID || categoria.NomeNormalizado != chave {
+			continue
+		}
+		mesmoPai := (idPai == nil && categoria.IDCategoriaPai == nil) ||
+			(idPai != nil && categoria.IDCategoriaPai != nil && *idPai == *categoria.IDCategoriaPai)
+		if mesmoPai {
+			return &categorias[i]
+		}
+	}
+	return nil
+}
+
+// categoriaPorChave prefere a categoria raiz; sem raiz, a de menor ID
+func categoriaPorChave(categorias []entity.Categoria, chave string) *entity.Categoria {
+	var encontrada *entity.Categoria
+	for i, categoria := range categorias {
+		if categoria.NomeNormalizado != chave {
+			continue
+		}
+		if categoria.IDCategoriaPai == nil {
+			return &categorias[i]
+		}
+		if encontrada == nil || categoria.ID < encontrada.ID {
+			encontrada = &categorias[i]
+		}
+	}
+	return encontrada
+}
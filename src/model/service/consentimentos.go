@@ -0,0 +1,212 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/disparo"
+	"go.uber.org/zap"
+)
+
+// Canais em que o cliente consente ou não em receber campanhas, na ordem da resposta
+var canaisConsentimento = []string{disparo.CanalEmail, disparo.CanalWhatsApp, disparo.CanalSMS}
+
+// Tamanho máximo do detalhe gravado no histórico de consentimento
+const tamanhoDetalheConsentimento = 255
+
+// FUNÇÕES DE CONSENTIMENTOS DE CLIENTES ------------------------------------------------------------------------------------------------------------------------------------
+
+// GetConsentimentosClienteService retorna a situação do cliente em todos os canais; canal sem registro aparece
+// como não consentido
+func (srv *Service) GetConsentimentosClienteService(userID string, idCliente string) (*dtos.ConsentimentosClienteResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get consentimentos cliente service", zap.String("idCliente", idCliente))
+
+	cliente := srv.dbClient.GetClienteByID(idCliente, userID)
+	if cliente.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Cliente not found")
+	}
+
+	response, restErr := srv.consentimentosCliente(userID, cliente.ID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	zap.L().Info("Consentimentos cliente service completed successfully", zap.Int("idCliente", cliente.ID))
+	return response, nil
+}
+
+// SetConsentimentosClienteService registra o consentimento ou a revogação informados pela loja. Canais sem mudança
+// não geram histórico.
+func (srv *Service) SetConsentimentosClienteService(userID string, idCliente string, request dtos.ConsentimentosClienteRequest) (*dtos.ConsentimentosClienteResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting set consentimentos cliente service", zap.String("idCliente", idCliente), zap.String("origem", request.Origem))
+
+	cliente := srv.dbClient.GetClienteByID(idCliente, userID)
+	if cliente.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Cliente not found")
+	}
+
+	var detalhe *string
+	if request.Detalhe != "" {
+		detalhe = &request.Detalhe
+	}
+	agora := time.Now().Format(formatoDataHora)
+	informados := make(map[string]bool, len(request.Canais))
+	alteracoes := make([]entity.ConsentimentoHistorico, len(request.Canais))
+	for i, canal := range request.Canais {
+		if informados[canal.Canal] {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Canal '%s' informed more than once", canal.Canal))
+		}
+		informados[canal.Canal] = true
+		alteracoes[i] = entity.ConsentimentoHistorico{
+			IDCliente:  cliente.ID,
+			Canal:      canal.Canal,
+			Consentido: *canal.Consentido,
+			Origem:     request.Origem,
+			Detalhe:    detalhe,
+			Data:       agora,
+		}
+	}
+
+	registradas, dbErr := srv.dbClient.RegistrarConsentimentos(userID, alteracoes)
+	if dbErr != nil {
+		return nil, exceptions.NewInternalServerError("Error registering consentimentos")
+	}
+
+	response, restErr := srv.consentimentosCliente(userID, cliente.ID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	zap.L().Info("Consentimentos cliente registered successfully", zap.Int("idCliente", cliente.ID), zap.Int("registradas", registradas))
+	return response, nil
+}
+
+func (srv *Service) GetHistoricoConsentimentosService(userID string, idCliente string, canal string, page, limit int) (*dtos.ConsentimentoHistoricoListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get historico consentimentos service", zap.String("idCliente", idCliente), zap.String("canal", canal), zap.Int("page", page), zap.Int("limit", limit))
+
+	if restErr := validarCanal(canal); restErr != nil {
+		return nil, restErr
+	}
+
+	cliente := srv.dbClient.GetClienteByID(idCliente, userID)
+	if cliente.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Cliente not found")
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	historico, total, dbErr := srv.dbClient.GetHistoricoConsentimentosPaginated(userID, cliente.ID, canal, limit, offset)
+	if dbErr != nil {
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.ConsentimentoHistoricoListResponse{
+		IDCliente:  cliente.ID,
+		Historico:  make([]dtos.ConsentimentoHistoricoResponse, len(historico)),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: (total + limit - 1) / limit,
+	}
+	for i, alteracao := range historico {
+		response.Historico[i] = buildConsentimentoHistoricoResponse(alteracao)
+	}
+
+	zap.L().Info("Historico consentimentos service completed successfully", zap.Int("total", total))
+	return response, nil
+}
+
+func buildConsentimentoHistoricoResponse(alteracao entity.ConsentimentoHistorico) dtos.ConsentimentoHistoricoResponse {
+	return dtos.ConsentimentoHistoricoResponse{
+		ID:         alteracao.ID,
+		Canal:      alteracao.Canal,
+		Consentido: alteracao.Consentido,
+		Origem:     alteracao.Origem,
+		Detalhe:    alteracao.Detalhe,
+		IDEnvio:    alteracao.IDEnvio,
+		Data:       alteracao.Data,
+	}
+}
+
+func (srv *Service) consentimentosCliente(userID string, idCliente int) (*dtos.ConsentimentosClienteResponse, *exceptions.RestErr) {
+	consentimentos, dbErr := srv.dbClient.GetConsentimentosCliente(userID, idCliente)
+	if dbErr != nil {
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	porCanal := make(map[string]entity.ConsentimentoCliente, len(consentimentos))
+	for _, consentimento := range consentimentos {
+		porCanal[consentimento.Canal] = consentimento
+	}
+
+	response := &dtos.ConsentimentosClienteResponse{
+		IDCliente: idCliente,
+		Canais:    make([]dtos.ConsentimentoResponse, len(canaisConsentimento)),
+	}
+	for i, canal := range canaisConsentimento {
+		response.Canais[i] = dtos.ConsentimentoResponse{Canal: canal}
+		if consentimento, ok := porCanal[canal]; ok {
+			origem, data := consentimento.Origem, consentimento.Data
+			response.Canais[i].Consentido = consentimento.Consentido
+			response.Canais[i].Origem = &origem
+			response.Canais[i].Detalhe = consentimento.Detalhe
+			response.Canais[i].Data = &data
+		}
+	}
+	return response, nil
+}
+
+// FUNÇÕES DE DESCADASTRO ------------------------------------------------------------------------------------------------------------------------------------
+
+// ValidarDescadastroService confere o token do link de descadastro antes da página de confirmação, sem alterar nada
+func (srv *Service) ValidarDescadastroService(token string) *exceptions.RestErr {
+	_, _, restErr := srv.lerTokenRastreio(token)
+	return restErr
+}
+
+// RegistrarDescadastroService revoga o consentimento do cliente no canal do envio pelo link de descadastro da mensagem
+func (srv *Service) RegistrarDescadastroService(token string) *exceptions.RestErr {
+	zap.L().Info("Starting registrar descadastro service")
+	return srv.descadastrarEnvio(token, entity.ConsentimentoOrigemLinkDescadastro, "")
+}
+
+// descadastrarEnvio marca o envio como descadastrado e revoga o consentimento do cliente no canal do envio. A
+// revogação vale mesmo quando o evento não se aplica ao envio (ex: resposta a um envio de outra campanha já cancelado).
+func (srv *Service) descadastrarEnvio(token, origem, detalhe string) *exceptions.RestErr {
+	userID, envio, restErr := srv.registrarEventoEnvio(token, disparo.EventoDescadastrado, "")
+	if restErr != nil {
+		return restErr
+	}
+
+	alteracao := entity.ConsentimentoHistorico{
+		IDCliente:  envio.IDCliente,
+		Canal:      envio.Canal,
+		Consentido: false,
+		Origem:     origem,
+		IDEnvio:    &envio.ID,
+		Data:       time.Now().Format(formatoDataHora),
+	}
+	if runes := []rune(detalhe); len(runes) > tamanhoDetalheConsentimento {
+		detalhe = string(runes[:tamanhoDetalheConsentimento])
+	}
+	if detalhe != "" {
+		alteracao.Detalhe = &detalhe
+	}
+	registradas, dbErr := srv.dbClient.RegistrarConsentimentos(userID, []entity.ConsentimentoHistorico{alteracao})
+	if dbErr != nil {
+		return exceptions.NewInternalServerError("Error registering descadastro")
+	}
+
+	zap.L().Info("Descadastro registered successfully", zap.String("userID", userID), zap.Int("idCliente", envio.IDCliente), zap.String("canal", envio.Canal), zap.String("origem", origem), zap.Int("registradas", registradas))
+	return nil
+}
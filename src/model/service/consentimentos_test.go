@@ -0,0 +1,315 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/disparo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TESTES PARA GetConsentimentosClienteService
+func TestService_GetConsentimentosClienteService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	consentimentos := []entity.ConsentimentoCliente{
+		{IDCliente: 7, Canal: disparo.CanalSMS, Consentido: false, Origem: entity.ConsentimentoOrigemPalavraChave, Data: "2025-03-02 10:00:00"},
+		{IDCliente: 7, Canal: disparo.CanalEmail, Consentido: true, Origem: entity.ConsentimentoOrigemLoja, Data: "2025-03-01 10:00:00"},
+	}
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+	mockDBClient.On("GetConsentimentosCliente", "1", 7).Return(consentimentos, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetConsentimentosClienteService("1", "7")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, result.Canais, 3)
+	assert.Equal(t, disparo.CanalEmail, result.Canais[0].Canal)
+	assert.True(t, result.Canais[0].Consentido)
+	assert.Equal(t, entity.ConsentimentoOrigemLoja, *result.Canais[0].Origem)
+	assert.Equal(t, disparo.CanalWhatsApp, result.Canais[1].Canal)
+	assert.False(t, result.Canais[1].Consentido)
+	assert.Nil(t, result.Canais[1].Origem)
+	assert.Equal(t, entity.ConsentimentoOrigemPalavraChave, *result.Canais[2].Origem)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_GetConsentimentosClienteService_ClienteNotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{})
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetConsentimentosClienteService("1", "7")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Cliente not found", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA SetConsentimentosClienteService
+func TestService_SetConsentimentosClienteService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	sim, nao := true, false
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+	mockDBClient.On("RegistrarConsentimentos", "1", mock.MatchedBy(func(alteracoes []entity.ConsentimentoHistorico) bool {
+		return len(alteracoes) == 2 &&
+			alteracoes[0].Canal == disparo.CanalEmail && alteracoes[0].Consentido && alteracoes[0].Origem == entity.ConsentimentoOrigemLoja &&
+			*alteracoes[0].Detalhe == "Ficha assinada no balcão" &&
+			alteracoes[1].Canal == disparo.CanalWhatsApp && !alteracoes[1].Consentido && alteracoes[1].IDEnvio == nil
+	})).Return(1, nil)
+	mockDBClient.On("GetConsentimentosCliente", "1", 7).Return([]entity.ConsentimentoCliente{
+		{IDCliente: 7, Canal: disparo.CanalEmail, Consentido: true, Origem: entity.ConsentimentoOrigemLoja},
+	}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.ConsentimentosClienteRequest{
+		Canais: []dtos.ConsentimentoCanalRequest{
+			{Canal: disparo.CanalEmail, Consentido: &sim},
+			{Canal: disparo.CanalWhatsApp, Consentido: &nao},
+		},
+		Origem:  entity.ConsentimentoOrigemLoja,
+		Detalhe: "Ficha assinada no balcão",
+	}
+
+	// Act
+	result, err := service.SetConsentimentosClienteService("1", "7", request)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 7, result.IDCliente)
+	assert.True(t, result.Canais[0].Consentido)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_SetConsentimentosClienteService_CanalRepetido(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	sim, nao := true, false
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.ConsentimentosClienteRequest{
+		Canais: []dtos.ConsentimentoCanalRequest{
+			{Canal: disparo.CanalSMS, Consentido: &sim},
+			{Canal: disparo.CanalSMS, Consentido: &nao},
+		},
+		Origem: entity.ConsentimentoOrigemLoja,
+	}
+
+	// Act
+	result, err := service.SetConsentimentosClienteService("1", "7", request)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Canal 'sms' informed more than once", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA GetHistoricoConsentimentosService
+func TestService_GetHistoricoConsentimentosService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	idEnvio := 9
+	historico := []entity.ConsentimentoHistorico{
+		{ID: 2, IDCliente: 7, Canal: disparo.CanalEmail, Consentido: false, Origem: entity.ConsentimentoOrigemLinkDescadastro, IDEnvio: &idEnvio},
+		{ID: 1, IDCliente: 7, Canal: disparo.CanalEmail, Consentido: true, Origem: entity.ConsentimentoOrigemCadastro},
+	}
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+	mockDBClient.On("GetHistoricoConsentimentosPaginated", "1", 7, disparo.CanalEmail, 30, 0).Return(historico, 2, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetHistoricoConsentimentosService("1", "7", disparo.CanalEmail, 0, 0)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, result.Historico, 2)
+	assert.Equal(t, 9, *result.Historico[0].IDEnvio)
+	assert.Equal(t, 1, result.TotalPages)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_GetHistoricoConsentimentosService_InvalidCanal(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetHistoricoConsentimentosService("1", "7", "telegram", 1, 30)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA ValidarDescadastroService
+func TestService_ValidarDescadastroService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	err := service.ValidarDescadastroService(disparador.Rastreador().Token("1", 9))
+
+	// Assert
+	assert.Nil(t, err)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_ValidarDescadastroService_InvalidToken(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparadorComRastreio(),
+	}
+
+	// Act
+	err := service.ValidarDescadastroService("token.adulterado")
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, "Invalid tracking token", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA RegistrarDescadastroService
+func TestService_RegistrarDescadastroService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+
+	var resultado entity.CampanhaEnvio
+	mockDBClient.On("RegistrarEventoEnvio", "1", 9, mock.Anything).
+		Run(aplicarEventoEnvio(entity.CampanhaEnvio{ID: 9, IDCliente: 7, Canal: disparo.CanalEmail, Status: entity.EnvioStatusAberto}, &resultado)).
+		Return(true, nil)
+	mockDBClient.On("RegistrarConsentimentos", "1", mock.MatchedBy(func(alteracoes []entity.ConsentimentoHistorico) bool {
+		return len(alteracoes) == 1 && alteracoes[0].IDCliente == 7 && alteracoes[0].Canal == disparo.CanalEmail &&
+			!alteracoes[0].Consentido && alteracoes[0].Origem == entity.ConsentimentoOrigemLinkDescadastro &&
+			*alteracoes[0].IDEnvio == 9 && alteracoes[0].Detalhe == nil
+	})).Return(1, nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	err := service.RegistrarDescadastroService(disparador.Rastreador().Token("1", 9))
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, entity.EnvioStatusDescadastrado, resultado.Status)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarDescadastroService_DBError(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+
+	mockDBClient.On("RegistrarEventoEnvio", "1", 9, mock.Anything).Return(true, nil)
+	mockDBClient.On("RegistrarConsentimentos", "1", mock.Anything).Return(0, errors.New("database error"))
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	err := service.RegistrarDescadastroService(disparador.Rastreador().Token("1", 9))
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, "Error registering descadastro", err.Message)
+	assert.Equal(t, 500, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA RegistrarStatusEnvioService (descadastro pela resposta do cliente)
+func TestService_RegistrarStatusEnvioService_PalavraDescadastro(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+	token := disparador.Rastreador().Token("1", 9)
+
+	mockDBClient.On("RegistrarEventoEnvio", "1", 9, mock.Anything).
+		Run(aplicarEventoEnvio(entity.CampanhaEnvio{ID: 9, IDCliente: 7, Canal: disparo.CanalSMS, Status: entity.EnvioStatusEnviado}, new(entity.CampanhaEnvio))).
+		Return(true, nil)
+	mockDBClient.On("RegistrarConsentimentos", "1", mock.MatchedBy(func(alteracoes []entity.ConsentimentoHistorico) bool {
+		return len(alteracoes) == 1 && alteracoes[0].Canal == disparo.CanalSMS && !alteracoes[0].Consentido &&
+			alteracoes[0].Origem == entity.ConsentimentoOrigemPalavraChave && *alteracoes[0].Detalhe == "Sair!"
+	})).Return(1, nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	err := service.RegistrarStatusEnvioService(token, assinaturaLink(t, disparador.Rastreador().RetornoStatus(token)), dtos.StatusEnvioRequest{Status: "reply", Message: "Sair!"})
+
+	// Assert
+	assert.Nil(t, err)
+
+	mockDBClient.AssertExpectations(t)
+}
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/betine97/back-project.git/src/model/service/valorizacao"
+	"github.com/betine97/back-project.git/src/model/service/vendas"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE DEVOLUÇÕES ------------------------------------------------------------------------------------------------------------------------------------
+
+// CreateDevolucaoService registra a devolução de itens de uma venda paga. Os totais da venda não mudam:
+// o valor devolvido fica na devolução, as unidades voltam aos lotes (ou são descartadas) e o cliente recebe
+// o reembolso ou o crédito na loja.
+func (srv *Service) CreateDevolucaoService(userID string, id string, request dtos.CreateDevolucaoRequest) (*dtos.DevolucaoDetalheResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting devolucao creation service", zap.String("id_venda", id), zap.String("tipo_reembolso", request.TipoReembolso), zap.Int("itens", len(request.Itens)))
+
+	venda, restErr := srv.getVenda(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if venda.Status != entity.VendaStatusPaga {
+		return nil, exceptions.NewConflictError(fmt.Sprintf("Venda with status '%s' cannot be returned", venda.Status))
+	}
+
+	itensVenda, dbErr := srv.dbClient.GetItensVenda(venda.IDVenda, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting itens venda from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	itensPorID := map[int]entity.ItemVenda{}
+	for _, item := range itensVenda {
+		itensPorID[item.IDItem] = item
+	}
+
+	// Calcula o valor de cada linha; o mesmo item pode aparecer mais de uma vez (parte no estoque, parte no descarte)
+	devolvidas := map[int]int{}
+	itens := make([]entity.ItemDevolucao, 0, len(request.Itens))
+	var retornoEstoque, retornoDescarte []dtos.ItemSaidaEstoqueRequest
+	var valorTotal float64
+	for _, itemRequest := range request.Itens {
+		itemVenda, ok := itensPorID[itemRequest.IDItemVenda]
+		if !ok {
+			return nil, exceptions.NewNotFoundError(fmt.Sprintf("Item %d not found in venda %d", itemRequest.IDItemVenda, venda.IDVenda))
+		}
+
+		valor, err := vendas.ValorDevolucao(vendas.Devolucao{
+			SubtotalItem:    itemVenda.Subtotal,
+			QuantidadeItem:  itemVenda.Quantidade,
+			JaDevolvida:     itemVenda.QuantidadeDevolvida + devolvidas[itemVenda.IDItem],
+			Quantidade:      itemRequest.Quantidade,
+			SubtotalVenda:   venda.Subtotal,
+			ValorTotalVenda: venda.ValorTotal,
+		})
+		if err != nil {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Item %d: %s", itemVenda.IDItem, err.Error()))
+		}
+		devolvidas[itemVenda.IDItem] += itemRequest.Quantidade
+		valorTotal += valor
+
+		destino := itemRequest.Destino
+		if destino == "" {
+			destino = entity.DevolucaoDestinoEstoque
+		}
+		retorno := dtos.ItemSaidaEstoqueRequest{IDProduto: itemVenda.IDProduto, Quantidade: itemRequest.Quantidade}
+		if destino == entity.DevolucaoDestinoDescarte {
+			retornoDescarte = append(retornoDescarte, retorno)
+		} else {
+			retornoEstoque = append(retornoEstoque, retorno)
+		}
+
+		itens = append(itens, entity.ItemDevolucao{
+			IDItemVenda: itemVenda.IDItem,
+			IDProduto:   itemVenda.IDProduto,
+			Quantidade:  itemRequest.Quantidade,
+			Valor:       valor,
+			Destino:     destino,
+		})
+	}
+	valorTotal = valorizacao.Arredondar(valorTotal)
+
+	// Kits voltam pelos componentes, do mesmo jeito que saíram no pagamento
+	retornos, restErr := srv.planejarRetornosEstoque(userID, retornoEstoque, false)
+	if restErr != nil {
+		return nil, restErr
+	}
+	descartes, restErr := srv.planejarRetornosEstoque(userID, retornoDescarte, true)
+	if restErr != nil {
+		return nil, restErr
+	}
+	retornos = append(retornos, descartes...)
+
+	dataDevolucao := time.Now().Format(formatoDataHora)
+	devolucao := &entity.Devolucao{
+		IDVenda:       venda.IDVenda,
+		IDCliente:     venda.IDCliente,
+		DataDevolucao: dataDevolucao,
+		TipoReembolso: request.TipoReembolso,
+		ValorTotal:    valorTotal,
+		Motivo:        request.Motivo,
+		Operador:      userID,
+	}
+
+	var credito *entity.CreditoCliente
+	var estornoCaixa *entity.CaixaMovimento
+	switch request.TipoReembolso {
+	case entity.DevolucaoCredito:
+		if venda.IDCliente == nil {
+			return nil, exceptions.NewBadRequestError("tipo_reembolso 'credito' requires a venda with id_cliente")
+		}
+		if request.IDCaixa > 0 {
+			return nil, exceptions.NewBadRequestError("id_caixa is only accepted with tipo_reembolso 'reembolso'")
+		}
+		credito = &entity.CreditoCliente{
+			IDCliente:      *venda.IDCliente,
+			Tipo:           entity.CreditoTipoCredito,
+			Valor:          valorTotal,
+			Origem:         entity.CreditoOrigemDevolucao,
+			DataLancamento: dataDevolucao,
+			Descricao:      fmt.Sprintf("Devolução da venda %d", venda.IDVenda),
+		}
+	default:
+		formaReembolso := request.FormaReembolso
+		if formaReembolso == "" {
+			formaReembolso = venda.FormaPagamento
+		}
+		if formaReembolso == entity.FormaPagamentoCreditoLoja {
+			return nil, exceptions.NewBadRequestError("Venda paid with 'credito_loja' must be returned with tipo_reembolso 'credito'")
+		}
+		devolucao.FormaReembolso = formaReembolso
+
+		if request.IDCaixa > 0 {
+			sessao, restErr := srv.getCaixaSessao(userID, strconv.Itoa(request.IDCaixa))
+			if restErr != nil {
+				return nil, restErr
+			}
+			if sessao.Status != entity.CaixaStatusAberto {
+				return nil, exceptions.NewConflictError("Caixa is not open")
+			}
+			devolucao.IDCaixaSessao = &sessao.ID
+			estornoCaixa = &entity.CaixaMovimento{
+				IDSessao:       sessao.ID,
+				Tipo:           entity.CaixaMovimentoEstorno,
+				FormaPagamento: formaReembolso,
+				Valor:          valorTotal,
+				IDVenda:        &venda.IDVenda,
+				Descricao:      fmt.Sprintf("Devolução da venda %d", venda.IDVenda),
+				DataMovimento:  dataDevolucao,
+			}
+		}
+	}
+
+	// Os pontos de fidelidade ganhos na venda saem na proporção do valor devolvido
+	var estornoPontos *entity.PontosCliente
+	if venda.IDCliente != nil {
+		estornoPontos = &entity.PontosCliente{
+			IDCliente:      *venda.IDCliente,
+			Tipo:           entity.PontosTipoDebito,
+			Origem:         entity.PontosOrigemDevolucao,
+			IDReferencia:   venda.IDVenda,
+			DataLancamento: dataDevolucao,
+			Descricao:      fmt.Sprintf("Devolução da venda %d", venda.IDVenda),
+		}
+	}
+
+	dbErr = srv.dbClient.RegistrarDevolucao(devolucao, itens, retornos, credito, estornoCaixa, estornoPontos, userID)
+	if dbErr != nil {
+		switch {
+		case errors.Is(dbErr, gorm.ErrRecordNotFound):
+			return nil, exceptions.NewConflictError("Venda status changed, try again")
+		case errors.Is(dbErr, persistence.ErrItemDevolucaoIndisponivel):
+			return nil, exceptions.NewConflictError("Item quantity already returned, try again")
+		case errors.Is(dbErr, persistence.ErrDevolucaoSemBaixa):
+			return nil, exceptions.NewConflictError("Returned products do not match the stock outflow of the venda")
+		case errors.Is(dbErr, persistence.ErrCaixaFechado):
+			return nil, exceptions.NewConflictError("Caixa is not open")
+		}
+		zap.L().Error("Error registering devolucao in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.DevolucaoDetalheResponse{
+		DevolucaoResponse: buildDevolucaoResponse(*devolucao),
+		Itens:             buildItensDevolucaoResponse(itens),
+	}
+
+	zap.L().Info("Devolucao created successfully", zap.Int("id", devolucao.ID), zap.Float64("valor_total", devolucao.ValorTotal))
+	return response, nil
+}
+
+func (srv *Service) GetAllDevolucoesService(userID string, idVenda string, idCliente string, page, limit int) (*dtos.DevolucaoListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get all devolucoes service", zap.String("id_venda", idVenda), zap.String("id_cliente", idCliente), zap.Int("page", page), zap.Int("limit", limit))
+
+	idVendaInt, idClienteInt, restErr := validarFiltrosDevolucoes(idVenda, idCliente)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	devolucoes, total, dbErr := srv.dbClient.GetDevolucoesPaginated(userID, idVendaInt, idClienteInt, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting devolucoes from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	devolucaoResponses := make([]dtos.DevolucaoResponse, len(devolucoes))
+	for i, devolucao := range devolucoes {
+		devolucaoResponses[i] = buildDevolucaoResponse(devolucao)
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	response := &dtos.DevolucaoListResponse{
+		Devolucoes: devolucaoResponses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+
+	zap.L().Info("Devolucoes service completed successfully", zap.Int("total", total))
+	return response, nil
+}
+
+// validarFiltrosDevolucoes converte os filtros opcionais da listagem; zero significa sem filtro
+func validarFiltrosDevolucoes(idVenda string, idCliente string) (int, int, *exceptions.RestErr) {
+	idVendaInt := 0
+	if idVenda != "" {
+		if _, err := fmt.Sscanf(idVenda, "%d", &idVendaInt); err != nil || idVendaInt <= 0 {
+			return 0, 0, exceptions.NewBadRequestError("Invalid id_venda")
+		}
+	}
+	idClienteInt := 0
+	if idCliente != "" {
+		if _, err := fmt.Sscanf(idCliente, "%d", &idClienteInt); err != nil || idClienteInt <= 0 {
+			return 0, 0, exceptions.NewBadRequestError("Invalid id_cliente")
+		}
+	}
+	return idVendaInt, idClienteInt, nil
+}
+
+func (srv *Service) GetDevolucaoByIDService(userID string, id string) (*dtos.DevolucaoDetalheResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get devolucao by ID service", zap.String("id", id))
+
+	idInt := 0
+	if _, err := fmt.Sscanf(id, "%d", &idInt); err != nil {
+		zap.L().Error("Error converting devolucao id to int", zap.Error(err))
+		return nil, exceptions.NewBadRequestError("Invalid devolucao ID")
+	}
+
+	devolucao, dbErr := srv.dbClient.GetDevolucaoByID(idInt, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Devolucao not found")
+		}
+		zap.L().Error("Error getting devolucao by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	itens, dbErr := srv.dbClient.GetItensDevolucao(devolucao.ID, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting itens devolucao from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.DevolucaoDetalheResponse{
+		DevolucaoResponse: buildDevolucaoResponse(*devolucao),
+		Itens:             buildItensDevolucaoResponse(itens),
+	}
+
+	zap.L().Info("Successfully retrieved devolucao by ID", zap.String("id", id))
+	return response, nil
+}
+
+// GetCreditosClienteService retorna o saldo de crédito na loja do cliente e o extrato dos lançamentos
+func (srv *Service) GetCreditosClienteService(userID string, id string) (*dtos.ExtratoCreditoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get creditos cliente service", zap.String("id", id))
+
+	cliente := srv.dbClient.GetClienteByID(id, userID)
+	if cliente.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Cliente not found")
+	}
+
+	creditos, dbErr := srv.dbClient.GetCreditosCliente(cliente.ID, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting creditos cliente from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.ExtratoCreditoResponse{
+		IDCliente:   cliente.ID,
+		Lancamentos: make([]dtos.CreditoClienteResponse, len(creditos)),
+		Total:       len(creditos),
+	}
+	for i, credito := range creditos {
+		if credito.Tipo == entity.CreditoTipoDebito {
+			response.Saldo -= credito.Valor
+		} else {
+			response.Saldo += credito.Valor
+		}
+		response.Lancamentos[i] = dtos.CreditoClienteResponse{
+			ID:             credito.ID,
+			Tipo:           credito.Tipo,
+			Valor:          credito.Valor,
+			Origem:         credito.Origem,
+			IDReferencia:   credito.IDReferencia,
+			DataLancamento: credito.DataLancamento,
+			Descricao:      credito.Descricao,
+		}
+	}
+	response.Saldo = valorizacao.Arredondar(response.Saldo)
+
+	zap.L().Info("Creditos cliente service completed successfully", zap.Int("id_cliente", cliente.ID), zap.Float64("saldo", response.Saldo))
+	return response, nil
+}
+
+// planejarRetornosEstoque converte os itens devolvidos nas quantidades por produto que voltam aos lotes
+func (srv *Service) planejarRetornosEstoque(userID string, itens []dtos.ItemSaidaEstoqueRequest, descartar bool) ([]entity.DevolucaoEstoque, *exceptions.RestErr) {
+	if len(itens) == 0 {
+		return nil, nil
+	}
+
+	saidas, restErr := srv.planejarSaidasEstoque(userID, itens)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	retornos := make([]entity.DevolucaoEstoque, len(saidas))
+	for i, saida := range saidas {
+		retornos[i] = entity.DevolucaoEstoque{IDProduto: saida.IDProduto, Quantidade: saida.Quantidade, Descartar: descartar}
+	}
+	return retornos, nil
+}
+
+func buildDevolucaoResponse(devolucao entity.Devolucao) dtos.DevolucaoResponse {
+	return dtos.DevolucaoResponse{
+		ID:             devolucao.ID,
+		IDVenda:        devolucao.IDVenda,
+		IDCliente:      devolucao.IDCliente,
+		DataDevolucao:  devolucao.DataDevolucao,
+		TipoReembolso:  devolucao.TipoReembolso,
+		FormaReembolso: devolucao.FormaReembolso,
+		ValorTotal:     devolucao.ValorTotal,
+		Motivo:         devolucao.Motivo,
+		IDCaixaSessao:  devolucao.IDCaixaSessao,
+		Operador:       devolucao.Operador,
+	}
+}
+
+func buildItensDevolucaoResponse(itens []entity.ItemDevolucao) []dtos.ItemDevolucaoResponse {
+	response := make([]dtos.ItemDevolucaoResponse, 0, len(itens))
+	for _, item := range itens {
+		response = append(response, dtos.ItemDevolucaoResponse{
+			ID:          item.ID,
+			IDItemVenda: item.IDItemVenda,
+			IDProduto:   item.IDProduto,
+			Quantidade:  item.Quantidade,
+			Valor:       item.Valor,
+			Destino:     item.Destino,
+		})
+	}
+	return response
+}
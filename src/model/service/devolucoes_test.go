@@ -0,0 +1,289 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func vendaPaga(idCliente *int) *entity.Venda {
+	return &entity.Venda{IDVenda: 21, IDCliente: idCliente, Subtotal: 100, Desconto: 10, ValorTotal: 90, FormaPagamento: entity.FormaPagamentoPix, Status: entity.VendaStatusPaga}
+}
+
+func itensVendaPaga() []entity.ItemVenda {
+	return []entity.ItemVenda{
+		{IDItem: 1, IDVenda: 21, IDProduto: 1, Quantidade: 4, Subtotal: 40},
+		{IDItem: 2, IDVenda: 21, IDProduto: 2, Quantidade: 1, Subtotal: 60},
+	}
+}
+
+// TESTES PARA CreateDevolucaoService
+func TestService_CreateDevolucaoService_Credito(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	idCliente := 7
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(vendaPaga(&idCliente), nil)
+	mockDBClient.On("GetItensVenda", 21, "1").Return(itensVendaPaga(), nil)
+	mockDBClient.On("GetProdutosByIDs", []int{1}, "1").Return([]entity.Produto{{IDProduto: 1, Tipo: entity.ProdutoTipoSimples}}, nil)
+	mockDBClient.On("RegistrarDevolucao", mock.MatchedBy(func(devolucao *entity.Devolucao) bool {
+		return devolucao.IDVenda == 21 && devolucao.ValorTotal == 18 && devolucao.TipoReembolso == entity.DevolucaoCredito
+	}), []entity.ItemDevolucao{
+		{IDItemVenda: 1, IDProduto: 1, Quantidade: 2, Valor: 18, Destino: entity.DevolucaoDestinoEstoque},
+	}, []entity.DevolucaoEstoque{{IDProduto: 1, Quantidade: 2}}, mock.MatchedBy(func(credito *entity.CreditoCliente) bool {
+		return credito.IDCliente == 7 && credito.Tipo == entity.CreditoTipoCredito && credito.Valor == 18 &&
+			credito.Origem == entity.CreditoOrigemDevolucao
+	}), (*entity.CaixaMovimento)(nil), mock.MatchedBy(func(pontos *entity.PontosCliente) bool {
+		return pontos.IDCliente == 7 && pontos.Tipo == entity.PontosTipoDebito && pontos.IDReferencia == 21
+	}), "1").Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.Devolucao).ID = 5
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.CreateDevolucaoRequest{
+		TipoReembolso: entity.DevolucaoCredito,
+		Itens:         []dtos.ItemDevolucaoRequest{{IDItemVenda: 1, Quantidade: 2}},
+	}
+
+	// Act
+	result, err := service.CreateDevolucaoService("1", "21", request)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 5, result.ID)
+	assert.Equal(t, 18.0, result.ValorTotal)
+	assert.Len(t, result.Itens, 1)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateDevolucaoService_ReembolsoNoCaixa(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(vendaPaga(nil), nil)
+	mockDBClient.On("GetItensVenda", 21, "1").Return(itensVendaPaga(), nil)
+	mockDBClient.On("GetProdutosByIDs", []int{2}, "1").Return([]entity.Produto{{IDProduto: 2, Tipo: entity.ProdutoTipoSimples}}, nil)
+	mockDBClient.On("GetCaixaSessaoByID", 2, "1").Return(caixaAberto(), nil)
+	mockDBClient.On("RegistrarDevolucao", mock.MatchedBy(func(devolucao *entity.Devolucao) bool {
+		return devolucao.FormaReembolso == entity.FormaPagamentoDinheiro && *devolucao.IDCaixaSessao == 2 && devolucao.ValorTotal == 54
+	}), mock.Anything, []entity.DevolucaoEstoque{{IDProduto: 2, Quantidade: 1, Descartar: true}}, (*entity.CreditoCliente)(nil),
+		mock.MatchedBy(func(estorno *entity.CaixaMovimento) bool {
+			return estorno.IDSessao == 2 && estorno.Tipo == entity.CaixaMovimentoEstorno && estorno.Valor == 54 && *estorno.IDVenda == 21
+		}), (*entity.PontosCliente)(nil), "1").Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.CreateDevolucaoRequest{
+		TipoReembolso:  entity.DevolucaoReembolso,
+		FormaReembolso: entity.FormaPagamentoDinheiro,
+		IDCaixa:        2,
+		Itens:          []dtos.ItemDevolucaoRequest{{IDItemVenda: 2, Quantidade: 1, Destino: entity.DevolucaoDestinoDescarte}},
+	}
+
+	// Act
+	result, err := service.CreateDevolucaoService("1", "21", request)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 54.0, result.ValorTotal)
+	assert.Equal(t, entity.DevolucaoDestinoDescarte, result.Itens[0].Destino)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateDevolucaoService_VendaNaoPaga(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	venda := vendaPaga(nil)
+	venda.Status = entity.VendaStatusAberta
+	mockDBClient.On("GetVendaByID", 21, "1").Return(venda, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.CreateDevolucaoService("1", "21", dtos.CreateDevolucaoRequest{TipoReembolso: entity.DevolucaoReembolso})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Venda with status 'aberta' cannot be returned", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateDevolucaoService_QuantidadeMaiorQueVendida(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	itens := itensVendaPaga()
+	itens[0].QuantidadeDevolvida = 3
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(vendaPaga(nil), nil)
+	mockDBClient.On("GetItensVenda", 21, "1").Return(itens, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.CreateDevolucaoRequest{
+		TipoReembolso: entity.DevolucaoReembolso,
+		Itens:         []dtos.ItemDevolucaoRequest{{IDItemVenda: 1, Quantidade: 2}},
+	}
+
+	// Act
+	result, err := service.CreateDevolucaoService("1", "21", request)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Item 1: quantidade 2 is greater than the 1 units available for return", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateDevolucaoService_CreditoSemCliente(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(vendaPaga(nil), nil)
+	mockDBClient.On("GetItensVenda", 21, "1").Return(itensVendaPaga(), nil)
+	mockDBClient.On("GetProdutosByIDs", []int{1}, "1").Return([]entity.Produto{{IDProduto: 1, Tipo: entity.ProdutoTipoSimples}}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.CreateDevolucaoRequest{
+		TipoReembolso: entity.DevolucaoCredito,
+		Itens:         []dtos.ItemDevolucaoRequest{{IDItemVenda: 1, Quantidade: 1}},
+	}
+
+	// Act
+	result, err := service.CreateDevolucaoService("1", "21", request)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "tipo_reembolso 'credito' requires a venda with id_cliente", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateDevolucaoService_ItemJaDevolvido(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(vendaPaga(nil), nil)
+	mockDBClient.On("GetItensVenda", 21, "1").Return(itensVendaPaga(), nil)
+	mockDBClient.On("GetProdutosByIDs", []int{1}, "1").Return([]entity.Produto{{IDProduto: 1, Tipo: entity.ProdutoTipoSimples}}, nil)
+	mockDBClient.On("RegistrarDevolucao", mock.AnythingOfType("*entity.Devolucao"), mock.Anything, mock.Anything,
+		(*entity.CreditoCliente)(nil), (*entity.CaixaMovimento)(nil), (*entity.PontosCliente)(nil), "1").Return(persistence.ErrItemDevolucaoIndisponivel)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.CreateDevolucaoRequest{
+		TipoReembolso: entity.DevolucaoReembolso,
+		Itens:         []dtos.ItemDevolucaoRequest{{IDItemVenda: 1, Quantidade: 1}},
+	}
+
+	// Act
+	result, err := service.CreateDevolucaoService("1", "21", request)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Item quantity already returned, try again", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA GetAllDevolucoesService
+func TestService_GetAllDevolucoesService_InvalidIDVenda(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetAllDevolucoesService("1", "abc", "", 1, 30)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Invalid id_venda", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA GetCreditosClienteService
+func TestService_GetCreditosClienteService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	creditos := []entity.CreditoCliente{
+		{ID: 1, IDCliente: 7, Tipo: entity.CreditoTipoCredito, Valor: 18, Origem: entity.CreditoOrigemDevolucao},
+		{ID: 2, IDCliente: 7, Tipo: entity.CreditoTipoDebito, Valor: 5.5, Origem: entity.CreditoOrigemVenda},
+	}
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+	mockDBClient.On("GetCreditosCliente", 7, "1").Return(creditos, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetCreditosClienteService("1", "7")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 12.5, result.Saldo)
+	assert.Equal(t, 2, result.Total)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_GetCreditosClienteService_ClienteNotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{})
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetCreditosClienteService("1", "7")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Cliente not found", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
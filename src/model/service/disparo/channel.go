@@ -0,0 +1,224 @@
+package disparo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"mime"
+	"net/http"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrEnvioRecusado indica que o provedor recusou a mensagem de forma definitiva (ex: destino inválido);
+// reenviar não adianta. Os demais erros são tratados como temporários.
+var ErrEnvioRecusado = errors.New("message rejected by provider")
+
+// Mensagem é o conteúdo já renderizado para um destinatário. Com rastreio, Retorno é a URL para o provedor
+// informar o status da entrega, Pixel é a imagem de abertura do email e Descadastro é o link de descadastro.
+type Mensagem struct {
+	Destino     string
+	Assunto     string
+	Corpo       string
+	Retorno     string
+	Pixel       string
+	Descadastro string
+}
+
+// Channel define o envio de mensagens de campanha por um canal (email, WhatsApp, SMS)
+type Channel interface {
+	Nome() string
+	Enviar(ctx context.Context, mensagem Mensagem) error
+}
+
+// SMTPChannel envia emails por um servidor SMTP
+type SMTPChannel struct {
+	host      string
+	porta     string
+	usuario   string
+	senha     string
+	remetente string
+}
+
+// NewSMTPChannel cria o canal de email; sem usuário o envio é feito sem autenticação
+func NewSMTPChannel(host, porta, usuario, senha, remetente string) Channel {
+	return &SMTPChannel{host: host, porta: porta, usuario: usuario, senha: senha, remetente: remetente}
+}
+
+func (c *SMTPChannel) Nome() string {
+	return CanalEmail
+}
+
+func (c *SMTPChannel) Enviar(ctx context.Context, mensagem Mensagem) error {
+	var auth smtp.Auth
+	if c.usuario != "" {
+		auth = smtp.PlainAuth("", c.usuario, c.senha, c.host)
+	}
+
+	var corpo bytes.Buffer
+	fmt.Fprintf(&corpo, "From: %s\r\n", c.remetente)
+	fmt.Fprintf(&corpo, "To: %s\r\n", mensagem.Destino)
+	fmt.Fprintf(&corpo, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", mensagem.Assunto))
+	if mensagem.Descadastro != "" {
+		// Permite que o cliente de email mostre o botão de descadastro, com o POST em um clique da RFC 8058
+		fmt.Fprintf(&corpo, "List-Unsubscribe: <%s>\r\n", mensagem.Descadastro)
+		corpo.WriteString("List-Unsubscribe-Post: List-Unsubscribe=One-Click\r\n")
+	}
+	corpo.WriteString("MIME-Version: 1.0\r\n")
+	if mensagem.Pixel == "" {
+		corpo.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+		corpo.WriteString(strings.ReplaceAll(mensagem.Corpo, "\n", "\r\n"))
+	} else {
+		// Com rastreio de abertura vai também uma versão HTML com o pixel; o texto continua como alternativa
+		fronteira := "campanha-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+		fmt.Fprintf(&corpo, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", fronteira)
+		fmt.Fprintf(&corpo, "--%s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n", fronteira)
+		corpo.WriteString(strings.ReplaceAll(mensagem.Corpo, "\n", "\r\n"))
+		fmt.Fprintf(&corpo, "\r\n--%s\r\nContent-Type: text/html; charset=UTF-8\r\n\r\n", fronteira)
+		corpo.WriteString(corpoHTML(mensagem.Corpo, mensagem.Pixel))
+		fmt.Fprintf(&corpo, "\r\n--%s--\r\n", fronteira)
+	}
+
+	err := smtp.SendMail(c.host+":"+c.porta, auth, c.remetente, []string{mensagem.Destino}, corpo.Bytes())
+	if err != nil {
+		zap.L().Error("Error sending email", zap.String("destino", mensagem.Destino), zap.Error(err))
+		// Respostas 5xx do servidor são definitivas (ex: caixa inexistente)
+		var smtpErr *textproto.Error
+		if errors.As(err, &smtpErr) && smtpErr.Code >= 500 {
+			return fmt.Errorf("%w: %v", ErrEnvioRecusado, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// corpoHTML converte o texto em HTML, com os links clicáveis e o pixel de abertura no fim
+func corpoHTML(texto, pixel string) string {
+	var saida strings.Builder
+	saida.WriteString(`<div style="white-space:pre-wrap">`)
+	inicio := 0
+	for _, posicao := range expressaoLink.FindAllStringIndex(texto, -1) {
+		link := strings.TrimRight(texto[posicao[0]:posicao[1]], pontuacaoFinal)
+		saida.WriteString(html.EscapeString(texto[inicio:posicao[0]]))
+		fmt.Fprintf(&saida, `<a href="%s">%s</a>`, html.EscapeString(link), html.EscapeString(link))
+		inicio = posicao[0] + len(link)
+	}
+	saida.WriteString(html.EscapeString(texto[inicio:]))
+	fmt.Fprintf(&saida, `</div><img src="%s" width="1" height="1" alt="">`, html.EscapeString(pixel))
+	return strings.ReplaceAll(saida.String(), "\n", "\r\n")
+}
+
+// HTTPChannel envia mensagens de WhatsApp ou SMS por um provedor HTTP, com POST JSON {"to", "message"} e, com
+// rastreio, "callback_url" para o retorno de status
+type HTTPChannel struct {
+	nome   string
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewHTTPChannel cria um canal de provedor HTTP; o token é enviado como Bearer
+func NewHTTPChannel(nome, url, token string) Channel {
+	return &HTTPChannel{
+		nome:   nome,
+		url:    url,
+		token:  token,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (c *HTTPChannel) Nome() string {
+	return c.nome
+}
+
+func (c *HTTPChannel) Enviar(ctx context.Context, mensagem Mensagem) error {
+	payload := map[string]string{
+		"to":      mensagem.Destino,
+		"message": mensagem.Corpo,
+	}
+	if mensagem.Retorno != "" {
+		payload["callback_url"] = mensagem.Retorno
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		zap.L().Error("Error sending message", zap.String("canal", c.nome), zap.Error(err))
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		zap.L().Error("Message rejected by provider", zap.String("canal", c.nome), zap.Int("status", resp.StatusCode))
+		// 4xx é definitivo, exceto limite de requisições; 5xx pode ser tentado de novo
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			return fmt.Errorf("%w: status %d", ErrEnvioRecusado, resp.StatusCode)
+		}
+		return fmt.Errorf("provider returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// FakeChannel guarda as mensagens em memória em vez de enviá-las; usado em testes e no ambiente local.
+// As primeiras Falhas chamadas retornam Erro, para simular indisponibilidade do provedor.
+type FakeChannel struct {
+	nome      string
+	Falhas    int
+	Erro      error
+	mu        sync.Mutex
+	mensagens []Mensagem
+}
+
+func NewFakeChannel(nome string) *FakeChannel {
+	return &FakeChannel{nome: nome}
+}
+
+func (c *FakeChannel) Nome() string {
+	return c.nome
+}
+
+func (c *FakeChannel) Enviar(ctx context.Context, mensagem Mensagem) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Falhas > 0 {
+		c.Falhas--
+		if c.Erro != nil {
+			return c.Erro
+		}
+		return errors.New("fake channel unavailable")
+	}
+
+	c.mensagens = append(c.mensagens, mensagem)
+	zap.L().Info("Fake channel message", zap.String("canal", c.nome), zap.String("destino", mensagem.Destino))
+	return nil
+}
+
+// Mensagens retorna uma cópia das mensagens recebidas
+func (c *FakeChannel) Mensagens() []Mensagem {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Mensagem(nil), c.mensagens...)
+}
@@ -0,0 +1,34 @@
+package disparo
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/betine97/back-project.git/src/model/service/catalogo"
+)
+
+const (
+	// Rodapé das mensagens de email e WhatsApp, seguido do link de descadastro
+	rodapeDescadastro = "\n\nPara não receber mais mensagens, acesse: "
+	// Rodapé do SMS, que não tem espaço para o link
+	rodapeSMS = " Resp SAIR p/ cancelar"
+)
+
+// Palavras que, respondidas a uma mensagem, pedem o descadastro do canal (na forma de catalogo.Chave)
+var palavrasDescadastro = map[string]bool{
+	"sair":         true,
+	"parar":        true,
+	"pare":         true,
+	"stop":         true,
+	"cancelar":     true,
+	"descadastrar": true,
+}
+
+// PalavraDescadastro informa se a resposta do cliente é um pedido de descadastro. A comparação ignora espaços,
+// pontuação, maiúsculas e acentos ("Sair!", " PARAR ").
+func PalavraDescadastro(texto string) bool {
+	palavra := strings.TrimFunc(catalogo.Chave(texto), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	})
+	return palavrasDescadastro[palavra]
+}
@@ -0,0 +1,16 @@
+package disparo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPalavraDescadastro(t *testing.T) {
+	for _, texto := range []string{"SAIR", "sair", " Sair! ", "Parar.", "STOP", "cancelar", "Descadastrar"} {
+		assert.True(t, PalavraDescadastro(texto), texto)
+	}
+	for _, texto := range []string{"", "Oi", "quero sair da loja", "sairr", "obrigado, pode parar"} {
+		assert.False(t, PalavraDescadastro(texto), texto)
+	}
+}
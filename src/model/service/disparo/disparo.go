@@ -0,0 +1,172 @@
+package disparo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+)
+
+// Canais de disparo
+const (
+	CanalEmail    = "email"
+	CanalWhatsApp = "whatsapp"
+	CanalSMS      = "sms"
+)
+
+const (
+	// MaxTentativas é o total de tentativas de um envio antes de ele falhar de vez
+	MaxTentativas = 5
+	// Tamanho máximo de um SMS, em caracteres
+	tamanhoSMS = 160
+	atrasoBase = 30 * time.Second
+	atrasoTeto = 30 * time.Minute
+)
+
+// Destinatario é o cliente que recebe a campanha
+type Destinatario struct {
+	Nome    string
+	Email   string
+	Celular string
+}
+
+// Campanha é o conteúdo da campanha usado na mensagem
+type Campanha struct {
+	Nome      string
+	Descricao string
+}
+
+// Resultado é o desfecho de uma tentativa de envio. Com status na_fila, o envio deve ser tentado de novo após Atraso.
+type Resultado struct {
+	Status string
+	Erro   string
+	Atraso time.Duration
+}
+
+// Disparador reúne os canais configurados, a fila e o limite de envios por minuto de cada canal
+type Disparador struct {
+	canais     map[string]Channel
+	fila       Fila
+	limites    map[string]int
+	rastreador *Rastreador
+}
+
+// NewDisparador cria o disparador; canais nulos são ignorados (não configurados)
+func NewDisparador(fila Fila, limites map[string]int, canais ...Channel) *Disparador {
+	d := &Disparador{
+		canais:  make(map[string]Channel),
+		fila:    fila,
+		limites: limites,
+	}
+	for _, canal := range canais {
+		if canal != nil {
+			d.canais[canal.Nome()] = canal
+		}
+	}
+	return d
+}
+
+// Canal retorna o canal configurado com o nome informado
+func (d *Disparador) Canal(nome string) (Channel, bool) {
+	canal, ok := d.canais[nome]
+	return canal, ok
+}
+
+func (d *Disparador) Fila() Fila {
+	return d.fila
+}
+
+// DefinirRastreador liga o rastreio de entrega, abertura e clique dos envios
+func (d *Disparador) DefinirRastreador(rastreador *Rastreador) {
+	d.rastreador = rastreador
+}
+
+// Rastreador retorna o rastreador configurado; nil quando o rastreio está desligado
+func (d *Disparador) Rastreador() *Rastreador {
+	return d.rastreador
+}
+
+// Limite é o máximo de envios por minuto do canal; zero não limita
+func (d *Disparador) Limite(canal string) int {
+	return d.limites[canal]
+}
+
+// Destino é o endereço do destinatário no canal: o email ou o celular (só dígitos). Vazio quando não há contato.
+func Destino(canal string, destinatario Destinatario) string {
+	switch canal {
+	case CanalEmail:
+		return strings.TrimSpace(destinatario.Email)
+	case CanalWhatsApp, CanalSMS:
+		return soDigitos(destinatario.Celular)
+	default:
+		return ""
+	}
+}
+
+// Renderizar monta a mensagem da campanha para o canal. O email leva o nome da campanha no assunto; o SMS é
+// cortado em 160 caracteres.
+func Renderizar(canal string, campanha Campanha, destinatario Destinatario, destino string) Mensagem {
+	saudacao := "Olá!"
+	if nome := strings.Fields(destinatario.Nome); len(nome) > 0 {
+		saudacao = "Olá, " + nome[0] + "!"
+	}
+
+	mensagem := Mensagem{Destino: destino}
+	switch canal {
+	case CanalEmail:
+		mensagem.Assunto = campanha.Nome
+		mensagem.Corpo = saudacao + "\n\n" + campanha.Descricao
+	case CanalSMS:
+		mensagem.Corpo = cortar(saudacao+" "+campanha.Descricao, tamanhoSMS)
+	default:
+		mensagem.Corpo = saudacao + " " + campanha.Descricao
+	}
+	mensagem.Corpo = strings.TrimSpace(mensagem.Corpo)
+	return mensagem
+}
+
+// Atraso é a espera antes da próxima tentativa: 30s, 1min, 2min... até 30min
+func Atraso(tentativa int) time.Duration {
+	atraso := atrasoBase
+	for i := 1; i < tentativa; i++ {
+		atraso *= 2
+		if atraso >= atrasoTeto {
+			return atrasoTeto
+		}
+	}
+	return atraso
+}
+
+// Enviar faz a tentativa de número informado (começando em 1) e classifica o resultado. Recusas do provedor falham
+// na hora; outros erros voltam para a fila até a última tentativa.
+func Enviar(ctx context.Context, canal Channel, mensagem Mensagem, tentativa int) Resultado {
+	err := canal.Enviar(ctx, mensagem)
+	if err == nil {
+		return Resultado{Status: entity.EnvioStatusEnviado}
+	}
+	if errors.Is(err, ErrEnvioRecusado) || tentativa >= MaxTentativas {
+		return Resultado{Status: entity.EnvioStatusFalhou, Erro: err.Error()}
+	}
+	return Resultado{Status: entity.EnvioStatusNaFila, Erro: err.Error(), Atraso: Atraso(tentativa)}
+}
+
+func soDigitos(valor string) string {
+	var digitos strings.Builder
+	for _, r := range valor {
+		if r >= '0' && r <= '9' {
+			digitos.WriteRune(r)
+		}
+	}
+	return digitos.String()
+}
+
+func cortar(texto string, limite int) string {
+	if utf8.RuneCountInString(texto) <= limite {
+		return texto
+	}
+	runes := []rune(texto)
+	return string(runes[:limite-1]) + "…"
+}
@@ -0,0 +1,111 @@
+package disparo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDestino(t *testing.T) {
+	destinatario := Destinatario{Nome: "Ana Souza", Email: " ana@email.com ", Celular: "(19) 99999-0000"}
+
+	assert.Equal(t, "ana@email.com", Destino(CanalEmail, destinatario))
+	assert.Equal(t, "19999990000", Destino(CanalWhatsApp, destinatario))
+	assert.Equal(t, "19999990000", Destino(CanalSMS, destinatario))
+	assert.Equal(t, "", Destino("pombo", destinatario))
+	assert.Equal(t, "", Destino(CanalEmail, Destinatario{Nome: "Sem Email"}))
+}
+
+func TestRenderizar(t *testing.T) {
+	campanha := Campanha{Nome: "Semana do Pet", Descricao: "Banho com 20% de desconto até domingo."}
+	destinatario := Destinatario{Nome: "Ana Souza"}
+
+	email := Renderizar(CanalEmail, campanha, destinatario, "ana@email.com")
+	assert.Equal(t, "ana@email.com", email.Destino)
+	assert.Equal(t, "Semana do Pet", email.Assunto)
+	assert.Equal(t, "Olá, Ana!\n\nBanho com 20% de desconto até domingo.", email.Corpo)
+
+	whatsapp := Renderizar(CanalWhatsApp, campanha, destinatario, "19999990000")
+	assert.Empty(t, whatsapp.Assunto)
+	assert.Equal(t, "Olá, Ana! Banho com 20% de desconto até domingo.", whatsapp.Corpo)
+
+	semNome := Renderizar(CanalWhatsApp, campanha, Destinatario{}, "19999990000")
+	assert.Equal(t, "Olá! Banho com 20% de desconto até domingo.", semNome.Corpo)
+}
+
+func TestRenderizar_SMSCortado(t *testing.T) {
+	campanha := Campanha{Nome: "Longa", Descricao: strings.Repeat("ã", 300)}
+
+	sms := Renderizar(CanalSMS, campanha, Destinatario{Nome: "Ana"}, "19999990000")
+	assert.Equal(t, 160, utf8.RuneCountInString(sms.Corpo))
+	assert.True(t, strings.HasSuffix(sms.Corpo, "…"))
+}
+
+func TestAtraso(t *testing.T) {
+	assert.Equal(t, 30*time.Second, Atraso(1))
+	assert.Equal(t, time.Minute, Atraso(2))
+	assert.Equal(t, 2*time.Minute, Atraso(3))
+	assert.Equal(t, 30*time.Minute, Atraso(10))
+}
+
+func TestEnviar(t *testing.T) {
+	mensagem := Mensagem{Destino: "ana@email.com", Corpo: "Olá"}
+
+	t.Run("enviado", func(t *testing.T) {
+		canal := NewFakeChannel(CanalEmail)
+
+		resultado := Enviar(context.Background(), canal, mensagem, 1)
+		assert.Equal(t, entity.EnvioStatusEnviado, resultado.Status)
+		assert.Equal(t, []Mensagem{mensagem}, canal.Mensagens())
+	})
+
+	t.Run("erro temporário volta para a fila", func(t *testing.T) {
+		canal := NewFakeChannel(CanalEmail)
+		canal.Falhas = 1
+
+		resultado := Enviar(context.Background(), canal, mensagem, 2)
+		assert.Equal(t, entity.EnvioStatusNaFila, resultado.Status)
+		assert.Equal(t, time.Minute, resultado.Atraso)
+		assert.NotEmpty(t, resultado.Erro)
+		assert.Empty(t, canal.Mensagens())
+	})
+
+	t.Run("última tentativa falha", func(t *testing.T) {
+		canal := NewFakeChannel(CanalEmail)
+		canal.Falhas = 1
+
+		resultado := Enviar(context.Background(), canal, mensagem, MaxTentativas)
+		assert.Equal(t, entity.EnvioStatusFalhou, resultado.Status)
+	})
+
+	t.Run("recusa do provedor falha na hora", func(t *testing.T) {
+		canal := NewFakeChannel(CanalSMS)
+		canal.Falhas = 1
+		canal.Erro = fmt.Errorf("%w: status 400", ErrEnvioRecusado)
+
+		resultado := Enviar(context.Background(), canal, mensagem, 1)
+		assert.Equal(t, entity.EnvioStatusFalhou, resultado.Status)
+		assert.Contains(t, resultado.Erro, "status 400")
+	})
+}
+
+func TestDisparador(t *testing.T) {
+	email := NewFakeChannel(CanalEmail)
+	d := NewDisparador(nil, map[string]int{CanalEmail: 60}, email, nil)
+
+	canal, ok := d.Canal(CanalEmail)
+	assert.True(t, ok)
+	assert.Equal(t, CanalEmail, canal.Nome())
+
+	_, ok = d.Canal(CanalSMS)
+	assert.False(t, ok)
+
+	assert.Equal(t, 60, d.Limite(CanalEmail))
+	assert.Equal(t, 0, d.Limite(CanalWhatsApp))
+}
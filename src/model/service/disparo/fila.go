@@ -0,0 +1,129 @@
+package disparo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	chaveFilaDisparos      = "disparos:fila"
+	chaveDisparosAgendados = "disparos:agendados"
+	prefixoLimiteDisparos  = "disparos:limite:"
+)
+
+// EnvioFila é um item da fila de disparos: a referência ao envio gravado no banco do tenant
+type EnvioFila struct {
+	UserID    string `json:"user_id"`
+	IDEnvio   int    `json:"id_envio"`
+	Canal     string `json:"canal"`
+	Tentativa int    `json:"tentativa"`
+}
+
+// Fila define a fila de envios de campanhas, compartilhada entre as réplicas
+type Fila interface {
+	// Enfileirar coloca os envios no fim da fila, prontos para envio
+	Enfileirar(ctx context.Context, envios []EnvioFila) error
+	// Reagendar devolve o envio à fila a partir do horário informado (retentativas e limite de envio)
+	Reagendar(ctx context.Context, envio EnvioFila, quando time.Time) error
+	// Proximos retira até n envios da fila, depois de liberar os reagendados que já venceram
+	Proximos(ctx context.Context, n int, agora time.Time) ([]EnvioFila, error)
+	// Permitir conta um envio na janela de um minuto do canal e diz se ele cabe no limite (limite <= 0 não limita)
+	Permitir(ctx context.Context, canal string, limite int, agora time.Time) (bool, error)
+}
+
+// RedisFila implementa a fila com uma lista (envios prontos) e um sorted set (envios reagendados, pelo horário)
+type RedisFila struct {
+	client *redis.Client
+}
+
+func NewRedisFila(client *redis.Client) Fila {
+	return &RedisFila{client: client}
+}
+
+func (f *RedisFila) Enfileirar(ctx context.Context, envios []EnvioFila) error {
+	if len(envios) == 0 {
+		return nil
+	}
+
+	valores := make([]interface{}, len(envios))
+	for i, envio := range envios {
+		valor, err := json.Marshal(envio)
+		if err != nil {
+			return err
+		}
+		valores[i] = valor
+	}
+	return f.client.RPush(ctx, chaveFilaDisparos, valores...).Err()
+}
+
+func (f *RedisFila) Reagendar(ctx context.Context, envio EnvioFila, quando time.Time) error {
+	valor, err := json.Marshal(envio)
+	if err != nil {
+		return err
+	}
+	return f.client.ZAdd(ctx, chaveDisparosAgendados, &redis.Z{Score: float64(quando.Unix()), Member: valor}).Err()
+}
+
+func (f *RedisFila) Proximos(ctx context.Context, n int, agora time.Time) ([]EnvioFila, error) {
+	vencidos, err := f.client.ZRangeByScore(ctx, chaveDisparosAgendados, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(agora.Unix(), 10),
+		Count: int64(n),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	for _, valor := range vencidos {
+		// Só a réplica que conseguiu remover o item o devolve à fila
+		removidos, err := f.client.ZRem(ctx, chaveDisparosAgendados, valor).Result()
+		if err != nil {
+			return nil, err
+		}
+		if removidos == 1 {
+			if err := f.client.RPush(ctx, chaveFilaDisparos, valor).Err(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var envios []EnvioFila
+	for len(envios) < n {
+		valor, err := f.client.LPop(ctx, chaveFilaDisparos).Result()
+		if err == redis.Nil {
+			break
+		}
+		if err != nil {
+			return envios, err
+		}
+
+		var envio EnvioFila
+		if err := json.Unmarshal([]byte(valor), &envio); err != nil {
+			return envios, fmt.Errorf("invalid queue item %q: %w", valor, err)
+		}
+		envios = append(envios, envio)
+	}
+	return envios, nil
+}
+
+func (f *RedisFila) Permitir(ctx context.Context, canal string, limite int, agora time.Time) (bool, error) {
+	if limite <= 0 {
+		return true, nil
+	}
+
+	chave := prefixoLimiteDisparos + canal + ":" + agora.Format("200601021504")
+	total, err := f.client.Incr(ctx, chave).Result()
+	if err != nil {
+		return false, err
+	}
+	if total == 1 {
+		if err := f.client.Expire(ctx, chave, 2*time.Minute).Err(); err != nil {
+			return false, err
+		}
+	}
+	return total <= int64(limite), nil
+}
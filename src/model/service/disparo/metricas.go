@@ -0,0 +1,11 @@
+package disparo
+
+import "math"
+
+// Taxa é o percentual de parte sobre total, com duas casas; zero quando não há total
+func Taxa(parte, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Round(float64(parte)*10000/float64(total)) / 100
+}
@@ -0,0 +1,190 @@
+package disparo
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+)
+
+// Eventos recebidos depois do envio, pelo retorno do provedor ou pelos links rastreados
+const (
+	EventoEntregue      = "entregue"
+	EventoAberto        = "aberto"
+	EventoClicado       = "clicado"
+	EventoFalhou        = "falhou"
+	EventoDescadastrado = "descadastrado"
+)
+
+// ErrTokenInvalido indica um token de rastreio adulterado ou de outro servidor
+var ErrTokenInvalido = errors.New("invalid tracking token")
+
+const (
+	formatoDataHora = "2006-01-02 15:04:05"
+	// Bytes do HMAC mantidos na assinatura
+	tamanhoAssinatura = 16
+	// Pontuação no fim de um link (ex: "visite https://loja.com.") que não faz parte dele
+	pontuacaoFinal = ".,;:!?)"
+	// Propósito da assinatura da URL de retorno de status, para que ela não valha como outra assinatura
+	propositoStatus = "status"
+)
+
+// Links nas mensagens
+var expressaoLink = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// Rastreador monta os links de rastreio dos envios: o pixel de abertura do email, os links de clique e a URL de
+// retorno de status do provedor. As rotas de rastreio são públicas, então o token leva o tenant e o envio
+// assinados com HMAC. A URL de retorno leva ainda uma assinatura própria, que não aparece na mensagem, para que
+// quem a recebe não consiga forjar status de entrega.
+type Rastreador struct {
+	base    string
+	segredo []byte
+}
+
+// NewRastreador cria o rastreador com a URL pública da API (ex: https://api.loja.com.br)
+func NewRastreador(base, segredo string) *Rastreador {
+	return &Rastreador{base: strings.TrimRight(base, "/"), segredo: []byte(segredo)}
+}
+
+// Token identifica o envio de um tenant
+func (r *Rastreador) Token(userID string, idEnvio int) string {
+	dados := base64.RawURLEncoding.EncodeToString([]byte(userID + ":" + strconv.Itoa(idEnvio)))
+	return dados + "." + r.assinar(dados)
+}
+
+// LerToken valida a assinatura e retorna o tenant e o envio
+func (r *Rastreador) LerToken(token string) (string, int, error) {
+	dados, assinatura, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(assinatura), []byte(r.assinar(dados))) {
+		return "", 0, ErrTokenInvalido
+	}
+	bruto, err := base64.RawURLEncoding.DecodeString(dados)
+	if err != nil {
+		return "", 0, ErrTokenInvalido
+	}
+	userID, id, ok := strings.Cut(string(bruto), ":")
+	idEnvio, err := strconv.Atoi(id)
+	if !ok || userID == "" || err != nil {
+		return "", 0, ErrTokenInvalido
+	}
+	return userID, idEnvio, nil
+}
+
+// LinkClique leva ao endereço original depois de registrar o clique. A assinatura cobre o endereço para que o link
+// não sirva para redirecionar a outros sites.
+func (r *Rastreador) LinkClique(token, destino string) string {
+	query := url.Values{"url": {destino}, "assinatura": {r.assinar(token + "|" + destino)}}
+	return r.base + "/rastreio/" + token + "/clique?" + query.Encode()
+}
+
+// ValidarClique confere se o endereço de destino foi gerado para o token
+func (r *Rastreador) ValidarClique(token, destino, assinatura string) bool {
+	return hmac.Equal([]byte(assinatura), []byte(r.assinar(token+"|"+destino)))
+}
+
+// RetornoStatus é a URL em que o provedor informa o status do envio
+func (r *Rastreador) RetornoStatus(token string) string {
+	query := url.Values{"assinatura": {r.assinar(propositoStatus + "|" + token)}}
+	return r.base + "/rastreio/" + token + "/status?" + query.Encode()
+}
+
+// ValidarStatus confere se a URL de retorno de status foi gerada para o token
+func (r *Rastreador) ValidarStatus(token, assinatura string) bool {
+	return hmac.Equal([]byte(assinatura), []byte(r.assinar(propositoStatus+"|"+token)))
+}
+
+// Rastrear prepara a mensagem do envio: troca os links por links de clique, inclui o pixel de abertura no email,
+// a URL de retorno de status e o rodapé de descadastro. Os links do SMS não são trocados, para não estourar os 160
+// caracteres; no SMS o rodapé indica a palavra-chave de descadastro em vez do link.
+func (r *Rastreador) Rastrear(canal string, mensagem Mensagem, userID string, idEnvio int) Mensagem {
+	token := r.Token(userID, idEnvio)
+	mensagem.Retorno = r.RetornoStatus(token)
+	mensagem.Descadastro = r.base + "/rastreio/" + token + "/descadastro"
+
+	if canal == CanalSMS {
+		mensagem.Corpo = cortar(mensagem.Corpo, tamanhoSMS-utf8.RuneCountInString(rodapeSMS)) + rodapeSMS
+		return mensagem
+	}
+	mensagem.Corpo = expressaoLink.ReplaceAllStringFunc(mensagem.Corpo, func(link string) string {
+		destino := strings.TrimRight(link, pontuacaoFinal)
+		return r.LinkClique(token, destino) + link[len(destino):]
+	})
+	mensagem.Corpo += rodapeDescadastro + mensagem.Descadastro
+	if canal == CanalEmail {
+		mensagem.Pixel = r.base + "/rastreio/" + token + "/abertura"
+	}
+	return mensagem
+}
+
+func (r *Rastreador) assinar(dados string) string {
+	mac := hmac.New(sha256.New, r.segredo)
+	mac.Write([]byte(dados))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:tamanhoAssinatura])
+}
+
+// Ordem do status depois do envio: um evento nunca faz o envio voltar (ex: entrega recebida depois do clique)
+var ordemStatusEnvio = map[string]int{
+	entity.EnvioStatusEnviado:       1,
+	entity.EnvioStatusEntregue:      2,
+	entity.EnvioStatusAberto:        3,
+	entity.EnvioStatusClicado:       4,
+	entity.EnvioStatusDescadastrado: 5,
+}
+
+// AplicarEvento atualiza o envio com o evento recebido. Abertura implica entrega e clique implica abertura, porque
+// muitos clientes de email bloqueiam o pixel. A falha só vale para envios ainda não entregues (ex: bounce).
+// Retorna false quando o evento não se aplica ao envio, como em envios que ainda não saíram.
+func AplicarEvento(envio entity.CampanhaEnvio, evento, erro string, agora time.Time) (entity.CampanhaEnvio, bool) {
+	if _, enviado := ordemStatusEnvio[envio.Status]; !enviado {
+		return envio, false
+	}
+	data := agora.Format(formatoDataHora)
+	marcar := func(campo **string) {
+		if *campo == nil {
+			*campo = &data
+		}
+	}
+	avancar := func(status string) {
+		if ordemStatusEnvio[status] > ordemStatusEnvio[envio.Status] {
+			envio.Status = status
+		}
+	}
+
+	switch evento {
+	case EventoEntregue:
+		marcar(&envio.DataEntrega)
+		avancar(entity.EnvioStatusEntregue)
+	case EventoAberto:
+		marcar(&envio.DataEntrega)
+		marcar(&envio.DataAbertura)
+		avancar(entity.EnvioStatusAberto)
+	case EventoClicado:
+		marcar(&envio.DataEntrega)
+		marcar(&envio.DataAbertura)
+		marcar(&envio.DataClique)
+		envio.Cliques++
+		avancar(entity.EnvioStatusClicado)
+	case EventoDescadastrado:
+		marcar(&envio.DataDescadastro)
+		avancar(entity.EnvioStatusDescadastrado)
+	case EventoFalhou:
+		if envio.Status != entity.EnvioStatusEnviado {
+			return envio, false
+		}
+		envio.Status = entity.EnvioStatusFalhou
+		if erro != "" {
+			envio.Erro = &erro
+		}
+	default:
+		return envio, false
+	}
+	return envio, true
+}
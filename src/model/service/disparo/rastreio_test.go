@@ -0,0 +1,129 @@
+package disparo
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRastreador_Token(t *testing.T) {
+	r := NewRastreador("https://api.loja.com/", "segredo")
+
+	userID, idEnvio, err := r.LerToken(r.Token("42", 1234))
+	require.NoError(t, err)
+	assert.Equal(t, "42", userID)
+	assert.Equal(t, 1234, idEnvio)
+
+	outro := NewRastreador("https://api.loja.com", "outro segredo")
+	invalidos := []string{"", "sem-ponto", outro.Token("42", 1234), r.Token("42", 1234) + "x"}
+	for _, token := range invalidos {
+		_, _, err := r.LerToken(token)
+		assert.ErrorIs(t, err, ErrTokenInvalido, token)
+	}
+}
+
+func TestRastreador_Rastrear(t *testing.T) {
+	r := NewRastreador("https://api.loja.com", "segredo")
+	token := r.Token("42", 7)
+	mensagem := Mensagem{Destino: "ana@email.com", Corpo: "Confira em https://loja.com/promo?id=1. Até logo"}
+
+	email := r.Rastrear(CanalEmail, mensagem, "42", 7)
+	retorno, err := url.Parse(email.Retorno)
+	require.NoError(t, err)
+	assert.Equal(t, "/rastreio/"+token+"/status", retorno.Path)
+	assert.True(t, r.ValidarStatus(token, retorno.Query().Get("assinatura")))
+	assert.False(t, r.ValidarStatus(r.Token("42", 8), retorno.Query().Get("assinatura")))
+	assert.False(t, r.ValidarStatus(token, ""))
+	assert.Equal(t, "https://api.loja.com/rastreio/"+token+"/abertura", email.Pixel)
+	assert.True(t, strings.HasPrefix(email.Corpo, "Confira em https://api.loja.com/rastreio/"+token+"/clique?"))
+	assert.Equal(t, "https://api.loja.com/rastreio/"+token+"/descadastro", email.Descadastro)
+	assert.True(t, strings.HasSuffix(email.Corpo, ". Até logo"+rodapeDescadastro+email.Descadastro))
+
+	link, err := url.Parse(strings.Fields(email.Corpo)[2])
+	require.NoError(t, err)
+	destino := strings.TrimSuffix(link.Query().Get("url"), ".")
+	assert.Equal(t, "https://loja.com/promo?id=1", destino)
+	assert.True(t, r.ValidarClique(token, destino, link.Query().Get("assinatura")))
+	assert.False(t, r.ValidarClique(token, "https://golpe.com", link.Query().Get("assinatura")))
+
+	whatsapp := r.Rastrear(CanalWhatsApp, mensagem, "42", 7)
+	assert.Empty(t, whatsapp.Pixel)
+	assert.Contains(t, whatsapp.Corpo, "/clique?")
+	assert.True(t, strings.HasSuffix(whatsapp.Corpo, rodapeDescadastro+whatsapp.Descadastro))
+
+	sms := r.Rastrear(CanalSMS, mensagem, "42", 7)
+	assert.Equal(t, mensagem.Corpo+rodapeSMS, sms.Corpo)
+	assert.NotEmpty(t, sms.Retorno)
+
+	longo := r.Rastrear(CanalSMS, Mensagem{Corpo: strings.Repeat("a", tamanhoSMS)}, "42", 7)
+	assert.Equal(t, tamanhoSMS, utf8.RuneCountInString(longo.Corpo))
+	assert.True(t, strings.HasSuffix(longo.Corpo, "…"+rodapeSMS))
+}
+
+func TestAplicarEvento(t *testing.T) {
+	agora := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		status   string
+		evento   string
+		esperado string
+		aplicado bool
+		entregue bool
+		aberto   bool
+		clicado  bool
+	}{
+		{"entrega", entity.EnvioStatusEnviado, EventoEntregue, entity.EnvioStatusEntregue, true, true, false, false},
+		{"abertura implica entrega", entity.EnvioStatusEnviado, EventoAberto, entity.EnvioStatusAberto, true, true, true, false},
+		{"clique implica abertura", entity.EnvioStatusEntregue, EventoClicado, entity.EnvioStatusClicado, true, true, true, true},
+		{"entrega depois do clique não volta", entity.EnvioStatusClicado, EventoEntregue, entity.EnvioStatusClicado, true, true, false, false},
+		{"falha antes da entrega", entity.EnvioStatusEnviado, EventoFalhou, entity.EnvioStatusFalhou, true, false, false, false},
+		{"falha depois da entrega é ignorada", entity.EnvioStatusEntregue, EventoFalhou, entity.EnvioStatusEntregue, false, false, false, false},
+		{"envio na fila ignora eventos", entity.EnvioStatusNaFila, EventoEntregue, entity.EnvioStatusNaFila, false, false, false, false},
+		{"evento desconhecido", entity.EnvioStatusEnviado, "lido", entity.EnvioStatusEnviado, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envio, aplicado := AplicarEvento(entity.CampanhaEnvio{Status: tt.status}, tt.evento, "bounce", agora)
+			assert.Equal(t, tt.aplicado, aplicado)
+			assert.Equal(t, tt.esperado, envio.Status)
+			assert.Equal(t, tt.entregue, envio.DataEntrega != nil)
+			assert.Equal(t, tt.aberto, envio.DataAbertura != nil)
+			assert.Equal(t, tt.clicado, envio.DataClique != nil)
+		})
+	}
+}
+
+func TestAplicarEvento_GuardaPrimeiraData(t *testing.T) {
+	primeira := "2025-03-01 09:00:00"
+	envio := entity.CampanhaEnvio{Status: entity.EnvioStatusClicado, DataEntrega: &primeira, DataAbertura: &primeira, DataClique: &primeira, Cliques: 1}
+
+	envio, aplicado := AplicarEvento(envio, EventoClicado, "", time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC))
+	assert.True(t, aplicado)
+	assert.Equal(t, primeira, *envio.DataClique)
+	assert.Equal(t, 2, envio.Cliques)
+
+	envio, _ = AplicarEvento(envio, EventoDescadastrado, "", time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, entity.EnvioStatusDescadastrado, envio.Status)
+	assert.Equal(t, "2025-03-02 00:00:00", *envio.DataDescadastro)
+}
+
+func TestCorpoHTML(t *testing.T) {
+	corpo := corpoHTML("Oi <Ana>!\nVeja https://loja.com.", "https://api.loja.com/rastreio/t/abertura")
+
+	assert.Equal(t, `<div style="white-space:pre-wrap">Oi &lt;Ana&gt;!`+"\r\n"+`Veja <a href="https://loja.com">https://loja.com</a>.</div>`+
+		`<img src="https://api.loja.com/rastreio/t/abertura" width="1" height="1" alt="">`, corpo)
+}
+
+func TestTaxa(t *testing.T) {
+	assert.Equal(t, 33.33, Taxa(1, 3))
+	assert.Equal(t, 100.0, Taxa(5, 5))
+	assert.Equal(t, 0.0, Taxa(3, 0))
+}
@@ -0,0 +1,261 @@
+package disparo
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+)
+
+// Campos disponíveis nos templates. Cada campo vira texto; não há acesso a outros dados nem execução de lógica.
+var camposTemplate = map[string]bool{
+	"cliente.nome":          true,
+	"cliente.primeiro_nome": true,
+	"cliente.email":         true,
+	"cliente.celular":       true,
+	"cliente.aniversario":   true,
+
+	"pet.nome":        true,
+	"pet.especie":     true,
+	"pet.raca":        true,
+	"pet.porte":       true,
+	"pet.idade":       true,
+	"pet.aniversario": true,
+
+	"endereco.logradouro":  true,
+	"endereco.numero":      true,
+	"endereco.complemento": true,
+	"endereco.bairro":      true,
+	"endereco.cidade":      true,
+	"endereco.estado":      true,
+	"endereco.cep":         true,
+
+	"loja.nome":   true,
+	"loja.cidade": true,
+	"loja.estado": true,
+
+	"campanha.nome":      true,
+	"campanha.descricao": true,
+}
+
+// CamposTemplate lista, em ordem alfabética, os campos que podem ser usados nos templates
+func CamposTemplate() []string {
+	campos := make([]string, 0, len(camposTemplate))
+	for campo := range camposTemplate {
+		campos = append(campos, campo)
+	}
+	sort.Strings(campos)
+	return campos
+}
+
+// Template é um texto com campos no formato {{cliente.nome}}. Um campo pode ter um valor padrão, usado quando o
+// dado não existe: {{pet.nome | "seu pet"}}.
+type Template struct {
+	partes []parteTemplate
+}
+
+type parteTemplate struct {
+	texto  string
+	campo  string
+	padrao *string
+}
+
+// CompilarTemplate lê o texto e valida os campos usados
+func CompilarTemplate(texto string) (*Template, error) {
+	t := &Template{}
+	resto := texto
+	posicao := 0
+	for {
+		inicio := strings.Index(resto, "{{")
+		if inicio < 0 {
+			t.adicionarTexto(resto)
+			return t, nil
+		}
+		t.adicionarTexto(resto[:inicio])
+
+		fim := strings.Index(resto[inicio+2:], "}}")
+		if fim < 0 {
+			return nil, fmt.Errorf("unclosed placeholder at position %d", posicao+inicio)
+		}
+		conteudo := resto[inicio+2 : inicio+2+fim]
+		parte, err := compilarCampo(conteudo)
+		if err != nil {
+			return nil, err
+		}
+		t.partes = append(t.partes, parte)
+
+		avanco := inicio + 2 + fim + 2
+		resto = resto[avanco:]
+		posicao += avanco
+	}
+}
+
+func compilarCampo(conteudo string) (parteTemplate, error) {
+	campo, padrao, temPadrao := strings.Cut(conteudo, "|")
+	campo = strings.TrimSpace(campo)
+	if campo == "" {
+		return parteTemplate{}, fmt.Errorf("empty placeholder '{{%s}}'", conteudo)
+	}
+	if !camposTemplate[campo] {
+		return parteTemplate{}, fmt.Errorf("unknown field '%s'", campo)
+	}
+
+	parte := parteTemplate{campo: campo}
+	if temPadrao {
+		padrao = strings.TrimSpace(padrao)
+		valor, err := strconv.Unquote(padrao)
+		if err != nil || !strings.HasPrefix(padrao, `"`) {
+			return parteTemplate{}, fmt.Errorf("default value of '%s' must be a quoted text", campo)
+		}
+		parte.padrao = &valor
+	}
+	return parte, nil
+}
+
+func (t *Template) adicionarTexto(texto string) {
+	if texto != "" {
+		t.partes = append(t.partes, parteTemplate{texto: texto})
+	}
+}
+
+// Campos lista os campos usados no template, sem repetição e na ordem em que aparecem
+func (t *Template) Campos() []string {
+	var campos []string
+	vistos := make(map[string]bool)
+	for _, parte := range t.partes {
+		if parte.campo != "" && !vistos[parte.campo] {
+			vistos[parte.campo] = true
+			campos = append(campos, parte.campo)
+		}
+	}
+	return campos
+}
+
+// Executar substitui os campos pelos valores. Retorna também os campos que ficaram sem valor (sem dado e sem
+// padrão), que são trocados por texto vazio.
+func (t *Template) Executar(valores map[string]string) (string, []string) {
+	var texto strings.Builder
+	var naoResolvidos []string
+	for _, parte := range t.partes {
+		if parte.campo == "" {
+			texto.WriteString(parte.texto)
+			continue
+		}
+		if valor := valores[parte.campo]; valor != "" {
+			texto.WriteString(valor)
+		} else if parte.padrao != nil {
+			texto.WriteString(*parte.padrao)
+		} else if !contem(naoResolvidos, parte.campo) {
+			naoResolvidos = append(naoResolvidos, parte.campo)
+		}
+	}
+	return texto.String(), naoResolvidos
+}
+
+// Loja são os dados do tenant usados nos templates
+type Loja struct {
+	Nome   string
+	Cidade string
+	Estado string
+}
+
+// DadosTemplate reúne os dados de um destinatário. Pet e Endereco são opcionais; o cliente com mais de um pet ou
+// endereço usa o primeiro cadastrado.
+type DadosTemplate struct {
+	Cliente  entity.Cliente
+	Pet      *entity.Pet
+	Endereco *entity.Endereco
+	Loja     Loja
+	Campanha Campanha
+}
+
+// Valores monta o valor de cada campo. Campos sem dado ficam de fora.
+func (d DadosTemplate) Valores() map[string]string {
+	valores := make(map[string]string)
+	definir := func(campo, valor string) {
+		if valor = strings.TrimSpace(valor); valor != "" {
+			valores[campo] = valor
+		}
+	}
+
+	definir("cliente.nome", d.Cliente.NomeCliente)
+	if nome := strings.Fields(d.Cliente.NomeCliente); len(nome) > 0 {
+		definir("cliente.primeiro_nome", nome[0])
+	}
+	definir("cliente.email", d.Cliente.Email)
+	definir("cliente.celular", d.Cliente.NumeroCelular)
+	if len(d.Cliente.DataNascimento) >= 10 {
+		if data, err := time.Parse("2006-01-02", d.Cliente.DataNascimento[:10]); err == nil {
+			definir("cliente.aniversario", data.Format("02/01"))
+		}
+	}
+
+	if d.Pet != nil {
+		definir("pet.nome", d.Pet.NomePet)
+		definir("pet.especie", d.Pet.Especie)
+		definir("pet.raca", d.Pet.Raca)
+		definir("pet.porte", d.Pet.Porte)
+		if d.Pet.Idade != nil {
+			definir("pet.idade", strconv.Itoa(*d.Pet.Idade))
+		}
+		if d.Pet.DataAniversario != nil {
+			definir("pet.aniversario", d.Pet.DataAniversario.Format("02/01"))
+		}
+	}
+
+	if d.Endereco != nil {
+		definir("endereco.logradouro", d.Endereco.Logradouro)
+		definir("endereco.numero", d.Endereco.Numero)
+		definir("endereco.complemento", d.Endereco.Complemento)
+		definir("endereco.bairro", d.Endereco.Bairro)
+		definir("endereco.cidade", d.Endereco.Cidade)
+		definir("endereco.estado", d.Endereco.Estado)
+		definir("endereco.cep", d.Endereco.CEP)
+	}
+
+	definir("loja.nome", d.Loja.Nome)
+	definir("loja.cidade", d.Loja.Cidade)
+	definir("loja.estado", d.Loja.Estado)
+
+	definir("campanha.nome", d.Campanha.Nome)
+	definir("campanha.descricao", d.Campanha.Descricao)
+	return valores
+}
+
+// RenderizarTemplate monta a mensagem a partir dos templates de assunto (só email, pode ser nil) e corpo. Retorna
+// os campos que não puderam ser preenchidos; a mensagem só deve ser enviada quando a lista vier vazia.
+func RenderizarTemplate(canal string, assunto, corpo *Template, dados DadosTemplate, destino string) (Mensagem, []string) {
+	valores := dados.Valores()
+
+	mensagem := Mensagem{Destino: destino}
+	var naoResolvidos []string
+	if canal == CanalEmail && assunto != nil {
+		mensagem.Assunto, naoResolvidos = assunto.Executar(valores)
+		mensagem.Assunto = strings.TrimSpace(mensagem.Assunto)
+	}
+
+	texto, faltando := corpo.Executar(valores)
+	for _, campo := range faltando {
+		if !contem(naoResolvidos, campo) {
+			naoResolvidos = append(naoResolvidos, campo)
+		}
+	}
+
+	mensagem.Corpo = strings.TrimSpace(texto)
+	if canal == CanalSMS {
+		mensagem.Corpo = cortar(mensagem.Corpo, tamanhoSMS)
+	}
+	return mensagem, naoResolvidos
+}
+
+func contem(lista []string, valor string) bool {
+	for _, item := range lista {
+		if item == valor {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package disparo
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dadosExemplo() DadosTemplate {
+	aniversario := time.Date(2020, 3, 15, 0, 0, 0, 0, time.UTC)
+	idade := 5
+	return DadosTemplate{
+		Cliente:  entity.Cliente{NomeCliente: "Ana Souza", Email: "ana@email.com", NumeroCelular: "19999990000", DataNascimento: "1990-07-04"},
+		Pet:      &entity.Pet{NomePet: "Thor", Especie: "Cachorro", DataAniversario: &aniversario, Idade: &idade},
+		Endereco: &entity.Endereco{Cidade: "Campinas", Estado: "SP"},
+		Loja:     Loja{Nome: "Pet Feliz"},
+		Campanha: Campanha{Nome: "Aniversário do Pet"},
+	}
+}
+
+func TestCompilarTemplate(t *testing.T) {
+	tmpl, err := CompilarTemplate("Oi {{cliente.nome}}, o {{ pet.nome }} faz aniversário dia {{pet.aniversario}}! {{cliente.nome}}")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cliente.nome", "pet.nome", "pet.aniversario"}, tmpl.Campos())
+
+	semCampos, err := CompilarTemplate("Promoção de banho")
+	require.NoError(t, err)
+	assert.Empty(t, semCampos.Campos())
+}
+
+func TestCompilarTemplate_Invalido(t *testing.T) {
+	casos := map[string]string{
+		"Oi {{cliente.nome":             "unclosed placeholder at position 3",
+		"Oi {{ }}":                      "empty placeholder",
+		"Oi {{cliente.senha}}":          "unknown field 'cliente.senha'",
+		"Oi {{cliente.nome | amigo}}":   "default value of 'cliente.nome' must be a quoted text",
+		"Oi {{cliente.nome | 'amigo'}}": "default value of 'cliente.nome' must be a quoted text",
+	}
+	for texto, esperado := range casos {
+		_, err := CompilarTemplate(texto)
+		if assert.Error(t, err, texto) {
+			assert.Contains(t, err.Error(), esperado, texto)
+		}
+	}
+}
+
+func TestTemplate_Executar(t *testing.T) {
+	tmpl, err := CompilarTemplate("Oi {{cliente.primeiro_nome}}, o {{pet.nome}} faz aniversário dia {{pet.aniversario}}! Beijos, {{loja.nome}}")
+	require.NoError(t, err)
+
+	texto, naoResolvidos := tmpl.Executar(dadosExemplo().Valores())
+	assert.Equal(t, "Oi Ana, o Thor faz aniversário dia 15/03! Beijos, Pet Feliz", texto)
+	assert.Empty(t, naoResolvidos)
+}
+
+func TestTemplate_ExecutarSemDados(t *testing.T) {
+	tmpl, err := CompilarTemplate(`Oi {{cliente.nome}}! O {{pet.nome | "seu pet"}} merece um banho. {{pet.raca}} {{pet.raca}}`)
+	require.NoError(t, err)
+
+	dados := dadosExemplo()
+	dados.Pet = nil
+	texto, naoResolvidos := tmpl.Executar(dados.Valores())
+	assert.Equal(t, "Oi Ana Souza! O seu pet merece um banho.  ", texto)
+	assert.Equal(t, []string{"pet.raca"}, naoResolvidos)
+}
+
+func TestTemplate_NaoInterpretaValores(t *testing.T) {
+	tmpl, err := CompilarTemplate("Oi {{cliente.nome}}")
+	require.NoError(t, err)
+
+	texto, naoResolvidos := tmpl.Executar(map[string]string{"cliente.nome": "{{loja.nome}}"})
+	assert.Equal(t, "Oi {{loja.nome}}", texto)
+	assert.Empty(t, naoResolvidos)
+}
+
+func TestDadosTemplate_Valores(t *testing.T) {
+	valores := dadosExemplo().Valores()
+
+	assert.Equal(t, "Ana", valores["cliente.primeiro_nome"])
+	assert.Equal(t, "04/07", valores["cliente.aniversario"])
+	assert.Equal(t, "5", valores["pet.idade"])
+	assert.Equal(t, "Campinas", valores["endereco.cidade"])
+	assert.NotContains(t, valores, "endereco.bairro")
+	assert.NotContains(t, valores, "loja.cidade")
+	for campo := range valores {
+		assert.Contains(t, CamposTemplate(), campo)
+	}
+}
+
+func TestRenderizarTemplate(t *testing.T) {
+	assunto, err := CompilarTemplate("{{campanha.nome}} do {{pet.nome}}")
+	require.NoError(t, err)
+	corpo, err := CompilarTemplate("Oi {{cliente.primeiro_nome}}!\n\nVenha para a {{loja.nome}} em {{endereco.bairro}}.")
+	require.NoError(t, err)
+
+	email, naoResolvidos := RenderizarTemplate(CanalEmail, assunto, corpo, dadosExemplo(), "ana@email.com")
+	assert.Equal(t, "ana@email.com", email.Destino)
+	assert.Equal(t, "Aniversário do Pet do Thor", email.Assunto)
+	assert.Equal(t, "Oi Ana!\n\nVenha para a Pet Feliz em .", email.Corpo)
+	assert.Equal(t, []string{"endereco.bairro"}, naoResolvidos)
+
+	whatsapp, _ := RenderizarTemplate(CanalWhatsApp, assunto, corpo, dadosExemplo(), "19999990000")
+	assert.Empty(t, whatsapp.Assunto)
+}
+
+func TestRenderizarTemplate_SMSCortado(t *testing.T) {
+	corpo, err := CompilarTemplate(strings.Repeat("a", 200) + " {{cliente.nome}}")
+	require.NoError(t, err)
+
+	sms, naoResolvidos := RenderizarTemplate(CanalSMS, nil, corpo, dadosExemplo(), "19999990000")
+	assert.Equal(t, 160, utf8.RuneCountInString(sms.Corpo))
+	assert.Empty(t, naoResolvidos)
+}
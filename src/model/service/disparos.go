@@ -0,0 +1,545 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/disparo"
+	"go.uber.org/zap"
+)
+
+const (
+	// Quantidade de envios retirados da fila a cada execução do job
+	loteDisparos = 200
+	// Tempo máximo de uma tentativa de envio
+	timeoutEnvio = 30 * time.Second
+	// Tamanho da coluna erro de campanhas_envios
+	tamanhoErroEnvio = 500
+	// Intervalo em que os envios de uma campanha pausada voltam a ser verificados
+	esperaCampanhaPausada = 5 * time.Minute
+	// Tempo sem atualização depois do qual um envio em 'enviando' é dado como abandonado pela réplica
+	envioAbandonado = 10 * time.Minute
+	// Tempo sem atualização depois do qual um envio em 'na_fila' é dado como perdido da fila; maior que a maior
+	// espera entre tentativas (30 minutos), para não antecipar retentativas
+	envioPerdido = time.Hour
+)
+
+// FUNÇÕES DE DISPAROS DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+// DispararCampanhaService expande os públicos da campanha em destinatários, monta a mensagem de cada um, grava um
+// envio por cliente e canal e coloca os envios na fila. Sem canais no pedido, usa os canais configurados na campanha.
+// O disparo é recusado se algum campo dos templates ficar sem valor. Disparar de novo só cria os envios que faltam
+// (ex: clientes que entraram nos públicos).
+func (srv *Service) DispararCampanhaService(userID string, idCampanha string, request dtos.DispararCampanhaRequest) (*dtos.DisparoCampanhaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting disparar campanha service", zap.String("idCampanha", idCampanha), zap.Strings("canais", request.Canais))
+
+	if srv.disparador == nil {
+		return nil, exceptions.NewInternalServerError("Dispatch is not configured")
+	}
+
+	campanha := srv.dbClient.GetCampanhaByID(idCampanha, userID)
+	if campanha.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Campanha not found")
+	}
+	if campanha.Status != entity.CampanhaStatusAtiva {
+		return nil, exceptions.NewConflictError("Only active campanhas can be dispatched")
+	}
+
+	response, restErr := srv.dispararCampanha(userID, campanha, request.Canais)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	zap.L().Info("Campanha dispatched successfully", zap.Int("idCampanha", campanha.ID), zap.Int("destinatarios", response.Destinatarios), zap.Int("enfileirados", response.Enfileirados))
+	return response, nil
+}
+
+// dispararCampanha cria os envios da campanha, coloca na fila os que aguardam a primeira tentativa e registra a data
+// do disparo. É usada pelo disparo manual e pelo automático no lançamento.
+func (srv *Service) dispararCampanha(userID string, campanha *entity.Campanha, canais []string) (*dtos.DisparoCampanhaResponse, *exceptions.RestErr) {
+	plano, restErr := srv.planejarDisparo(userID, campanha, canais)
+	if restErr != nil {
+		return nil, restErr
+	}
+	for _, canal := range plano.canais {
+		if _, ok := srv.disparador.Canal(canal.Canal); !ok {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Channel '%s' is not configured", canal.Canal))
+		}
+	}
+	if plano.naoResolvidos > 0 {
+		return nil, exceptions.NewConflictError(fmt.Sprintf("Template fields have no value for %d envios, check GET /api/campanhas/%d/validacao", plano.naoResolvidos, campanha.ID))
+	}
+
+	criados, pendentes, dbErr := srv.dbClient.CriarEnviosCampanha(userID, campanha.ID, plano.envios)
+	if dbErr != nil {
+		zap.L().Error("Error creating envios of campanha", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	// Os envios ainda sem tentativa voltam à fila; a reserva no banco impede que um item repetido seja enviado duas vezes
+	itens := make([]disparo.EnvioFila, len(pendentes))
+	for i, envio := range pendentes {
+		itens[i] = disparo.EnvioFila{UserID: userID, IDEnvio: envio.ID, Canal: envio.Canal}
+	}
+	if err := srv.disparador.Fila().Enfileirar(ctx, itens); err != nil {
+		zap.L().Error("Error queueing envios of campanha", zap.Error(err))
+		return nil, exceptions.NewInternalServerError("Error queueing envios")
+	}
+
+	response := &dtos.DisparoCampanhaResponse{
+		IDCampanha:    campanha.ID,
+		Destinatarios: plano.destinatarios,
+		Enfileirados:  len(itens),
+		JaExistiam:    len(plano.envios) - criados,
+	}
+	for _, canal := range plano.canais {
+		response.SemContato += canal.SemContato
+		response.SemConsentimento += canal.SemConsentimento
+	}
+
+	dataDisparo := time.Now().Format(formatoDataHora)
+	if dbErr := srv.dbClient.RegistrarDisparoCampanha(userID, campanha.ID, &dataDisparo, nil); dbErr != nil {
+		zap.L().Error("Error registering disparo of campanha", zap.Int("idCampanha", campanha.ID), zap.Error(dbErr))
+	}
+	return response, nil
+}
+
+// planoDisparo são os envios da campanha com a mensagem de cada destinatário e o resumo de cada canal
+type planoDisparo struct {
+	destinatarios int
+	envios        []entity.CampanhaEnvio
+	canais        []dtos.ValidacaoCanalResponse
+	naoResolvidos int
+}
+
+// Quantidade de clientes com campos sem valor listados por canal na validação
+const limiteClientesNaoResolvidos = 50
+
+// planejarDisparo monta a mensagem de cada destinatário nos canais informados (ou nos configurados na campanha),
+// usando o template do canal quando houver e a mensagem padrão quando não.
+func (srv *Service) planejarDisparo(userID string, campanha *entity.Campanha, canais []string) (*planoDisparo, *exceptions.RestErr) {
+	configurados, dbErr := srv.dbClient.GetCanaisCampanha(userID, campanha.ID)
+	if dbErr != nil {
+		zap.L().Error("Error getting canais of campanha", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	templatesCanal := make(map[string]*int, len(configurados))
+	for _, canal := range configurados {
+		templatesCanal[canal.Canal] = canal.IDTemplate
+	}
+	if len(canais) == 0 {
+		for _, canal := range configurados {
+			canais = append(canais, canal.Canal)
+		}
+	}
+	canais = distintosTexto(canais)
+	if len(canais) == 0 {
+		return nil, exceptions.NewBadRequestError("No channels informed or configured for the campanha")
+	}
+
+	type templateCanal struct {
+		assunto *disparo.Template
+		corpo   *disparo.Template
+	}
+	templates := make(map[string]templateCanal)
+	plano := &planoDisparo{canais: make([]dtos.ValidacaoCanalResponse, len(canais))}
+	for i, canal := range canais {
+		plano.canais[i] = dtos.ValidacaoCanalResponse{Canal: canal, IDTemplate: templatesCanal[canal], Clientes: []dtos.ClienteNaoResolvidoResponse{}}
+		if templatesCanal[canal] == nil {
+			continue
+		}
+		template, restErr := srv.getTemplateMensagem(userID, strconv.Itoa(*templatesCanal[canal]))
+		if restErr != nil {
+			return nil, restErr
+		}
+		assunto, corpo, err := compilarTemplateMensagem(*template)
+		if err != nil {
+			return nil, exceptions.NewConflictError(fmt.Sprintf("Template %d is invalid: %v", template.ID, err))
+		}
+		templates[canal] = templateCanal{assunto: assunto, corpo: corpo}
+	}
+
+	destinatarios, dbErr := srv.dbClient.GetDestinatariosCampanha(userID, campanha.ID)
+	if dbErr != nil {
+		zap.L().Error("Error getting destinatarios of campanha", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	plano.destinatarios = len(destinatarios)
+
+	ids := make([]int, len(destinatarios))
+	for i, cliente := range destinatarios {
+		ids[i] = cliente.ID
+	}
+
+	// Só recebem a campanha os clientes que consentiram no canal; sem registro de consentimento não há envio
+	consentimentos, dbErr := srv.dbClient.GetClientesConsentidos(userID, ids)
+	if dbErr != nil {
+		zap.L().Error("Error getting consentimentos of destinatarios", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	consentidos := make(map[string]bool, len(consentimentos))
+	for _, consentimento := range consentimentos {
+		consentidos[consentimento.Canal+":"+strconv.Itoa(consentimento.IDCliente)] = true
+	}
+
+	// Pets e endereços só são buscados quando algum canal usa template; vale o primeiro cadastrado de cada cliente
+	petsCliente := make(map[int]*entity.Pet)
+	enderecosCliente := make(map[int]*entity.Endereco)
+	var loja disparo.Loja
+	if len(templates) > 0 && len(destinatarios) > 0 {
+		pets, enderecos, dbErr := srv.dbClient.GetPetsEnderecosClientes(userID, ids)
+		if dbErr != nil {
+			zap.L().Error("Error getting pets and enderecos of destinatarios", zap.Error(dbErr))
+			return nil, exceptions.NewInternalServerError("Internal server error")
+		}
+		for i := range pets {
+			if petsCliente[pets[i].ClienteID] == nil {
+				petsCliente[pets[i].ClienteID] = &pets[i]
+			}
+		}
+		for i := range enderecos {
+			if enderecosCliente[enderecos[i].IDCliente] == nil {
+				enderecosCliente[enderecos[i].IDCliente] = &enderecos[i]
+			}
+		}
+		loja = srv.dadosLoja(userID)
+	}
+
+	conteudo := disparo.Campanha{Nome: campanha.Nome, Descricao: campanha.Desc}
+	agora := time.Now().Format(formatoDataHora)
+	for _, cliente := range destinatarios {
+		destinatario := disparo.Destinatario{Nome: cliente.NomeCliente, Email: cliente.Email, Celular: cliente.NumeroCelular}
+		dados := disparo.DadosTemplate{Cliente: cliente.Cliente, Pet: petsCliente[cliente.ID], Endereco: enderecosCliente[cliente.ID], Loja: loja, Campanha: conteudo}
+		idPublico := cliente.IDPublico
+
+		for i, canal := range canais {
+			resumo := &plano.canais[i]
+			if !consentidos[canal+":"+strconv.Itoa(cliente.ID)] {
+				resumo.SemConsentimento++
+				continue
+			}
+			destino := disparo.Destino(canal, destinatario)
+			if destino == "" {
+				resumo.SemContato++
+				continue
+			}
+
+			var mensagem disparo.Mensagem
+			if template, ok := templates[canal]; ok {
+				var naoResolvidos []string
+				mensagem, naoResolvidos = disparo.RenderizarTemplate(canal, template.assunto, template.corpo, dados, destino)
+				if len(naoResolvidos) > 0 {
+					resumo.NaoResolvidos++
+					plano.naoResolvidos++
+					if len(resumo.Clientes) < limiteClientesNaoResolvidos {
+						resumo.Clientes = append(resumo.Clientes, dtos.ClienteNaoResolvidoResponse{IDCliente: cliente.ID, NomeCliente: cliente.NomeCliente, Campos: naoResolvidos})
+					}
+					continue
+				}
+			} else {
+				mensagem = disparo.Renderizar(canal, conteudo, destinatario, destino)
+			}
+
+			resumo.Envios++
+			envio := entity.CampanhaEnvio{
+				IDCampanha:      campanha.ID,
+				IDCliente:       cliente.ID,
+				IDPublico:       &idPublico,
+				Canal:           canal,
+				Destino:         destino,
+				Status:          entity.EnvioStatusNaFila,
+				DataCriacao:     agora,
+				DataAtualizacao: &agora,
+				Mensagem:        &mensagem.Corpo,
+			}
+			if mensagem.Assunto != "" {
+				envio.Assunto = &mensagem.Assunto
+			}
+			plano.envios = append(plano.envios, envio)
+		}
+	}
+
+	return plano, nil
+}
+
+func distintosTexto(valores []string) []string {
+	vistos := make(map[string]bool, len(valores))
+	resultado := make([]string, 0, len(valores))
+	for _, valor := range valores {
+		if !vistos[valor] {
+			vistos[valor] = true
+			resultado = append(resultado, valor)
+		}
+	}
+	return resultado
+}
+
+func (srv *Service) GetEnviosCampanhaService(userID string, idCampanha string, canal, status string, page, limit int) (*dtos.CampanhaEnvioListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get envios campanha service", zap.String("idCampanha", idCampanha), zap.String("canal", canal), zap.String("status", status), zap.Int("page", page), zap.Int("limit", limit))
+
+	if restErr := validarFiltrosEnvios(canal, status); restErr != nil {
+		return nil, restErr
+	}
+
+	campanha := srv.dbClient.GetCampanhaByID(idCampanha, userID)
+	if campanha.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Campanha not found")
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	envios, total, dbErr := srv.dbClient.GetEnviosCampanhaPaginated(userID, campanha.ID, canal, status, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting envios of campanha from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.CampanhaEnvioListResponse{
+		IDCampanha: campanha.ID,
+		Envios:     make([]dtos.CampanhaEnvioResponse, len(envios)),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: (total + limit - 1) / limit,
+	}
+	for i, envio := range envios {
+		response.Envios[i] = buildCampanhaEnvioResponse(envio)
+	}
+
+	zap.L().Info("Envios campanha service completed successfully", zap.Int("total", total))
+	return response, nil
+}
+
+func validarFiltrosEnvios(canal, status string) *exceptions.RestErr {
+	if restErr := validarCanal(canal); restErr != nil {
+		return restErr
+	}
+	switch status {
+	case "", entity.EnvioStatusNaFila, entity.EnvioStatusEnviando, entity.EnvioStatusEnviado, entity.EnvioStatusFalhou, entity.EnvioStatusCancelado,
+		entity.EnvioStatusEntregue, entity.EnvioStatusAberto, entity.EnvioStatusClicado, entity.EnvioStatusDescadastrado:
+		return nil
+	default:
+		return exceptions.NewBadRequestError("Invalid status, expected 'na_fila', 'enviando', 'enviado', 'entregue', 'aberto', 'clicado', 'descadastrado', 'falhou' or 'cancelado'")
+	}
+}
+
+func buildCampanhaEnvioResponse(envio entity.CampanhaEnvio) dtos.CampanhaEnvioResponse {
+	return dtos.CampanhaEnvioResponse{
+		ID:              envio.ID,
+		IDCliente:       envio.IDCliente,
+		Canal:           envio.Canal,
+		Destino:         envio.Destino,
+		Status:          envio.Status,
+		Tentativas:      envio.Tentativas,
+		Erro:            envio.Erro,
+		DataCriacao:     envio.DataCriacao,
+		DataEnvio:       envio.DataEnvio,
+		DataEntrega:     envio.DataEntrega,
+		DataAbertura:    envio.DataAbertura,
+		DataClique:      envio.DataClique,
+		DataDescadastro: envio.DataDescadastro,
+		Cliques:         envio.Cliques,
+	}
+}
+
+// ExecutarDisparosJob envia os próximos itens da fila de disparos de todos os tenants; usado pelo job periódico
+func (srv *Service) ExecutarDisparosJob() {
+	if srv.disparador == nil {
+		return
+	}
+
+	itens, err := srv.disparador.Fila().Proximos(ctx, loteDisparos, time.Now())
+	if err != nil {
+		zap.L().Error("Error reading dispatch queue", zap.Error(err))
+	}
+	for _, item := range itens {
+		srv.processarEnvio(item)
+	}
+}
+
+// processarEnvio faz uma tentativa de envio respeitando o limite por minuto do canal. Falhas temporárias voltam para
+// a fila com espera crescente até a última tentativa.
+func (srv *Service) processarEnvio(item disparo.EnvioFila) {
+	fila := srv.disparador.Fila()
+	agora := time.Now()
+
+	permitido, err := fila.Permitir(ctx, item.Canal, srv.disparador.Limite(item.Canal), agora)
+	if err != nil || !permitido {
+		if err != nil {
+			zap.L().Error("Error checking dispatch rate limit", zap.String("canal", item.Canal), zap.Error(err))
+		}
+		// O envio volta no próximo minuto, quando o limite do canal é renovado
+		srv.reagendarEnvio(item, agora.Truncate(time.Minute).Add(time.Minute))
+		return
+	}
+
+	reservado, dbErr := srv.dbClient.ReservarEnvioCampanha(item.UserID, item.IDEnvio, agora.Format(formatoDataHora))
+	if dbErr != nil {
+		srv.reagendarEnvio(item, agora.Add(disparo.Atraso(1)))
+		return
+	}
+	if !reservado {
+		return
+	}
+
+	detalhe, dbErr := srv.dbClient.GetEnvioCampanha(item.UserID, item.IDEnvio)
+	if dbErr != nil {
+		zap.L().Error("Error getting reserved envio", zap.String("userID", item.UserID), zap.Int("idEnvio", item.IDEnvio), zap.Error(dbErr))
+		srv.liberarEnvio(item, agora.Add(disparo.Atraso(1)))
+		return
+	}
+	envio := detalhe.CampanhaEnvio
+
+	// Campanha pausada segura os envios na fila sem gastar tentativas; finalizada ou desativada cancela o que falta
+	switch detalhe.StatusCampanha {
+	case entity.CampanhaStatusPausada, entity.CampanhaStatusAgendada:
+		envio.Status = entity.EnvioStatusNaFila
+		if srv.concluirEnvio(item, envio) {
+			srv.reagendarEnvio(item, agora.Add(esperaCampanhaPausada))
+		}
+		return
+	case entity.CampanhaStatusFinalizada, entity.CampanhaStatusInativa:
+		envio.Status = entity.EnvioStatusCancelado
+		if srv.concluirEnvio(item, envio) {
+			zap.L().Info("Envio cancelled", zap.String("userID", item.UserID), zap.Int("idEnvio", envio.ID), zap.String("statusCampanha", detalhe.StatusCampanha))
+		}
+		return
+	}
+
+	// O consentimento é conferido de novo no envio: o cliente pode ter se descadastrado depois do disparo
+	if !detalhe.Consentido {
+		envio.Status = entity.EnvioStatusCancelado
+		erro := "client has not consented to channel"
+		envio.Erro = &erro
+		if srv.concluirEnvio(item, envio) {
+			zap.L().Info("Envio cancelled without consent", zap.String("userID", item.UserID), zap.Int("idEnvio", envio.ID), zap.String("canal", envio.Canal))
+		}
+		return
+	}
+
+	envio.Tentativas++
+
+	var resultado disparo.Resultado
+	canal, ok := srv.disparador.Canal(envio.Canal)
+	if !ok {
+		resultado = disparo.Resultado{Status: entity.EnvioStatusFalhou, Erro: fmt.Sprintf("channel '%s' is not configured", envio.Canal)}
+	} else {
+		// A mensagem é montada no disparo; envios antigos, sem mensagem gravada, usam a mensagem padrão
+		mensagem := disparo.Renderizar(envio.Canal,
+			disparo.Campanha{Nome: detalhe.NomeCampanha, Descricao: detalhe.DescCampanha},
+			disparo.Destinatario{Nome: detalhe.NomeCliente},
+			envio.Destino)
+		if envio.Mensagem != nil {
+			mensagem = disparo.Mensagem{Destino: envio.Destino, Corpo: *envio.Mensagem}
+			if envio.Assunto != nil {
+				mensagem.Assunto = *envio.Assunto
+			}
+		}
+
+		if rastreador := srv.disparador.Rastreador(); rastreador != nil {
+			mensagem = rastreador.Rastrear(envio.Canal, mensagem, item.UserID, envio.ID)
+		}
+
+		envioCtx, cancel := context.WithTimeout(ctx, timeoutEnvio)
+		resultado = disparo.Enviar(envioCtx, canal, mensagem, envio.Tentativas)
+		cancel()
+	}
+
+	envio.Status = resultado.Status
+	envio.Erro = nil
+	if resultado.Erro != "" {
+		erro := resultado.Erro
+		if runes := []rune(erro); len(runes) > tamanhoErroEnvio {
+			erro = string(runes[:tamanhoErroEnvio])
+		}
+		envio.Erro = &erro
+	}
+	if resultado.Status == entity.EnvioStatusEnviado {
+		dataEnvio := time.Now().Format(formatoDataHora)
+		envio.DataEnvio = &dataEnvio
+	}
+	if !srv.concluirEnvio(item, envio) {
+		return
+	}
+
+	if resultado.Status == entity.EnvioStatusNaFila {
+		item.Tentativa = envio.Tentativas
+		srv.reagendarEnvio(item, time.Now().Add(resultado.Atraso))
+	}
+
+	zap.L().Info("Envio processed", zap.String("userID", item.UserID), zap.Int("idEnvio", envio.ID), zap.String("canal", envio.Canal), zap.String("status", envio.Status), zap.Int("tentativas", envio.Tentativas))
+}
+
+// concluirEnvio grava o resultado do envio reservado. Se a gravação falhar, o envio volta para a fila e a função
+// retorna false.
+func (srv *Service) concluirEnvio(item disparo.EnvioFila, envio entity.CampanhaEnvio) bool {
+	dataAtualizacao := time.Now().Format(formatoDataHora)
+	envio.DataAtualizacao = &dataAtualizacao
+	if dbErr := srv.dbClient.ConcluirEnvioCampanha(item.UserID, envio); dbErr != nil {
+		zap.L().Error("Error concluding envio", zap.String("userID", item.UserID), zap.Int("idEnvio", envio.ID), zap.String("status", envio.Status), zap.Error(dbErr))
+		srv.liberarEnvio(item, time.Now().Add(disparo.Atraso(1)))
+		return false
+	}
+	return true
+}
+
+// liberarEnvio devolve à fila um envio reservado cuja tentativa não pôde ser gravada. Se nem isso der certo, o envio
+// fica em 'enviando' e a varredura de envios parados o recupera.
+func (srv *Service) liberarEnvio(item disparo.EnvioFila, quando time.Time) {
+	if dbErr := srv.dbClient.LiberarEnvioCampanha(item.UserID, item.IDEnvio, time.Now().Format(formatoDataHora)); dbErr != nil {
+		zap.L().Error("Error releasing envio", zap.String("userID", item.UserID), zap.Int("idEnvio", item.IDEnvio), zap.Error(dbErr))
+		return
+	}
+	srv.reagendarEnvio(item, quando)
+}
+
+// ExecutarEnviosParadosJob devolve à fila os envios de todos os tenants que ficaram presos em 'enviando' ou que se
+// perderam da fila do Redis; usado pelo job periódico
+func (srv *Service) ExecutarEnviosParadosJob() {
+	if srv.disparador == nil {
+		return
+	}
+
+	agora := time.Now()
+	limiteEnviando := agora.Add(-envioAbandonado).Format(formatoDataHora)
+	limiteNaFila := agora.Add(-envioPerdido).Format(formatoDataHora)
+	for _, userID := range srv.dbClient.GetClientIDs() {
+		envios, dbErr := srv.dbClient.RecuperarEnviosParados(userID, limiteEnviando, limiteNaFila, agora.Format(formatoDataHora))
+		if dbErr != nil {
+			zap.L().Error("Error recovering stale envios", zap.String("userID", userID), zap.Error(dbErr))
+			continue
+		}
+		if len(envios) == 0 {
+			continue
+		}
+
+		itens := make([]disparo.EnvioFila, len(envios))
+		for i, envio := range envios {
+			itens[i] = disparo.EnvioFila{UserID: userID, IDEnvio: envio.ID, Canal: envio.Canal, Tentativa: envio.Tentativas}
+		}
+		if err := srv.disparador.Fila().Enfileirar(ctx, itens); err != nil {
+			zap.L().Error("Error enqueuing stale envios", zap.String("userID", userID), zap.Error(err))
+			continue
+		}
+		zap.L().Warn("Stale envios enqueued again", zap.String("userID", userID), zap.Int("count", len(itens)))
+	}
+}
+
+func (srv *Service) reagendarEnvio(item disparo.EnvioFila, quando time.Time) {
+	if err := srv.disparador.Fila().Reagendar(ctx, item, quando); err != nil {
+		zap.L().Error("Error rescheduling envio", zap.String("userID", item.UserID), zap.Int("idEnvio", item.IDEnvio), zap.Error(err))
+	}
+}
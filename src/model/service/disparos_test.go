@@ -0,0 +1,262 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/disparo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func envioNaFila() *entity.CampanhaEnvioDetalhe {
+	mensagem := "Olá, Ana! Promoção de banho"
+	return &entity.CampanhaEnvioDetalhe{
+		CampanhaEnvio:  entity.CampanhaEnvio{ID: 9, IDCampanha: 5, IDCliente: 1, Canal: disparo.CanalEmail, Destino: "ana@email.com", Status: entity.EnvioStatusEnviando, Mensagem: &mensagem},
+		NomeCliente:    "Ana",
+		NomeCampanha:   "Banho",
+		StatusCampanha: entity.CampanhaStatusAtiva,
+		Consentido:     true,
+	}
+}
+
+// TESTES PARA DispararCampanhaService
+func TestService_DispararCampanhaService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	mockFila := new(MockFila)
+
+	destinatarios := []entity.DestinatarioCampanha{
+		{Cliente: entity.Cliente{ID: 1, NomeCliente: "Ana", Email: "ana@email.com"}, IDPublico: 4},
+		{Cliente: entity.Cliente{ID: 2, NomeCliente: "Bruno"}, IDPublico: 4},
+		{Cliente: entity.Cliente{ID: 3, NomeCliente: "Carla", Email: "carla@email.com"}, IDPublico: 6},
+	}
+	consentimentos := []entity.ConsentimentoCliente{
+		{IDCliente: 1, Canal: disparo.CanalEmail, Consentido: true},
+		{IDCliente: 2, Canal: disparo.CanalEmail, Consentido: true},
+	}
+
+	mockDBClient.On("GetCampanhaByID", "5", "1").Return(&entity.Campanha{ID: 5, Nome: "Banho", Status: entity.CampanhaStatusAtiva})
+	mockDBClient.On("GetCanaisCampanha", "1", 5).Return([]entity.CampanhaCanalJoin{{Canal: disparo.CanalEmail}}, nil)
+	mockDBClient.On("GetDestinatariosCampanha", "1", 5).Return(destinatarios, nil)
+	mockDBClient.On("GetClientesConsentidos", "1", []int{1, 2, 3}).Return(consentimentos, nil)
+	mockDBClient.On("CriarEnviosCampanha", "1", 5, mock.MatchedBy(func(envios []entity.CampanhaEnvio) bool {
+		return len(envios) == 1 && envios[0].IDCliente == 1 && *envios[0].IDPublico == 4 && envios[0].Destino == "ana@email.com" &&
+			envios[0].Status == entity.EnvioStatusNaFila && *envios[0].Assunto == "Banho"
+	})).Return(1, []entity.CampanhaEnvio{{ID: 9, Canal: disparo.CanalEmail}}, nil)
+	mockFila.On("Enfileirar", mock.Anything, []disparo.EnvioFila{{UserID: "1", IDEnvio: 9, Canal: disparo.CanalEmail}}).Return(nil)
+	mockDBClient.On("RegistrarDisparoCampanha", "1", 5, mock.AnythingOfType("*string"), (*string)(nil)).Return(nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparo.NewDisparador(mockFila, nil, disparo.NewFakeChannel(disparo.CanalEmail)),
+	}
+
+	// Act
+	result, err := service.DispararCampanhaService("1", "5", dtos.DispararCampanhaRequest{})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 3, result.Destinatarios)
+	assert.Equal(t, 1, result.Enfileirados)
+	assert.Equal(t, 0, result.JaExistiam)
+	assert.Equal(t, 1, result.SemContato)
+	assert.Equal(t, 1, result.SemConsentimento)
+
+	mockDBClient.AssertExpectations(t)
+	mockFila.AssertExpectations(t)
+}
+
+func TestService_DispararCampanhaService_CanalNaoConfigurado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	mockFila := new(MockFila)
+
+	mockDBClient.On("GetCampanhaByID", "5", "1").Return(&entity.Campanha{ID: 5, Status: entity.CampanhaStatusAtiva})
+	mockDBClient.On("GetCanaisCampanha", "1", 5).Return([]entity.CampanhaCanalJoin{}, nil)
+	mockDBClient.On("GetDestinatariosCampanha", "1", 5).Return([]entity.DestinatarioCampanha{}, nil)
+	mockDBClient.On("GetClientesConsentidos", "1", []int{}).Return([]entity.ConsentimentoCliente{}, nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparo.NewDisparador(mockFila, nil, disparo.NewFakeChannel(disparo.CanalEmail)),
+	}
+
+	// Act
+	result, err := service.DispararCampanhaService("1", "5", dtos.DispararCampanhaRequest{Canais: []string{disparo.CanalSMS}})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Channel 'sms' is not configured", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+	mockFila.AssertExpectations(t)
+}
+
+func TestService_DispararCampanhaService_CampanhaInativa(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetCampanhaByID", "5", "1").Return(&entity.Campanha{ID: 5, Status: entity.CampanhaStatusPausada})
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparo.NewDisparador(new(MockFila), nil),
+	}
+
+	// Act
+	result, err := service.DispararCampanhaService("1", "5", dtos.DispararCampanhaRequest{})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Only active campanhas can be dispatched", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_DispararCampanhaService_SemDisparador(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.DispararCampanhaService("1", "5", dtos.DispararCampanhaRequest{})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Dispatch is not configured", err.Message)
+	assert.Equal(t, 500, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA ExecutarDisparosJob
+func TestService_ExecutarDisparosJob_Enviado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	mockFila := new(MockFila)
+	canal := disparo.NewFakeChannel(disparo.CanalEmail)
+
+	item := disparo.EnvioFila{UserID: "1", IDEnvio: 9, Canal: disparo.CanalEmail}
+
+	mockFila.On("Proximos", mock.Anything, loteDisparos, mock.AnythingOfType("time.Time")).Return([]disparo.EnvioFila{item}, nil)
+	mockFila.On("Permitir", mock.Anything, disparo.CanalEmail, 0, mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockDBClient.On("ReservarEnvioCampanha", "1", 9, mock.AnythingOfType("string")).Return(true, nil)
+	mockDBClient.On("GetEnvioCampanha", "1", 9).Return(envioNaFila(), nil)
+	mockDBClient.On("ConcluirEnvioCampanha", "1", mock.MatchedBy(func(envio entity.CampanhaEnvio) bool {
+		return envio.Status == entity.EnvioStatusEnviado && envio.Tentativas == 1 && envio.DataEnvio != nil && envio.Erro == nil
+	})).Return(nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparo.NewDisparador(mockFila, nil, canal),
+	}
+
+	// Act
+	service.ExecutarDisparosJob()
+
+	// Assert
+	assert.Len(t, canal.Mensagens(), 1)
+	assert.Equal(t, "Olá, Ana! Promoção de banho", canal.Mensagens()[0].Corpo)
+
+	mockDBClient.AssertExpectations(t)
+	mockFila.AssertExpectations(t)
+}
+
+func TestService_ExecutarDisparosJob_FalhaTemporariaReagenda(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	mockFila := new(MockFila)
+	canal := disparo.NewFakeChannel(disparo.CanalEmail)
+	canal.Falhas = 1
+
+	item := disparo.EnvioFila{UserID: "1", IDEnvio: 9, Canal: disparo.CanalEmail}
+
+	mockFila.On("Proximos", mock.Anything, loteDisparos, mock.AnythingOfType("time.Time")).Return([]disparo.EnvioFila{item}, nil)
+	mockFila.On("Permitir", mock.Anything, disparo.CanalEmail, 0, mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockDBClient.On("ReservarEnvioCampanha", "1", 9, mock.AnythingOfType("string")).Return(true, nil)
+	mockDBClient.On("GetEnvioCampanha", "1", 9).Return(envioNaFila(), nil)
+	mockDBClient.On("ConcluirEnvioCampanha", "1", mock.MatchedBy(func(envio entity.CampanhaEnvio) bool {
+		return envio.Status == entity.EnvioStatusNaFila && envio.Tentativas == 1 && *envio.Erro == "fake channel unavailable"
+	})).Return(nil)
+	mockFila.On("Reagendar", mock.Anything, disparo.EnvioFila{UserID: "1", IDEnvio: 9, Canal: disparo.CanalEmail, Tentativa: 1}, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparo.NewDisparador(mockFila, nil, canal),
+	}
+
+	// Act
+	service.ExecutarDisparosJob()
+
+	// Assert
+	assert.Empty(t, canal.Mensagens())
+
+	mockDBClient.AssertExpectations(t)
+	mockFila.AssertExpectations(t)
+}
+
+func TestService_ExecutarDisparosJob_SemConsentimentoCancela(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	mockFila := new(MockFila)
+	canal := disparo.NewFakeChannel(disparo.CanalEmail)
+
+	detalhe := envioNaFila()
+	detalhe.Consentido = false
+
+	mockFila.On("Proximos", mock.Anything, loteDisparos, mock.AnythingOfType("time.Time")).Return([]disparo.EnvioFila{{UserID: "1", IDEnvio: 9, Canal: disparo.CanalEmail}}, nil)
+	mockFila.On("Permitir", mock.Anything, disparo.CanalEmail, 0, mock.AnythingOfType("time.Time")).Return(true, nil)
+	mockDBClient.On("ReservarEnvioCampanha", "1", 9, mock.AnythingOfType("string")).Return(true, nil)
+	mockDBClient.On("GetEnvioCampanha", "1", 9).Return(detalhe, nil)
+	mockDBClient.On("ConcluirEnvioCampanha", "1", mock.MatchedBy(func(envio entity.CampanhaEnvio) bool {
+		return envio.Status == entity.EnvioStatusCancelado && envio.Tentativas == 0
+	})).Return(nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparo.NewDisparador(mockFila, nil, canal),
+	}
+
+	// Act
+	service.ExecutarDisparosJob()
+
+	// Assert
+	assert.Empty(t, canal.Mensagens())
+
+	mockDBClient.AssertExpectations(t)
+	mockFila.AssertExpectations(t)
+}
+
+func TestService_ExecutarDisparosJob_LimiteDoCanal(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	mockFila := new(MockFila)
+
+	item := disparo.EnvioFila{UserID: "1", IDEnvio: 9, Canal: disparo.CanalSMS}
+
+	mockFila.On("Proximos", mock.Anything, loteDisparos, mock.AnythingOfType("time.Time")).Return([]disparo.EnvioFila{item}, nil)
+	mockFila.On("Permitir", mock.Anything, disparo.CanalSMS, 10, mock.AnythingOfType("time.Time")).Return(false, nil)
+	mockFila.On("Reagendar", mock.Anything, item, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparo.NewDisparador(mockFila, map[string]int{disparo.CanalSMS: 10}, disparo.NewFakeChannel(disparo.CanalSMS)),
+	}
+
+	// Act
+	service.ExecutarDisparosJob()
+
+	// Assert
+	mockDBClient.AssertExpectations(t)
+	mockFila.AssertExpectations(t)
+}
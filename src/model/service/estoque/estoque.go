@@ -0,0 +1,34 @@
+package estoque
+
+// Componente é um item de um kit com o saldo disponível do produto componente
+type Componente struct {
+	IDProduto  int
+	Quantidade int // quantidade do componente em uma unidade do kit
+	Saldo      int
+}
+
+// DisponivelKit retorna quantos kits completos podem ser montados com o saldo dos componentes
+func DisponivelKit(componentes []Componente) int {
+	if len(componentes) == 0 {
+		return 0
+	}
+
+	disponivel := -1
+	for _, componente := range componentes {
+		if componente.Quantidade <= 0 {
+			continue
+		}
+		kits := componente.Saldo / componente.Quantidade
+		if kits < 0 {
+			kits = 0
+		}
+		if disponivel == -1 || kits < disponivel {
+			disponivel = kits
+		}
+	}
+
+	if disponivel == -1 {
+		return 0
+	}
+	return disponivel
+}
@@ -0,0 +1,27 @@
+package estoque
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisponivelKit(t *testing.T) {
+	componentes := []Componente{
+		{IDProduto: 1, Quantidade: 2, Saldo: 9},
+		{IDProduto: 2, Quantidade: 1, Saldo: 7},
+	}
+	assert.Equal(t, 4, DisponivelKit(componentes))
+}
+
+func TestDisponivelKit_ComponenteSemSaldo(t *testing.T) {
+	componentes := []Componente{
+		{IDProduto: 1, Quantidade: 1, Saldo: 10},
+		{IDProduto: 2, Quantidade: 3, Saldo: 2},
+	}
+	assert.Equal(t, 0, DisponivelKit(componentes))
+}
+
+func TestDisponivelKit_SemComponentes(t *testing.T) {
+	assert.Equal(t, 0, DisponivelKit(nil))
+}
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE EXPORTAÇÃO ------------------------------------------------------------------------------------------------------------------------------------
+
+// ExportarFunc percorre todos os registros da listagem, já convertidos para o DTO de resposta, entregando um por vez.
+// Os filtros são validados antes de a função ser devolvida, para que o controller ainda possa responder com erro.
+type ExportarFunc func(escrever func(registro interface{}) error) error
+
+// exportarRegistros liga a consulta em streaming do repositório ao builder do DTO de resposta
+func exportarRegistros[E any, D any](userID string, stream func(string, func(E) error) error, build func(E) D) ExportarFunc {
+	return func(escrever func(registro interface{}) error) error {
+		return stream(userID, func(item E) error {
+			return escrever(build(item))
+		})
+	}
+}
+
+func (srv *Service) ExportarFornecedoresService(userID string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar fornecedores service")
+	return exportarRegistros(userID, srv.dbClient.StreamFornecedores, buildFornecedorResponse), nil
+}
+
+func (srv *Service) ExportarProductsService(userID string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar products service")
+	return exportarRegistros(userID, srv.dbClient.StreamProducts, buildProductResponse), nil
+}
+
+func (srv *Service) ExportarPedidosService(userID string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar pedidos service")
+	return exportarRegistros(userID, srv.dbClient.StreamPedidos, buildPedidoResponse), nil
+}
+
+func (srv *Service) ExportarEstoqueService(userID string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar estoque service")
+	return exportarRegistros(userID, srv.dbClient.StreamDetalhesEstoque, buildDetalhesEstoqueResponse), nil
+}
+
+func (srv *Service) ExportarAlertasEstoqueService(userID string, tipo, status string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar alertas estoque service", zap.String("tipo", tipo), zap.String("status", status))
+
+	if restErr := validarFiltrosAlertas(tipo, status); restErr != nil {
+		return nil, restErr
+	}
+
+	stream := func(userID string, fn func(entity.AlertaEstoque) error) error {
+		return srv.dbClient.StreamAlertasEstoque(userID, tipo, status, fn)
+	}
+	build := func(alerta entity.AlertaEstoque) dtos.AlertaEstoqueResponse {
+		return buildAlertasEstoqueResponse([]entity.AlertaEstoque{alerta})[0]
+	}
+	return exportarRegistros(userID, stream, build), nil
+}
+
+func (srv *Service) ExportarInventariosService(userID string, status string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar inventarios service", zap.String("status", status))
+
+	if restErr := validarStatusInventario(status); restErr != nil {
+		return nil, restErr
+	}
+
+	stream := func(userID string, fn func(entity.Inventario) error) error {
+		return srv.dbClient.StreamInventarios(userID, status, fn)
+	}
+	return exportarRegistros(userID, stream, buildInventarioResponse), nil
+}
+
+func (srv *Service) ExportarClientesService(userID string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar clientes service")
+	return exportarRegistros(userID, srv.dbClient.StreamClientes, buildClienteResponse), nil
+}
+
+func (srv *Service) ExportarEnderecosService(userID string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar enderecos service")
+	return exportarRegistros(userID, srv.dbClient.StreamEnderecos, buildEnderecoResponse), nil
+}
+
+func (srv *Service) ExportarPetsService(userID string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar pets service")
+	return exportarRegistros(userID, srv.dbClient.StreamPets, buildPetResponse), nil
+}
+
+func (srv *Service) ExportarTagsService(userID string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar tags service")
+	return exportarRegistros(userID, srv.dbClient.StreamTags, buildTagResponse), nil
+}
+
+func (srv *Service) ExportarPublicosService(userID string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar publicos service")
+	return exportarRegistros(userID, srv.dbClient.StreamPublicos, buildPublicoResponse), nil
+}
+
+// ExportarClientesPublicoService exporta os membros do público que consentiram no canal informado ou, sem canal,
+// em ao menos um canal. Clientes sem consentimento nunca saem na exportação.
+func (srv *Service) ExportarClientesPublicoService(userID string, idPublico string, canal string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar clientes publico service", zap.String("idPublico", idPublico), zap.String("canal", canal))
+
+	if restErr := validarCanal(canal); restErr != nil {
+		return nil, restErr
+	}
+	publico, restErr := srv.getPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	stream := func(userID string, fn func(entity.Cliente) error) error {
+		return srv.dbClient.StreamClientesPublicoConsentidos(userID, publico.ID, canal, fn)
+	}
+	return exportarRegistros(userID, stream, buildClienteResponse), nil
+}
+
+func (srv *Service) ExportarCampanhasService(userID string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar campanhas service")
+	return exportarRegistros(userID, srv.dbClient.StreamCampanhas, buildCampanhaResponse), nil
+}
+
+func (srv *Service) ExportarVendasService(userID string, status string, idCliente string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar vendas service", zap.String("status", status), zap.String("id_cliente", idCliente))
+
+	idClienteInt, restErr := validarFiltrosVendas(status, idCliente)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	stream := func(userID string, fn func(entity.Venda) error) error {
+		return srv.dbClient.StreamVendas(userID, status, idClienteInt, fn)
+	}
+	return exportarRegistros(userID, stream, buildVendaResponse), nil
+}
+
+func (srv *Service) ExportarHistoricoPrecosService(userID string, idProduto string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar historico precos service", zap.String("id_produto", idProduto))
+
+	produto, restErr := srv.getProduto(userID, idProduto)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	stream := func(userID string, fn func(entity.HistoricoPreco) error) error {
+		return srv.dbClient.StreamHistoricoPrecos(userID, produto.IDProduto, fn)
+	}
+	return exportarRegistros(userID, stream, buildHistoricoPrecoResponse), nil
+}
+
+func (srv *Service) ExportarImportacoesService(userID string, recurso string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar importacoes service", zap.String("recurso", recurso))
+
+	stream := func(userID string, fn func(entity.Importacao) error) error {
+		return srv.dbClient.StreamImportacoes(userID, recurso, fn)
+	}
+	return exportarRegistros(userID, stream, buildImportacaoResponse), nil
+}
+
+func (srv *Service) ExportarImportacaoErrosService(userID string, id string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar importacao erros service", zap.String("id", id))
+
+	job, restErr := srv.getImportacao(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	stream := func(userID string, fn func(entity.ImportacaoErro) error) error {
+		return srv.dbClient.StreamImportacaoErros(userID, job.ID, fn)
+	}
+	return exportarRegistros(userID, stream, buildImportacaoErroResponse), nil
+}
+
+func (srv *Service) ExportarCaixasService(userID string, status string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar caixas service", zap.String("status", status))
+
+	if restErr := validarStatusCaixa(status); restErr != nil {
+		return nil, restErr
+	}
+
+	stream := func(userID string, fn func(entity.CaixaSessao) error) error {
+		return srv.dbClient.StreamCaixaSessoes(userID, status, fn)
+	}
+	return exportarRegistros(userID, stream, buildCaixaSessaoResponse), nil
+}
+
+func (srv *Service) ExportarDevolucoesService(userID string, idVenda string, idCliente string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar devolucoes service", zap.String("id_venda", idVenda), zap.String("id_cliente", idCliente))
+
+	idVendaInt, idClienteInt, restErr := validarFiltrosDevolucoes(idVenda, idCliente)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	stream := func(userID string, fn func(entity.Devolucao) error) error {
+		return srv.dbClient.StreamDevolucoes(userID, idVendaInt, idClienteInt, fn)
+	}
+	return exportarRegistros(userID, stream, buildDevolucaoResponse), nil
+}
+
+// ExportarRFMClientesService exporta os clientes do segmento na ordem da listagem. As notas dependem da
+// comparação entre todos os clientes, então o cálculo é feito antes e os registros saem da memória.
+func (srv *Service) ExportarRFMClientesService(userID string, segmento string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar RFM clientes service", zap.String("segmento", segmento))
+
+	if restErr := validarSegmentoRFM(segmento); restErr != nil {
+		return nil, restErr
+	}
+
+	filtrados, _, restErr := srv.filtrarRFMClientes(userID, segmento)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	return func(escrever func(registro interface{}) error) error {
+		for _, cliente := range filtrados {
+			if err := escrever(buildRFMClienteResponse(cliente)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+func (srv *Service) ExportarEnviosCampanhaService(userID string, idCampanha string, canal, status string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar envios campanha service", zap.String("idCampanha", idCampanha), zap.String("canal", canal), zap.String("status", status))
+
+	if restErr := validarFiltrosEnvios(canal, status); restErr != nil {
+		return nil, restErr
+	}
+
+	campanha := srv.dbClient.GetCampanhaByID(idCampanha, userID)
+	if campanha.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Campanha not found")
+	}
+
+	stream := func(userID string, fn func(entity.CampanhaEnvio) error) error {
+		return srv.dbClient.StreamEnviosCampanha(userID, campanha.ID, canal, status, fn)
+	}
+	return exportarRegistros(userID, stream, buildCampanhaEnvioResponse), nil
+}
+
+func (srv *Service) ExportarTemplatesMensagensService(userID string, canal string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar templates service", zap.String("canal", canal))
+
+	if restErr := validarCanal(canal); restErr != nil {
+		return nil, restErr
+	}
+
+	stream := func(userID string, fn func(entity.TemplateMensagem) error) error {
+		return srv.dbClient.StreamTemplatesMensagens(userID, canal, fn)
+	}
+	return exportarRegistros(userID, stream, buildTemplateMensagemResponse), nil
+}
+
+func (srv *Service) ExportarHistoricoConsentimentosService(userID string, idCliente string, canal string) (ExportarFunc, *exceptions.RestErr) {
+	zap.L().Info("Starting exportar historico consentimentos service", zap.String("idCliente", idCliente), zap.String("canal", canal))
+
+	if restErr := validarCanal(canal); restErr != nil {
+		return nil, restErr
+	}
+
+	cliente := srv.dbClient.GetClienteByID(idCliente, userID)
+	if cliente.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Cliente not found")
+	}
+
+	stream := func(userID string, fn func(entity.ConsentimentoHistorico) error) error {
+		return srv.dbClient.StreamHistoricoConsentimentos(userID, cliente.ID, canal, fn)
+	}
+	return exportarRegistros(userID, stream, buildConsentimentoHistoricoResponse), nil
+}
@@ -0,0 +1,357 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/betine97/back-project.git/src/model/service/fidelidade"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// EventoPontosExpirando é o nome do evento enviado ao notifier com os clientes que têm pontos perto de vencer
+const EventoPontosExpirando = "pontos_expirando"
+
+// fidelidadeConfigPadrao é usada enquanto o tenant não salva a sua configuração; o programa começa desativado
+var fidelidadeConfigPadrao = entity.FidelidadeConfig{
+	ID:                 entity.FidelidadeConfigID,
+	Ativo:              false,
+	PontosPorReal:      1,
+	ValorPonto:         0.05,
+	ValidadeDias:       365,
+	MinimoResgate:      100,
+	DiasAvisoExpiracao: 15,
+}
+
+// FUNÇÕES DE FIDELIDADE ------------------------------------------------------------------------------------------------------------------------------------
+
+func (srv *Service) GetFidelidadeConfigService(userID string) (*dtos.FidelidadeConfigResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get fidelidade config service")
+
+	config, restErr := srv.getFidelidadeConfig(userID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := buildFidelidadeConfigResponse(*config)
+
+	zap.L().Info("Successfully retrieved fidelidade config", zap.Bool("ativo", config.Ativo))
+	return &response, nil
+}
+
+func (srv *Service) UpdateFidelidadeConfigService(userID string, request dtos.UpdateFidelidadeConfigRequest) (*dtos.FidelidadeConfigResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting update fidelidade config service", zap.Bool("ativo", *request.Ativo))
+
+	if *request.Ativo && (request.PontosPorReal <= 0 || request.ValorPonto <= 0) {
+		return nil, exceptions.NewBadRequestError("pontos_por_real and valor_ponto must be greater than zero when the program is active")
+	}
+
+	config := &entity.FidelidadeConfig{
+		Ativo:              *request.Ativo,
+		PontosPorReal:      request.PontosPorReal,
+		ValorPonto:         request.ValorPonto,
+		ValidadeDias:       request.ValidadeDias,
+		MinimoResgate:      request.MinimoResgate,
+		DiasAvisoExpiracao: request.DiasAvisoExpiracao,
+		DataAtualizacao:    time.Now().Format(formatoDataHora),
+	}
+
+	if dbErr := srv.dbClient.SalvarFidelidadeConfig(config, userID); dbErr != nil {
+		zap.L().Error("Error saving fidelidade config in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := buildFidelidadeConfigResponse(*config)
+
+	zap.L().Info("Fidelidade config updated successfully", zap.Bool("ativo", config.Ativo))
+	return &response, nil
+}
+
+// GetPontosClienteService retorna o saldo de pontos válidos do cliente, os que vencem dentro do prazo de aviso e o extrato
+func (srv *Service) GetPontosClienteService(userID string, id string) (*dtos.ExtratoPontosResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get pontos cliente service", zap.String("id", id))
+
+	cliente := srv.dbClient.GetClienteByID(id, userID)
+	if cliente.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Cliente not found")
+	}
+
+	config, restErr := srv.getFidelidadeConfig(userID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	lancamentos, dbErr := srv.dbClient.GetPontosCliente(cliente.ID, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting pontos cliente from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	saldo, restErr := calcularSaldoPontos(lancamentos, *config, time.Now())
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := &dtos.ExtratoPontosResponse{
+		IDCliente:      cliente.ID,
+		Saldo:          saldo.Pontos,
+		ValorSaldo:     fidelidade.ValorResgate(saldo.Pontos, config.ValorPonto),
+		PontosAExpirar: saldo.PontosAExpirar,
+		Lancamentos:    make([]dtos.PontosClienteResponse, len(lancamentos)),
+		Total:          len(lancamentos),
+	}
+	if !saldo.ProximaExpiracao.IsZero() {
+		proximaExpiracao := saldo.ProximaExpiracao.Format(formatoDataHora)
+		response.ProximaExpiracao = &proximaExpiracao
+	}
+	for i, lancamento := range lancamentos {
+		response.Lancamentos[i] = buildPontosClienteResponse(lancamento)
+	}
+
+	zap.L().Info("Successfully retrieved pontos cliente", zap.String("id", id), zap.Int("saldo", saldo.Pontos))
+	return response, nil
+}
+
+// ResgatarPontosService troca pontos do cliente por crédito na loja, que pode ser usado com a forma de pagamento credito_loja
+func (srv *Service) ResgatarPontosService(userID string, id string, request dtos.ResgatarPontosRequest) (*dtos.ResgatePontosResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting resgatar pontos service", zap.String("id", id), zap.Int("pontos", request.Pontos))
+
+	cliente := srv.dbClient.GetClienteByID(id, userID)
+	if cliente.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Cliente not found")
+	}
+
+	config, restErr := srv.getFidelidadeConfig(userID)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if !config.Ativo {
+		return nil, exceptions.NewConflictError("Loyalty program is not active")
+	}
+	if request.Pontos < config.MinimoResgate {
+		return nil, exceptions.NewBadRequestError(fmt.Sprintf("Minimum redemption is %d pontos", config.MinimoResgate))
+	}
+
+	valorCredito := fidelidade.ValorResgate(request.Pontos, config.ValorPonto)
+	if valorCredito <= 0 {
+		return nil, exceptions.NewBadRequestError("Pontos are not enough for a store credit")
+	}
+
+	dataResgate := time.Now().Format(formatoDataHora)
+	debito := &entity.PontosCliente{
+		IDCliente:      cliente.ID,
+		Tipo:           entity.PontosTipoDebito,
+		Pontos:         request.Pontos,
+		Origem:         entity.PontosOrigemResgate,
+		DataLancamento: dataResgate,
+		Descricao:      fmt.Sprintf("Resgate de %d pontos", request.Pontos),
+	}
+	credito := &entity.CreditoCliente{
+		IDCliente:      cliente.ID,
+		Tipo:           entity.CreditoTipoCredito,
+		Valor:          valorCredito,
+		Origem:         entity.CreditoOrigemResgatePontos,
+		DataLancamento: dataResgate,
+		Descricao:      fmt.Sprintf("Resgate de %d pontos", request.Pontos),
+	}
+
+	dbErr := srv.dbClient.ResgatarPontos(debito, credito, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, persistence.ErrPontosInsuficientes) {
+			return nil, exceptions.NewConflictError("Insufficient pontos for the cliente")
+		}
+		zap.L().Error("Error redeeming pontos in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	extrato, restErr := srv.GetPontosClienteService(userID, strconv.Itoa(cliente.ID))
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := &dtos.ResgatePontosResponse{
+		IDCliente:    cliente.ID,
+		Pontos:       request.Pontos,
+		ValorCredito: valorCredito,
+		SaldoPontos:  extrato.Saldo,
+	}
+
+	zap.L().Info("Pontos redeemed successfully", zap.Int("id_cliente", cliente.ID), zap.Int("pontos", request.Pontos), zap.Float64("valor_credito", valorCredito))
+	return response, nil
+}
+
+// ExpirarPontosService expira os pontos vencidos e avisa, pelo notifier, os clientes com pontos perto de vencer.
+// Cada crédito é avisado uma única vez.
+func (srv *Service) ExpirarPontosService(userID string) (*dtos.ExpiracaoPontosResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting expirar pontos service", zap.String("userID", userID))
+
+	config, restErr := srv.getFidelidadeConfig(userID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	agora := time.Now()
+	data := agora.Format(formatoDataHora)
+
+	lancamentos, pontos, dbErr := srv.dbClient.ExpirarPontos(data, userID)
+	if dbErr != nil {
+		zap.L().Error("Error expiring pontos in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.ExpiracaoPontosResponse{
+		LancamentosExpirados: lancamentos,
+		PontosExpirados:      pontos,
+	}
+
+	// O aviso é opcional e não deve impedir a expiração. Os créditos são reservados antes do envio, para que duas
+	// execuções não avisem o mesmo cliente; se o envio falhar, a reserva é desfeita e o aviso fica para a próxima.
+	if config.DiasAvisoExpiracao > 0 && srv.notifier != nil {
+		limite := agora.AddDate(0, 0, config.DiasAvisoExpiracao).Format(formatoDataHora)
+		aExpirar, ids, dbErr := srv.dbClient.ReservarAvisoPontos(data, limite, userID)
+		if dbErr != nil {
+			zap.L().Error("Error reserving aviso of pontos in database", zap.Error(dbErr))
+			return nil, exceptions.NewInternalServerError("Internal server error")
+		}
+		if len(aExpirar) > 0 {
+			payload := map[string]interface{}{
+				"tenant":   userID,
+				"clientes": aExpirar,
+			}
+			if err := srv.notifier.Notify(ctx, EventoPontosExpirando, payload); err != nil {
+				zap.L().Warn("Failed to notify pontos expirando", zap.String("userID", userID), zap.Error(err))
+				if dbErr := srv.dbClient.LiberarAvisoPontos(ids, userID); dbErr != nil {
+					zap.L().Error("Error releasing aviso of pontos in database", zap.Error(dbErr))
+					return nil, exceptions.NewInternalServerError("Internal server error")
+				}
+			} else {
+				response.ClientesAvisados = len(aExpirar)
+			}
+		}
+	}
+
+	zap.L().Info("Expirar pontos service completed successfully", zap.Int("lancamentos", lancamentos), zap.Int("pontos", pontos), zap.Int("clientes_avisados", response.ClientesAvisados))
+	return response, nil
+}
+
+// ExecutarFidelidadeJob expira os pontos e envia os avisos de todos os tenants; usado pelo job diário
+func (srv *Service) ExecutarFidelidadeJob() {
+	for _, userID := range srv.dbClient.GetClientIDs() {
+		if _, err := srv.ExpirarPontosService(userID); err != nil {
+			zap.L().Error("Error running fidelidade job", zap.String("userID", userID), zap.String("error", err.Error()))
+		}
+	}
+}
+
+// pontosVenda monta o crédito de pontos do pagamento. Só vendas com cliente e com o programa ativo pontuam;
+// pagamentos com crédito na loja não pontuam, já que o crédito vem de devoluções ou de pontos resgatados.
+func (srv *Service) pontosVenda(userID string, venda entity.Venda, formaPagamento string, dataPagamento string) (*entity.PontosCliente, *exceptions.RestErr) {
+	if venda.IDCliente == nil || formaPagamento == entity.FormaPagamentoCreditoLoja {
+		return nil, nil
+	}
+
+	config, restErr := srv.getFidelidadeConfig(userID)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if !config.Ativo {
+		return nil, nil
+	}
+
+	pontos := fidelidade.PontosGanhos(venda.ValorTotal, config.PontosPorReal)
+	if pontos == 0 {
+		return nil, nil
+	}
+
+	credito := &entity.PontosCliente{
+		IDCliente:         *venda.IDCliente,
+		Tipo:              entity.PontosTipoCredito,
+		Pontos:            pontos,
+		PontosDisponiveis: pontos,
+		Origem:            entity.PontosOrigemVenda,
+		IDReferencia:      venda.IDVenda,
+		DataLancamento:    dataPagamento,
+		Descricao:         fmt.Sprintf("Venda %d", venda.IDVenda),
+	}
+	if config.ValidadeDias > 0 {
+		data, err := time.ParseInLocation(formatoDataHora, dataPagamento, time.Local)
+		if err != nil {
+			zap.L().Error("Error parsing data pagamento", zap.Error(err))
+			return nil, exceptions.NewInternalServerError("Internal server error")
+		}
+		dataExpiracao := data.AddDate(0, 0, config.ValidadeDias).Format(formatoDataHora)
+		credito.DataExpiracao = &dataExpiracao
+	}
+	return credito, nil
+}
+
+// getFidelidadeConfig busca a configuração do tenant, usando a padrão se ela ainda não foi salva
+func (srv *Service) getFidelidadeConfig(userID string) (*entity.FidelidadeConfig, *exceptions.RestErr) {
+	config, dbErr := srv.dbClient.GetFidelidadeConfig(userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			padrao := fidelidadeConfigPadrao
+			return &padrao, nil
+		}
+		zap.L().Error("Error getting fidelidade config from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	return config, nil
+}
+
+// calcularSaldoPontos converte os créditos do extrato em lotes e calcula o saldo válido na data
+func calcularSaldoPontos(lancamentos []entity.PontosCliente, config entity.FidelidadeConfig, data time.Time) (fidelidade.Saldo, *exceptions.RestErr) {
+	lotes := make([]fidelidade.Lote, 0, len(lancamentos))
+	for _, lancamento := range lancamentos {
+		if lancamento.Tipo != entity.PontosTipoCredito {
+			continue
+		}
+		lote := fidelidade.Lote{Disponivel: lancamento.PontosDisponiveis}
+		if lancamento.DataExpiracao != nil {
+			expiracao, err := time.ParseInLocation(formatoDataHora, *lancamento.DataExpiracao, time.Local)
+			if err != nil {
+				zap.L().Error("Error parsing data expiracao", zap.Int("id", lancamento.ID), zap.Error(err))
+				return fidelidade.Saldo{}, exceptions.NewInternalServerError("Internal server error")
+			}
+			lote.Expiracao = expiracao
+		}
+		lotes = append(lotes, lote)
+	}
+	return fidelidade.CalcularSaldo(lotes, data, data.AddDate(0, 0, config.DiasAvisoExpiracao)), nil
+}
+
+func buildFidelidadeConfigResponse(config entity.FidelidadeConfig) dtos.FidelidadeConfigResponse {
+	return dtos.FidelidadeConfigResponse{
+		Ativo:              config.Ativo,
+		PontosPorReal:      config.PontosPorReal,
+		ValorPonto:         config.ValorPonto,
+		ValidadeDias:       config.ValidadeDias,
+		MinimoResgate:      config.MinimoResgate,
+		DiasAvisoExpiracao: config.DiasAvisoExpiracao,
+		DataAtualizacao:    config.DataAtualizacao,
+	}
+}
+
+func buildPontosClienteResponse(lancamento entity.PontosCliente) dtos.PontosClienteResponse {
+	response := dtos.PontosClienteResponse{
+		ID:             lancamento.ID,
+		Tipo:           lancamento.Tipo,
+		Pontos:         lancamento.Pontos,
+		Origem:         lancamento.Origem,
+		IDReferencia:   lancamento.IDReferencia,
+		DataLancamento: lancamento.DataLancamento,
+		DataExpiracao:  lancamento.DataExpiracao,
+		Descricao:      lancamento.Descricao,
+	}
+	if lancamento.Tipo == entity.PontosTipoCredito {
+		disponiveis := lancamento.PontosDisponiveis
+		response.PontosDisponiveis = &disponiveis
+	}
+	return response
+}
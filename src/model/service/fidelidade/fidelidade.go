@@ -0,0 +1,61 @@
+package fidelidade
+
+import (
+	"math"
+	"time"
+)
+
+// Lote é um crédito de pontos com o que ainda resta dele; Expiracao zero significa que não expira
+type Lote struct {
+	Disponivel int
+	Expiracao  time.Time
+}
+
+// Saldo é a posição de pontos do cliente numa data
+type Saldo struct {
+	Pontos           int
+	PontosAExpirar   int
+	ProximaExpiracao time.Time
+}
+
+// PontosGanhos converte o valor gasto em pontos, arredondando para baixo
+func PontosGanhos(valor float64, pontosPorReal float64) int {
+	if valor <= 0 || pontosPorReal <= 0 {
+		return 0
+	}
+	// A tolerância evita perder um ponto por erro de ponto flutuante (ex: 0.1 * 30)
+	return int(math.Floor(valor*pontosPorReal + 1e-9))
+}
+
+// ValorResgate é o crédito em reais gerado pelo resgate dos pontos
+func ValorResgate(pontos int, valorPonto float64) float64 {
+	if pontos <= 0 || valorPonto <= 0 {
+		return 0
+	}
+	return math.Round(float64(pontos)*valorPonto*100) / 100
+}
+
+// CalcularSaldo soma os pontos ainda válidos na data e os que vencem até o limite do aviso
+func CalcularSaldo(lotes []Lote, data time.Time, limiteAviso time.Time) Saldo {
+	var saldo Saldo
+	for _, lote := range lotes {
+		if lote.Disponivel <= 0 {
+			continue
+		}
+		if lote.Expiracao.IsZero() {
+			saldo.Pontos += lote.Disponivel
+			continue
+		}
+		if !lote.Expiracao.After(data) {
+			continue
+		}
+		saldo.Pontos += lote.Disponivel
+		if !lote.Expiracao.After(limiteAviso) {
+			saldo.PontosAExpirar += lote.Disponivel
+		}
+		if saldo.ProximaExpiracao.IsZero() || lote.Expiracao.Before(saldo.ProximaExpiracao) {
+			saldo.ProximaExpiracao = lote.Expiracao
+		}
+	}
+	return saldo
+}
@@ -0,0 +1,58 @@
+package fidelidade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPontosGanhos(t *testing.T) {
+	tests := []struct {
+		name          string
+		valor         float64
+		pontosPorReal float64
+		esperado      int
+	}{
+		{"um ponto por real", 89.9, 1, 89},
+		{"dois pontos por real", 50, 2, 100},
+		{"fração de ponto por real", 0.1, 30, 3},
+		{"meio ponto por real", 99.99, 0.5, 49},
+		{"valor zero", 0, 1, 0},
+		{"programa sem pontos", 100, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.esperado, PontosGanhos(tt.valor, tt.pontosPorReal))
+		})
+	}
+}
+
+func TestValorResgate(t *testing.T) {
+	assert.Equal(t, 5.0, ValorResgate(500, 0.01))
+	assert.Equal(t, 3.33, ValorResgate(333, 0.01))
+	assert.Equal(t, 0.0, ValorResgate(0, 0.01))
+	assert.Equal(t, 0.0, ValorResgate(100, 0))
+}
+
+func TestCalcularSaldo(t *testing.T) {
+	data := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	lotes := []Lote{
+		{Disponivel: 100, Expiracao: data.AddDate(0, 0, -1)},
+		{Disponivel: 40, Expiracao: data.AddDate(0, 0, 5)},
+		{Disponivel: 60, Expiracao: data.AddDate(0, 2, 0)},
+		{Disponivel: 25},
+		{Disponivel: 0, Expiracao: data.AddDate(0, 0, 2)},
+	}
+
+	saldo := CalcularSaldo(lotes, data, data.AddDate(0, 0, 7))
+
+	assert.Equal(t, Saldo{Pontos: 125, PontosAExpirar: 40, ProximaExpiracao: data.AddDate(0, 0, 5)}, saldo)
+}
+
+func TestCalcularSaldo_SemLotes(t *testing.T) {
+	saldo := CalcularSaldo(nil, time.Now(), time.Now())
+
+	assert.Equal(t, Saldo{}, saldo)
+}
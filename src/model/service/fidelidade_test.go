@@ -0,0 +1,279 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func fidelidadeAtiva() *entity.FidelidadeConfig {
+	return &entity.FidelidadeConfig{ID: 1, Ativo: true, PontosPorReal: 1, ValorPonto: 0.05, ValidadeDias: 365, MinimoResgate: 100, DiasAvisoExpiracao: 15}
+}
+
+// TESTES PARA GetFidelidadeConfigService
+func TestService_GetFidelidadeConfigService_Padrao(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetFidelidadeConfig", "1").Return(nil, gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetFidelidadeConfigService("1")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.False(t, result.Ativo)
+	assert.Equal(t, 100, result.MinimoResgate)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA UpdateFidelidadeConfigService
+func TestService_UpdateFidelidadeConfigService_AtivoSemValores(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	ativo := true
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.UpdateFidelidadeConfigService("1", dtos.UpdateFidelidadeConfigRequest{Ativo: &ativo, ValorPonto: 0.05})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA GetPontosClienteService
+func TestService_GetPontosClienteService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	expiracao := "2099-01-01 00:00:00"
+	lancamentos := []entity.PontosCliente{
+		{ID: 1, IDCliente: 7, Tipo: entity.PontosTipoCredito, Pontos: 200, PontosDisponiveis: 150, Origem: entity.PontosOrigemVenda, DataExpiracao: &expiracao},
+		{ID: 2, IDCliente: 7, Tipo: entity.PontosTipoDebito, Pontos: 50, Origem: entity.PontosOrigemResgate},
+	}
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+	mockDBClient.On("GetFidelidadeConfig", "1").Return(fidelidadeAtiva(), nil)
+	mockDBClient.On("GetPontosCliente", 7, "1").Return(lancamentos, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetPontosClienteService("1", "7")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 150, result.Saldo)
+	assert.Equal(t, 7.5, result.ValorSaldo)
+	assert.Equal(t, 0, result.PontosAExpirar)
+	assert.Equal(t, expiracao, *result.ProximaExpiracao)
+	assert.Equal(t, 150, *result.Lancamentos[0].PontosDisponiveis)
+	assert.Nil(t, result.Lancamentos[1].PontosDisponiveis)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA ResgatarPontosService
+func TestService_ResgatarPontosService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+	mockDBClient.On("GetFidelidadeConfig", "1").Return(fidelidadeAtiva(), nil)
+	mockDBClient.On("ResgatarPontos", mock.MatchedBy(func(debito *entity.PontosCliente) bool {
+		return debito.IDCliente == 7 && debito.Tipo == entity.PontosTipoDebito && debito.Pontos == 120 && debito.Origem == entity.PontosOrigemResgate
+	}), mock.MatchedBy(func(credito *entity.CreditoCliente) bool {
+		return credito.IDCliente == 7 && credito.Valor == 6 && credito.Origem == entity.CreditoOrigemResgatePontos
+	}), "1").Return(nil)
+	mockDBClient.On("GetPontosCliente", 7, "1").Return([]entity.PontosCliente{
+		{ID: 1, Tipo: entity.PontosTipoCredito, Pontos: 200, PontosDisponiveis: 80},
+	}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.ResgatarPontosService("1", "7", dtos.ResgatarPontosRequest{Pontos: 120})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 120, result.Pontos)
+	assert.Equal(t, 6.0, result.ValorCredito)
+	assert.Equal(t, 80, result.SaldoPontos)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_ResgatarPontosService_ProgramaInativo(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+	mockDBClient.On("GetFidelidadeConfig", "1").Return(nil, gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.ResgatarPontosService("1", "7", dtos.ResgatarPontosRequest{Pontos: 120})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Loyalty program is not active", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_ResgatarPontosService_AbaixoDoMinimo(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+	mockDBClient.On("GetFidelidadeConfig", "1").Return(fidelidadeAtiva(), nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.ResgatarPontosService("1", "7", dtos.ResgatarPontosRequest{Pontos: 99})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Minimum redemption is 100 pontos", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_ResgatarPontosService_PontosInsuficientes(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+	mockDBClient.On("GetFidelidadeConfig", "1").Return(fidelidadeAtiva(), nil)
+	mockDBClient.On("ResgatarPontos", mock.AnythingOfType("*entity.PontosCliente"), mock.AnythingOfType("*entity.CreditoCliente"), "1").Return(persistence.ErrPontosInsuficientes)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.ResgatarPontosService("1", "7", dtos.ResgatarPontosRequest{Pontos: 500})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Insufficient pontos for the cliente", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA ExpirarPontosService
+func TestService_ExpirarPontosService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	mockNotifier := new(MockNotifier)
+
+	aExpirar := []entity.PontosAExpirar{{IDCliente: 7, NomeCliente: "Ana", Pontos: 40, ProximaExpiracao: "2025-03-10 10:00:00"}}
+
+	mockDBClient.On("GetFidelidadeConfig", "1").Return(fidelidadeAtiva(), nil)
+	mockDBClient.On("ExpirarPontos", mock.AnythingOfType("string"), "1").Return(2, 35, nil)
+	mockDBClient.On("ReservarAvisoPontos", mock.AnythingOfType("string"), mock.AnythingOfType("string"), "1").Return(aExpirar, []int{3, 4}, nil)
+	mockNotifier.On("Notify", mock.Anything, EventoPontosExpirando, mock.MatchedBy(func(payload map[string]interface{}) bool {
+		return payload["tenant"] == "1"
+	})).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+		notifier: mockNotifier,
+	}
+
+	// Act
+	result, err := service.ExpirarPontosService("1")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 2, result.LancamentosExpirados)
+	assert.Equal(t, 35, result.PontosExpirados)
+	assert.Equal(t, 1, result.ClientesAvisados)
+
+	mockDBClient.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestService_ExpirarPontosService_NotifierErrorLiberaAviso(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	mockNotifier := new(MockNotifier)
+
+	mockDBClient.On("GetFidelidadeConfig", "1").Return(fidelidadeAtiva(), nil)
+	mockDBClient.On("ExpirarPontos", mock.AnythingOfType("string"), "1").Return(0, 0, nil)
+	mockDBClient.On("ReservarAvisoPontos", mock.AnythingOfType("string"), mock.AnythingOfType("string"), "1").Return([]entity.PontosAExpirar{{IDCliente: 7}}, []int{3}, nil)
+	mockDBClient.On("LiberarAvisoPontos", []int{3}, "1").Return(nil)
+	mockNotifier.On("Notify", mock.Anything, EventoPontosExpirando, mock.Anything).Return(errors.New("webhook down"))
+
+	service := &Service{
+		dbClient: mockDBClient,
+		notifier: mockNotifier,
+	}
+
+	// Act
+	result, err := service.ExpirarPontosService("1")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 0, result.ClientesAvisados)
+
+	mockDBClient.AssertExpectations(t)
+	mockNotifier.AssertExpectations(t)
+}
+
+func TestService_ExpirarPontosService_DBError(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetFidelidadeConfig", "1").Return(fidelidadeAtiva(), nil)
+	mockDBClient.On("ExpirarPontos", mock.AnythingOfType("string"), "1").Return(0, 0, errors.New("database error"))
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.ExpirarPontosService("1")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 500, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
@@ -0,0 +1,35 @@
+package gtin
+
+// Valido verifica se o código é um GTIN válido (GTIN-8, GTIN-12/UPC-A, GTIN-13/EAN-13 ou GTIN-14)
+// conferindo apenas dígitos, o tamanho e o dígito verificador.
+func Valido(codigo string) bool {
+	switch len(codigo) {
+	case 8, 12, 13, 14:
+	default:
+		return false
+	}
+
+	for _, c := range codigo {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return DigitoVerificador(codigo[:len(codigo)-1]) == int(codigo[len(codigo)-1]-'0')
+}
+
+// DigitoVerificador calcula o dígito verificador (módulo 10) para os dígitos informados, sem o verificador.
+// Da direita para a esquerda, os dígitos são multiplicados alternadamente por 3 e 1.
+func DigitoVerificador(digitos string) int {
+	soma := 0
+	peso := 3
+	for i := len(digitos) - 1; i >= 0; i-- {
+		soma += int(digitos[i]-'0') * peso
+		if peso == 3 {
+			peso = 1
+		} else {
+			peso = 3
+		}
+	}
+	return (10 - soma%10) % 10
+}
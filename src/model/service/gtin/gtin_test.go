@@ -0,0 +1,36 @@
+package gtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValido(t *testing.T) {
+	tests := []struct {
+		name   string
+		codigo string
+		valido bool
+	}{
+		{"EAN-13 válido", "7891000315507", true},
+		{"EAN-13 válido com verificador zero", "4006381333931", true},
+		{"EAN-13 com verificador errado", "7891000315508", false},
+		{"EAN-8 válido", "96385074", true},
+		{"UPC-A válido", "036000291452", true},
+		{"GTIN-14 válido", "17891000315504", true},
+		{"tamanho inválido", "123456789", false},
+		{"com letras", "789100031550A", false},
+		{"vazio", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valido, Valido(tt.codigo))
+		})
+	}
+}
+
+func TestDigitoVerificador(t *testing.T) {
+	assert.Equal(t, 7, DigitoVerificador("789100031550"))
+	assert.Equal(t, 1, DigitoVerificador("400638133393"))
+}
@@ -0,0 +1,193 @@
+package importacao
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/betine97/back-project.git/src/model/service/catalogo"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	FormatoCSV  = "csv"
+	FormatoXLSX = "xlsx"
+)
+
+var bom = []byte("\xEF\xBB\xBF")
+
+// Formato identifica o formato do arquivo pela extensão do nome
+func Formato(nomeArquivo string) (string, error) {
+	switch strings.ToLower(filepath.Ext(nomeArquivo)) {
+	case ".csv", ".txt":
+		return FormatoCSV, nil
+	case ".xlsx":
+		return FormatoXLSX, nil
+	default:
+		return "", fmt.Errorf("unsupported file format %q, use .csv or .xlsx", filepath.Ext(nomeArquivo))
+	}
+}
+
+// Ler devolve as linhas do arquivo, sendo a primeira o cabeçalho.
+// CSV aceita ";" ou "," como separador (detectado pelo cabeçalho) e BOM UTF-8; XLSX usa a primeira planilha.
+func Ler(conteudo []byte, formato string) ([][]string, error) {
+	var linhas [][]string
+	var err error
+
+	switch formato {
+	case FormatoCSV:
+		linhas, err = lerCSV(conteudo)
+	case FormatoXLSX:
+		linhas, err = lerXLSX(conteudo)
+	default:
+		return nil, fmt.Errorf("unsupported file format %q", formato)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(linhas) == 0 || LinhaVazia(linhas[0]) {
+		return nil, errors.New("file is empty or has no header")
+	}
+	return linhas, nil
+}
+
+func lerCSV(conteudo []byte) ([][]string, error) {
+	conteudo = bytes.TrimPrefix(conteudo, bom)
+
+	cabecalho := conteudo
+	if i := bytes.IndexByte(conteudo, '\n'); i >= 0 {
+		cabecalho = conteudo[:i]
+	}
+
+	reader := csv.NewReader(bytes.NewReader(conteudo))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	if bytes.Count(cabecalho, []byte(";")) > bytes.Count(cabecalho, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	linhas, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV file: %w", err)
+	}
+	return linhas, nil
+}
+
+func lerXLSX(conteudo []byte) ([][]string, error) {
+	arquivo, err := excelize.OpenReader(bytes.NewReader(conteudo))
+	if err != nil {
+		return nil, fmt.Errorf("invalid XLSX file: %w", err)
+	}
+	defer arquivo.Close()
+
+	planilhas := arquivo.GetSheetList()
+	if len(planilhas) == 0 {
+		return nil, errors.New("XLSX file has no sheets")
+	}
+
+	linhas, err := arquivo.GetRows(planilhas[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid XLSX file: %w", err)
+	}
+	return linhas, nil
+}
+
+// Colunas associa cada campo à coluna do arquivo. O mapeamento informado (cabeçalho -> campo) tem prioridade;
+// as demais colunas são associadas quando o cabeçalho corresponde ao nome do campo ("Código Barra" -> codigo_barra).
+// Retorna também os cabeçalhos que não foram associados a nenhum campo.
+func Colunas(cabecalho []string, mapeamento map[string]string, campos []string) (map[string]int, []string, error) {
+	validos := make(map[string]bool, len(campos))
+	for _, campo := range campos {
+		validos[campo] = true
+	}
+
+	mapeamentoPorChave := make(map[string]string, len(mapeamento))
+	for coluna, campo := range mapeamento {
+		if !validos[campo] {
+			return nil, nil, fmt.Errorf("unknown field %q in mapping, valid fields: %s", campo, strings.Join(campos, ", "))
+		}
+		mapeamentoPorChave[chaveColuna(coluna)] = campo
+	}
+
+	colunas := map[string]int{}
+	ignoradas := []string{}
+	for i, titulo := range cabecalho {
+		chave := chaveColuna(titulo)
+		campo, ok := mapeamentoPorChave[chave]
+		if !ok && validos[chave] {
+			campo, ok = chave, true
+		}
+		if !ok {
+			if strings.TrimSpace(titulo) != "" {
+				ignoradas = append(ignoradas, titulo)
+			}
+			continue
+		}
+		if _, repetido := colunas[campo]; repetido {
+			return nil, nil, fmt.Errorf("field %q is mapped to more than one column", campo)
+		}
+		colunas[campo] = i
+	}
+
+	if len(colunas) == 0 {
+		return nil, nil, fmt.Errorf("no column matches the fields: %s", strings.Join(campos, ", "))
+	}
+	return colunas, ignoradas, nil
+}
+
+// Valores devolve os valores da linha por campo, sem espaços nas pontas
+func Valores(linha []string, colunas map[string]int) map[string]string {
+	valores := make(map[string]string, len(colunas))
+	for campo, i := range colunas {
+		if i < len(linha) {
+			valores[campo] = strings.TrimSpace(linha[i])
+		} else {
+			valores[campo] = ""
+		}
+	}
+	return valores
+}
+
+func LinhaVazia(linha []string) bool {
+	for _, valor := range linha {
+		if strings.TrimSpace(valor) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Decimal converte valores como "1.234,56", "1234.56" ou "R$ 12,90"
+func Decimal(valor string) (float64, error) {
+	valor = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(valor), "R$"))
+	valor = strings.ReplaceAll(valor, " ", "")
+
+	if strings.Contains(valor, ",") {
+		valor = strings.ReplaceAll(valor, ".", "")
+		valor = strings.Replace(valor, ",", ".", 1)
+	}
+
+	numero, err := strconv.ParseFloat(valor, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid number", valor)
+	}
+	return numero, nil
+}
+
+// Inteiro aceita também valores como "12,0" ou "12.0" gerados por planilhas
+func Inteiro(valor string) (int, error) {
+	numero, err := Decimal(valor)
+	if err != nil || numero != float64(int(numero)) {
+		return 0, fmt.Errorf("%q is not a valid integer", valor)
+	}
+	return int(numero), nil
+}
+
+func chaveColuna(titulo string) string {
+	return strings.ReplaceAll(catalogo.Chave(strings.ReplaceAll(titulo, "_", " ")), " ", "_")
+}
@@ -0,0 +1,120 @@
+package importacao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestFormato(t *testing.T) {
+	formato, err := Formato("produtos.CSV")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatoCSV, formato)
+
+	formato, err = Formato("clientes.xlsx")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatoXLSX, formato)
+
+	_, err = Formato("clientes.xls")
+	assert.Error(t, err)
+}
+
+func TestLerCSVComPontoEVirgulaEBOM(t *testing.T) {
+	conteudo := []byte("\xEF\xBB\xBFNome Produto;Preço Venda\n\"Ração; Premium\";\"129,90\"\nAreia;19,9\n")
+
+	linhas, err := Ler(conteudo, FormatoCSV)
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"Nome Produto", "Preço Venda"}, {"Ração; Premium", "129,90"}, {"Areia", "19,9"}}, linhas)
+}
+
+func TestLerCSVComVirgula(t *testing.T) {
+	linhas, err := Ler([]byte("nome,email\nPetShop,contato@petshop.com\n"), FormatoCSV)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PetShop", "contato@petshop.com"}, linhas[1])
+}
+
+func TestLerArquivoVazio(t *testing.T) {
+	_, err := Ler([]byte(""), FormatoCSV)
+	assert.Error(t, err)
+}
+
+func TestLerXLSX(t *testing.T) {
+	arquivo := excelize.NewFile()
+	planilha := arquivo.GetSheetName(0)
+	require.NoError(t, arquivo.SetSheetRow(planilha, "A1", &[]interface{}{"nome_cliente", "sexo"}))
+	require.NoError(t, arquivo.SetSheetRow(planilha, "A2", &[]interface{}{"Maria", "F"}))
+	buffer, err := arquivo.WriteToBuffer()
+	require.NoError(t, err)
+
+	linhas, err := Ler(buffer.Bytes(), FormatoXLSX)
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"nome_cliente", "sexo"}, {"Maria", "F"}}, linhas)
+}
+
+func TestColunas(t *testing.T) {
+	campos := []string{"codigo_barra", "nome_produto", "preco_venda"}
+	cabecalho := []string{"Código Barra", "Descrição do Item", "Valor", "Observação"}
+
+	colunas, ignoradas, err := Colunas(cabecalho, map[string]string{"Descrição do item": "nome_produto", "valor": "preco_venda"}, campos)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"codigo_barra": 0, "nome_produto": 1, "preco_venda": 2}, colunas)
+	assert.Equal(t, []string{"Observação"}, ignoradas)
+}
+
+func TestColunasErros(t *testing.T) {
+	campos := []string{"nome", "email"}
+
+	_, _, err := Colunas([]string{"nome"}, map[string]string{"nome": "cnpj"}, campos)
+	assert.Error(t, err, "campo desconhecido no mapeamento")
+
+	_, _, err = Colunas([]string{"nome", "Nome"}, nil, campos)
+	assert.Error(t, err, "campo em duas colunas")
+
+	_, _, err = Colunas([]string{"cnpj"}, nil, campos)
+	assert.Error(t, err, "nenhuma coluna reconhecida")
+}
+
+func TestValores(t *testing.T) {
+	valores := Valores([]string{" Areia ", "19,9"}, map[string]int{"nome_produto": 0, "preco_venda": 1, "sku": 2})
+
+	assert.Equal(t, map[string]string{"nome_produto": "Areia", "preco_venda": "19,9", "sku": ""}, valores)
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		valor    string
+		esperado float64
+	}{
+		{"129,90", 129.9},
+		{"1.234,56", 1234.56},
+		{"1234.56", 1234.56},
+		{"R$ 12,90", 12.9},
+		{"7", 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.valor, func(t *testing.T) {
+			numero, err := Decimal(tt.valor)
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.esperado, numero, 0.0001)
+		})
+	}
+
+	_, err := Decimal("doze")
+	assert.Error(t, err)
+}
+
+func TestInteiro(t *testing.T) {
+	numero, err := Inteiro("12,0")
+	assert.NoError(t, err)
+	assert.Equal(t, 12, numero)
+
+	_, err = Inteiro("1,5")
+	assert.Error(t, err)
+}
@@ -0,0 +1,586 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/importacao"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE IMPORTAÇÃO ------------------------------------------------------------------------------------------------------------------------------------
+
+// ValidadorCampos valida o DTO montado a partir de uma linha com as mesmas regras dos middlewares de criação
+type ValidadorCampos func(request interface{}) []exceptions.Causes
+
+// Campos aceitos em cada recurso, na ordem em que aparecem na documentação
+var camposImportacao = map[string][]string{
+	entity.ImportacaoRecursoProdutos:     {"codigo_barra", "nome_produto", "sku", "categoria", "destinado_para", "variacao", "marca", "descricao", "status", "preco_venda", "id_fornecedor", "estoque_minimo", "data_cadastro"},
+	entity.ImportacaoRecursoClientes:     {"tipo_cliente", "nome_cliente", "numero_celular", "sexo", "email", "data_nascimento", "data_cadastro"},
+	entity.ImportacaoRecursoFornecedores: {"nome", "telefone", "email", "cidade", "estado", "status", "data_cadastro"},
+}
+
+const limiteLinhasImportacao = 10000
+
+// Resultado do processamento de uma linha
+const (
+	linhaInserida   = "inserida"
+	linhaAtualizada = "atualizada"
+	linhaIgnorada   = "ignorada"
+)
+
+// ImportarService lê o arquivo, associa as colunas aos campos e cria o job; as linhas são processadas em segundo plano.
+// Problemas no arquivo como um todo (formato, cabeçalho, mapeamento) são devolvidos na hora.
+func (srv *Service) ImportarService(userID string, recurso string, request dtos.ImportacaoRequest, validar ValidadorCampos) (*dtos.ImportacaoIniciadaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting importar service", zap.String("recurso", recurso), zap.String("arquivo", request.NomeArquivo), zap.Bool("dry_run", request.DryRun))
+
+	campos, ok := camposImportacao[recurso]
+	if !ok {
+		return nil, exceptions.NewBadRequestError(fmt.Sprintf("Resource '%s' does not support import", recurso))
+	}
+
+	formato, err := importacao.Formato(request.NomeArquivo)
+	if err != nil {
+		return nil, exceptions.NewBadRequestError(err.Error())
+	}
+
+	linhas, err := importacao.Ler(request.Conteudo, formato)
+	if err != nil {
+		zap.L().Warn("Error reading import file", zap.Error(err))
+		return nil, exceptions.NewBadRequestError(err.Error())
+	}
+	if len(linhas)-1 > limiteLinhasImportacao {
+		return nil, exceptions.NewBadRequestError(fmt.Sprintf("File has %d rows, the limit is %d", len(linhas)-1, limiteLinhasImportacao))
+	}
+
+	colunas, ignoradas, err := importacao.Colunas(linhas[0], request.Mapeamento, campos)
+	if err != nil {
+		return nil, exceptions.NewBadRequestError(err.Error())
+	}
+
+	job := &entity.Importacao{
+		Recurso:             recurso,
+		NomeArquivo:         request.NomeArquivo,
+		Formato:             formato,
+		Status:              entity.ImportacaoStatusProcessando,
+		DryRun:              request.DryRun,
+		AtualizarExistentes: request.AtualizarExistentes,
+		TotalLinhas:         len(linhas) - 1,
+		CriadoPor:           userID,
+		DataCriacao:         time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	if dbErr := srv.dbClient.CreateImportacao(job, userID); dbErr != nil {
+		zap.L().Error("Error creating importacao in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	go srv.processarImportacao(userID, job, linhas[1:], colunas, validar)
+
+	response := &dtos.ImportacaoIniciadaResponse{
+		ID:               job.ID,
+		Status:           job.Status,
+		TotalLinhas:      job.TotalLinhas,
+		Colunas:          make(map[string]string, len(colunas)),
+		ColunasIgnoradas: ignoradas,
+	}
+	for campo, i := range colunas {
+		response.Colunas[campo] = linhas[0][i]
+	}
+
+	zap.L().Info("Importacao started successfully", zap.Int("id", job.ID), zap.Int("total_linhas", job.TotalLinhas))
+	return response, nil
+}
+
+func (srv *Service) GetImportacoesService(userID string, recurso string, page, limit int) (*dtos.ImportacaoListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get importacoes service", zap.String("recurso", recurso), zap.Int("page", page), zap.Int("limit", limit))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	importacoes, total, dbErr := srv.dbClient.GetImportacoesPaginated(userID, recurso, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting importacoes from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.ImportacaoListResponse{
+		Importacoes: make([]dtos.ImportacaoResponse, 0, len(importacoes)),
+		Total:       total,
+		Page:        page,
+		Limit:       limit,
+		TotalPages:  (total + limit - 1) / limit,
+	}
+	for _, job := range importacoes {
+		response.Importacoes = append(response.Importacoes, buildImportacaoResponse(job))
+	}
+
+	zap.L().Info("Successfully retrieved importacoes", zap.Int("count", len(importacoes)), zap.Int("total", total))
+	return response, nil
+}
+
+func (srv *Service) GetImportacaoByIDService(userID string, id string) (*dtos.ImportacaoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get importacao by ID service", zap.String("id", id))
+
+	job, restErr := srv.getImportacao(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := buildImportacaoResponse(*job)
+
+	zap.L().Info("Successfully retrieved importacao by ID", zap.Int("id", job.ID), zap.String("status", job.Status))
+	return &response, nil
+}
+
+func (srv *Service) GetImportacaoErrosService(userID string, id string, page, limit int) (*dtos.ImportacaoErroListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get importacao erros service", zap.String("id", id), zap.Int("page", page), zap.Int("limit", limit))
+
+	job, restErr := srv.getImportacao(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	erros, total, dbErr := srv.dbClient.GetImportacaoErrosPaginated(job.ID, userID, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting importacao erros from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.ImportacaoErroListResponse{
+		Erros:      make([]dtos.ImportacaoErroResponse, 0, len(erros)),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: (total + limit - 1) / limit,
+	}
+	for _, erro := range erros {
+		response.Erros = append(response.Erros, buildImportacaoErroResponse(erro))
+	}
+
+	zap.L().Info("Successfully retrieved importacao erros", zap.Int("count", len(erros)), zap.Int("total", total))
+	return response, nil
+}
+
+func buildImportacaoErroResponse(erro entity.ImportacaoErro) dtos.ImportacaoErroResponse {
+	return dtos.ImportacaoErroResponse{
+		Linha:    erro.Linha,
+		Campo:    erro.Campo,
+		Mensagem: erro.Mensagem,
+	}
+}
+
+// processarImportacao percorre as linhas em ordem; uma linha com erro não interrompe as demais
+func (srv *Service) processarImportacao(userID string, job *entity.Importacao, linhas [][]string, colunas map[string]int, validar ValidadorCampos) {
+	zap.L().Info("Starting processar importacao", zap.Int("id", job.ID), zap.String("recurso", job.Recurso))
+
+	var erros []entity.ImportacaoErro
+
+	defer func() {
+		if r := recover(); r != nil {
+			zap.L().Error("Panic processing importacao", zap.Int("id", job.ID), zap.Any("panic", r))
+			job.Status = entity.ImportacaoStatusFalhou
+			job.MensagemErro = "Internal error processing the file"
+			srv.concluirImportacao(userID, job, erros)
+		}
+	}()
+
+	// Chaves já vistas no arquivo: no dry-run nada é gravado, então uma chave repetida conta como existente
+	vistas := map[string]bool{}
+
+	for i, linha := range linhas {
+		numero := i + 2
+		if importacao.LinhaVazia(linha) {
+			job.Ignoradas++
+			continue
+		}
+
+		valores := importacao.Valores(linha, colunas)
+
+		var resultado string
+		var errosLinha []entity.ImportacaoErro
+		switch job.Recurso {
+		case entity.ImportacaoRecursoProdutos:
+			resultado, errosLinha = srv.importarProduto(userID, job, valores, vistas, validar)
+		case entity.ImportacaoRecursoClientes:
+			resultado, errosLinha = srv.importarCliente(userID, job, valores, vistas, validar)
+		case entity.ImportacaoRecursoFornecedores:
+			resultado, errosLinha = srv.importarFornecedor(userID, job, valores, vistas, validar)
+		}
+
+		if len(errosLinha) > 0 {
+			job.ComErro++
+			for _, erro := range errosLinha {
+				erro.IDImportacao = job.ID
+				erro.Linha = numero
+				erros = append(erros, erro)
+			}
+			continue
+		}
+
+		switch resultado {
+		case linhaInserida:
+			job.Inseridas++
+		case linhaAtualizada:
+			job.Atualizadas++
+		default:
+			job.Ignoradas++
+		}
+	}
+
+	job.Status = entity.ImportacaoStatusConcluida
+	srv.concluirImportacao(userID, job, erros)
+}
+
+func (srv *Service) concluirImportacao(userID string, job *entity.Importacao, erros []entity.ImportacaoErro) {
+	dataConclusao := time.Now().Format("2006-01-02 15:04:05")
+	job.DataConclusao = &dataConclusao
+
+	if dbErr := srv.dbClient.ConcluirImportacao(job, erros, userID); dbErr != nil {
+		zap.L().Error("Error concluding importacao", zap.Int("id", job.ID), zap.Error(dbErr))
+		return
+	}
+
+	zap.L().Info("Importacao processed successfully",
+		zap.Int("id", job.ID),
+		zap.String("status", job.Status),
+		zap.Int("inseridas", job.Inseridas),
+		zap.Int("atualizadas", job.Atualizadas),
+		zap.Int("ignoradas", job.Ignoradas),
+		zap.Int("com_erro", job.ComErro))
+}
+
+func (srv *Service) importarProduto(userID string, job *entity.Importacao, valores map[string]string, vistas map[string]bool, validar ValidadorCampos) (string, []entity.ImportacaoErro) {
+	request := dtos.CreateProductRequest{
+		DataCadastro:  valorOuPadrao(valores["data_cadastro"], time.Now().Format("2006-01-02")),
+		CodigoBarra:   valores["codigo_barra"],
+		NomeProduto:   valores["nome_produto"],
+		SKU:           valores["sku"],
+		Categoria:     valores["categoria"],
+		DestinadoPara: valores["destinado_para"],
+		Variacao:      valores["variacao"],
+		Marca:         valores["marca"],
+		Descricao:     valores["descricao"],
+		Status:        valorOuPadrao(valores["status"], "ativo"),
+	}
+
+	var erros []entity.ImportacaoErro
+	if valor := valores["preco_venda"]; valor != "" {
+		preco, err := importacao.Decimal(valor)
+		if err != nil {
+			erros = append(erros, entity.ImportacaoErro{Campo: "preco_venda", Mensagem: err.Error()})
+		}
+		request.PrecoVenda = preco
+	}
+	if valor := valores["id_fornecedor"]; valor != "" {
+		idFornecedor, err := importacao.Inteiro(valor)
+		if err != nil {
+			erros = append(erros, entity.ImportacaoErro{Campo: "id_fornecedor", Mensagem: err.Error()})
+		}
+		request.IDFornecedor = idFornecedor
+	}
+	if valor := valores["estoque_minimo"]; valor != "" {
+		estoqueMinimo, err := importacao.Inteiro(valor)
+		if err != nil {
+			erros = append(erros, entity.ImportacaoErro{Campo: "estoque_minimo", Mensagem: err.Error()})
+		}
+		request.EstoqueMinimo = estoqueMinimo
+	}
+	if len(erros) > 0 {
+		return "", erros
+	}
+	if causas := validar(&request); len(causas) > 0 {
+		return "", errosDeValidacao(causas)
+	}
+
+	// O produto existente é encontrado pelo código de barras e, sem ele, pelo SKU
+	chave := ""
+	var existente *entity.Produto
+	if request.CodigoBarra != "" {
+		chave = "codigo_barra:" + request.CodigoBarra
+		existente = srv.dbClient.GetProductByBarcode(request.CodigoBarra, userID)
+	}
+	if (existente == nil || existente.IDProduto == 0) && request.SKU != "" {
+		if chave == "" {
+			chave = "sku:" + request.SKU
+		}
+		existente = srv.dbClient.GetProductBySKU(request.SKU, userID)
+	}
+
+	existe := (existente != nil && existente.IDProduto != 0) || (job.DryRun && chave != "" && vistas[chave])
+	if existe && !job.AtualizarExistentes {
+		return linhaIgnorada, nil
+	}
+
+	if job.DryRun {
+		if request.Categoria != "" {
+			if _, restErr := srv.resolverCategoria(userID, nil, request.Categoria); restErr != nil {
+				return "", []entity.ImportacaoErro{{Campo: "categoria", Mensagem: restErr.Error()}}
+			}
+		}
+		marcarVista(vistas, chave)
+		if existe {
+			return linhaAtualizada, nil
+		}
+		return linhaInserida, nil
+	}
+
+	if existe {
+		update := dtos.UpdateProductRequest{
+			CodigoBarra:   textoOpcional(valores, "codigo_barra"),
+			NomeProduto:   textoOpcional(valores, "nome_produto"),
+			SKU:           textoOpcional(valores, "sku"),
+			Categoria:     textoOpcional(valores, "categoria"),
+			DestinadoPara: textoOpcional(valores, "destinado_para"),
+			Variacao:      textoOpcional(valores, "variacao"),
+			Marca:         textoOpcional(valores, "marca"),
+			Descricao:     textoOpcional(valores, "descricao"),
+			Status:        textoOpcional(valores, "status"),
+		}
+		if valores["preco_venda"] != "" {
+			update.PrecoVenda = &request.PrecoVenda
+		}
+		if valores["id_fornecedor"] != "" {
+			update.IDFornecedor = &request.IDFornecedor
+		}
+		if valores["estoque_minimo"] != "" {
+			update.EstoqueMinimo = &request.EstoqueMinimo
+		}
+
+		if _, restErr := srv.UpdateProductService(userID, strconv.Itoa(existente.IDProduto), update); restErr != nil {
+			return "", []entity.ImportacaoErro{{Mensagem: restErr.Error()}}
+		}
+		return linhaAtualizada, nil
+	}
+
+	if _, restErr := srv.CreateProductService(userID, request); restErr != nil {
+		return "", []entity.ImportacaoErro{{Mensagem: restErr.Error()}}
+	}
+	marcarVista(vistas, chave)
+	return linhaInserida, nil
+}
+
+func (srv *Service) importarCliente(userID string, job *entity.Importacao, valores map[string]string, vistas map[string]bool, validar ValidadorCampos) (string, []entity.ImportacaoErro) {
+	request := dtos.CreateClienteRequest{
+		TipoCliente:    valores["tipo_cliente"],
+		NomeCliente:    valores["nome_cliente"],
+		NumeroCelular:  valores["numero_celular"],
+		Sexo:           valores["sexo"],
+		Email:          valores["email"],
+		DataNascimento: valores["data_nascimento"],
+		DataCadastro:   valorOuPadrao(valores["data_cadastro"], time.Now().Format("2006-01-02")),
+	}
+	if causas := validar(&request); len(causas) > 0 {
+		return "", errosDeValidacao(causas)
+	}
+
+	// O cliente existente é encontrado pelo e-mail e, sem ele, pelo celular
+	chave := "email:" + request.Email
+	existente := srv.dbClient.GetClienteByEmail(request.Email, userID)
+	if existente == nil || existente.ID == 0 {
+		existente = srv.dbClient.GetClienteByTelefone(request.NumeroCelular, userID)
+	}
+
+	existe := (existente != nil && existente.ID != 0) || (job.DryRun && (vistas[chave] || vistas["celular:"+request.NumeroCelular]))
+	if existe && !job.AtualizarExistentes {
+		return linhaIgnorada, nil
+	}
+
+	if existe && existente != nil && existente.ID != 0 {
+		// E-mail e celular identificam o cliente; não podem pertencer a outro cadastro
+		if outro := srv.dbClient.GetClienteByTelefone(request.NumeroCelular, userID); outro != nil && outro.ID != 0 && outro.ID != existente.ID {
+			return "", []entity.ImportacaoErro{{Campo: "numero_celular", Mensagem: "numero_celular belongs to another cliente"}}
+		}
+		if outro := srv.dbClient.GetClienteByEmail(request.Email, userID); outro != nil && outro.ID != 0 && outro.ID != existente.ID {
+			return "", []entity.ImportacaoErro{{Campo: "email", Mensagem: "email belongs to another cliente"}}
+		}
+	}
+
+	if job.DryRun {
+		marcarVista(vistas, chave)
+		marcarVista(vistas, "celular:"+request.NumeroCelular)
+		if existe {
+			return linhaAtualizada, nil
+		}
+		return linhaInserida, nil
+	}
+
+	if existe {
+		campos := map[string]interface{}{}
+		for _, campo := range camposImportacao[entity.ImportacaoRecursoClientes] {
+			if valor, ok := valores[campo]; ok && valor != "" && campo != "data_cadastro" {
+				campos[campo] = valor
+			}
+		}
+
+		if dbErr := srv.dbClient.UpdateCliente(existente.ID, campos, userID); dbErr != nil {
+			zap.L().Error("Error updating cliente from importacao", zap.Error(dbErr))
+			return "", []entity.ImportacaoErro{{Mensagem: "Internal server error"}}
+		}
+		return linhaAtualizada, nil
+	}
+
+	if _, restErr := srv.CreateClienteService(userID, request); restErr != nil {
+		return "", []entity.ImportacaoErro{{Mensagem: restErr.Error()}}
+	}
+	return linhaInserida, nil
+}
+
+func (srv *Service) importarFornecedor(userID string, job *entity.Importacao, valores map[string]string, vistas map[string]bool, validar ValidadorCampos) (string, []entity.ImportacaoErro) {
+	request := dtos.CreateFornecedorRequest{
+		Nome:         valores["nome"],
+		Telefone:     valores["telefone"],
+		Email:        valores["email"],
+		Cidade:       valores["cidade"],
+		Estado:       valores["estado"],
+		Status:       valorOuPadrao(valores["status"], "Ativo"),
+		DataCadastro: valorOuPadrao(valores["data_cadastro"], time.Now().Format("2006-01-02")),
+	}
+	if causas := validar(&request); len(causas) > 0 {
+		return "", errosDeValidacao(causas)
+	}
+
+	// O fornecedor existente é encontrado pelo nome
+	chave := "nome:" + request.Nome
+	existente, dbErr := srv.dbClient.GetFornecedorByNome(request.Nome, userID)
+	if dbErr != nil && !errors.Is(dbErr, gorm.ErrRecordNotFound) {
+		zap.L().Error("Error getting fornecedor by nome", zap.Error(dbErr))
+		return "", []entity.ImportacaoErro{{Mensagem: "Internal server error"}}
+	}
+
+	existe := existente != nil || (job.DryRun && vistas[chave])
+	if existe && !job.AtualizarExistentes {
+		return linhaIgnorada, nil
+	}
+
+	if job.DryRun {
+		marcarVista(vistas, chave)
+		if existe {
+			return linhaAtualizada, nil
+		}
+		return linhaInserida, nil
+	}
+
+	if existe {
+		if request.Telefone != "" {
+			existente.Telefone = request.Telefone
+		}
+		if request.Email != "" {
+			existente.Email = request.Email
+		}
+		if request.Cidade != "" {
+			existente.Cidade = request.Cidade
+		}
+		if request.Estado != "" {
+			existente.Estado = request.Estado
+		}
+		if valores["status"] != "" {
+			existente.Status = request.Status
+		}
+
+		if dbErr := srv.dbClient.UpdateFornecedor(*existente, userID); dbErr != nil {
+			zap.L().Error("Error updating fornecedor from importacao", zap.Error(dbErr))
+			return "", []entity.ImportacaoErro{{Mensagem: "Internal server error"}}
+		}
+		return linhaAtualizada, nil
+	}
+
+	if _, restErr := srv.CreateFornecedorService(userID, request); restErr != nil {
+		return "", []entity.ImportacaoErro{{Mensagem: restErr.Error()}}
+	}
+	return linhaInserida, nil
+}
+
+func (srv *Service) getImportacao(userID string, id string) (*entity.Importacao, *exceptions.RestErr) {
+	idInt := 0
+	if _, err := fmt.Sscanf(id, "%d", &idInt); err != nil {
+		zap.L().Error("Error converting importacao id to int", zap.Error(err))
+		return nil, exceptions.NewBadRequestError("Invalid importacao ID")
+	}
+
+	job, dbErr := srv.dbClient.GetImportacaoByID(idInt, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Importacao not found")
+		}
+		zap.L().Error("Error getting importacao by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	return job, nil
+}
+
+func buildImportacaoResponse(job entity.Importacao) dtos.ImportacaoResponse {
+	response := dtos.ImportacaoResponse{
+		ID:                  job.ID,
+		Recurso:             job.Recurso,
+		NomeArquivo:         job.NomeArquivo,
+		Formato:             job.Formato,
+		Status:              job.Status,
+		DryRun:              job.DryRun,
+		AtualizarExistentes: job.AtualizarExistentes,
+		TotalLinhas:         job.TotalLinhas,
+		Inseridas:           job.Inseridas,
+		Atualizadas:         job.Atualizadas,
+		Ignoradas:           job.Ignoradas,
+		ComErro:             job.ComErro,
+		MensagemErro:        job.MensagemErro,
+		CriadoPor:           job.CriadoPor,
+		DataCriacao:         job.DataCriacao,
+	}
+	if job.DataConclusao != nil {
+		response.DataConclusao = *job.DataConclusao
+	}
+	return response
+}
+
+func errosDeValidacao(causas []exceptions.Causes) []entity.ImportacaoErro {
+	erros := make([]entity.ImportacaoErro, 0, len(causas))
+	for _, causa := range causas {
+		erros = append(erros, entity.ImportacaoErro{Campo: causa.Field, Mensagem: causa.FieldMessage})
+	}
+	return erros
+}
+
+func valorOuPadrao(valor string, padrao string) string {
+	if valor == "" {
+		return padrao
+	}
+	return valor
+}
+
+// textoOpcional devolve nil para células vazias, que não alteram o cadastro existente
+func textoOpcional(valores map[string]string, campo string) *string {
+	valor, ok := valores[campo]
+	if !ok || valor == "" {
+		return nil
+	}
+	return &valor
+}
+
+func marcarVista(vistas map[string]bool, chave string) {
+	if chave != "" {
+		vistas[chave] = true
+	}
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// validarNomeFornecedor simula o validador do middleware exigindo o nome do fornecedor
+func validarNomeFornecedor(request interface{}) []exceptions.Causes {
+	if fornecedor, ok := request.(*dtos.CreateFornecedorRequest); ok && fornecedor.Nome == "" {
+		return []exceptions.Causes{{Field: "nome", FieldMessage: "nome is required"}}
+	}
+	return nil
+}
+
+// esperarConclusao aguarda o processamento em segundo plano gravar o resultado da importação
+func esperarConclusao(t *testing.T, concluida chan *entity.Importacao) *entity.Importacao {
+	select {
+	case job := <-concluida:
+		return job
+	case <-time.After(2 * time.Second):
+		t.Fatal("importacao was not concluded")
+		return nil
+	}
+}
+
+// TESTES PARA ImportarService
+func TestService_ImportarService_DryRunConta(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	concluida := make(chan *entity.Importacao, 1)
+	var erros []entity.ImportacaoErro
+
+	mockDBClient.On("CreateImportacao", mock.AnythingOfType("*entity.Importacao"), "1").Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.Importacao).ID = 12
+	}).Return(nil)
+	mockDBClient.On("GetFornecedorByNome", "Pet Ração", "1").Return(nil, gorm.ErrRecordNotFound)
+	mockDBClient.On("GetFornecedorByNome", "Cobasi", "1").Return(&entity.Fornecedores{ID: 3, Nome: "Cobasi"}, nil)
+	mockDBClient.On("ConcluirImportacao", mock.AnythingOfType("*entity.Importacao"), mock.Anything, "1").Run(func(args mock.Arguments) {
+		erros, _ = args.Get(1).([]entity.ImportacaoErro)
+		concluida <- args.Get(0).(*entity.Importacao)
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Linha repetida no arquivo conta como existente; linha vazia é ignorada; linha sem nome vai para os erros
+	conteudo := "nome;cidade;estado;observacao\n" +
+		"Pet Ração;Campinas;SP;\n" +
+		"Pet Ração;Campinas;SP;\n" +
+		"Cobasi;São Paulo;SP;\n" +
+		";;;\n" +
+		";Santos;SP;\n"
+
+	// Act
+	result, err := service.ImportarService("1", entity.ImportacaoRecursoFornecedores, dtos.ImportacaoRequest{
+		NomeArquivo:         "fornecedores.csv",
+		Conteudo:            []byte(conteudo),
+		DryRun:              true,
+		AtualizarExistentes: true,
+	}, validarNomeFornecedor)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 12, result.ID)
+	assert.Equal(t, entity.ImportacaoStatusProcessando, result.Status)
+	assert.Equal(t, 5, result.TotalLinhas)
+	assert.Equal(t, "nome", result.Colunas["nome"])
+	assert.Equal(t, []string{"observacao"}, result.ColunasIgnoradas)
+
+	job := esperarConclusao(t, concluida)
+	assert.Equal(t, entity.ImportacaoStatusConcluida, job.Status)
+	assert.Equal(t, 1, job.Inseridas)
+	assert.Equal(t, 2, job.Atualizadas)
+	assert.Equal(t, 1, job.Ignoradas)
+	assert.Equal(t, 1, job.ComErro)
+	assert.NotNil(t, job.DataConclusao)
+	assert.Equal(t, []entity.ImportacaoErro{{IDImportacao: 12, Linha: 6, Campo: "nome", Mensagem: "nome is required"}}, erros)
+
+	mockDBClient.AssertNotCalled(t, "CreateFornecedor", mock.Anything, mock.Anything)
+	mockDBClient.AssertNotCalled(t, "UpdateFornecedor", mock.Anything, mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_ImportarService_SemAtualizarIgnoraExistentes(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	concluida := make(chan *entity.Importacao, 1)
+
+	mockDBClient.On("CreateImportacao", mock.AnythingOfType("*entity.Importacao"), "1").Return(nil)
+	mockDBClient.On("GetFornecedorByNome", "Pet Ração", "1").Return(nil, gorm.ErrRecordNotFound)
+	mockDBClient.On("GetFornecedorByNome", "Cobasi", "1").Return(&entity.Fornecedores{ID: 3, Nome: "Cobasi"}, nil)
+	mockDBClient.On("ConcluirImportacao", mock.AnythingOfType("*entity.Importacao"), mock.Anything, "1").Run(func(args mock.Arguments) {
+		concluida <- args.Get(0).(*entity.Importacao)
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	_, err := service.ImportarService("1", entity.ImportacaoRecursoFornecedores, dtos.ImportacaoRequest{
+		NomeArquivo: "fornecedores.csv",
+		Conteudo:    []byte("nome,cidade\nPet Ração,Campinas\nPet Ração,Campinas\nCobasi,São Paulo\n"),
+		DryRun:      true,
+	}, validarNomeFornecedor)
+
+	// Assert
+	assert.Nil(t, err)
+
+	job := esperarConclusao(t, concluida)
+	assert.Equal(t, 1, job.Inseridas)
+	assert.Equal(t, 0, job.Atualizadas)
+	assert.Equal(t, 2, job.Ignoradas)
+	assert.Equal(t, 0, job.ComErro)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_ImportarService_FormatoInvalido(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.ImportarService("1", entity.ImportacaoRecursoFornecedores, dtos.ImportacaoRequest{
+		NomeArquivo: "fornecedores.pdf",
+		Conteudo:    []byte("nome\nPet Ração\n"),
+	}, validarNomeFornecedor)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertNotCalled(t, "CreateImportacao", mock.Anything, mock.Anything)
+}
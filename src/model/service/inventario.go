@@ -0,0 +1,343 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/valorizacao"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE INVENTÁRIO ------------------------------------------------------------------------------------------------------------------------------------
+
+func (srv *Service) CreateInventarioService(userID string, request dtos.CreateInventarioRequest) (int, *exceptions.RestErr) {
+	zap.L().Info("Starting inventario creation service", zap.String("descricao", request.Descricao), zap.Ints("produtos", request.Produtos))
+
+	inventario := &entity.Inventario{
+		Descricao:    request.Descricao,
+		Status:       entity.InventarioStatusAberto,
+		DataAbertura: time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	dbErr := srv.dbClient.CreateInventario(inventario, request.Produtos, userID)
+	if dbErr != nil {
+		zap.L().Error("Error creating inventario in database", zap.Error(dbErr))
+		return 0, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Inventario created successfully", zap.Int("id", inventario.ID), zap.Int("itens", inventario.TotalItens))
+	return inventario.ID, nil
+}
+
+func (srv *Service) GetAllInventariosService(userID string, status string, page, limit int) (*dtos.InventarioListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get all inventarios service", zap.String("status", status), zap.Int("page", page), zap.Int("limit", limit))
+
+	if restErr := validarStatusInventario(status); restErr != nil {
+		return nil, restErr
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	inventarios, total, dbErr := srv.dbClient.GetInventariosPaginated(userID, status, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting inventarios from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	var inventariosResponse []dtos.InventarioResponse
+	for _, inventario := range inventarios {
+		inventariosResponse = append(inventariosResponse, buildInventarioResponse(inventario))
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	response := &dtos.InventarioListResponse{
+		Inventarios: inventariosResponse,
+		Total:       total,
+		Page:        page,
+		Limit:       limit,
+		TotalPages:  totalPages,
+	}
+
+	zap.L().Info("Inventarios service completed successfully", zap.Int("total", total))
+	return response, nil
+}
+
+func (srv *Service) GetInventarioByIDService(userID string, id string) (*dtos.InventarioResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get inventario by ID service", zap.String("id", id))
+
+	inventario, restErr := srv.getInventario(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := buildInventarioResponse(*inventario)
+
+	zap.L().Info("Successfully retrieved inventario by ID", zap.String("id", id))
+	return &response, nil
+}
+
+// RegistrarContagemService grava as quantidades contadas por um dispositivo para os lotes da sessão
+func (srv *Service) RegistrarContagemService(userID string, id string, request dtos.RegistrarContagemRequest) (*dtos.RegistrarContagemResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting registrar contagem service", zap.String("id", id), zap.String("dispositivo", request.Dispositivo), zap.Int("itens", len(request.Itens)))
+
+	inventario, restErr := srv.getInventario(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if inventario.Status != entity.InventarioStatusAberto {
+		return nil, exceptions.NewConflictError("Inventario is not open")
+	}
+
+	itens, dbErr := srv.dbClient.GetInventarioItens(inventario.ID, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting inventario itens", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	// Localizar o item da sessão pelo produto e lote informados
+	itensPorLote := make(map[[2]int]int, len(itens))
+	for _, item := range itens {
+		itensPorLote[[2]int{item.IDProduto, item.IDLote}] = item.ID
+	}
+
+	agora := time.Now().Format("2006-01-02 15:04:05")
+	contagens := make([]entity.InventarioContagem, 0, len(request.Itens))
+	for _, item := range request.Itens {
+		idItem, ok := itensPorLote[[2]int{item.IDProduto, item.IDLote}]
+		if !ok {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Lote %d of produto %d is not part of this inventario", item.IDLote, item.IDProduto))
+		}
+		contagens = append(contagens, entity.InventarioContagem{
+			IDInventario: inventario.ID,
+			IDItem:       idItem,
+			Dispositivo:  request.Dispositivo,
+			Quantidade:   item.Quantidade,
+			DataContagem: agora,
+		})
+	}
+
+	dbErr = srv.dbClient.RegistrarContagensInventario(contagens, userID)
+	if dbErr != nil {
+		zap.L().Error("Error registering contagens inventario", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Contagens registered successfully", zap.String("id", id), zap.Int("registradas", len(contagens)))
+	return &dtos.RegistrarContagemResponse{Registradas: len(contagens)}, nil
+}
+
+// GetDivergenciasInventarioService compara o saldo da abertura com o contado e valoriza a diferença pelo custo unitário do lote
+func (srv *Service) GetDivergenciasInventarioService(userID string, id string) (*dtos.InventarioDivergenciasResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get divergencias inventario service", zap.String("id", id))
+
+	inventario, restErr := srv.getInventario(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	divergencias, dbErr := srv.dbClient.GetInventarioDivergencias(inventario.ID, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting inventario divergencias", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.InventarioDivergenciasResponse{
+		Inventario: buildInventarioResponse(*inventario),
+		Itens:      []dtos.InventarioDivergenciaResponse{},
+	}
+
+	for _, item := range divergencias {
+		diferenca := 0
+		if item.QuantidadeContada != nil {
+			response.ItensContados++
+			diferenca = *item.QuantidadeContada - item.QuantidadeSistema
+		} else {
+			response.ItensPendentes++
+		}
+
+		valorDiferenca := valorizacao.Arredondar(float64(diferenca) * item.CustoUnitario)
+		if diferenca != 0 {
+			response.ItensDivergentes++
+		}
+		if valorDiferenca > 0 {
+			response.ValorSobras += valorDiferenca
+		} else {
+			response.ValorFaltas += -valorDiferenca
+		}
+
+		response.Itens = append(response.Itens, dtos.InventarioDivergenciaResponse{
+			IDItem:            item.IDItem,
+			IDProduto:         item.IDProduto,
+			NomeProduto:       item.NomeProduto,
+			IDLote:            item.IDLote,
+			QuantidadeSistema: item.QuantidadeSistema,
+			QuantidadeContada: item.QuantidadeContada,
+			Contagens:         item.Contagens,
+			Diferenca:         diferenca,
+			CustoUnitario:     item.CustoUnitario,
+			ValorDiferenca:    valorDiferenca,
+		})
+	}
+
+	response.ValorSobras = valorizacao.Arredondar(response.ValorSobras)
+	response.ValorFaltas = valorizacao.Arredondar(response.ValorFaltas)
+	response.ValorLiquido = valorizacao.Arredondar(response.ValorSobras - response.ValorFaltas)
+
+	zap.L().Info("Divergencias inventario service completed successfully", zap.String("id", id), zap.Int("itens", len(response.Itens)), zap.Int("divergentes", response.ItensDivergentes))
+	return response, nil
+}
+
+// FecharInventarioService leva cada lote contado à quantidade contada, com uma movimentação de ajuste pela diferença,
+// e fecha a sessão de forma atômica. A diferença é tirada do saldo atual do lote, travado no fechamento: o que foi
+// vendido ou recebido depois da abertura já está nele.
+func (srv *Service) FecharInventarioService(userID string, id string, request dtos.FecharInventarioRequest) (*dtos.FecharInventarioResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting fechar inventario service", zap.String("id", id), zap.Bool("zerar_nao_contados", request.ZerarNaoContados))
+
+	inventario, restErr := srv.getInventario(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if inventario.Status != entity.InventarioStatusAberto {
+		return nil, exceptions.NewConflictError("Inventario is not open")
+	}
+
+	divergencias, dbErr := srv.dbClient.GetInventarioDivergencias(inventario.ID, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting inventario divergencias", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	var contagens []entity.InventarioDivergencia
+	for _, item := range divergencias {
+		if item.QuantidadeContada == nil {
+			if !request.ZerarNaoContados {
+				continue
+			}
+			zero := 0
+			item.QuantidadeContada = &zero
+		}
+		contagens = append(contagens, item)
+	}
+
+	agora := time.Now().Format("2006-01-02 15:04:05")
+
+	ajustar := func(item entity.InventarioDivergencia, saldoAtual int) (entity.MovimentacaoEstoque, bool) {
+		contada := *item.QuantidadeContada
+		diferenca := contada - saldoAtual
+		if diferenca == 0 {
+			return entity.MovimentacaoEstoque{}, false
+		}
+
+		return entity.MovimentacaoEstoque{
+			IDEstoque:     item.IDEstoque,
+			IDProduto:     item.IDProduto,
+			IDLote:        item.IDLote,
+			Tipo:          entity.MovimentacaoTipoAjuste,
+			Quantidade:    diferenca,
+			CustoUnitario: item.CustoUnitario,
+			Origem:        entity.MovimentacaoOrigemInventario,
+			IDReferencia:  inventario.ID,
+			DataMovimento: agora,
+			Observacao:    fmt.Sprintf("Ajuste do inventário %d: sistema %d, contado %d", inventario.ID, saldoAtual, contada),
+		}, true
+	}
+
+	ajustes, dbErr := srv.dbClient.FecharInventario(inventario.ID, contagens, ajustar, agora, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewConflictError("Inventario is not open")
+		}
+		zap.L().Error("Error closing inventario in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	valorLiquido := 0.0
+	for _, ajuste := range ajustes {
+		valorLiquido += float64(ajuste.Quantidade) * ajuste.CustoUnitario
+	}
+
+	response := &dtos.FecharInventarioResponse{
+		IDInventario: inventario.ID,
+		Ajustes:      len(ajustes),
+		ValorLiquido: valorizacao.Arredondar(valorLiquido),
+	}
+
+	zap.L().Info("Inventario closed successfully", zap.String("id", id), zap.Int("ajustes", len(ajustes)))
+	return response, nil
+}
+
+func (srv *Service) CancelarInventarioService(userID string, id string) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting cancelar inventario service", zap.String("id", id))
+
+	inventario, restErr := srv.getInventario(userID, id)
+	if restErr != nil {
+		return false, restErr
+	}
+
+	dbErr := srv.dbClient.CancelarInventario(inventario.ID, time.Now().Format("2006-01-02 15:04:05"), userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return false, exceptions.NewConflictError("Inventario is not open")
+		}
+		zap.L().Error("Error canceling inventario in database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Inventario canceled successfully", zap.String("id", id))
+	return true, nil
+}
+
+// getInventario busca a sessão e traduz a ausência em 404
+func (srv *Service) getInventario(userID string, id string) (*entity.Inventario, *exceptions.RestErr) {
+	idInt := 0
+	if _, err := fmt.Sscanf(id, "%d", &idInt); err != nil {
+		zap.L().Error("Error converting inventario id to int", zap.Error(err))
+		return nil, exceptions.NewBadRequestError("Invalid inventario ID")
+	}
+
+	inventario, dbErr := srv.dbClient.GetInventarioByID(id, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Inventario not found")
+		}
+		zap.L().Error("Error getting inventario by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	return inventario, nil
+}
+
+func validarStatusInventario(status string) *exceptions.RestErr {
+	if status != "" && status != entity.InventarioStatusAberto && status != entity.InventarioStatusFechado && status != entity.InventarioStatusCancelado {
+		return exceptions.NewBadRequestError("Invalid status, expected 'aberto', 'fechado' or 'cancelado'")
+	}
+	return nil
+}
+
+func buildInventarioResponse(inventario entity.Inventario) dtos.InventarioResponse {
+	response := dtos.InventarioResponse{
+		ID:           inventario.ID,
+		Descricao:    inventario.Descricao,
+		Status:       inventario.Status,
+		DataAbertura: inventario.DataAbertura,
+		TotalItens:   inventario.TotalItens,
+	}
+	if inventario.DataFechamento != nil {
+		response.DataFechamento = *inventario.DataFechamento
+	}
+	return response
+}
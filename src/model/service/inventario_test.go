@@ -0,0 +1,364 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func inventarioAberto() *entity.Inventario {
+	return &entity.Inventario{ID: 4, Descricao: "Balanço", Status: entity.InventarioStatusAberto, DataAbertura: "2025-03-01 08:00:00"}
+}
+
+// TESTES PARA CreateInventarioService
+func TestService_CreateInventarioService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("CreateInventario", mock.AnythingOfType("*entity.Inventario"), []int{1, 2}, "1").Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.Inventario).ID = 4
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	id, err := service.CreateInventarioService("1", dtos.CreateInventarioRequest{Descricao: "Balanço", Produtos: []int{1, 2}})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 4, id)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateInventarioService_DBError(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("CreateInventario", mock.AnythingOfType("*entity.Inventario"), []int(nil), "1").Return(errors.New("database error"))
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	id, err := service.CreateInventarioService("1", dtos.CreateInventarioRequest{Descricao: "Balanço"})
+
+	// Assert
+	assert.Equal(t, 0, id)
+	assert.NotNil(t, err)
+	assert.Equal(t, 500, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA RegistrarContagemService
+func TestService_RegistrarContagemService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	itens := []entity.InventarioItem{
+		{ID: 11, IDInventario: 4, IDProduto: 1, IDLote: 7},
+		{ID: 12, IDInventario: 4, IDProduto: 2, IDLote: 8},
+	}
+
+	mockDBClient.On("GetInventarioByID", "4", "1").Return(inventarioAberto(), nil)
+	mockDBClient.On("GetInventarioItens", 4, "1").Return(itens, nil)
+	mockDBClient.On("RegistrarContagensInventario", mock.MatchedBy(func(contagens []entity.InventarioContagem) bool {
+		return len(contagens) == 1 && contagens[0].IDItem == 12 && contagens[0].Quantidade == 9 && contagens[0].Dispositivo == "coletor-1"
+	}), "1").Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.RegistrarContagemRequest{
+		Dispositivo: "coletor-1",
+		Itens:       []dtos.ContagemItemRequest{{IDProduto: 2, IDLote: 8, Quantidade: 9}},
+	}
+
+	// Act
+	result, err := service.RegistrarContagemService("1", "4", request)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.Registradas)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarContagemService_LoteForaDoInventario(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetInventarioByID", "4", "1").Return(inventarioAberto(), nil)
+	mockDBClient.On("GetInventarioItens", 4, "1").Return([]entity.InventarioItem{{ID: 11, IDProduto: 1, IDLote: 7}}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.RegistrarContagemRequest{
+		Dispositivo: "coletor-1",
+		Itens:       []dtos.ContagemItemRequest{{IDProduto: 1, IDLote: 99, Quantidade: 3}},
+	}
+
+	// Act
+	result, err := service.RegistrarContagemService("1", "4", request)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarContagemService_InventarioFechado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	inventario := inventarioAberto()
+	inventario.Status = entity.InventarioStatusFechado
+	mockDBClient.On("GetInventarioByID", "4", "1").Return(inventario, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.RegistrarContagemService("1", "4", dtos.RegistrarContagemRequest{Dispositivo: "coletor-1"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Inventario is not open", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA GetDivergenciasInventarioService
+func TestService_GetDivergenciasInventarioService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	sobra, falta := 12, 8
+	divergencias := []entity.InventarioDivergencia{
+		{IDItem: 11, IDProduto: 1, IDLote: 7, QuantidadeSistema: 10, QuantidadeContada: &sobra, CustoUnitario: 2.5},
+		{IDItem: 12, IDProduto: 2, IDLote: 8, QuantidadeSistema: 10, QuantidadeContada: &falta, CustoUnitario: 4},
+		{IDItem: 13, IDProduto: 3, IDLote: 9, QuantidadeSistema: 5, CustoUnitario: 1},
+	}
+
+	mockDBClient.On("GetInventarioByID", "4", "1").Return(inventarioAberto(), nil)
+	mockDBClient.On("GetInventarioDivergencias", 4, "1").Return(divergencias, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetDivergenciasInventarioService("1", "4")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 2, result.ItensContados)
+	assert.Equal(t, 1, result.ItensPendentes)
+	assert.Equal(t, 2, result.ItensDivergentes)
+	assert.Equal(t, 5.0, result.ValorSobras)
+	assert.Equal(t, 8.0, result.ValorFaltas)
+	assert.Equal(t, -3.0, result.ValorLiquido)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_GetDivergenciasInventarioService_NotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetInventarioByID", "4", "1").Return(nil, gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetDivergenciasInventarioService("1", "4")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Inventario not found", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA FecharInventarioService
+
+// fecharComSaldos executa, sobre os saldos atuais informados, a função de ajuste que o serviço passa para
+// FecharInventario e guarda os ajustes resultantes
+func fecharComSaldos(saldos map[int]int, ajustes *[]entity.MovimentacaoEstoque) func(mock.Arguments) {
+	return func(args mock.Arguments) {
+		contagens := args.Get(1).([]entity.InventarioDivergencia)
+		ajustar := args.Get(2).(func(entity.InventarioDivergencia, int) (entity.MovimentacaoEstoque, bool))
+		for _, item := range contagens {
+			if ajuste, ajustado := ajustar(item, saldos[item.IDEstoque]); ajustado {
+				*ajustes = append(*ajustes, ajuste)
+			}
+		}
+	}
+}
+
+func TestService_FecharInventarioService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	contada := 7
+	divergencias := []entity.InventarioDivergencia{
+		{IDItem: 11, IDEstoque: 21, IDProduto: 1, IDLote: 7, QuantidadeSistema: 10, QuantidadeContada: &contada, CustoUnitario: 2},
+		{IDItem: 12, IDEstoque: 22, IDProduto: 2, IDLote: 8, QuantidadeSistema: 3, CustoUnitario: 5},
+	}
+
+	var ajustes []entity.MovimentacaoEstoque
+	mockDBClient.On("GetInventarioByID", "4", "1").Return(inventarioAberto(), nil)
+	mockDBClient.On("GetInventarioDivergencias", 4, "1").Return(divergencias, nil)
+	mockDBClient.On("FecharInventario", 4, mock.MatchedBy(func(contagens []entity.InventarioDivergencia) bool {
+		return len(contagens) == 2 && *contagens[0].QuantidadeContada == 7 && *contagens[1].QuantidadeContada == 0
+	}), mock.Anything, mock.AnythingOfType("string"), "1").
+		Run(fecharComSaldos(map[int]int{21: 10, 22: 3}, &ajustes)).
+		Return(func(int, []entity.InventarioDivergencia, func(entity.InventarioDivergencia, int) (entity.MovimentacaoEstoque, bool), string, string) []entity.MovimentacaoEstoque {
+			return ajustes
+		}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.FecharInventarioService("1", "4", dtos.FecharInventarioRequest{ZerarNaoContados: true})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result.IDInventario)
+	assert.Equal(t, 2, result.Ajustes)
+	assert.Equal(t, -21.0, result.ValorLiquido)
+	assert.Equal(t, -3, ajustes[0].Quantidade)
+	assert.Equal(t, entity.MovimentacaoTipoAjuste, ajustes[0].Tipo)
+	assert.Equal(t, -3, ajustes[1].Quantidade)
+	assert.Equal(t, entity.MovimentacaoOrigemInventario, ajustes[1].Origem)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_FecharInventarioService_VendaDuranteContagem(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	// O lote tinha 10 na abertura, vendeu 2 durante a contagem e foram contados 8: não há o que ajustar
+	contadaSemDiferenca, contadaComFalta := 8, 5
+	divergencias := []entity.InventarioDivergencia{
+		{IDItem: 11, IDEstoque: 21, IDProduto: 1, IDLote: 7, QuantidadeSistema: 10, QuantidadeContada: &contadaSemDiferenca, CustoUnitario: 2},
+		{IDItem: 12, IDEstoque: 22, IDProduto: 2, IDLote: 8, QuantidadeSistema: 10, QuantidadeContada: &contadaComFalta, CustoUnitario: 5},
+	}
+
+	var ajustes []entity.MovimentacaoEstoque
+	mockDBClient.On("GetInventarioByID", "4", "1").Return(inventarioAberto(), nil)
+	mockDBClient.On("GetInventarioDivergencias", 4, "1").Return(divergencias, nil)
+	mockDBClient.On("FecharInventario", 4, mock.Anything, mock.Anything, mock.AnythingOfType("string"), "1").
+		Run(fecharComSaldos(map[int]int{21: 8, 22: 6}, &ajustes)).
+		Return(func(int, []entity.InventarioDivergencia, func(entity.InventarioDivergencia, int) (entity.MovimentacaoEstoque, bool), string, string) []entity.MovimentacaoEstoque {
+			return ajustes
+		}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.FecharInventarioService("1", "4", dtos.FecharInventarioRequest{})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.Ajustes)
+	assert.Equal(t, -5.0, result.ValorLiquido)
+	assert.Len(t, ajustes, 1)
+	assert.Equal(t, 22, ajustes[0].IDEstoque)
+	assert.Equal(t, -1, ajustes[0].Quantidade)
+	assert.Equal(t, "Ajuste do inventário 4: sistema 6, contado 5", ajustes[0].Observacao)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_FecharInventarioService_JaFechado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetInventarioByID", "4", "1").Return(inventarioAberto(), nil)
+	mockDBClient.On("GetInventarioDivergencias", 4, "1").Return([]entity.InventarioDivergencia{}, nil)
+	mockDBClient.On("FecharInventario", 4, mock.Anything, mock.Anything, mock.AnythingOfType("string"), "1").Return(nil, gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.FecharInventarioService("1", "4", dtos.FecharInventarioRequest{})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Inventario is not open", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA CancelarInventarioService
+func TestService_CancelarInventarioService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetInventarioByID", "4", "1").Return(inventarioAberto(), nil)
+	mockDBClient.On("CancelarInventario", 4, mock.AnythingOfType("string"), "1").Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.CancelarInventarioService("1", "4")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, success)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CancelarInventarioService_InvalidID(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.CancelarInventarioService("1", "abc")
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Invalid inventario ID", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
@@ -0,0 +1,5498 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDBClient is an autogenerated mock type for the PersistenceInterfaceDBClient type
+type MockDBClient struct {
+	mock.Mock
+}
+
+// AbrirCaixaSessao provides a mock function with given fields: sessao, userID
+func (_m *MockDBClient) AbrirCaixaSessao(sessao *entity.CaixaSessao, userID string) error {
+	ret := _m.Called(sessao, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbrirCaixaSessao")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.CaixaSessao, string) error); ok {
+		r0 = rf(sessao, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdicionarItemVenda provides a mock function with given fields: item, userID
+func (_m *MockDBClient) AdicionarItemVenda(item *entity.ItemVenda, userID string) error {
+	ret := _m.Called(item, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdicionarItemVenda")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.ItemVenda, string) error); ok {
+		r0 = rf(item, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AdicionarMembrosPublico provides a mock function with given fields: userID, idPublico, clienteIDs, origem, data
+func (_m *MockDBClient) AdicionarMembrosPublico(userID string, idPublico int, clienteIDs []int, origem string, data string) (int, int, error) {
+	ret := _m.Called(userID, idPublico, clienteIDs, origem, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdicionarMembrosPublico")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, []int, string, string) (int, int, error)); ok {
+		return rf(userID, idPublico, clienteIDs, origem, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, []int, string, string) int); ok {
+		r0 = rf(userID, idPublico, clienteIDs, origem, data)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, []int, string, string) int); ok {
+		r1 = rf(userID, idPublico, clienteIDs, origem, data)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, []int, string, string) error); ok {
+		r2 = rf(userID, idPublico, clienteIDs, origem, data)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// AplicarNormalizacaoCatalogo provides a mock function with given fields: plano, userID
+func (_m *MockDBClient) AplicarNormalizacaoCatalogo(plano *entity.PlanoNormalizacaoCatalogo, userID string) error {
+	ret := _m.Called(plano, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AplicarNormalizacaoCatalogo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.PlanoNormalizacaoCatalogo, string) error); ok {
+		r0 = rf(plano, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AplicarPrecoAgendado provides a mock function with given fields: agendamento, dataAplicacao, userID
+func (_m *MockDBClient) AplicarPrecoAgendado(agendamento entity.PrecoAgendado, dataAplicacao string, userID string) error {
+	ret := _m.Called(agendamento, dataAplicacao, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AplicarPrecoAgendado")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.PrecoAgendado, string, string) error); ok {
+		r0 = rf(agendamento, dataAplicacao, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssociarCriteriosPublico provides a mock function with given fields: idPublico, criterios, regra, userID
+func (_m *MockDBClient) AssociarCriteriosPublico(idPublico int, criterios []int, regra *entity.PublicoRegra, userID string) error {
+	ret := _m.Called(idPublico, criterios, regra, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssociarCriteriosPublico")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, []int, *entity.PublicoRegra, string) error); ok {
+		r0 = rf(idPublico, criterios, regra, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssociarPublicosCampanha provides a mock function with given fields: idCampanha, publicos, userID
+func (_m *MockDBClient) AssociarPublicosCampanha(idCampanha int, publicos []int, userID string) error {
+	ret := _m.Called(idCampanha, publicos, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssociarPublicosCampanha")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, []int, string) error); ok {
+		r0 = rf(idCampanha, publicos, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AtribuirTagsCliente provides a mock function with given fields: clienteID, tagIDs, userID
+func (_m *MockDBClient) AtribuirTagsCliente(clienteID int, tagIDs []int, userID string) error {
+	ret := _m.Called(clienteID, tagIDs, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AtribuirTagsCliente")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, []int, string) error); ok {
+		r0 = rf(clienteID, tagIDs, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AtualizarStatusCampanha provides a mock function with given fields: userID, id, de, para
+func (_m *MockDBClient) AtualizarStatusCampanha(userID string, id int, de string, para string) (bool, error) {
+	ret := _m.Called(userID, id, de, para)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AtualizarStatusCampanha")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string, string) (bool, error)); ok {
+		return rf(userID, id, de, para)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string, string) bool); ok {
+		r0 = rf(userID, id, de, para)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string, string) error); ok {
+		r1 = rf(userID, id, de, para)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BuscarClientesCriterios provides a mock function with given fields: userID
+func (_m *MockDBClient) BuscarClientesCriterios(userID string) ([]entity.Cliente, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuscarClientesCriterios")
+	}
+
+	var r0 []entity.Cliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Cliente, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Cliente); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Cliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BuscarClientesPorRegra provides a mock function with given fields: userID, condicao, args, idPublico
+func (_m *MockDBClient) BuscarClientesPorRegra(userID string, condicao string, args []interface{}, idPublico int) ([]entity.Cliente, error) {
+	ret := _m.Called(userID, condicao, args, idPublico)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuscarClientesPorRegra")
+	}
+
+	var r0 []entity.Cliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, []interface{}, int) ([]entity.Cliente, error)); ok {
+		return rf(userID, condicao, args, idPublico)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, []interface{}, int) []entity.Cliente); ok {
+		r0 = rf(userID, condicao, args, idPublico)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Cliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, []interface{}, int) error); ok {
+		r1 = rf(userID, condicao, args, idPublico)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelarInventario provides a mock function with given fields: idInventario, dataFechamento, userID
+func (_m *MockDBClient) CancelarInventario(idInventario int, dataFechamento string, userID string) error {
+	ret := _m.Called(idInventario, dataFechamento, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelarInventario")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string, string) error); ok {
+		r0 = rf(idInventario, dataFechamento, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelarPrecoAgendado provides a mock function with given fields: idProduto, idAgendamento, userID
+func (_m *MockDBClient) CancelarPrecoAgendado(idProduto int, idAgendamento int, userID string) error {
+	ret := _m.Called(idProduto, idAgendamento, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelarPrecoAgendado")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int, string) error); ok {
+		r0 = rf(idProduto, idAgendamento, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelarVenda provides a mock function with given fields: idVenda, statusAtual, dataCancelamento, estornoCaixa, estornoCredito, estornoPontos, userID
+func (_m *MockDBClient) CancelarVenda(idVenda int, statusAtual string, dataCancelamento string, estornoCaixa *entity.CaixaMovimento, estornoCredito *entity.CreditoCliente, estornoPontos *entity.PontosCliente, userID string) error {
+	ret := _m.Called(idVenda, statusAtual, dataCancelamento, estornoCaixa, estornoCredito, estornoPontos, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelarVenda")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string, string, *entity.CaixaMovimento, *entity.CreditoCliente, *entity.PontosCliente, string) error); ok {
+		r0 = rf(idVenda, statusAtual, dataCancelamento, estornoCaixa, estornoCredito, estornoPontos, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConcluirEnvioCampanha provides a mock function with given fields: userID, envio
+func (_m *MockDBClient) ConcluirEnvioCampanha(userID string, envio entity.CampanhaEnvio) error {
+	ret := _m.Called(userID, envio)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConcluirEnvioCampanha")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, entity.CampanhaEnvio) error); ok {
+		r0 = rf(userID, envio)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConcluirImportacao provides a mock function with given fields: importacao, erros, userID
+func (_m *MockDBClient) ConcluirImportacao(importacao *entity.Importacao, erros []entity.ImportacaoErro, userID string) error {
+	ret := _m.Called(importacao, erros, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConcluirImportacao")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Importacao, []entity.ImportacaoErro, string) error); ok {
+		r0 = rf(importacao, erros, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CountCampanhasTemplate provides a mock function with given fields: userID, idTemplate
+func (_m *MockDBClient) CountCampanhasTemplate(userID string, idTemplate int) (int, error) {
+	ret := _m.Called(userID, idTemplate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCampanhasTemplate")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (int, error)); ok {
+		return rf(userID, idTemplate)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) int); ok {
+		r0 = rf(userID, idTemplate)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(userID, idTemplate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAlertasEstoque provides a mock function with given fields: alertas, userID
+func (_m *MockDBClient) CreateAlertasEstoque(alertas []entity.AlertaEstoque, userID string) ([]entity.AlertaEstoque, error) {
+	ret := _m.Called(alertas, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAlertasEstoque")
+	}
+
+	var r0 []entity.AlertaEstoque
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]entity.AlertaEstoque, string) ([]entity.AlertaEstoque, error)); ok {
+		return rf(alertas, userID)
+	}
+	if rf, ok := ret.Get(0).(func([]entity.AlertaEstoque, string) []entity.AlertaEstoque); ok {
+		r0 = rf(alertas, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AlertaEstoque)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]entity.AlertaEstoque, string) error); ok {
+		r1 = rf(alertas, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCampanha provides a mock function with given fields: campanha, userID
+func (_m *MockDBClient) CreateCampanha(campanha *entity.Campanha, userID string) error {
+	ret := _m.Called(campanha, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCampanha")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Campanha, string) error); ok {
+		r0 = rf(campanha, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCategoria provides a mock function with given fields: categoria, userID
+func (_m *MockDBClient) CreateCategoria(categoria *entity.Categoria, userID string) error {
+	ret := _m.Called(categoria, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategoria")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Categoria, string) error); ok {
+		r0 = rf(categoria, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCliente provides a mock function with given fields: cliente, userID
+func (_m *MockDBClient) CreateCliente(cliente entity.Cliente, userID string) error {
+	ret := _m.Called(cliente, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCliente")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.Cliente, string) error); ok {
+		r0 = rf(cliente, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCriterio provides a mock function with given fields: criterio, userID
+func (_m *MockDBClient) CreateCriterio(criterio *entity.Criterio, userID string) error {
+	ret := _m.Called(criterio, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCriterio")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Criterio, string) error); ok {
+		r0 = rf(criterio, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateEndereco provides a mock function with given fields: endereco, userID
+func (_m *MockDBClient) CreateEndereco(endereco entity.Endereco, userID string) error {
+	ret := _m.Called(endereco, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEndereco")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.Endereco, string) error); ok {
+		r0 = rf(endereco, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateEstoque provides a mock function with given fields: estoque, userID
+func (_m *MockDBClient) CreateEstoque(estoque entity.Estoque, userID string) error {
+	ret := _m.Called(estoque, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEstoque")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.Estoque, string) error); ok {
+		r0 = rf(estoque, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateFornecedor provides a mock function with given fields: fornecedor, userID
+func (_m *MockDBClient) CreateFornecedor(fornecedor entity.Fornecedores, userID string) error {
+	ret := _m.Called(fornecedor, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFornecedor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.Fornecedores, string) error); ok {
+		r0 = rf(fornecedor, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateImportacao provides a mock function with given fields: importacao, userID
+func (_m *MockDBClient) CreateImportacao(importacao *entity.Importacao, userID string) error {
+	ret := _m.Called(importacao, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateImportacao")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Importacao, string) error); ok {
+		r0 = rf(importacao, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateInventario provides a mock function with given fields: inventario, produtos, userID
+func (_m *MockDBClient) CreateInventario(inventario *entity.Inventario, produtos []int, userID string) error {
+	ret := _m.Called(inventario, produtos, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInventario")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Inventario, []int, string) error); ok {
+		r0 = rf(inventario, produtos, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateItemPedido provides a mock function with given fields: item, userID
+func (_m *MockDBClient) CreateItemPedido(item entity.ItemPedido, userID string) error {
+	ret := _m.Called(item, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateItemPedido")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.ItemPedido, string) error); ok {
+		r0 = rf(item, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePedido provides a mock function with given fields: pedido, userID
+func (_m *MockDBClient) CreatePedido(pedido *entity.Pedido, userID string) error {
+	ret := _m.Called(pedido, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePedido")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Pedido, string) error); ok {
+		r0 = rf(pedido, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePet provides a mock function with given fields: pet, userID
+func (_m *MockDBClient) CreatePet(pet *entity.Pet, userID string) error {
+	ret := _m.Called(pet, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Pet, string) error); ok {
+		r0 = rf(pet, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePrecoAgendado provides a mock function with given fields: agendamento, userID
+func (_m *MockDBClient) CreatePrecoAgendado(agendamento *entity.PrecoAgendado, userID string) error {
+	ret := _m.Called(agendamento, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePrecoAgendado")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.PrecoAgendado, string) error); ok {
+		r0 = rf(agendamento, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateProduct provides a mock function with given fields: product, userID
+func (_m *MockDBClient) CreateProduct(product entity.Produto, userID string) error {
+	ret := _m.Called(product, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.Produto, string) error); ok {
+		r0 = rf(product, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePublico provides a mock function with given fields: publico, userID
+func (_m *MockDBClient) CreatePublico(publico *entity.PublicoCliente, userID string) error {
+	ret := _m.Called(publico, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePublico")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.PublicoCliente, string) error); ok {
+		r0 = rf(publico, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePublicoComMembros provides a mock function with given fields: userID, publico, clienteIDs, data
+func (_m *MockDBClient) CreatePublicoComMembros(userID string, publico *entity.PublicoCliente, clienteIDs []int, data string) error {
+	ret := _m.Called(userID, publico, clienteIDs, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePublicoComMembros")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *entity.PublicoCliente, []int, string) error); ok {
+		r0 = rf(userID, publico, clienteIDs, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTag provides a mock function with given fields: tag, userID
+func (_m *MockDBClient) CreateTag(tag entity.Tag, userID string) error {
+	ret := _m.Called(tag, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.Tag, string) error); ok {
+		r0 = rf(tag, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTemplateMensagem provides a mock function with given fields: userID, template
+func (_m *MockDBClient) CreateTemplateMensagem(userID string, template *entity.TemplateMensagem) error {
+	ret := _m.Called(userID, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTemplateMensagem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *entity.TemplateMensagem) error); ok {
+		r0 = rf(userID, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateVariacao provides a mock function with given fields: idPai, variacao, userID
+func (_m *MockDBClient) CreateVariacao(idPai int, variacao *entity.Produto, userID string) error {
+	ret := _m.Called(idPai, variacao, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariacao")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, *entity.Produto, string) error); ok {
+		r0 = rf(idPai, variacao, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateVenda provides a mock function with given fields: venda, itens, userID
+func (_m *MockDBClient) CreateVenda(venda *entity.Venda, itens []entity.ItemVenda, userID string) error {
+	ret := _m.Called(venda, itens, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVenda")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Venda, []entity.ItemVenda, string) error); ok {
+		r0 = rf(venda, itens, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CriarEnviosCampanha provides a mock function with given fields: userID, idCampanha, envios
+func (_m *MockDBClient) CriarEnviosCampanha(userID string, idCampanha int, envios []entity.CampanhaEnvio) (int, []entity.CampanhaEnvio, error) {
+	ret := _m.Called(userID, idCampanha, envios)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CriarEnviosCampanha")
+	}
+
+	var r0 int
+	var r1 []entity.CampanhaEnvio
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, []entity.CampanhaEnvio) (int, []entity.CampanhaEnvio, error)); ok {
+		return rf(userID, idCampanha, envios)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, []entity.CampanhaEnvio) int); ok {
+		r0 = rf(userID, idCampanha, envios)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, []entity.CampanhaEnvio) []entity.CampanhaEnvio); ok {
+		r1 = rf(userID, idCampanha, envios)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]entity.CampanhaEnvio)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, []entity.CampanhaEnvio) error); ok {
+		r2 = rf(userID, idCampanha, envios)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// DeleteCategoria provides a mock function with given fields: id, userID
+func (_m *MockDBClient) DeleteCategoria(id int, userID string) error {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategoria")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = rf(id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCliente provides a mock function with given fields: id, userID
+func (_m *MockDBClient) DeleteCliente(id string, userID string) error {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCliente")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEndereco provides a mock function with given fields: idEndereco, userID
+func (_m *MockDBClient) DeleteEndereco(idEndereco string, userID string) error {
+	ret := _m.Called(idEndereco, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEndereco")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(idEndereco, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteFornecedor provides a mock function with given fields: id, userID
+func (_m *MockDBClient) DeleteFornecedor(id string, userID string) error {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFornecedor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteProduct provides a mock function with given fields: id, userID
+func (_m *MockDBClient) DeleteProduct(id string, userID string) error {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTemplateMensagem provides a mock function with given fields: userID, id
+func (_m *MockDBClient) DeleteTemplateMensagem(userID string, id int) error {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTemplateMensagem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int) error); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExcluirClientesPublico provides a mock function with given fields: userID, idPublico, clienteIDs, motivo, data
+func (_m *MockDBClient) ExcluirClientesPublico(userID string, idPublico int, clienteIDs []int, motivo *string, data string) (int, int, int, error) {
+	ret := _m.Called(userID, idPublico, clienteIDs, motivo, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExcluirClientesPublico")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 int
+	var r3 error
+	if rf, ok := ret.Get(0).(func(string, int, []int, *string, string) (int, int, int, error)); ok {
+		return rf(userID, idPublico, clienteIDs, motivo, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, []int, *string, string) int); ok {
+		r0 = rf(userID, idPublico, clienteIDs, motivo, data)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, []int, *string, string) int); ok {
+		r1 = rf(userID, idPublico, clienteIDs, motivo, data)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, []int, *string, string) int); ok {
+		r2 = rf(userID, idPublico, clienteIDs, motivo, data)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	if rf, ok := ret.Get(3).(func(string, int, []int, *string, string) error); ok {
+		r3 = rf(userID, idPublico, clienteIDs, motivo, data)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// ExpirarPontos provides a mock function with given fields: data, userID
+func (_m *MockDBClient) ExpirarPontos(data string, userID string) (int, int, error) {
+	ret := _m.Called(data, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpirarPontos")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string) (int, int, error)); ok {
+		return rf(data, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) int); ok {
+		r0 = rf(data, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) int); ok {
+		r1 = rf(data, userID)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string) error); ok {
+		r2 = rf(data, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FecharCaixaSessao provides a mock function with given fields: sessao, movimentosConferidos, userID
+func (_m *MockDBClient) FecharCaixaSessao(sessao *entity.CaixaSessao, movimentosConferidos int, userID string) error {
+	ret := _m.Called(sessao, movimentosConferidos, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FecharCaixaSessao")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.CaixaSessao, int, string) error); ok {
+		r0 = rf(sessao, movimentosConferidos, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FecharInventario provides a mock function with given fields: idInventario, contagens, ajustar, dataFechamento, userID
+func (_m *MockDBClient) FecharInventario(idInventario int, contagens []entity.InventarioDivergencia, ajustar func(entity.InventarioDivergencia, int) (entity.MovimentacaoEstoque, bool), dataFechamento string, userID string) ([]entity.MovimentacaoEstoque, error) {
+	ret := _m.Called(idInventario, contagens, ajustar, dataFechamento, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FecharInventario")
+	}
+
+	var r0 []entity.MovimentacaoEstoque
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, []entity.InventarioDivergencia, func(entity.InventarioDivergencia, int) (entity.MovimentacaoEstoque, bool), string, string) ([]entity.MovimentacaoEstoque, error)); ok {
+		return rf(idInventario, contagens, ajustar, dataFechamento, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, []entity.InventarioDivergencia, func(entity.InventarioDivergencia, int) (entity.MovimentacaoEstoque, bool), string, string) []entity.MovimentacaoEstoque); ok {
+		r0 = rf(idInventario, contagens, ajustar, dataFechamento, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MovimentacaoEstoque)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, []entity.InventarioDivergencia, func(entity.InventarioDivergencia, int) (entity.MovimentacaoEstoque, bool), string, string) error); ok {
+		r1 = rf(idInventario, contagens, ajustar, dataFechamento, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAlertasEstoquePaginated provides a mock function with given fields: userID, tipo, status, limit, offset
+func (_m *MockDBClient) GetAlertasEstoquePaginated(userID string, tipo string, status string, limit int, offset int) ([]entity.AlertaEstoque, int, error) {
+	ret := _m.Called(userID, tipo, status, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlertasEstoquePaginated")
+	}
+
+	var r0 []entity.AlertaEstoque
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) ([]entity.AlertaEstoque, int, error)); ok {
+		return rf(userID, tipo, status, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, int, int) []entity.AlertaEstoque); ok {
+		r0 = rf(userID, tipo, status, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AlertaEstoque)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, int, int) int); ok {
+		r1 = rf(userID, tipo, status, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, string, int, int) error); ok {
+		r2 = rf(userID, tipo, status, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllCampanhas provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllCampanhas(userID string) ([]entity.Campanha, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCampanhas")
+	}
+
+	var r0 []entity.Campanha
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Campanha, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Campanha); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Campanha)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllCampanhasPaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllCampanhasPaginated(userID string, limit int, offset int) ([]entity.Campanha, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCampanhasPaginated")
+	}
+
+	var r0 []entity.Campanha
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.Campanha, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.Campanha); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Campanha)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllCategorias provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllCategorias(userID string) ([]entity.Categoria, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCategorias")
+	}
+
+	var r0 []entity.Categoria
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Categoria, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Categoria); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Categoria)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllClientes provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllClientes(userID string) ([]entity.Cliente, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllClientes")
+	}
+
+	var r0 []entity.Cliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Cliente, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Cliente); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Cliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllClientesPaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllClientesPaginated(userID string, limit int, offset int) ([]entity.Cliente, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllClientesPaginated")
+	}
+
+	var r0 []entity.Cliente
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.Cliente, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.Cliente); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Cliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllCriterios provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllCriterios(userID string) ([]entity.Criterio, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllCriterios")
+	}
+
+	var r0 []entity.Criterio
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Criterio, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Criterio); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Criterio)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllDetalhesEstoque provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllDetalhesEstoque(userID string) ([]entity.ViewDetalhesEstoque, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllDetalhesEstoque")
+	}
+
+	var r0 []entity.ViewDetalhesEstoque
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.ViewDetalhesEstoque, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.ViewDetalhesEstoque); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ViewDetalhesEstoque)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllDetalhesEstoquePaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllDetalhesEstoquePaginated(userID string, limit int, offset int) ([]entity.ViewDetalhesEstoque, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllDetalhesEstoquePaginated")
+	}
+
+	var r0 []entity.ViewDetalhesEstoque
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.ViewDetalhesEstoque, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.ViewDetalhesEstoque); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ViewDetalhesEstoque)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllEnderecos provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllEnderecos(userID string) ([]entity.Endereco, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllEnderecos")
+	}
+
+	var r0 []entity.Endereco
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Endereco, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Endereco); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Endereco)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllEnderecosPaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllEnderecosPaginated(userID string, limit int, offset int) ([]entity.Endereco, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllEnderecosPaginated")
+	}
+
+	var r0 []entity.Endereco
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.Endereco, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.Endereco); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Endereco)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllEstoque provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllEstoque(userID string) ([]entity.Estoque, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllEstoque")
+	}
+
+	var r0 []entity.Estoque
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Estoque, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Estoque); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Estoque)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllEstoquePaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllEstoquePaginated(userID string, limit int, offset int) ([]entity.Estoque, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllEstoquePaginated")
+	}
+
+	var r0 []entity.Estoque
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.Estoque, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.Estoque); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Estoque)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllFornecedores provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllFornecedores(userID string) ([]entity.Fornecedores, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllFornecedores")
+	}
+
+	var r0 []entity.Fornecedores
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Fornecedores, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Fornecedores); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Fornecedores)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllFornecedoresPaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllFornecedoresPaginated(userID string, limit int, offset int) ([]entity.Fornecedores, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllFornecedoresPaginated")
+	}
+
+	var r0 []entity.Fornecedores
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.Fornecedores, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.Fornecedores); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Fornecedores)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllPedidos provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllPedidos(userID string) ([]entity.Pedido, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPedidos")
+	}
+
+	var r0 []entity.Pedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Pedido, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Pedido); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Pedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllPedidosPaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllPedidosPaginated(userID string, limit int, offset int) ([]entity.Pedido, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPedidosPaginated")
+	}
+
+	var r0 []entity.Pedido
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.Pedido, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.Pedido); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Pedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllPets provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllPets(userID string) ([]entity.Pet, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPets")
+	}
+
+	var r0 []entity.Pet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Pet, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Pet); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Pet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllPetsPaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllPetsPaginated(userID string, limit int, offset int) ([]entity.Pet, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPetsPaginated")
+	}
+
+	var r0 []entity.Pet
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.Pet, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.Pet); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Pet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllProducts provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllProducts(userID string) ([]entity.Produto, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllProducts")
+	}
+
+	var r0 []entity.Produto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Produto, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Produto); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Produto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllProductsPaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllProductsPaginated(userID string, limit int, offset int) ([]entity.Produto, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllProductsPaginated")
+	}
+
+	var r0 []entity.Produto
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.Produto, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.Produto); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Produto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllPublicos provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllPublicos(userID string) ([]entity.PublicoCliente, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPublicos")
+	}
+
+	var r0 []entity.PublicoCliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.PublicoCliente, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.PublicoCliente); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PublicoCliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllPublicosPaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllPublicosPaginated(userID string, limit int, offset int) ([]entity.PublicoCliente, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPublicosPaginated")
+	}
+
+	var r0 []entity.PublicoCliente
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.PublicoCliente, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.PublicoCliente); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PublicoCliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAllTags provides a mock function with given fields: userID
+func (_m *MockDBClient) GetAllTags(userID string) ([]entity.Tag, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllTags")
+	}
+
+	var r0 []entity.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Tag, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Tag); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllTagsPaginated provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetAllTagsPaginated(userID string, limit int, offset int) ([]entity.Tag, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllTagsPaginated")
+	}
+
+	var r0 []entity.Tag
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.Tag, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.Tag); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) int); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCaixaMovimentos provides a mock function with given fields: idSessao, userID
+func (_m *MockDBClient) GetCaixaMovimentos(idSessao int, userID string) ([]entity.CaixaMovimento, error) {
+	ret := _m.Called(idSessao, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCaixaMovimentos")
+	}
+
+	var r0 []entity.CaixaMovimento
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.CaixaMovimento, error)); ok {
+		return rf(idSessao, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.CaixaMovimento); ok {
+		r0 = rf(idSessao, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CaixaMovimento)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idSessao, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCaixaSessaoAberta provides a mock function with given fields: operador, userID
+func (_m *MockDBClient) GetCaixaSessaoAberta(operador string, userID string) (*entity.CaixaSessao, error) {
+	ret := _m.Called(operador, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCaixaSessaoAberta")
+	}
+
+	var r0 *entity.CaixaSessao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*entity.CaixaSessao, error)); ok {
+		return rf(operador, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *entity.CaixaSessao); ok {
+		r0 = rf(operador, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CaixaSessao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(operador, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCaixaSessaoByID provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetCaixaSessaoByID(id int, userID string) (*entity.CaixaSessao, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCaixaSessaoByID")
+	}
+
+	var r0 *entity.CaixaSessao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) (*entity.CaixaSessao, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) *entity.CaixaSessao); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CaixaSessao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCaixaSessoesPaginated provides a mock function with given fields: userID, status, limit, offset
+func (_m *MockDBClient) GetCaixaSessoesPaginated(userID string, status string, limit int, offset int) ([]entity.CaixaSessao, int, error) {
+	ret := _m.Called(userID, status, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCaixaSessoesPaginated")
+	}
+
+	var r0 []entity.CaixaSessao
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]entity.CaixaSessao, int, error)); ok {
+		return rf(userID, status, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []entity.CaixaSessao); ok {
+		r0 = rf(userID, status, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CaixaSessao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) int); ok {
+		r1 = rf(userID, status, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, int, int) error); ok {
+		r2 = rf(userID, status, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCampanhaByID provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetCampanhaByID(id string, userID string) *entity.Campanha {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampanhaByID")
+	}
+
+	var r0 *entity.Campanha
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Campanha); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Campanha)
+		}
+	}
+
+	return r0
+}
+
+// GetCampanhasEmAndamento provides a mock function with given fields: userID
+func (_m *MockDBClient) GetCampanhasEmAndamento(userID string) ([]entity.Campanha, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampanhasEmAndamento")
+	}
+
+	var r0 []entity.Campanha
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Campanha, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Campanha); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Campanha)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCampanhasParaDisparo provides a mock function with given fields: userID
+func (_m *MockDBClient) GetCampanhasParaDisparo(userID string) ([]entity.Campanha, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampanhasParaDisparo")
+	}
+
+	var r0 []entity.Campanha
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.Campanha, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.Campanha); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Campanha)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCanaisCampanha provides a mock function with given fields: userID, idCampanha
+func (_m *MockDBClient) GetCanaisCampanha(userID string, idCampanha int) ([]entity.CampanhaCanalJoin, error) {
+	ret := _m.Called(userID, idCampanha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCanaisCampanha")
+	}
+
+	var r0 []entity.CampanhaCanalJoin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]entity.CampanhaCanalJoin, error)); ok {
+		return rf(userID, idCampanha)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []entity.CampanhaCanalJoin); ok {
+		r0 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CampanhaCanalJoin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(userID, idCampanha)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCategoriaByID provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetCategoriaByID(id int, userID string) (*entity.Categoria, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoriaByID")
+	}
+
+	var r0 *entity.Categoria
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) (*entity.Categoria, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) *entity.Categoria); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Categoria)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClientIDs provides a mock function with no fields
+func (_m *MockDBClient) GetClientIDs() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientIDs")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// GetClienteByEmail provides a mock function with given fields: email, userID
+func (_m *MockDBClient) GetClienteByEmail(email string, userID string) *entity.Cliente {
+	ret := _m.Called(email, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClienteByEmail")
+	}
+
+	var r0 *entity.Cliente
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Cliente); ok {
+		r0 = rf(email, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Cliente)
+		}
+	}
+
+	return r0
+}
+
+// GetClienteByID provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetClienteByID(id string, userID string) *entity.Cliente {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClienteByID")
+	}
+
+	var r0 *entity.Cliente
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Cliente); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Cliente)
+		}
+	}
+
+	return r0
+}
+
+// GetClienteByTelefone provides a mock function with given fields: telefone, userID
+func (_m *MockDBClient) GetClienteByTelefone(telefone string, userID string) *entity.Cliente {
+	ret := _m.Called(telefone, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClienteByTelefone")
+	}
+
+	var r0 *entity.Cliente
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Cliente); ok {
+		r0 = rf(telefone, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Cliente)
+		}
+	}
+
+	return r0
+}
+
+// GetClientesComPetsEnderecosParaCompletude provides a mock function with given fields: userID, limit, offset
+func (_m *MockDBClient) GetClientesComPetsEnderecosParaCompletude(userID string, limit int, offset int) ([]entity.Cliente, []entity.Pet, []entity.Endereco, int, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientesComPetsEnderecosParaCompletude")
+	}
+
+	var r0 []entity.Cliente
+	var r1 []entity.Pet
+	var r2 []entity.Endereco
+	var r3 int
+	var r4 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.Cliente, []entity.Pet, []entity.Endereco, int, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.Cliente); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Cliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) []entity.Pet); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]entity.Pet)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int) []entity.Endereco); ok {
+		r2 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).([]entity.Endereco)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func(string, int, int) int); ok {
+		r3 = rf(userID, limit, offset)
+	} else {
+		r3 = ret.Get(3).(int)
+	}
+
+	if rf, ok := ret.Get(4).(func(string, int, int) error); ok {
+		r4 = rf(userID, limit, offset)
+	} else {
+		r4 = ret.Error(4)
+	}
+
+	return r0, r1, r2, r3, r4
+}
+
+// GetClientesConsentidos provides a mock function with given fields: userID, clienteIDs
+func (_m *MockDBClient) GetClientesConsentidos(userID string, clienteIDs []int) ([]entity.ConsentimentoCliente, error) {
+	ret := _m.Called(userID, clienteIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientesConsentidos")
+	}
+
+	var r0 []entity.ConsentimentoCliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []int) ([]entity.ConsentimentoCliente, error)); ok {
+		return rf(userID, clienteIDs)
+	}
+	if rf, ok := ret.Get(0).(func(string, []int) []entity.ConsentimentoCliente); ok {
+		r0 = rf(userID, clienteIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ConsentimentoCliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []int) error); ok {
+		r1 = rf(userID, clienteIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClientesDoPublico provides a mock function with given fields: userID, idPublico, limit, offset
+func (_m *MockDBClient) GetClientesDoPublico(userID string, idPublico int, limit int, offset int) ([]entity.Cliente, int, error) {
+	ret := _m.Called(userID, idPublico, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClientesDoPublico")
+	}
+
+	var r0 []entity.Cliente
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int, int) ([]entity.Cliente, int, error)); ok {
+		return rf(userID, idPublico, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int, int) []entity.Cliente); ok {
+		r0 = rf(userID, idPublico, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Cliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int, int) int); ok {
+		r1 = rf(userID, idPublico, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int, int) error); ok {
+		r2 = rf(userID, idPublico, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetComponentesDosKits provides a mock function with given fields: idsKits, userID
+func (_m *MockDBClient) GetComponentesDosKits(idsKits []int, userID string) ([]entity.KitComponente, error) {
+	ret := _m.Called(idsKits, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComponentesDosKits")
+	}
+
+	var r0 []entity.KitComponente
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int, string) ([]entity.KitComponente, error)); ok {
+		return rf(idsKits, userID)
+	}
+	if rf, ok := ret.Get(0).(func([]int, string) []entity.KitComponente); ok {
+		r0 = rf(idsKits, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.KitComponente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int, string) error); ok {
+		r1 = rf(idsKits, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetComprasCliente provides a mock function with given fields: idCliente, userID
+func (_m *MockDBClient) GetComprasCliente(idCliente int, userID string) (*entity.ComprasCliente, error) {
+	ret := _m.Called(idCliente, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComprasCliente")
+	}
+
+	var r0 *entity.ComprasCliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) (*entity.ComprasCliente, error)); ok {
+		return rf(idCliente, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) *entity.ComprasCliente); ok {
+		r0 = rf(idCliente, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ComprasCliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idCliente, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetComprasClientes provides a mock function with given fields: userID
+func (_m *MockDBClient) GetComprasClientes(userID string) ([]entity.ComprasCliente, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComprasClientes")
+	}
+
+	var r0 []entity.ComprasCliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.ComprasCliente, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.ComprasCliente); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ComprasCliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConsentimentosCliente provides a mock function with given fields: userID, idCliente
+func (_m *MockDBClient) GetConsentimentosCliente(userID string, idCliente int) ([]entity.ConsentimentoCliente, error) {
+	ret := _m.Called(userID, idCliente)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsentimentosCliente")
+	}
+
+	var r0 []entity.ConsentimentoCliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]entity.ConsentimentoCliente, error)); ok {
+		return rf(userID, idCliente)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []entity.ConsentimentoCliente); ok {
+		r0 = rf(userID, idCliente)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ConsentimentoCliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(userID, idCliente)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCreditosCliente provides a mock function with given fields: idCliente, userID
+func (_m *MockDBClient) GetCreditosCliente(idCliente int, userID string) ([]entity.CreditoCliente, error) {
+	ret := _m.Called(idCliente, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreditosCliente")
+	}
+
+	var r0 []entity.CreditoCliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.CreditoCliente, error)); ok {
+		return rf(idCliente, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.CreditoCliente); ok {
+		r0 = rf(idCliente, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CreditoCliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idCliente, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCriteriosPublico provides a mock function with given fields: idPublico, userID
+func (_m *MockDBClient) GetCriteriosPublico(idPublico string, userID string) ([]entity.PublicoCriterioJoin, error) {
+	ret := _m.Called(idPublico, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCriteriosPublico")
+	}
+
+	var r0 []entity.PublicoCriterioJoin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]entity.PublicoCriterioJoin, error)); ok {
+		return rf(idPublico, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []entity.PublicoCriterioJoin); ok {
+		r0 = rf(idPublico, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PublicoCriterioJoin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(idPublico, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDestinatariosCampanha provides a mock function with given fields: userID, idCampanha
+func (_m *MockDBClient) GetDestinatariosCampanha(userID string, idCampanha int) ([]entity.DestinatarioCampanha, error) {
+	ret := _m.Called(userID, idCampanha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDestinatariosCampanha")
+	}
+
+	var r0 []entity.DestinatarioCampanha
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]entity.DestinatarioCampanha, error)); ok {
+		return rf(userID, idCampanha)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []entity.DestinatarioCampanha); ok {
+		r0 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.DestinatarioCampanha)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(userID, idCampanha)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDetalhesPedido provides a mock function with given fields: idPedido, userID
+func (_m *MockDBClient) GetDetalhesPedido(idPedido string, userID string) ([]entity.ViewDetalhesPedido, error) {
+	ret := _m.Called(idPedido, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetalhesPedido")
+	}
+
+	var r0 []entity.ViewDetalhesPedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]entity.ViewDetalhesPedido, error)); ok {
+		return rf(idPedido, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []entity.ViewDetalhesPedido); ok {
+		r0 = rf(idPedido, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ViewDetalhesPedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(idPedido, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDetalhesPedidoPaginated provides a mock function with given fields: idPedido, userID, limit, offset
+func (_m *MockDBClient) GetDetalhesPedidoPaginated(idPedido string, userID string, limit int, offset int) ([]entity.ViewDetalhesPedido, int, error) {
+	ret := _m.Called(idPedido, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetalhesPedidoPaginated")
+	}
+
+	var r0 []entity.ViewDetalhesPedido
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]entity.ViewDetalhesPedido, int, error)); ok {
+		return rf(idPedido, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []entity.ViewDetalhesPedido); ok {
+		r0 = rf(idPedido, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ViewDetalhesPedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) int); ok {
+		r1 = rf(idPedido, userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, int, int) error); ok {
+		r2 = rf(idPedido, userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetDevolucaoByID provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetDevolucaoByID(id int, userID string) (*entity.Devolucao, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDevolucaoByID")
+	}
+
+	var r0 *entity.Devolucao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) (*entity.Devolucao, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) *entity.Devolucao); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Devolucao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDevolucoesPaginated provides a mock function with given fields: userID, idVenda, idCliente, limit, offset
+func (_m *MockDBClient) GetDevolucoesPaginated(userID string, idVenda int, idCliente int, limit int, offset int) ([]entity.Devolucao, int, error) {
+	ret := _m.Called(userID, idVenda, idCliente, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDevolucoesPaginated")
+	}
+
+	var r0 []entity.Devolucao
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int, int, int) ([]entity.Devolucao, int, error)); ok {
+		return rf(userID, idVenda, idCliente, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int, int, int) []entity.Devolucao); ok {
+		r0 = rf(userID, idVenda, idCliente, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Devolucao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int, int, int) int); ok {
+		r1 = rf(userID, idVenda, idCliente, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int, int, int) error); ok {
+		r2 = rf(userID, idVenda, idCliente, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetEnvioCampanha provides a mock function with given fields: userID, id
+func (_m *MockDBClient) GetEnvioCampanha(userID string, id int) (*entity.CampanhaEnvioDetalhe, error) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvioCampanha")
+	}
+
+	var r0 *entity.CampanhaEnvioDetalhe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*entity.CampanhaEnvioDetalhe, error)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *entity.CampanhaEnvioDetalhe); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.CampanhaEnvioDetalhe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEnviosCampanhaPaginated provides a mock function with given fields: userID, idCampanha, canal, status, limit, offset
+func (_m *MockDBClient) GetEnviosCampanhaPaginated(userID string, idCampanha int, canal string, status string, limit int, offset int) ([]entity.CampanhaEnvio, int, error) {
+	ret := _m.Called(userID, idCampanha, canal, status, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnviosCampanhaPaginated")
+	}
+
+	var r0 []entity.CampanhaEnvio
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, string, string, int, int) ([]entity.CampanhaEnvio, int, error)); ok {
+		return rf(userID, idCampanha, canal, status, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string, string, int, int) []entity.CampanhaEnvio); ok {
+		r0 = rf(userID, idCampanha, canal, status, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CampanhaEnvio)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string, string, int, int) int); ok {
+		r1 = rf(userID, idCampanha, canal, status, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, string, string, int, int) error); ok {
+		r2 = rf(userID, idCampanha, canal, status, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetExclusoesPublicoPaginated provides a mock function with given fields: userID, idPublico, limit, offset
+func (_m *MockDBClient) GetExclusoesPublicoPaginated(userID string, idPublico int, limit int, offset int) ([]entity.PublicoExclusaoJoin, int, error) {
+	ret := _m.Called(userID, idPublico, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExclusoesPublicoPaginated")
+	}
+
+	var r0 []entity.PublicoExclusaoJoin
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int, int) ([]entity.PublicoExclusaoJoin, int, error)); ok {
+		return rf(userID, idPublico, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int, int) []entity.PublicoExclusaoJoin); ok {
+		r0 = rf(userID, idPublico, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PublicoExclusaoJoin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int, int) int); ok {
+		r1 = rf(userID, idPublico, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int, int) error); ok {
+		r2 = rf(userID, idPublico, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetFidelidadeConfig provides a mock function with given fields: userID
+func (_m *MockDBClient) GetFidelidadeConfig(userID string) (*entity.FidelidadeConfig, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFidelidadeConfig")
+	}
+
+	var r0 *entity.FidelidadeConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entity.FidelidadeConfig, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entity.FidelidadeConfig); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.FidelidadeConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFornecedorById provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetFornecedorById(id string, userID string) (*entity.Fornecedores, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFornecedorById")
+	}
+
+	var r0 *entity.Fornecedores
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*entity.Fornecedores, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Fornecedores); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Fornecedores)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFornecedorByNome provides a mock function with given fields: nome, userID
+func (_m *MockDBClient) GetFornecedorByNome(nome string, userID string) (*entity.Fornecedores, error) {
+	ret := _m.Called(nome, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFornecedorByNome")
+	}
+
+	var r0 *entity.Fornecedores
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*entity.Fornecedores, error)); ok {
+		return rf(nome, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Fornecedores); ok {
+		r0 = rf(nome, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Fornecedores)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(nome, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistoricoConsentimentosPaginated provides a mock function with given fields: userID, idCliente, canal, limit, offset
+func (_m *MockDBClient) GetHistoricoConsentimentosPaginated(userID string, idCliente int, canal string, limit int, offset int) ([]entity.ConsentimentoHistorico, int, error) {
+	ret := _m.Called(userID, idCliente, canal, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricoConsentimentosPaginated")
+	}
+
+	var r0 []entity.ConsentimentoHistorico
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, string, int, int) ([]entity.ConsentimentoHistorico, int, error)); ok {
+		return rf(userID, idCliente, canal, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string, int, int) []entity.ConsentimentoHistorico); ok {
+		r0 = rf(userID, idCliente, canal, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ConsentimentoHistorico)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string, int, int) int); ok {
+		r1 = rf(userID, idCliente, canal, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, string, int, int) error); ok {
+		r2 = rf(userID, idCliente, canal, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetHistoricoPrecos provides a mock function with given fields: idProduto, userID
+func (_m *MockDBClient) GetHistoricoPrecos(idProduto int, userID string) ([]entity.HistoricoPreco, error) {
+	ret := _m.Called(idProduto, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricoPrecos")
+	}
+
+	var r0 []entity.HistoricoPreco
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.HistoricoPreco, error)); ok {
+		return rf(idProduto, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.HistoricoPreco); ok {
+		r0 = rf(idProduto, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.HistoricoPreco)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idProduto, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistoricoPrecosPaginated provides a mock function with given fields: idProduto, userID, limit, offset
+func (_m *MockDBClient) GetHistoricoPrecosPaginated(idProduto int, userID string, limit int, offset int) ([]entity.HistoricoPreco, int, error) {
+	ret := _m.Called(idProduto, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricoPrecosPaginated")
+	}
+
+	var r0 []entity.HistoricoPreco
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, string, int, int) ([]entity.HistoricoPreco, int, error)); ok {
+		return rf(idProduto, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, int, int) []entity.HistoricoPreco); ok {
+		r0 = rf(idProduto, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.HistoricoPreco)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, int, int) int); ok {
+		r1 = rf(idProduto, userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, string, int, int) error); ok {
+		r2 = rf(idProduto, userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetHistoricoPublicoPaginated provides a mock function with given fields: userID, idPublico, idCliente, tipo, limit, offset
+func (_m *MockDBClient) GetHistoricoPublicoPaginated(userID string, idPublico int, idCliente int, tipo string, limit int, offset int) ([]entity.PublicoMembroEventoJoin, int, error) {
+	ret := _m.Called(userID, idPublico, idCliente, tipo, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoricoPublicoPaginated")
+	}
+
+	var r0 []entity.PublicoMembroEventoJoin
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, int, string, int, int) ([]entity.PublicoMembroEventoJoin, int, error)); ok {
+		return rf(userID, idPublico, idCliente, tipo, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int, string, int, int) []entity.PublicoMembroEventoJoin); ok {
+		r0 = rf(userID, idPublico, idCliente, tipo, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PublicoMembroEventoJoin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int, string, int, int) int); ok {
+		r1 = rf(userID, idPublico, idCliente, tipo, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int, string, int, int) error); ok {
+		r2 = rf(userID, idPublico, idCliente, tipo, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetIDsClientesExistentes provides a mock function with given fields: userID, clienteIDs
+func (_m *MockDBClient) GetIDsClientesExistentes(userID string, clienteIDs []int) ([]int, error) {
+	ret := _m.Called(userID, clienteIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIDsClientesExistentes")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []int) ([]int, error)); ok {
+		return rf(userID, clienteIDs)
+	}
+	if rf, ok := ret.Get(0).(func(string, []int) []int); ok {
+		r0 = rf(userID, clienteIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []int) error); ok {
+		r1 = rf(userID, clienteIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIDsExclusoesPublico provides a mock function with given fields: userID, idPublico
+func (_m *MockDBClient) GetIDsExclusoesPublico(userID string, idPublico int) ([]int, error) {
+	ret := _m.Called(userID, idPublico)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIDsExclusoesPublico")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]int, error)); ok {
+		return rf(userID, idPublico)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []int); ok {
+		r0 = rf(userID, idPublico)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(userID, idPublico)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetImportacaoByID provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetImportacaoByID(id int, userID string) (*entity.Importacao, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportacaoByID")
+	}
+
+	var r0 *entity.Importacao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) (*entity.Importacao, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) *entity.Importacao); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Importacao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetImportacaoErrosPaginated provides a mock function with given fields: idImportacao, userID, limit, offset
+func (_m *MockDBClient) GetImportacaoErrosPaginated(idImportacao int, userID string, limit int, offset int) ([]entity.ImportacaoErro, int, error) {
+	ret := _m.Called(idImportacao, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportacaoErrosPaginated")
+	}
+
+	var r0 []entity.ImportacaoErro
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, string, int, int) ([]entity.ImportacaoErro, int, error)); ok {
+		return rf(idImportacao, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, int, int) []entity.ImportacaoErro); ok {
+		r0 = rf(idImportacao, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ImportacaoErro)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, int, int) int); ok {
+		r1 = rf(idImportacao, userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, string, int, int) error); ok {
+		r2 = rf(idImportacao, userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetImportacoesPaginated provides a mock function with given fields: userID, recurso, limit, offset
+func (_m *MockDBClient) GetImportacoesPaginated(userID string, recurso string, limit int, offset int) ([]entity.Importacao, int, error) {
+	ret := _m.Called(userID, recurso, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportacoesPaginated")
+	}
+
+	var r0 []entity.Importacao
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]entity.Importacao, int, error)); ok {
+		return rf(userID, recurso, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []entity.Importacao); ok {
+		r0 = rf(userID, recurso, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Importacao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) int); ok {
+		r1 = rf(userID, recurso, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, int, int) error); ok {
+		r2 = rf(userID, recurso, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetInventarioByID provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetInventarioByID(id string, userID string) (*entity.Inventario, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventarioByID")
+	}
+
+	var r0 *entity.Inventario
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*entity.Inventario, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Inventario); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Inventario)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInventarioDivergencias provides a mock function with given fields: idInventario, userID
+func (_m *MockDBClient) GetInventarioDivergencias(idInventario int, userID string) ([]entity.InventarioDivergencia, error) {
+	ret := _m.Called(idInventario, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventarioDivergencias")
+	}
+
+	var r0 []entity.InventarioDivergencia
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.InventarioDivergencia, error)); ok {
+		return rf(idInventario, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.InventarioDivergencia); ok {
+		r0 = rf(idInventario, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.InventarioDivergencia)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idInventario, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInventarioItens provides a mock function with given fields: idInventario, userID
+func (_m *MockDBClient) GetInventarioItens(idInventario int, userID string) ([]entity.InventarioItem, error) {
+	ret := _m.Called(idInventario, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventarioItens")
+	}
+
+	var r0 []entity.InventarioItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.InventarioItem, error)); ok {
+		return rf(idInventario, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.InventarioItem); ok {
+		r0 = rf(idInventario, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.InventarioItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idInventario, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInventariosPaginated provides a mock function with given fields: userID, status, limit, offset
+func (_m *MockDBClient) GetInventariosPaginated(userID string, status string, limit int, offset int) ([]entity.Inventario, int, error) {
+	ret := _m.Called(userID, status, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventariosPaginated")
+	}
+
+	var r0 []entity.Inventario
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]entity.Inventario, int, error)); ok {
+		return rf(userID, status, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []entity.Inventario); ok {
+		r0 = rf(userID, status, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Inventario)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) int); ok {
+		r1 = rf(userID, status, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, int, int) error); ok {
+		r2 = rf(userID, status, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetItensDevolucao provides a mock function with given fields: idDevolucao, userID
+func (_m *MockDBClient) GetItensDevolucao(idDevolucao int, userID string) ([]entity.ItemDevolucao, error) {
+	ret := _m.Called(idDevolucao, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItensDevolucao")
+	}
+
+	var r0 []entity.ItemDevolucao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.ItemDevolucao, error)); ok {
+		return rf(idDevolucao, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.ItemDevolucao); ok {
+		r0 = rf(idDevolucao, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ItemDevolucao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idDevolucao, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetItensVenda provides a mock function with given fields: idVenda, userID
+func (_m *MockDBClient) GetItensVenda(idVenda int, userID string) ([]entity.ItemVenda, error) {
+	ret := _m.Called(idVenda, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItensVenda")
+	}
+
+	var r0 []entity.ItemVenda
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.ItemVenda, error)); ok {
+		return rf(idVenda, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.ItemVenda); ok {
+		r0 = rf(idVenda, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ItemVenda)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idVenda, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetKitComponentes provides a mock function with given fields: idKit, userID
+func (_m *MockDBClient) GetKitComponentes(idKit int, userID string) ([]entity.KitComponenteDetalhe, error) {
+	ret := _m.Called(idKit, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKitComponentes")
+	}
+
+	var r0 []entity.KitComponenteDetalhe
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.KitComponenteDetalhe, error)); ok {
+		return rf(idKit, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.KitComponenteDetalhe); ok {
+		r0 = rf(idKit, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.KitComponenteDetalhe)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idKit, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLotesValorizacao provides a mock function with given fields: userID, dataCorte
+func (_m *MockDBClient) GetLotesValorizacao(userID string, dataCorte string) ([]entity.LoteValorizacao, error) {
+	ret := _m.Called(userID, dataCorte)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLotesValorizacao")
+	}
+
+	var r0 []entity.LoteValorizacao
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]entity.LoteValorizacao, error)); ok {
+		return rf(userID, dataCorte)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []entity.LoteValorizacao); ok {
+		r0 = rf(userID, dataCorte)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoteValorizacao)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(userID, dataCorte)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLotesVencendo provides a mock function with given fields: userID, dias
+func (_m *MockDBClient) GetLotesVencendo(userID string, dias int) ([]entity.LoteVencendo, error) {
+	ret := _m.Called(userID, dias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLotesVencendo")
+	}
+
+	var r0 []entity.LoteVencendo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]entity.LoteVencendo, error)); ok {
+		return rf(userID, dias)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []entity.LoteVencendo); ok {
+		r0 = rf(userID, dias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.LoteVencendo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(userID, dias)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMembrosPublicos provides a mock function with given fields: userID, idsPublicos
+func (_m *MockDBClient) GetMembrosPublicos(userID string, idsPublicos []int) ([]entity.AddClientePublico, error) {
+	ret := _m.Called(userID, idsPublicos)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembrosPublicos")
+	}
+
+	var r0 []entity.AddClientePublico
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []int) ([]entity.AddClientePublico, error)); ok {
+		return rf(userID, idsPublicos)
+	}
+	if rf, ok := ret.Get(0).(func(string, []int) []entity.AddClientePublico); ok {
+		r0 = rf(userID, idsPublicos)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.AddClientePublico)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []int) error); ok {
+		r1 = rf(userID, idsPublicos)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMetricasEnviosCampanha provides a mock function with given fields: userID, idCampanha
+func (_m *MockDBClient) GetMetricasEnviosCampanha(userID string, idCampanha int) (*entity.MetricasEnvios, error) {
+	ret := _m.Called(userID, idCampanha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetricasEnviosCampanha")
+	}
+
+	var r0 *entity.MetricasEnvios
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*entity.MetricasEnvios, error)); ok {
+		return rf(userID, idCampanha)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *entity.MetricasEnvios); ok {
+		r0 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.MetricasEnvios)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(userID, idCampanha)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMetricasEnviosPublicos provides a mock function with given fields: userID, idCampanha
+func (_m *MockDBClient) GetMetricasEnviosPublicos(userID string, idCampanha int) ([]entity.MetricasEnvios, error) {
+	ret := _m.Called(userID, idCampanha)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetricasEnviosPublicos")
+	}
+
+	var r0 []entity.MetricasEnvios
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]entity.MetricasEnvios, error)); ok {
+		return rf(userID, idCampanha)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []entity.MetricasEnvios); ok {
+		r0 = rf(userID, idCampanha)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MetricasEnvios)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(userID, idCampanha)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPedidoById provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetPedidoById(id string, userID string) (*entity.Pedido, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPedidoById")
+	}
+
+	var r0 *entity.Pedido
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*entity.Pedido, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Pedido); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Pedido)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPetsEnderecosClientes provides a mock function with given fields: userID, clienteIDs
+func (_m *MockDBClient) GetPetsEnderecosClientes(userID string, clienteIDs []int) ([]entity.Pet, []entity.Endereco, error) {
+	ret := _m.Called(userID, clienteIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPetsEnderecosClientes")
+	}
+
+	var r0 []entity.Pet
+	var r1 []entity.Endereco
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, []int) ([]entity.Pet, []entity.Endereco, error)); ok {
+		return rf(userID, clienteIDs)
+	}
+	if rf, ok := ret.Get(0).(func(string, []int) []entity.Pet); ok {
+		r0 = rf(userID, clienteIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Pet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []int) []entity.Endereco); ok {
+		r1 = rf(userID, clienteIDs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]entity.Endereco)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, []int) error); ok {
+		r2 = rf(userID, clienteIDs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetPontosCliente provides a mock function with given fields: idCliente, userID
+func (_m *MockDBClient) GetPontosCliente(idCliente int, userID string) ([]entity.PontosCliente, error) {
+	ret := _m.Called(idCliente, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPontosCliente")
+	}
+
+	var r0 []entity.PontosCliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.PontosCliente, error)); ok {
+		return rf(idCliente, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.PontosCliente); ok {
+		r0 = rf(idCliente, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PontosCliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idCliente, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPrecosAgendados provides a mock function with given fields: idProduto, status, userID
+func (_m *MockDBClient) GetPrecosAgendados(idProduto int, status string, userID string) ([]entity.PrecoAgendado, error) {
+	ret := _m.Called(idProduto, status, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrecosAgendados")
+	}
+
+	var r0 []entity.PrecoAgendado
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string, string) ([]entity.PrecoAgendado, error)); ok {
+		return rf(idProduto, status, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, string) []entity.PrecoAgendado); ok {
+		r0 = rf(idProduto, status, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PrecoAgendado)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, string) error); ok {
+		r1 = rf(idProduto, status, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPrecosAgendadosVencidos provides a mock function with given fields: agora, userID
+func (_m *MockDBClient) GetPrecosAgendadosVencidos(agora string, userID string) ([]entity.PrecoAgendado, error) {
+	ret := _m.Called(agora, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrecosAgendadosVencidos")
+	}
+
+	var r0 []entity.PrecoAgendado
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]entity.PrecoAgendado, error)); ok {
+		return rf(agora, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []entity.PrecoAgendado); ok {
+		r0 = rf(agora, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PrecoAgendado)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(agora, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductByBarcode provides a mock function with given fields: barcode, userID
+func (_m *MockDBClient) GetProductByBarcode(barcode string, userID string) *entity.Produto {
+	ret := _m.Called(barcode, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByBarcode")
+	}
+
+	var r0 *entity.Produto
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Produto); ok {
+		r0 = rf(barcode, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Produto)
+		}
+	}
+
+	return r0
+}
+
+// GetProductByID provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetProductByID(id string, userID string) (*entity.Produto, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductByID")
+	}
+
+	var r0 *entity.Produto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*entity.Produto, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Produto); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Produto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductBySKU provides a mock function with given fields: sku, userID
+func (_m *MockDBClient) GetProductBySKU(sku string, userID string) *entity.Produto {
+	ret := _m.Called(sku, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductBySKU")
+	}
+
+	var r0 *entity.Produto
+	if rf, ok := ret.Get(0).(func(string, string) *entity.Produto); ok {
+		r0 = rf(sku, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Produto)
+		}
+	}
+
+	return r0
+}
+
+// GetProdutosAbaixoMinimo provides a mock function with given fields: userID
+func (_m *MockDBClient) GetProdutosAbaixoMinimo(userID string) ([]entity.ProdutoAbaixoMinimo, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProdutosAbaixoMinimo")
+	}
+
+	var r0 []entity.ProdutoAbaixoMinimo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.ProdutoAbaixoMinimo, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.ProdutoAbaixoMinimo); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProdutoAbaixoMinimo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProdutosByIDs provides a mock function with given fields: idsProdutos, userID
+func (_m *MockDBClient) GetProdutosByIDs(idsProdutos []int, userID string) ([]entity.Produto, error) {
+	ret := _m.Called(idsProdutos, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProdutosByIDs")
+	}
+
+	var r0 []entity.Produto
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int, string) ([]entity.Produto, error)); ok {
+		return rf(idsProdutos, userID)
+	}
+	if rf, ok := ret.Get(0).(func([]int, string) []entity.Produto); ok {
+		r0 = rf(idsProdutos, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Produto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int, string) error); ok {
+		r1 = rf(idsProdutos, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPublicoByID provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetPublicoByID(id int, userID string) (*entity.PublicoCliente, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicoByID")
+	}
+
+	var r0 *entity.PublicoCliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) (*entity.PublicoCliente, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) *entity.PublicoCliente); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.PublicoCliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPublicosCampanha provides a mock function with given fields: idCampanha, userID
+func (_m *MockDBClient) GetPublicosCampanha(idCampanha string, userID string) ([]entity.CampanhaPublicoJoin, error) {
+	ret := _m.Called(idCampanha, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicosCampanha")
+	}
+
+	var r0 []entity.CampanhaPublicoJoin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]entity.CampanhaPublicoJoin, error)); ok {
+		return rf(idCampanha, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []entity.CampanhaPublicoJoin); ok {
+		r0 = rf(idCampanha, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CampanhaPublicoJoin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(idCampanha, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPublicosDinamicos provides a mock function with given fields: userID
+func (_m *MockDBClient) GetPublicosDinamicos(userID string) ([]entity.PublicoCliente, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicosDinamicos")
+	}
+
+	var r0 []entity.PublicoCliente
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.PublicoCliente, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.PublicoCliente); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PublicoCliente)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRegraPublico provides a mock function with given fields: idPublico, userID
+func (_m *MockDBClient) GetRegraPublico(idPublico int, userID string) (*entity.PublicoRegra, error) {
+	ret := _m.Called(idPublico, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegraPublico")
+	}
+
+	var r0 *entity.PublicoRegra
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) (*entity.PublicoRegra, error)); ok {
+		return rf(idPublico, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) *entity.PublicoRegra); ok {
+		r0 = rf(idPublico, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.PublicoRegra)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idPublico, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSaldoProdutos provides a mock function with given fields: idsProdutos, userID
+func (_m *MockDBClient) GetSaldoProdutos(idsProdutos []int, userID string) (map[int]int, error) {
+	ret := _m.Called(idsProdutos, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSaldoProdutos")
+	}
+
+	var r0 map[int]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int, string) (map[int]int, error)); ok {
+		return rf(idsProdutos, userID)
+	}
+	if rf, ok := ret.Get(0).(func([]int, string) map[int]int); ok {
+		r0 = rf(idsProdutos, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int, string) error); ok {
+		r1 = rf(idsProdutos, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTagsCliente provides a mock function with given fields: clienteID, userID
+func (_m *MockDBClient) GetTagsCliente(clienteID int, userID string) ([]entity.TagClienteJoin, error) {
+	ret := _m.Called(clienteID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagsCliente")
+	}
+
+	var r0 []entity.TagClienteJoin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.TagClienteJoin, error)); ok {
+		return rf(clienteID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.TagClienteJoin); ok {
+		r0 = rf(clienteID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.TagClienteJoin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(clienteID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplateMensagemByID provides a mock function with given fields: userID, id
+func (_m *MockDBClient) GetTemplateMensagemByID(userID string, id int) (*entity.TemplateMensagem, error) {
+	ret := _m.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplateMensagemByID")
+	}
+
+	var r0 *entity.TemplateMensagem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*entity.TemplateMensagem, error)); ok {
+		return rf(userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *entity.TemplateMensagem); ok {
+		r0 = rf(userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.TemplateMensagem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplatesMensagensPaginated provides a mock function with given fields: userID, canal, limit, offset
+func (_m *MockDBClient) GetTemplatesMensagensPaginated(userID string, canal string, limit int, offset int) ([]entity.TemplateMensagem, int, error) {
+	ret := _m.Called(userID, canal, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplatesMensagensPaginated")
+	}
+
+	var r0 []entity.TemplateMensagem
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]entity.TemplateMensagem, int, error)); ok {
+		return rf(userID, canal, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []entity.TemplateMensagem); ok {
+		r0 = rf(userID, canal, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.TemplateMensagem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) int); ok {
+		r1 = rf(userID, canal, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, int, int) error); ok {
+		r2 = rf(userID, canal, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetValoresCatalogo provides a mock function with given fields: userID
+func (_m *MockDBClient) GetValoresCatalogo(userID string) ([]entity.ValorDistinto, []entity.ValorDistinto, []entity.ValorDistinto, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetValoresCatalogo")
+	}
+
+	var r0 []entity.ValorDistinto
+	var r1 []entity.ValorDistinto
+	var r2 []entity.ValorDistinto
+	var r3 error
+	if rf, ok := ret.Get(0).(func(string) ([]entity.ValorDistinto, []entity.ValorDistinto, []entity.ValorDistinto, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []entity.ValorDistinto); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ValorDistinto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) []entity.ValorDistinto); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]entity.ValorDistinto)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string) []entity.ValorDistinto); ok {
+		r2 = rf(userID)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).([]entity.ValorDistinto)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func(string) error); ok {
+		r3 = rf(userID)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetVariacoes provides a mock function with given fields: idPai, userID
+func (_m *MockDBClient) GetVariacoes(idPai int, userID string) ([]entity.ProdutoSaldo, error) {
+	ret := _m.Called(idPai, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariacoes")
+	}
+
+	var r0 []entity.ProdutoSaldo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]entity.ProdutoSaldo, error)); ok {
+		return rf(idPai, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []entity.ProdutoSaldo); ok {
+		r0 = rf(idPai, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.ProdutoSaldo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idPai, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVendaByID provides a mock function with given fields: id, userID
+func (_m *MockDBClient) GetVendaByID(id int, userID string) (*entity.Venda, error) {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVendaByID")
+	}
+
+	var r0 *entity.Venda
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) (*entity.Venda, error)); ok {
+		return rf(id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) *entity.Venda); ok {
+		r0 = rf(id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Venda)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVendasAtribuidasCampanha provides a mock function with given fields: userID, idCampanha, janelaDias
+func (_m *MockDBClient) GetVendasAtribuidasCampanha(userID string, idCampanha int, janelaDias int) (*entity.VendasAtribuidas, error) {
+	ret := _m.Called(userID, idCampanha, janelaDias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVendasAtribuidasCampanha")
+	}
+
+	var r0 *entity.VendasAtribuidas
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) (*entity.VendasAtribuidas, error)); ok {
+		return rf(userID, idCampanha, janelaDias)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) *entity.VendasAtribuidas); ok {
+		r0 = rf(userID, idCampanha, janelaDias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.VendasAtribuidas)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(userID, idCampanha, janelaDias)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVendasAtribuidasPublicos provides a mock function with given fields: userID, idCampanha, janelaDias
+func (_m *MockDBClient) GetVendasAtribuidasPublicos(userID string, idCampanha int, janelaDias int) ([]entity.VendasAtribuidas, error) {
+	ret := _m.Called(userID, idCampanha, janelaDias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVendasAtribuidasPublicos")
+	}
+
+	var r0 []entity.VendasAtribuidas
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]entity.VendasAtribuidas, error)); ok {
+		return rf(userID, idCampanha, janelaDias)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []entity.VendasAtribuidas); ok {
+		r0 = rf(userID, idCampanha, janelaDias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.VendasAtribuidas)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(userID, idCampanha, janelaDias)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVendasPaginated provides a mock function with given fields: userID, status, idCliente, limit, offset
+func (_m *MockDBClient) GetVendasPaginated(userID string, status string, idCliente int, limit int, offset int) ([]entity.Venda, int, error) {
+	ret := _m.Called(userID, status, idCliente, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVendasPaginated")
+	}
+
+	var r0 []entity.Venda
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int, int) ([]entity.Venda, int, error)); ok {
+		return rf(userID, status, idCliente, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int, int) []entity.Venda); ok {
+		r0 = rf(userID, status, idCliente, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Venda)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int, int) int); ok {
+		r1 = rf(userID, status, idCliente, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, int, int, int) error); ok {
+		r2 = rf(userID, status, idCliente, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// LiberarAvisoPontos provides a mock function with given fields: ids, userID
+func (_m *MockDBClient) LiberarAvisoPontos(ids []int, userID string) error {
+	ret := _m.Called(ids, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LiberarAvisoPontos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]int, string) error); ok {
+		r0 = rf(ids, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LiberarEnvioCampanha provides a mock function with given fields: userID, id, data
+func (_m *MockDBClient) LiberarEnvioCampanha(userID string, id int, data string) error {
+	ret := _m.Called(userID, id, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LiberarEnvioCampanha")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, string) error); ok {
+		r0 = rf(userID, id, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MesclarCategorias provides a mock function with given fields: idOrigem, destino, userID
+func (_m *MockDBClient) MesclarCategorias(idOrigem int, destino entity.Categoria, userID string) error {
+	ret := _m.Called(idOrigem, destino, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MesclarCategorias")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, entity.Categoria, string) error); ok {
+		r0 = rf(idOrigem, destino, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MoverCategoria provides a mock function with given fields: id, idPai, userID
+func (_m *MockDBClient) MoverCategoria(id int, idPai *int, userID string) error {
+	ret := _m.Called(id, idPai, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoverCategoria")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, *int, string) error); ok {
+		r0 = rf(id, idPai, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PagarVenda provides a mock function with given fields: idVenda, formaPagamento, saidas, dataPagamento, movimentoCaixa, debitoCredito, creditoPontos, userID
+func (_m *MockDBClient) PagarVenda(idVenda int, formaPagamento string, saidas []entity.SaidaEstoque, dataPagamento string, movimentoCaixa *entity.CaixaMovimento, debitoCredito *entity.CreditoCliente, creditoPontos *entity.PontosCliente, userID string) ([]entity.MovimentacaoEstoque, error) {
+	ret := _m.Called(idVenda, formaPagamento, saidas, dataPagamento, movimentoCaixa, debitoCredito, creditoPontos, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PagarVenda")
+	}
+
+	var r0 []entity.MovimentacaoEstoque
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string, []entity.SaidaEstoque, string, *entity.CaixaMovimento, *entity.CreditoCliente, *entity.PontosCliente, string) ([]entity.MovimentacaoEstoque, error)); ok {
+		return rf(idVenda, formaPagamento, saidas, dataPagamento, movimentoCaixa, debitoCredito, creditoPontos, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, []entity.SaidaEstoque, string, *entity.CaixaMovimento, *entity.CreditoCliente, *entity.PontosCliente, string) []entity.MovimentacaoEstoque); ok {
+		r0 = rf(idVenda, formaPagamento, saidas, dataPagamento, movimentoCaixa, debitoCredito, creditoPontos, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MovimentacaoEstoque)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, []entity.SaidaEstoque, string, *entity.CaixaMovimento, *entity.CreditoCliente, *entity.PontosCliente, string) error); ok {
+		r1 = rf(idVenda, formaPagamento, saidas, dataPagamento, movimentoCaixa, debitoCredito, creditoPontos, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewPublico provides a mock function with given fields: userID, condicao, args, amostra
+func (_m *MockDBClient) PreviewPublico(userID string, condicao string, args []interface{}, amostra int) (*entity.PreviewPublico, error) {
+	ret := _m.Called(userID, condicao, args, amostra)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewPublico")
+	}
+
+	var r0 *entity.PreviewPublico
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, []interface{}, int) (*entity.PreviewPublico, error)); ok {
+		return rf(userID, condicao, args, amostra)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, []interface{}, int) *entity.PreviewPublico); ok {
+		r0 = rf(userID, condicao, args, amostra)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.PreviewPublico)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, []interface{}, int) error); ok {
+		r1 = rf(userID, condicao, args, amostra)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProdutoEmKit provides a mock function with given fields: idProduto, userID
+func (_m *MockDBClient) ProdutoEmKit(idProduto int, userID string) (bool, error) {
+	ret := _m.Called(idProduto, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProdutoEmKit")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) (bool, error)); ok {
+		return rf(idProduto, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) bool); ok {
+		r0 = rf(idProduto, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idProduto, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProdutoTemVariacoes provides a mock function with given fields: idProduto, userID
+func (_m *MockDBClient) ProdutoTemVariacoes(idProduto int, userID string) (bool, error) {
+	ret := _m.Called(idProduto, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProdutoTemVariacoes")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string) (bool, error)); ok {
+		return rf(idProduto, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) bool); ok {
+		r0 = rf(idProduto, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = rf(idProduto, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecuperarEnviosParados provides a mock function with given fields: userID, limiteEnviando, limiteNaFila, data
+func (_m *MockDBClient) RecuperarEnviosParados(userID string, limiteEnviando string, limiteNaFila string, data string) ([]entity.CampanhaEnvio, error) {
+	ret := _m.Called(userID, limiteEnviando, limiteNaFila, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecuperarEnviosParados")
+	}
+
+	var r0 []entity.CampanhaEnvio
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) ([]entity.CampanhaEnvio, error)); ok {
+		return rf(userID, limiteEnviando, limiteNaFila, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) []entity.CampanhaEnvio); ok {
+		r0 = rf(userID, limiteEnviando, limiteNaFila, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CampanhaEnvio)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(userID, limiteEnviando, limiteNaFila, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegistrarConsentimentos provides a mock function with given fields: userID, alteracoes
+func (_m *MockDBClient) RegistrarConsentimentos(userID string, alteracoes []entity.ConsentimentoHistorico) (int, error) {
+	ret := _m.Called(userID, alteracoes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarConsentimentos")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []entity.ConsentimentoHistorico) (int, error)); ok {
+		return rf(userID, alteracoes)
+	}
+	if rf, ok := ret.Get(0).(func(string, []entity.ConsentimentoHistorico) int); ok {
+		r0 = rf(userID, alteracoes)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []entity.ConsentimentoHistorico) error); ok {
+		r1 = rf(userID, alteracoes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegistrarContagensInventario provides a mock function with given fields: contagens, userID
+func (_m *MockDBClient) RegistrarContagensInventario(contagens []entity.InventarioContagem, userID string) error {
+	ret := _m.Called(contagens, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarContagensInventario")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]entity.InventarioContagem, string) error); ok {
+		r0 = rf(contagens, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegistrarDevolucao provides a mock function with given fields: devolucao, itens, retornos, credito, estornoCaixa, estornoPontos, userID
+func (_m *MockDBClient) RegistrarDevolucao(devolucao *entity.Devolucao, itens []entity.ItemDevolucao, retornos []entity.DevolucaoEstoque, credito *entity.CreditoCliente, estornoCaixa *entity.CaixaMovimento, estornoPontos *entity.PontosCliente, userID string) error {
+	ret := _m.Called(devolucao, itens, retornos, credito, estornoCaixa, estornoPontos, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarDevolucao")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.Devolucao, []entity.ItemDevolucao, []entity.DevolucaoEstoque, *entity.CreditoCliente, *entity.CaixaMovimento, *entity.PontosCliente, string) error); ok {
+		r0 = rf(devolucao, itens, retornos, credito, estornoCaixa, estornoPontos, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegistrarDisparoCampanha provides a mock function with given fields: userID, id, dataDisparo, erro
+func (_m *MockDBClient) RegistrarDisparoCampanha(userID string, id int, dataDisparo *string, erro *string) error {
+	ret := _m.Called(userID, id, dataDisparo, erro)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarDisparoCampanha")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, *string, *string) error); ok {
+		r0 = rf(userID, id, dataDisparo, erro)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegistrarEventoEnvio provides a mock function with given fields: userID, id, aplicar
+func (_m *MockDBClient) RegistrarEventoEnvio(userID string, id int, aplicar func(entity.CampanhaEnvio) (entity.CampanhaEnvio, bool)) (bool, error) {
+	ret := _m.Called(userID, id, aplicar)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarEventoEnvio")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, func(entity.CampanhaEnvio) (entity.CampanhaEnvio, bool)) (bool, error)); ok {
+		return rf(userID, id, aplicar)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, func(entity.CampanhaEnvio) (entity.CampanhaEnvio, bool)) bool); ok {
+		r0 = rf(userID, id, aplicar)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, func(entity.CampanhaEnvio) (entity.CampanhaEnvio, bool)) error); ok {
+		r1 = rf(userID, id, aplicar)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegistrarMovimentoCaixa provides a mock function with given fields: movimento, movimentosConferidos, userID
+func (_m *MockDBClient) RegistrarMovimentoCaixa(movimento *entity.CaixaMovimento, movimentosConferidos int, userID string) error {
+	ret := _m.Called(movimento, movimentosConferidos, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarMovimentoCaixa")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.CaixaMovimento, int, string) error); ok {
+		r0 = rf(movimento, movimentosConferidos, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegistrarSaidaEstoque provides a mock function with given fields: saidas, origem, idReferencia, observacao, dataMovimento, userID
+func (_m *MockDBClient) RegistrarSaidaEstoque(saidas []entity.SaidaEstoque, origem string, idReferencia int, observacao string, dataMovimento string, userID string) ([]entity.MovimentacaoEstoque, error) {
+	ret := _m.Called(saidas, origem, idReferencia, observacao, dataMovimento, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegistrarSaidaEstoque")
+	}
+
+	var r0 []entity.MovimentacaoEstoque
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]entity.SaidaEstoque, string, int, string, string, string) ([]entity.MovimentacaoEstoque, error)); ok {
+		return rf(saidas, origem, idReferencia, observacao, dataMovimento, userID)
+	}
+	if rf, ok := ret.Get(0).(func([]entity.SaidaEstoque, string, int, string, string, string) []entity.MovimentacaoEstoque); ok {
+		r0 = rf(saidas, origem, idReferencia, observacao, dataMovimento, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.MovimentacaoEstoque)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]entity.SaidaEstoque, string, int, string, string, string) error); ok {
+		r1 = rf(saidas, origem, idReferencia, observacao, dataMovimento, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoverExclusaoPublico provides a mock function with given fields: userID, idPublico, idCliente
+func (_m *MockDBClient) RemoverExclusaoPublico(userID string, idPublico int, idCliente int) error {
+	ret := _m.Called(userID, idPublico, idCliente)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoverExclusaoPublico")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, int) error); ok {
+		r0 = rf(userID, idPublico, idCliente)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoverMembrosPublico provides a mock function with given fields: userID, idPublico, clienteIDs, data
+func (_m *MockDBClient) RemoverMembrosPublico(userID string, idPublico int, clienteIDs []int, data string) (int, error) {
+	ret := _m.Called(userID, idPublico, clienteIDs, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoverMembrosPublico")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, []int, string) (int, error)); ok {
+		return rf(userID, idPublico, clienteIDs, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, []int, string) int); ok {
+		r0 = rf(userID, idPublico, clienteIDs, data)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, []int, string) error); ok {
+		r1 = rf(userID, idPublico, clienteIDs, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoverTagsCliente provides a mock function with given fields: clienteID, tagIDs, userID
+func (_m *MockDBClient) RemoverTagsCliente(clienteID int, tagIDs []int, userID string) error {
+	ret := _m.Called(clienteID, tagIDs, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoverTagsCliente")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, []int, string) error); ok {
+		r0 = rf(clienteID, tagIDs, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RenomearCategoria provides a mock function with given fields: id, nome, nomeNormalizado, userID
+func (_m *MockDBClient) RenomearCategoria(id int, nome string, nomeNormalizado string, userID string) error {
+	ret := _m.Called(id, nome, nomeNormalizado, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenomearCategoria")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string, string, string) error); ok {
+		r0 = rf(id, nome, nomeNormalizado, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReservarAvisoPontos provides a mock function with given fields: data, limite, userID
+func (_m *MockDBClient) ReservarAvisoPontos(data string, limite string, userID string) ([]entity.PontosAExpirar, []int, error) {
+	ret := _m.Called(data, limite, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservarAvisoPontos")
+	}
+
+	var r0 []entity.PontosAExpirar
+	var r1 []int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, string) ([]entity.PontosAExpirar, []int, error)); ok {
+		return rf(data, limite, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) []entity.PontosAExpirar); ok {
+		r0 = rf(data, limite, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.PontosAExpirar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) []int); ok {
+		r1 = rf(data, limite, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, string) error); ok {
+		r2 = rf(data, limite, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReservarEnvioCampanha provides a mock function with given fields: userID, id, data
+func (_m *MockDBClient) ReservarEnvioCampanha(userID string, id int, data string) (bool, error) {
+	ret := _m.Called(userID, id, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservarEnvioCampanha")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) (bool, error)); ok {
+		return rf(userID, id, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) bool); ok {
+		r0 = rf(userID, id, data)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(userID, id, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResgatarPontos provides a mock function with given fields: debito, credito, userID
+func (_m *MockDBClient) ResgatarPontos(debito *entity.PontosCliente, credito *entity.CreditoCliente, userID string) error {
+	ret := _m.Called(debito, credito, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResgatarPontos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.PontosCliente, *entity.CreditoCliente, string) error); ok {
+		r0 = rf(debito, credito, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResolverAlertaEstoque provides a mock function with given fields: id, userID
+func (_m *MockDBClient) ResolverAlertaEstoque(id string, userID string) error {
+	ret := _m.Called(id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolverAlertaEstoque")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SalvarFidelidadeConfig provides a mock function with given fields: config, userID
+func (_m *MockDBClient) SalvarFidelidadeConfig(config *entity.FidelidadeConfig, userID string) error {
+	ret := _m.Called(config, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SalvarFidelidadeConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entity.FidelidadeConfig, string) error); ok {
+		r0 = rf(config, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetCanaisCampanha provides a mock function with given fields: userID, idCampanha, canais
+func (_m *MockDBClient) SetCanaisCampanha(userID string, idCampanha int, canais []entity.CampanhaCanal) error {
+	ret := _m.Called(userID, idCampanha, canais)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCanaisCampanha")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, []entity.CampanhaCanal) error); ok {
+		r0 = rf(userID, idCampanha, canais)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetKitComponentes provides a mock function with given fields: idKit, componentes, userID
+func (_m *MockDBClient) SetKitComponentes(idKit int, componentes []entity.KitComponente, userID string) error {
+	ret := _m.Called(idKit, componentes, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetKitComponentes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, []entity.KitComponente, string) error); ok {
+		r0 = rf(idKit, componentes, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SincronizarMembrosPublico provides a mock function with given fields: userID, idPublico, clienteIDs, data
+func (_m *MockDBClient) SincronizarMembrosPublico(userID string, idPublico int, clienteIDs []int, data string) (int, int, error) {
+	ret := _m.Called(userID, idPublico, clienteIDs, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SincronizarMembrosPublico")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, int, []int, string) (int, int, error)); ok {
+		return rf(userID, idPublico, clienteIDs, data)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, []int, string) int); ok {
+		r0 = rf(userID, idPublico, clienteIDs, data)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, []int, string) int); ok {
+		r1 = rf(userID, idPublico, clienteIDs, data)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, []int, string) error); ok {
+		r2 = rf(userID, idPublico, clienteIDs, data)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// StreamAlertasEstoque provides a mock function with given fields: userID, tipo, status, fn
+func (_m *MockDBClient) StreamAlertasEstoque(userID string, tipo string, status string, fn func(entity.AlertaEstoque) error) error {
+	ret := _m.Called(userID, tipo, status, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAlertasEstoque")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, func(entity.AlertaEstoque) error) error); ok {
+		r0 = rf(userID, tipo, status, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamCaixaSessoes provides a mock function with given fields: userID, status, fn
+func (_m *MockDBClient) StreamCaixaSessoes(userID string, status string, fn func(entity.CaixaSessao) error) error {
+	ret := _m.Called(userID, status, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamCaixaSessoes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, func(entity.CaixaSessao) error) error); ok {
+		r0 = rf(userID, status, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamCampanhas provides a mock function with given fields: userID, fn
+func (_m *MockDBClient) StreamCampanhas(userID string, fn func(entity.Campanha) error) error {
+	ret := _m.Called(userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamCampanhas")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(entity.Campanha) error) error); ok {
+		r0 = rf(userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamClientes provides a mock function with given fields: userID, fn
+func (_m *MockDBClient) StreamClientes(userID string, fn func(entity.Cliente) error) error {
+	ret := _m.Called(userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamClientes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(entity.Cliente) error) error); ok {
+		r0 = rf(userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamClientesPublicoConsentidos provides a mock function with given fields: userID, idPublico, canal, fn
+func (_m *MockDBClient) StreamClientesPublicoConsentidos(userID string, idPublico int, canal string, fn func(entity.Cliente) error) error {
+	ret := _m.Called(userID, idPublico, canal, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamClientesPublicoConsentidos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, string, func(entity.Cliente) error) error); ok {
+		r0 = rf(userID, idPublico, canal, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamDetalhesEstoque provides a mock function with given fields: userID, fn
+func (_m *MockDBClient) StreamDetalhesEstoque(userID string, fn func(entity.ViewDetalhesEstoque) error) error {
+	ret := _m.Called(userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamDetalhesEstoque")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(entity.ViewDetalhesEstoque) error) error); ok {
+		r0 = rf(userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamDevolucoes provides a mock function with given fields: userID, idVenda, idCliente, fn
+func (_m *MockDBClient) StreamDevolucoes(userID string, idVenda int, idCliente int, fn func(entity.Devolucao) error) error {
+	ret := _m.Called(userID, idVenda, idCliente, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamDevolucoes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, int, func(entity.Devolucao) error) error); ok {
+		r0 = rf(userID, idVenda, idCliente, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamEnderecos provides a mock function with given fields: userID, fn
+func (_m *MockDBClient) StreamEnderecos(userID string, fn func(entity.Endereco) error) error {
+	ret := _m.Called(userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamEnderecos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(entity.Endereco) error) error); ok {
+		r0 = rf(userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamEnviosCampanha provides a mock function with given fields: userID, idCampanha, canal, status, fn
+func (_m *MockDBClient) StreamEnviosCampanha(userID string, idCampanha int, canal string, status string, fn func(entity.CampanhaEnvio) error) error {
+	ret := _m.Called(userID, idCampanha, canal, status, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamEnviosCampanha")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, string, string, func(entity.CampanhaEnvio) error) error); ok {
+		r0 = rf(userID, idCampanha, canal, status, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamFornecedores provides a mock function with given fields: userID, fn
+func (_m *MockDBClient) StreamFornecedores(userID string, fn func(entity.Fornecedores) error) error {
+	ret := _m.Called(userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamFornecedores")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(entity.Fornecedores) error) error); ok {
+		r0 = rf(userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamHistoricoConsentimentos provides a mock function with given fields: userID, idCliente, canal, fn
+func (_m *MockDBClient) StreamHistoricoConsentimentos(userID string, idCliente int, canal string, fn func(entity.ConsentimentoHistorico) error) error {
+	ret := _m.Called(userID, idCliente, canal, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamHistoricoConsentimentos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, string, func(entity.ConsentimentoHistorico) error) error); ok {
+		r0 = rf(userID, idCliente, canal, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamHistoricoPrecos provides a mock function with given fields: userID, idProduto, fn
+func (_m *MockDBClient) StreamHistoricoPrecos(userID string, idProduto int, fn func(entity.HistoricoPreco) error) error {
+	ret := _m.Called(userID, idProduto, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamHistoricoPrecos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, func(entity.HistoricoPreco) error) error); ok {
+		r0 = rf(userID, idProduto, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamImportacaoErros provides a mock function with given fields: userID, idImportacao, fn
+func (_m *MockDBClient) StreamImportacaoErros(userID string, idImportacao int, fn func(entity.ImportacaoErro) error) error {
+	ret := _m.Called(userID, idImportacao, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamImportacaoErros")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, func(entity.ImportacaoErro) error) error); ok {
+		r0 = rf(userID, idImportacao, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamImportacoes provides a mock function with given fields: userID, recurso, fn
+func (_m *MockDBClient) StreamImportacoes(userID string, recurso string, fn func(entity.Importacao) error) error {
+	ret := _m.Called(userID, recurso, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamImportacoes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, func(entity.Importacao) error) error); ok {
+		r0 = rf(userID, recurso, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamInventarios provides a mock function with given fields: userID, status, fn
+func (_m *MockDBClient) StreamInventarios(userID string, status string, fn func(entity.Inventario) error) error {
+	ret := _m.Called(userID, status, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamInventarios")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, func(entity.Inventario) error) error); ok {
+		r0 = rf(userID, status, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamPedidos provides a mock function with given fields: userID, fn
+func (_m *MockDBClient) StreamPedidos(userID string, fn func(entity.Pedido) error) error {
+	ret := _m.Called(userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPedidos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(entity.Pedido) error) error); ok {
+		r0 = rf(userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamPets provides a mock function with given fields: userID, fn
+func (_m *MockDBClient) StreamPets(userID string, fn func(entity.Pet) error) error {
+	ret := _m.Called(userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPets")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(entity.Pet) error) error); ok {
+		r0 = rf(userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamProducts provides a mock function with given fields: userID, fn
+func (_m *MockDBClient) StreamProducts(userID string, fn func(entity.Produto) error) error {
+	ret := _m.Called(userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamProducts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(entity.Produto) error) error); ok {
+		r0 = rf(userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamPublicos provides a mock function with given fields: userID, fn
+func (_m *MockDBClient) StreamPublicos(userID string, fn func(entity.PublicoCliente) error) error {
+	ret := _m.Called(userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPublicos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(entity.PublicoCliente) error) error); ok {
+		r0 = rf(userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamTags provides a mock function with given fields: userID, fn
+func (_m *MockDBClient) StreamTags(userID string, fn func(entity.Tag) error) error {
+	ret := _m.Called(userID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(entity.Tag) error) error); ok {
+		r0 = rf(userID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamTemplatesMensagens provides a mock function with given fields: userID, canal, fn
+func (_m *MockDBClient) StreamTemplatesMensagens(userID string, canal string, fn func(entity.TemplateMensagem) error) error {
+	ret := _m.Called(userID, canal, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamTemplatesMensagens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, func(entity.TemplateMensagem) error) error); ok {
+		r0 = rf(userID, canal, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamVendas provides a mock function with given fields: userID, status, idCliente, fn
+func (_m *MockDBClient) StreamVendas(userID string, status string, idCliente int, fn func(entity.Venda) error) error {
+	ret := _m.Called(userID, status, idCliente, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamVendas")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, int, func(entity.Venda) error) error); ok {
+		r0 = rf(userID, status, idCliente, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCliente provides a mock function with given fields: id, campos, userID
+func (_m *MockDBClient) UpdateCliente(id int, campos map[string]interface{}, userID string) error {
+	ret := _m.Called(id, campos, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCliente")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, map[string]interface{}, string) error); ok {
+		r0 = rf(id, campos, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEstoqueMinimo provides a mock function with given fields: id, estoqueMinimo, userID
+func (_m *MockDBClient) UpdateEstoqueMinimo(id string, estoqueMinimo int, userID string) error {
+	ret := _m.Called(id, estoqueMinimo, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEstoqueMinimo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, string) error); ok {
+		r0 = rf(id, estoqueMinimo, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateFornecedor provides a mock function with given fields: fornecedor, userID
+func (_m *MockDBClient) UpdateFornecedor(fornecedor entity.Fornecedores, userID string) error {
+	ret := _m.Called(fornecedor, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFornecedor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.Fornecedores, string) error); ok {
+		r0 = rf(fornecedor, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateFornecedorField provides a mock function with given fields: id, campo, valor, userID
+func (_m *MockDBClient) UpdateFornecedorField(id string, campo string, valor string, userID string) error {
+	ret := _m.Called(id, campo, valor, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFornecedorField")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) error); ok {
+		r0 = rf(id, campo, valor, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProduct provides a mock function with given fields: id, campos, historico, userID
+func (_m *MockDBClient) UpdateProduct(id string, campos map[string]interface{}, historico *entity.HistoricoPreco, userID string) error {
+	ret := _m.Called(id, campos, historico, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProduct")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}, *entity.HistoricoPreco, string) error); ok {
+		r0 = rf(id, campos, historico, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTemplateMensagem provides a mock function with given fields: userID, template
+func (_m *MockDBClient) UpdateTemplateMensagem(userID string, template entity.TemplateMensagem) error {
+	ret := _m.Called(userID, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTemplateMensagem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, entity.TemplateMensagem) error); ok {
+		r0 = rf(userID, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTipoPublico provides a mock function with given fields: id, tipo, userID
+func (_m *MockDBClient) UpdateTipoPublico(id int, tipo string, userID string) error {
+	ret := _m.Called(id, tipo, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTipoPublico")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string, string) error); ok {
+		r0 = rf(id, tipo, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VincularVariacao provides a mock function with given fields: idPai, idVariacao, compartilhados, userID
+func (_m *MockDBClient) VincularVariacao(idPai int, idVariacao int, compartilhados map[string]interface{}, userID string) error {
+	ret := _m.Called(idPai, idVariacao, compartilhados, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VincularVariacao")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int, map[string]interface{}, string) error); ok {
+		r0 = rf(idPai, idVariacao, compartilhados, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockDBClient creates a new instance of MockDBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDBClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDBClient {
+	mock := &MockDBClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,133 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDBMaster is an autogenerated mock type for the PersistenceInterfaceDBMaster type
+type MockDBMaster struct {
+	mock.Mock
+}
+
+// CreateUser provides a mock function with given fields: user
+func (_m *MockDBMaster) CreateUser(user entity.User) error {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entity.User) error); ok {
+		r0 = rf(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTenantByUserID provides a mock function with given fields: userID
+func (_m *MockDBMaster) GetTenantByUserID(userID uint) *entity.Tenants {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantByUserID")
+	}
+
+	var r0 *entity.Tenants
+	if rf, ok := ret.Get(0).(func(uint) *entity.Tenants); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Tenants)
+		}
+	}
+
+	return r0
+}
+
+// GetUser provides a mock function with given fields: email
+func (_m *MockDBMaster) GetUser(email string) *entity.User {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *entity.User
+	if rf, ok := ret.Get(0).(func(string) *entity.User); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.User)
+		}
+	}
+
+	return r0
+}
+
+// GetUserByID provides a mock function with given fields: id
+func (_m *MockDBMaster) GetUserByID(id uint) *entity.User {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 *entity.User
+	if rf, ok := ret.Get(0).(func(uint) *entity.User); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.User)
+		}
+	}
+
+	return r0
+}
+
+// VerifyExist provides a mock function with given fields: email
+func (_m *MockDBMaster) VerifyExist(email string) (bool, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyExist")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockDBMaster creates a new instance of MockDBMaster. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDBMaster(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDBMaster {
+	mock := &MockDBMaster{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
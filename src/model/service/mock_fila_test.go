@@ -0,0 +1,125 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	disparo "github.com/betine97/back-project.git/src/model/service/disparo"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockFila is an autogenerated mock type for the Fila type
+type MockFila struct {
+	mock.Mock
+}
+
+// Enfileirar provides a mock function with given fields: ctx, envios
+func (_m *MockFila) Enfileirar(ctx context.Context, envios []disparo.EnvioFila) error {
+	ret := _m.Called(ctx, envios)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enfileirar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []disparo.EnvioFila) error); ok {
+		r0 = rf(ctx, envios)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Permitir provides a mock function with given fields: ctx, canal, limite, agora
+func (_m *MockFila) Permitir(ctx context.Context, canal string, limite int, agora time.Time) (bool, error) {
+	ret := _m.Called(ctx, canal, limite, agora)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Permitir")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, time.Time) (bool, error)); ok {
+		return rf(ctx, canal, limite, agora)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, time.Time) bool); ok {
+		r0 = rf(ctx, canal, limite, agora)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, time.Time) error); ok {
+		r1 = rf(ctx, canal, limite, agora)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Proximos provides a mock function with given fields: ctx, n, agora
+func (_m *MockFila) Proximos(ctx context.Context, n int, agora time.Time) ([]disparo.EnvioFila, error) {
+	ret := _m.Called(ctx, n, agora)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Proximos")
+	}
+
+	var r0 []disparo.EnvioFila
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) ([]disparo.EnvioFila, error)); ok {
+		return rf(ctx, n, agora)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) []disparo.EnvioFila); ok {
+		r0 = rf(ctx, n, agora)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]disparo.EnvioFila)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, n, agora)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reagendar provides a mock function with given fields: ctx, envio, quando
+func (_m *MockFila) Reagendar(ctx context.Context, envio disparo.EnvioFila, quando time.Time) error {
+	ret := _m.Called(ctx, envio, quando)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reagendar")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, disparo.EnvioFila, time.Time) error); ok {
+		r0 = rf(ctx, envio, quando)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockFila creates a new instance of MockFila. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFila(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFila {
+	mock := &MockFila{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/precos"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const formatoDataHora = "2006-01-02 15:04:05"
+
+// FUNÇÕES DE PREÇOS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (srv *Service) GetHistoricoPrecosService(userID string, idProduto string, page, limit int) (*dtos.HistoricoPrecoListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get historico precos service", zap.String("id_produto", idProduto), zap.Int("page", page), zap.Int("limit", limit))
+
+	produto, restErr := srv.getProduto(userID, idProduto)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	historico, total, dbErr := srv.dbClient.GetHistoricoPrecosPaginated(produto.IDProduto, userID, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting historico precos from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	historicoResponse := []dtos.HistoricoPrecoResponse{}
+	for _, alteracao := range historico {
+		historicoResponse = append(historicoResponse, buildHistoricoPrecoResponse(alteracao))
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	response := &dtos.HistoricoPrecoListResponse{
+		Historico:  historicoResponse,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+
+	zap.L().Info("Get historico precos service completed successfully", zap.Int("count", len(historicoResponse)), zap.Int("total", total))
+	return response, nil
+}
+
+func buildHistoricoPrecoResponse(alteracao entity.HistoricoPreco) dtos.HistoricoPrecoResponse {
+	response := dtos.HistoricoPrecoResponse{
+		ID:            alteracao.ID,
+		IDProduto:     alteracao.IDProduto,
+		PrecoAnterior: alteracao.PrecoAnterior,
+		PrecoNovo:     alteracao.PrecoNovo,
+		Origem:        alteracao.Origem,
+		AlteradoPor:   alteracao.AlteradoPor,
+		DataAlteracao: alteracao.DataAlteracao,
+	}
+	if alteracao.IDAgendamento != nil {
+		response.IDAgendamento = *alteracao.IDAgendamento
+	}
+	return response
+}
+
+// CreatePrecoAgendadoService agenda uma mudança de preco_venda aplicada pelo job quando a vigência começar
+func (srv *Service) CreatePrecoAgendadoService(userID string, idProduto string, request dtos.CreatePrecoAgendadoRequest) (int, *exceptions.RestErr) {
+	zap.L().Info("Starting create preco agendado service", zap.String("id_produto", idProduto), zap.Float64("preco_venda", request.PrecoVenda), zap.String("data_vigencia", request.DataVigencia))
+
+	produto, restErr := srv.getProduto(userID, idProduto)
+	if restErr != nil {
+		return 0, restErr
+	}
+
+	dataVigencia, err := parseDataHora(request.DataVigencia, false)
+	if err != nil {
+		return 0, exceptions.NewBadRequestError("Invalid data_vigencia, expected format YYYY-MM-DD or YYYY-MM-DD HH:MM:SS")
+	}
+	agora := time.Now()
+	if !dataVigencia.After(agora) {
+		return 0, exceptions.NewBadRequestError("data_vigencia must be in the future")
+	}
+
+	agendamento := &entity.PrecoAgendado{
+		IDProduto:    produto.IDProduto,
+		PrecoVenda:   request.PrecoVenda,
+		DataVigencia: dataVigencia.Format(formatoDataHora),
+		Status:       entity.PrecoAgendadoStatusPendente,
+		CriadoPor:    userID,
+		DataCriacao:  agora.Format(formatoDataHora),
+	}
+
+	if dbErr := srv.dbClient.CreatePrecoAgendado(agendamento, userID); dbErr != nil {
+		zap.L().Error("Error creating preco agendado in database", zap.Error(dbErr))
+		return 0, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Preco agendado created successfully", zap.Int("id", agendamento.ID), zap.Int("id_produto", produto.IDProduto))
+	return agendamento.ID, nil
+}
+
+func (srv *Service) GetPrecosAgendadosService(userID string, idProduto string, status string) (*dtos.PrecoAgendadoListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get precos agendados service", zap.String("id_produto", idProduto), zap.String("status", status))
+
+	if status != "" && status != entity.PrecoAgendadoStatusPendente && status != entity.PrecoAgendadoStatusAplicado && status != entity.PrecoAgendadoStatusCancelado {
+		return nil, exceptions.NewBadRequestError("Invalid status, expected 'pendente', 'aplicado' or 'cancelado'")
+	}
+
+	produto, restErr := srv.getProduto(userID, idProduto)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	agendamentos, dbErr := srv.dbClient.GetPrecosAgendados(produto.IDProduto, status, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting precos agendados from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.PrecoAgendadoListResponse{Agendamentos: []dtos.PrecoAgendadoResponse{}}
+	for _, agendamento := range agendamentos {
+		item := dtos.PrecoAgendadoResponse{
+			ID:           agendamento.ID,
+			IDProduto:    agendamento.IDProduto,
+			PrecoVenda:   agendamento.PrecoVenda,
+			DataVigencia: agendamento.DataVigencia,
+			Status:       agendamento.Status,
+			CriadoPor:    agendamento.CriadoPor,
+			DataCriacao:  agendamento.DataCriacao,
+		}
+		if agendamento.DataAplicacao != nil {
+			item.DataAplicacao = *agendamento.DataAplicacao
+		}
+		response.Agendamentos = append(response.Agendamentos, item)
+	}
+
+	zap.L().Info("Get precos agendados service completed successfully", zap.Int("count", len(response.Agendamentos)))
+	return response, nil
+}
+
+func (srv *Service) CancelarPrecoAgendadoService(userID string, idProduto string, idAgendamento string) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting cancelar preco agendado service", zap.String("id_produto", idProduto), zap.String("id_agendamento", idAgendamento))
+
+	produto, restErr := srv.getProduto(userID, idProduto)
+	if restErr != nil {
+		return false, restErr
+	}
+
+	idAgendamentoInt := 0
+	if _, err := fmt.Sscanf(idAgendamento, "%d", &idAgendamentoInt); err != nil {
+		zap.L().Error("Error converting agendamento id to int", zap.Error(err))
+		return false, exceptions.NewBadRequestError("Invalid agendamento ID")
+	}
+
+	dbErr := srv.dbClient.CancelarPrecoAgendado(produto.IDProduto, idAgendamentoInt, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return false, exceptions.NewNotFoundError("Pending scheduled price not found")
+		}
+		zap.L().Error("Error canceling preco agendado in database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Preco agendado canceled successfully", zap.Int("id_agendamento", idAgendamentoInt))
+	return true, nil
+}
+
+// GetPrecoVigenteService retorna o preço em vigor na data informada, reconstruído a partir do histórico.
+// Data sem horário considera o fim do dia; vazia significa agora.
+func (srv *Service) GetPrecoVigenteService(userID string, idProduto string, data string) (*dtos.PrecoVigenteResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get preco vigente service", zap.String("id_produto", idProduto), zap.String("data", data))
+
+	dataConsulta := time.Now()
+	if data != "" {
+		var err error
+		dataConsulta, err = parseDataHora(data, true)
+		if err != nil {
+			return nil, exceptions.NewBadRequestError("Invalid data, expected format YYYY-MM-DD or YYYY-MM-DD HH:MM:SS")
+		}
+	}
+
+	produto, restErr := srv.getProduto(userID, idProduto)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	historico, dbErr := srv.dbClient.GetHistoricoPrecos(produto.IDProduto, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting historico precos from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	alteracoes := make([]precos.Alteracao, 0, len(historico))
+	for _, alteracao := range historico {
+		alteracoes = append(alteracoes, precos.Alteracao{
+			Data:          alteracao.DataAlteracao,
+			PrecoAnterior: alteracao.PrecoAnterior,
+			PrecoNovo:     alteracao.PrecoNovo,
+		})
+	}
+
+	response := &dtos.PrecoVigenteResponse{
+		IDProduto:  produto.IDProduto,
+		Data:       dataConsulta.Format(formatoDataHora),
+		PrecoVenda: precos.PrecoVigente(produto.PrecoVenda, alteracoes, dataConsulta.Format(formatoDataHora)),
+		PrecoAtual: produto.PrecoVenda,
+	}
+
+	zap.L().Info("Get preco vigente service completed successfully", zap.Int("id_produto", produto.IDProduto), zap.Float64("preco_venda", response.PrecoVenda))
+	return response, nil
+}
+
+// AplicarPrecosAgendadosService aplica os agendamentos pendentes cuja vigência já começou e retorna quantos foram aplicados
+func (srv *Service) AplicarPrecosAgendadosService(userID string) (int, *exceptions.RestErr) {
+	zap.L().Info("Starting aplicar precos agendados service")
+
+	agora := time.Now().Format(formatoDataHora)
+	agendamentos, dbErr := srv.dbClient.GetPrecosAgendadosVencidos(agora, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting precos agendados vencidos from database", zap.Error(dbErr))
+		return 0, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	aplicados := 0
+	for _, agendamento := range agendamentos {
+		dbErr := srv.dbClient.AplicarPrecoAgendado(agendamento, agora, userID)
+		if dbErr != nil {
+			// Já aplicado ou cancelado por outra requisição; segue para os próximos
+			if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+				continue
+			}
+			zap.L().Error("Error applying preco agendado", zap.Error(dbErr), zap.Int("id_agendamento", agendamento.ID))
+			continue
+		}
+		aplicados++
+	}
+
+	zap.L().Info("Aplicar precos agendados service completed successfully", zap.Int("agendamentos", len(agendamentos)), zap.Int("aplicados", aplicados))
+	return aplicados, nil
+}
+
+// ExecutarPrecosAgendadosJob aplica os preços agendados de todos os tenants; usado pelo job periódico
+func (srv *Service) ExecutarPrecosAgendadosJob() {
+	for _, userID := range srv.dbClient.GetClientIDs() {
+		if _, err := srv.AplicarPrecosAgendadosService(userID); err != nil {
+			zap.L().Error("Error running precos agendados job", zap.String("userID", userID), zap.String("error", err.Error()))
+		}
+	}
+}
+
+// getProduto busca o produto pelo ID da rota, convertendo os erros de banco
+func (srv *Service) getProduto(userID string, id string) (*entity.Produto, *exceptions.RestErr) {
+	produto, dbErr := srv.dbClient.GetProductByID(id, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Product not found")
+		}
+		zap.L().Error("Error getting product by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	return produto, nil
+}
+
+// parseDataHora aceita YYYY-MM-DD HH:MM:SS ou YYYY-MM-DD; sem horário usa o início ou o fim do dia
+func parseDataHora(valor string, fimDoDia bool) (time.Time, error) {
+	if data, err := time.ParseInLocation(formatoDataHora, valor, time.Local); err == nil {
+		return data, nil
+	}
+	data, err := time.ParseInLocation("2006-01-02", valor, time.Local)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if fimDoDia {
+		data = data.Add(24*time.Hour - time.Second)
+	}
+	return data, nil
+}
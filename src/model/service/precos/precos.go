@@ -0,0 +1,28 @@
+package precos
+
+// Alteracao é uma mudança de preço registrada no histórico
+type Alteracao struct {
+	Data          string // formato YYYY-MM-DD HH:MM:SS
+	PrecoAnterior float64
+	PrecoNovo     float64
+}
+
+// PrecoVigente retorna o preço em vigor na data informada (formato YYYY-MM-DD HH:MM:SS).
+//
+// O histórico deve estar em ordem cronológica. Vale o preço da última alteração até a data;
+// se a data for anterior a todas as alterações, vale o preço anterior à primeira delas;
+// sem histórico, vale o preço atual do produto.
+func PrecoVigente(precoAtual float64, historico []Alteracao, data string) float64 {
+	if len(historico) == 0 {
+		return precoAtual
+	}
+
+	preco := historico[0].PrecoAnterior
+	for _, alteracao := range historico {
+		if alteracao.Data > data {
+			break
+		}
+		preco = alteracao.PrecoNovo
+	}
+	return preco
+}
@@ -0,0 +1,35 @@
+package precos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrecoVigente(t *testing.T) {
+	historico := []Alteracao{
+		{Data: "2025-01-10 09:00:00", PrecoAnterior: 100, PrecoNovo: 110},
+		{Data: "2025-02-01 00:00:00", PrecoAnterior: 110, PrecoNovo: 120},
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		esperado float64
+	}{
+		{"antes da primeira alteração", "2025-01-01 00:00:00", 100},
+		{"no instante da alteração", "2025-01-10 09:00:00", 110},
+		{"entre alterações", "2025-01-20 12:00:00", 110},
+		{"depois da última alteração", "2025-03-01 00:00:00", 120},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.esperado, PrecoVigente(120, historico, tt.data))
+		})
+	}
+}
+
+func TestPrecoVigente_SemHistorico(t *testing.T) {
+	assert.Equal(t, 99.9, PrecoVigente(99.9, nil, "2025-01-01 00:00:00"))
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// TESTES PARA CreatePrecoAgendadoService
+func TestService_CreatePrecoAgendadoService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	vigencia := time.Now().AddDate(0, 0, 2).Format(formatoDataHora)
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(&entity.Produto{IDProduto: 3, PrecoVenda: 10}, nil)
+	mockDBClient.On("CreatePrecoAgendado", mock.MatchedBy(func(agendamento *entity.PrecoAgendado) bool {
+		return agendamento.IDProduto == 3 && agendamento.PrecoVenda == 12.5 && agendamento.DataVigencia == vigencia &&
+			agendamento.Status == entity.PrecoAgendadoStatusPendente
+	}), "1").Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.PrecoAgendado).ID = 9
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	id, err := service.CreatePrecoAgendadoService("1", "3", dtos.CreatePrecoAgendadoRequest{PrecoVenda: 12.5, DataVigencia: vigencia})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 9, id)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreatePrecoAgendadoService_VigenciaNoPassado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(&entity.Produto{IDProduto: 3}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	id, err := service.CreatePrecoAgendadoService("1", "3", dtos.CreatePrecoAgendadoRequest{PrecoVenda: 12.5, DataVigencia: "2020-01-01"})
+
+	// Assert
+	assert.Equal(t, 0, id)
+	assert.NotNil(t, err)
+	assert.Equal(t, "data_vigencia must be in the future", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreatePrecoAgendadoService_ProductNotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(nil, gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	id, err := service.CreatePrecoAgendadoService("1", "3", dtos.CreatePrecoAgendadoRequest{PrecoVenda: 12.5, DataVigencia: "2099-01-01"})
+
+	// Assert
+	assert.Equal(t, 0, id)
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA CancelarPrecoAgendadoService
+func TestService_CancelarPrecoAgendadoService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(&entity.Produto{IDProduto: 3}, nil)
+	mockDBClient.On("CancelarPrecoAgendado", 3, 9, "1").Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.CancelarPrecoAgendadoService("1", "3", "9")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, success)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CancelarPrecoAgendadoService_NotPending(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(&entity.Produto{IDProduto: 3}, nil)
+	mockDBClient.On("CancelarPrecoAgendado", 3, 9, "1").Return(gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.CancelarPrecoAgendadoService("1", "3", "9")
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Pending scheduled price not found", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA GetPrecoVigenteService
+func TestService_GetPrecoVigenteService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	historico := []entity.HistoricoPreco{
+		{IDProduto: 3, PrecoAnterior: 8, PrecoNovo: 9, DataAlteracao: "2025-01-10 10:00:00"},
+		{IDProduto: 3, PrecoAnterior: 9, PrecoNovo: 10, DataAlteracao: "2025-02-10 10:00:00"},
+	}
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(&entity.Produto{IDProduto: 3, PrecoVenda: 10}, nil)
+	mockDBClient.On("GetHistoricoPrecos", 3, "1").Return(historico, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetPrecoVigenteService("1", "3", "2025-01-31")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "2025-01-31 23:59:59", result.Data)
+	assert.Equal(t, 9.0, result.PrecoVenda)
+	assert.Equal(t, 10.0, result.PrecoAtual)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_GetPrecoVigenteService_InvalidData(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetPrecoVigenteService("1", "3", "31/01/2025")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA AplicarPrecosAgendadosService
+func TestService_AplicarPrecosAgendadosService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	agendamentos := []entity.PrecoAgendado{
+		{ID: 1, IDProduto: 3, PrecoVenda: 11},
+		{ID: 2, IDProduto: 4, PrecoVenda: 20},
+		{ID: 3, IDProduto: 5, PrecoVenda: 30},
+	}
+
+	mockDBClient.On("GetPrecosAgendadosVencidos", mock.AnythingOfType("string"), "1").Return(agendamentos, nil)
+	mockDBClient.On("AplicarPrecoAgendado", agendamentos[0], mock.AnythingOfType("string"), "1").Return(nil)
+	mockDBClient.On("AplicarPrecoAgendado", agendamentos[1], mock.AnythingOfType("string"), "1").Return(gorm.ErrRecordNotFound)
+	mockDBClient.On("AplicarPrecoAgendado", agendamentos[2], mock.AnythingOfType("string"), "1").Return(errors.New("database error"))
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	aplicados, err := service.AplicarPrecosAgendadosService("1")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 1, aplicados)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_AplicarPrecosAgendadosService_DBError(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetPrecosAgendadosVencidos", mock.AnythingOfType("string"), "1").Return(nil, errors.New("database error"))
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	aplicados, err := service.AplicarPrecosAgendadosService("1")
+
+	// Assert
+	assert.Equal(t, 0, aplicados)
+	assert.NotNil(t, err)
+	assert.Equal(t, 500, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
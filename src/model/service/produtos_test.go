@@ -0,0 +1,80 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+)
+
+// TESTES PARA CreateProductService (códigos únicos)
+func TestService_CreateProductService_CodigoBarraDuplicado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProductByBarcode", "7891234567895", "1").Return(&entity.Produto{IDProduto: 3, CodigoBarra: "7891234567895"})
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.CreateProductService("1", dtos.CreateProductRequest{NomeProduto: "Ração", CodigoBarra: "7891234567895", SKU: "RAC-1", PrecoVenda: 10})
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, msgCodigoBarraEmUso, err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA UpdateProductService (códigos únicos)
+func TestService_UpdateProductService_SKUDuplicado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	sku := "RAC-2"
+	mockDBClient.On("GetProductByID", "5", "1").Return(&entity.Produto{IDProduto: 5, SKU: "RAC-1"}, nil)
+	mockDBClient.On("GetProductBySKU", sku, "1").Return(&entity.Produto{IDProduto: 6, SKU: sku})
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.UpdateProductService("1", "5", dtos.UpdateProductRequest{SKU: &sku})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, msgSKUEmUso, err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_UpdateProductService_MesmoSKUNaoConsulta(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	sku := "RAC-1"
+	nome := ""
+	mockDBClient.On("GetProductByID", "5", "1").Return(&entity.Produto{IDProduto: 5, SKU: sku}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.UpdateProductService("1", "5", dtos.UpdateProductRequest{SKU: &sku, NomeProduto: &nome})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "nome_produto cannot be empty", err.Message)
+
+	mockDBClient.AssertExpectations(t)
+}
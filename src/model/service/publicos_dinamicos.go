@@ -0,0 +1,177 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE PÚBLICOS DINÂMICOS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (srv *Service) UpdateTipoPublicoService(userID string, idPublico string, request dtos.UpdateTipoPublicoRequest) (*dtos.PublicoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting update tipo publico service", zap.String("idPublico", idPublico), zap.String("tipo", request.Tipo))
+
+	publico, restErr := srv.getPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	if dbErr := srv.dbClient.UpdateTipoPublico(publico.ID, request.Tipo, userID); dbErr != nil {
+		zap.L().Error("Error updating tipo of publico in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	publico.Tipo = request.Tipo
+
+	response := buildPublicoResponse(*publico)
+	zap.L().Info("Tipo publico updated successfully", zap.Int("idPublico", publico.ID))
+	return &response, nil
+}
+
+// AtualizarPublicoService reavalia a regra de um público dinâmico e sincroniza os membros
+func (srv *Service) AtualizarPublicoService(userID string, idPublico string) (*dtos.AtualizacaoPublicoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting atualizar publico service", zap.String("idPublico", idPublico))
+
+	publico, restErr := srv.getPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if publico.Tipo != entity.PublicoTipoDinamico {
+		return nil, exceptions.NewConflictError("Only dynamic publicos can be refreshed")
+	}
+
+	return srv.atualizarPublico(userID, *publico)
+}
+
+func (srv *Service) GetHistoricoPublicoService(userID string, idPublico string, idCliente int, tipo string, page, limit int) (*dtos.PublicoHistoricoListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get historico publico service", zap.String("idPublico", idPublico), zap.Int("id_cliente", idCliente), zap.String("tipo", tipo), zap.Int("page", page), zap.Int("limit", limit))
+
+	if tipo != "" && tipo != entity.PublicoEventoEntrada && tipo != entity.PublicoEventoSaida {
+		return nil, exceptions.NewBadRequestError("Invalid tipo, expected 'entrada' or 'saida'")
+	}
+
+	publico, restErr := srv.getPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	eventos, total, dbErr := srv.dbClient.GetHistoricoPublicoPaginated(userID, publico.ID, idCliente, tipo, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting historico of publico from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.PublicoHistoricoListResponse{
+		IDPublico:  publico.ID,
+		Eventos:    make([]dtos.PublicoMembroEventoResponse, len(eventos)),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: (total + limit - 1) / limit,
+	}
+	for i, evento := range eventos {
+		response.Eventos[i] = dtos.PublicoMembroEventoResponse{
+			ID:          evento.ID,
+			IDCliente:   evento.IDCliente,
+			NomeCliente: evento.NomeCliente,
+			Tipo:        evento.Tipo,
+			Origem:      evento.Origem,
+			DataEvento:  evento.DataEvento,
+		}
+	}
+
+	zap.L().Info("Historico publico service completed successfully", zap.Int("total", total))
+	return response, nil
+}
+
+// ExecutarPublicosDinamicosJob reavalia os públicos dinâmicos de todos os tenants; usado pelo job periódico.
+// A falha de um público não impede a atualização dos demais.
+func (srv *Service) ExecutarPublicosDinamicosJob() {
+	for _, userID := range srv.dbClient.GetClientIDs() {
+		publicos, dbErr := srv.dbClient.GetPublicosDinamicos(userID)
+		if dbErr != nil {
+			zap.L().Error("Error running publicos dinamicos job", zap.String("userID", userID), zap.Error(dbErr))
+			continue
+		}
+		for _, publico := range publicos {
+			if _, err := srv.atualizarPublico(userID, publico); err != nil {
+				zap.L().Error("Error running publicos dinamicos job", zap.String("userID", userID), zap.Int("idPublico", publico.ID), zap.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// atualizarPublico avalia a regra do público e deixa como membros exatamente os clientes que a atendem
+func (srv *Service) atualizarPublico(userID string, publico entity.PublicoCliente) (*dtos.AtualizacaoPublicoResponse, *exceptions.RestErr) {
+	idPublico := strconv.Itoa(publico.ID)
+
+	regra, criterios, restErr := srv.regraDoPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
+	}
+	// Sem regra nem critérios, esvaziar o público apagaria os membros por engano
+	if regra == nil {
+		return nil, exceptions.NewConflictError("Publico has no regra or criterios")
+	}
+
+	clientes, restErr := srv.buscarClientesPorRegra(userID, publico.ID, *regra, criterios)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	clienteIDs := make([]int, len(clientes))
+	for i, cliente := range clientes {
+		clienteIDs[i] = cliente.ID
+	}
+
+	agora := time.Now().Format(formatoDataHora)
+	entradas, saidas, dbErr := srv.dbClient.SincronizarMembrosPublico(userID, publico.ID, clienteIDs, agora)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Publico not found")
+		}
+		zap.L().Error("Error synchronizing members of publico", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Publico refreshed successfully", zap.String("userID", userID), zap.Int("idPublico", publico.ID), zap.Int("total", len(clienteIDs)), zap.Int("entradas", entradas), zap.Int("saidas", saidas))
+	return &dtos.AtualizacaoPublicoResponse{
+		IDPublico:       publico.ID,
+		Total:           len(clienteIDs),
+		Entradas:        entradas,
+		Saidas:          saidas,
+		DataAtualizacao: agora,
+	}, nil
+}
+
+func (srv *Service) getPublico(userID string, idPublico string) (*entity.PublicoCliente, *exceptions.RestErr) {
+	id, err := strconv.Atoi(idPublico)
+	if err != nil || id < 1 {
+		return nil, exceptions.NewBadRequestError("Invalid publico ID")
+	}
+
+	publico, dbErr := srv.dbClient.GetPublicoByID(id, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Publico not found")
+		}
+		zap.L().Error("Error getting publico by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	return publico, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// TESTES PARA AtualizarPublicoService
+func TestService_AtualizarPublicoService_SincronizaMembros(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetPublicoByID", 4, "1").Return(&entity.PublicoCliente{ID: 4, Nome: "Gateiros", Tipo: entity.PublicoTipoDinamico}, nil)
+	mockDBClient.On("GetAllCriterios", "1").Return(criteriosFixos(), nil)
+	mockDBClient.On("GetRegraPublico", 4, "1").Return(nil, gorm.ErrRecordNotFound)
+	mockDBClient.On("GetCriteriosPublico", "4", "1").Return([]entity.PublicoCriterioJoin{{IDPublico: 4, IDCriterio: 6}}, nil)
+	mockDBClient.On("BuscarClientesPorRegra", "1", mock.AnythingOfType("string"), []interface{}{"Gato"}, 4).
+		Return([]entity.Cliente{{ID: 2}, {ID: 5}, {ID: 7}}, nil)
+	mockDBClient.On("SincronizarMembrosPublico", "1", 4, []int{2, 5, 7}, mock.AnythingOfType("string")).Return(1, 2, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.AtualizarPublicoService("1", "4")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result.IDPublico)
+	assert.Equal(t, 3, result.Total)
+	assert.Equal(t, 1, result.Entradas)
+	assert.Equal(t, 2, result.Saidas)
+	assert.NotEmpty(t, result.DataAtualizacao)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_AtualizarPublicoService_PublicoEstatico(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetPublicoByID", 4, "1").Return(&entity.PublicoCliente{ID: 4, Nome: "Gateiros", Tipo: entity.PublicoTipoEstatico}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.AtualizarPublicoService("1", "4")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertNotCalled(t, "SincronizarMembrosPublico", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_AtualizarPublicoService_SemRegraNaoEsvazia(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetPublicoByID", 4, "1").Return(&entity.PublicoCliente{ID: 4, Nome: "Gateiros", Tipo: entity.PublicoTipoDinamico}, nil)
+	mockDBClient.On("GetAllCriterios", "1").Return(criteriosFixos(), nil)
+	mockDBClient.On("GetRegraPublico", 4, "1").Return(nil, gorm.ErrRecordNotFound)
+	mockDBClient.On("GetCriteriosPublico", "4", "1").Return([]entity.PublicoCriterioJoin{}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.AtualizarPublicoService("1", "4")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+	assert.Equal(t, "Publico has no regra or criterios", err.Message)
+
+	mockDBClient.AssertNotCalled(t, "SincronizarMembrosPublico", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA ExecutarPublicosDinamicosJob
+func TestService_ExecutarPublicosDinamicosJob_FalhaNaoInterrompe(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	regra := `{"criterio":6}`
+	mockDBClient.On("GetClientIDs").Return([]string{"1", "2"})
+	mockDBClient.On("GetPublicosDinamicos", "1").Return(nil, errors.New("database error"))
+	mockDBClient.On("GetPublicosDinamicos", "2").Return([]entity.PublicoCliente{
+		{ID: 3, Tipo: entity.PublicoTipoDinamico},
+		{ID: 4, Tipo: entity.PublicoTipoDinamico},
+	}, nil)
+	mockDBClient.On("GetAllCriterios", "2").Return(criteriosFixos(), nil)
+	mockDBClient.On("GetRegraPublico", 3, "2").Return(&entity.PublicoRegra{IDPublico: 3, Regra: regra}, nil)
+	mockDBClient.On("GetRegraPublico", 4, "2").Return(&entity.PublicoRegra{IDPublico: 4, Regra: regra}, nil)
+	mockDBClient.On("BuscarClientesPorRegra", "2", mock.AnythingOfType("string"), []interface{}{"Gato"}, 3).Return(nil, errors.New("database error"))
+	mockDBClient.On("BuscarClientesPorRegra", "2", mock.AnythingOfType("string"), []interface{}{"Gato"}, 4).Return([]entity.Cliente{{ID: 9}}, nil)
+	mockDBClient.On("SincronizarMembrosPublico", "2", 4, []int{9}, mock.AnythingOfType("string")).Return(1, 0, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	service.ExecutarPublicosDinamicosJob()
+
+	// Assert
+	mockDBClient.AssertNotCalled(t, "SincronizarMembrosPublico", "2", 3, mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
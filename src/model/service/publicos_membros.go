@@ -0,0 +1,337 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE MEMBROS E EXCLUSÕES DE PÚBLICOS ------------------------------------------------------------------------------------------------------------------------------------
+
+// AdicionarMembrosPublicoService adiciona clientes escolhidos ao público. Os clientes excluídos do público não entram
+// e voltam em clientes_excluidos.
+func (srv *Service) AdicionarMembrosPublicoService(userID string, idPublico string, request dtos.MembrosPublicoRequest) (*dtos.AdicionarMembrosPublicoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting adicionar membros publico service", zap.String("idPublico", idPublico), zap.Int("clientes_count", len(request.Clientes)))
+
+	publico, restErr := srv.getPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if restErr := srv.validarClientesExistentes(userID, request.Clientes); restErr != nil {
+		return nil, restErr
+	}
+
+	exclusoes, dbErr := srv.dbClient.GetIDsExclusoesPublico(userID, publico.ID)
+	if dbErr != nil {
+		zap.L().Error("Error getting exclusoes of publico", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	excluidos := make(map[int]bool, len(exclusoes))
+	for _, id := range exclusoes {
+		excluidos[id] = true
+	}
+
+	response := &dtos.AdicionarMembrosPublicoResponse{
+		IDPublico:         publico.ID,
+		ClientesExcluidos: []int{},
+	}
+	permitidos := make([]int, 0, len(request.Clientes))
+	for _, id := range distintos(request.Clientes) {
+		if excluidos[id] {
+			response.ClientesExcluidos = append(response.ClientesExcluidos, id)
+			continue
+		}
+		permitidos = append(permitidos, id)
+	}
+
+	if len(permitidos) > 0 {
+		adicionados, jaExistiam, dbErr := srv.dbClient.AdicionarMembrosPublico(userID, publico.ID, permitidos, entity.PublicoEventoOrigemManual, time.Now().Format(formatoDataHora))
+		if dbErr != nil {
+			if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+				return nil, exceptions.NewNotFoundError("Publico not found")
+			}
+			zap.L().Error("Error adding membros to publico", zap.Error(dbErr))
+			return nil, exceptions.NewInternalServerError("Internal server error")
+		}
+		response.ClientesAdicionados = adicionados
+		response.ClientesJaExistiam = jaExistiam
+	}
+
+	zap.L().Info("Membros added to publico successfully", zap.Int("idPublico", publico.ID), zap.Int("adicionados", response.ClientesAdicionados), zap.Int("excluidos", len(response.ClientesExcluidos)))
+	return response, nil
+}
+
+func (srv *Service) RemoverMembrosPublicoService(userID string, idPublico string, request dtos.MembrosPublicoRequest) (*dtos.RemoverMembrosPublicoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting remover membros publico service", zap.String("idPublico", idPublico), zap.Int("clientes_count", len(request.Clientes)))
+
+	publico, restErr := srv.getPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	removidos, dbErr := srv.dbClient.RemoverMembrosPublico(userID, publico.ID, request.Clientes, time.Now().Format(formatoDataHora))
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Publico not found")
+		}
+		zap.L().Error("Error removing membros from publico", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Membros removed from publico successfully", zap.Int("idPublico", publico.ID), zap.Int("removidos", removidos))
+	return &dtos.RemoverMembrosPublicoResponse{
+		IDPublico:          publico.ID,
+		ClientesRemovidos:  removidos,
+		ClientesNaoMembros: len(distintos(request.Clientes)) - removidos,
+	}, nil
+}
+
+// ExcluirClientesPublicoService impede que os clientes entrem no público pela regra, pela reavaliação ou pela adição
+// manual; os que já eram membros saem do público
+func (srv *Service) ExcluirClientesPublicoService(userID string, idPublico string, request dtos.ExcluirClientesPublicoRequest) (*dtos.ExcluirClientesPublicoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting excluir clientes publico service", zap.String("idPublico", idPublico), zap.Int("clientes_count", len(request.Clientes)))
+
+	publico, restErr := srv.getPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if restErr := srv.validarClientesExistentes(userID, request.Clientes); restErr != nil {
+		return nil, restErr
+	}
+
+	var motivo *string
+	if request.Motivo != "" {
+		motivo = &request.Motivo
+	}
+
+	novas, jaExcluidos, removidos, dbErr := srv.dbClient.ExcluirClientesPublico(userID, publico.ID, request.Clientes, motivo, time.Now().Format(formatoDataHora))
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Publico not found")
+		}
+		zap.L().Error("Error excluding clientes from publico", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Clientes excluded from publico successfully", zap.Int("idPublico", publico.ID), zap.Int("novas", novas), zap.Int("removidos", removidos))
+	return &dtos.ExcluirClientesPublicoResponse{
+		IDPublico:           publico.ID,
+		ClientesExcluidos:   novas,
+		ClientesJaExcluidos: jaExcluidos,
+		MembrosRemovidos:    removidos,
+	}, nil
+}
+
+func (srv *Service) RemoverExclusaoPublicoService(userID string, idPublico string, idCliente string) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting remover exclusao publico service", zap.String("idPublico", idPublico), zap.String("idCliente", idCliente))
+
+	publico, restErr := srv.getPublico(userID, idPublico)
+	if restErr != nil {
+		return false, restErr
+	}
+	idClienteInt, err := strconv.Atoi(idCliente)
+	if err != nil || idClienteInt < 1 {
+		return false, exceptions.NewBadRequestError("Invalid cliente ID")
+	}
+
+	if dbErr := srv.dbClient.RemoverExclusaoPublico(userID, publico.ID, idClienteInt); dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return false, exceptions.NewNotFoundError("Exclusao not found")
+		}
+		zap.L().Error("Error removing exclusao of publico", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Exclusao removed successfully", zap.Int("idPublico", publico.ID), zap.Int("idCliente", idClienteInt))
+	return true, nil
+}
+
+func (srv *Service) GetExclusoesPublicoService(userID string, idPublico string, page, limit int) (*dtos.PublicoExclusaoListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get exclusoes publico service", zap.String("idPublico", idPublico), zap.Int("page", page), zap.Int("limit", limit))
+
+	publico, restErr := srv.getPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	exclusoes, total, dbErr := srv.dbClient.GetExclusoesPublicoPaginated(userID, publico.ID, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting exclusoes of publico from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.PublicoExclusaoListResponse{
+		IDPublico:  publico.ID,
+		Exclusoes:  make([]dtos.PublicoExclusaoResponse, len(exclusoes)),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: (total + limit - 1) / limit,
+	}
+	for i, exclusao := range exclusoes {
+		response.Exclusoes[i] = dtos.PublicoExclusaoResponse{
+			IDCliente:    exclusao.IDCliente,
+			NomeCliente:  exclusao.NomeCliente,
+			Motivo:       exclusao.Motivo,
+			DataExclusao: exclusao.DataExclusao,
+		}
+	}
+
+	zap.L().Info("Exclusoes publico service completed successfully", zap.Int("total", total))
+	return response, nil
+}
+
+// OperacaoPublicosService cria um público estático com o resultado da união, interseção ou diferença dos membros
+// atuais dos públicos informados
+func (srv *Service) OperacaoPublicosService(userID string, request dtos.OperacaoPublicosRequest) (*dtos.OperacaoPublicosResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting operacao publicos service", zap.String("operacao", request.Operacao), zap.Ints("publicos", request.Publicos))
+
+	if len(distintos(request.Publicos)) != len(request.Publicos) {
+		return nil, exceptions.NewBadRequestError("publicos must not repeat")
+	}
+	for _, id := range request.Publicos {
+		if _, restErr := srv.getPublico(userID, strconv.Itoa(id)); restErr != nil {
+			return nil, restErr
+		}
+	}
+
+	membros, dbErr := srv.dbClient.GetMembrosPublicos(userID, request.Publicos)
+	if dbErr != nil {
+		zap.L().Error("Error getting members of publicos", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	clienteIDs := operarPublicos(request.Operacao, request.Publicos, membros)
+
+	agora := time.Now()
+	publico := &entity.PublicoCliente{
+		Nome:        request.Nome,
+		Descricao:   request.Descricao,
+		DataCriacao: agora.Format("2006-01-02"),
+		Status:      "ativo",
+		Tipo:        entity.PublicoTipoEstatico,
+	}
+	if dbErr := srv.dbClient.CreatePublicoComMembros(userID, publico, clienteIDs, agora.Format(formatoDataHora)); dbErr != nil {
+		zap.L().Error("Error creating publico from operacao", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Publico created from operacao successfully", zap.Int("id", publico.ID), zap.String("operacao", request.Operacao), zap.Int("total", len(clienteIDs)))
+	return &dtos.OperacaoPublicosResponse{
+		Publico:  buildPublicoResponse(*publico),
+		Operacao: request.Operacao,
+		Publicos: request.Publicos,
+		Total:    len(clienteIDs),
+	}, nil
+}
+
+// operarPublicos aplica a operação aos membros dos públicos. Na diferença, o primeiro público da lista perde os
+// membros dos demais. O resultado sai ordenado por ID do cliente.
+func operarPublicos(operacao string, idsPublicos []int, membros []entity.AddClientePublico) []int {
+	conjuntos := make(map[int]map[int]bool, len(idsPublicos))
+	for _, id := range idsPublicos {
+		conjuntos[id] = map[int]bool{}
+	}
+	for _, membro := range membros {
+		if conjunto, ok := conjuntos[membro.IDPublico]; ok {
+			conjunto[membro.IDCliente] = true
+		}
+	}
+
+	resultado := map[int]bool{}
+	switch operacao {
+	case entity.PublicoOperacaoUniao:
+		for _, conjunto := range conjuntos {
+			for id := range conjunto {
+				resultado[id] = true
+			}
+		}
+	case entity.PublicoOperacaoIntersecao:
+		for id := range conjuntos[idsPublicos[0]] {
+			emTodos := true
+			for _, outro := range idsPublicos[1:] {
+				if !conjuntos[outro][id] {
+					emTodos = false
+					break
+				}
+			}
+			resultado[id] = emTodos
+		}
+	case entity.PublicoOperacaoDiferenca:
+		for id := range conjuntos[idsPublicos[0]] {
+			emOutro := false
+			for _, outro := range idsPublicos[1:] {
+				if conjuntos[outro][id] {
+					emOutro = true
+					break
+				}
+			}
+			resultado[id] = !emOutro
+		}
+	}
+
+	clienteIDs := make([]int, 0, len(resultado))
+	for id, incluir := range resultado {
+		if incluir {
+			clienteIDs = append(clienteIDs, id)
+		}
+	}
+	sort.Ints(clienteIDs)
+	return clienteIDs
+}
+
+// validarClientesExistentes retorna 404 com os IDs que não existem na tabela clientes
+func (srv *Service) validarClientesExistentes(userID string, clienteIDs []int) *exceptions.RestErr {
+	existentes, dbErr := srv.dbClient.GetIDsClientesExistentes(userID, clienteIDs)
+	if dbErr != nil {
+		zap.L().Error("Error checking clientes", zap.Error(dbErr))
+		return exceptions.NewInternalServerError("Internal server error")
+	}
+
+	encontrados := make(map[int]bool, len(existentes))
+	for _, id := range existentes {
+		encontrados[id] = true
+	}
+	var faltando []string
+	for _, id := range distintos(clienteIDs) {
+		if !encontrados[id] {
+			faltando = append(faltando, strconv.Itoa(id))
+		}
+	}
+	if len(faltando) > 0 {
+		return exceptions.NewNotFoundError(fmt.Sprintf("Clientes not found: %s", strings.Join(faltando, ", ")))
+	}
+	return nil
+}
+
+// distintos retorna os IDs sem repetição, na ordem em que aparecem
+func distintos(ids []int) []int {
+	vistos := make(map[int]bool, len(ids))
+	unicos := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !vistos[id] {
+			vistos[id] = true
+			unicos = append(unicos, id)
+		}
+	}
+	return unicos
+}
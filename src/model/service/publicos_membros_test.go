@@ -0,0 +1,155 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// TESTES PARA AdicionarMembrosPublicoService
+func TestService_AdicionarMembrosPublicoService_FiltraExcluidos(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetPublicoByID", 4, "1").Return(&entity.PublicoCliente{ID: 4, Nome: "Cães"}, nil)
+	mockDBClient.On("GetIDsClientesExistentes", "1", []int{1, 2, 2, 3}).Return([]int{1, 2, 3}, nil)
+	mockDBClient.On("GetIDsExclusoesPublico", "1", 4).Return([]int{3}, nil)
+	mockDBClient.On("AdicionarMembrosPublico", "1", 4, []int{1, 2}, entity.PublicoEventoOrigemManual, mock.AnythingOfType("string")).Return(1, 1, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.AdicionarMembrosPublicoService("1", "4", dtos.MembrosPublicoRequest{Clientes: []int{1, 2, 2, 3}})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 4, result.IDPublico)
+	assert.Equal(t, 1, result.ClientesAdicionados)
+	assert.Equal(t, 1, result.ClientesJaExistiam)
+	assert.Equal(t, []int{3}, result.ClientesExcluidos)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_AdicionarMembrosPublicoService_TodosExcluidos(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetPublicoByID", 4, "1").Return(&entity.PublicoCliente{ID: 4, Nome: "Cães"}, nil)
+	mockDBClient.On("GetIDsClientesExistentes", "1", []int{3}).Return([]int{3}, nil)
+	mockDBClient.On("GetIDsExclusoesPublico", "1", 4).Return([]int{3}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.AdicionarMembrosPublicoService("1", "4", dtos.MembrosPublicoRequest{Clientes: []int{3}})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 0, result.ClientesAdicionados)
+	assert.Equal(t, []int{3}, result.ClientesExcluidos)
+
+	mockDBClient.AssertNotCalled(t, "AdicionarMembrosPublico", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_AdicionarMembrosPublicoService_ClientesNotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetPublicoByID", 4, "1").Return(&entity.PublicoCliente{ID: 4, Nome: "Cães"}, nil)
+	mockDBClient.On("GetIDsClientesExistentes", "1", []int{1, 8, 9}).Return([]int{1}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.AdicionarMembrosPublicoService("1", "4", dtos.MembrosPublicoRequest{Clientes: []int{1, 8, 9}})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, err.Code)
+	assert.Equal(t, "Clientes not found: 8, 9", err.Message)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA RemoverMembrosPublicoService
+func TestService_RemoverMembrosPublicoService_ContaNaoMembros(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetPublicoByID", 4, "1").Return(&entity.PublicoCliente{ID: 4, Nome: "Cães"}, nil)
+	mockDBClient.On("RemoverMembrosPublico", "1", 4, []int{1, 2, 2, 5}, mock.AnythingOfType("string")).Return(2, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.RemoverMembrosPublicoService("1", "4", dtos.MembrosPublicoRequest{Clientes: []int{1, 2, 2, 5}})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 2, result.ClientesRemovidos)
+	assert.Equal(t, 1, result.ClientesNaoMembros)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA ExcluirClientesPublicoService
+func TestService_ExcluirClientesPublicoService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	motivo := "Pediu para não receber"
+	mockDBClient.On("GetPublicoByID", 4, "1").Return(&entity.PublicoCliente{ID: 4, Nome: "Cães"}, nil)
+	mockDBClient.On("GetIDsClientesExistentes", "1", []int{1, 2}).Return([]int{1, 2}, nil)
+	mockDBClient.On("ExcluirClientesPublico", "1", 4, []int{1, 2}, &motivo, mock.AnythingOfType("string")).Return(1, 1, 1, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.ExcluirClientesPublicoService("1", "4", dtos.ExcluirClientesPublicoRequest{Clientes: []int{1, 2}, Motivo: motivo})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.ClientesExcluidos)
+	assert.Equal(t, 1, result.ClientesJaExcluidos)
+	assert.Equal(t, 1, result.MembrosRemovidos)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_ExcluirClientesPublicoService_PublicoNotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetPublicoByID", 4, "1").Return(nil, gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.ExcluirClientesPublicoService("1", "4", dtos.ExcluirClientesPublicoRequest{Clientes: []int{1}})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/disparo"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// Dias depois do envio em que uma venda é atribuída à campanha
+	janelaAtribuicaoPadrao = 7
+	janelaAtribuicaoMaxima = 90
+)
+
+// Status informado pelo provedor no retorno e o evento correspondente
+var eventosStatusEnvio = map[string]string{
+	"delivered": disparo.EventoEntregue,
+	"read":      disparo.EventoAberto,
+	"failed":    disparo.EventoFalhou,
+}
+
+const (
+	// Status do retorno em que o provedor informa que o cliente se descadastrou
+	statusEnvioDescadastro = "opted_out"
+	// Status do retorno com a resposta do cliente à mensagem (WhatsApp e SMS)
+	statusEnvioResposta = "reply"
+)
+
+// FUNÇÕES DE RASTREIO DE ENVIOS ------------------------------------------------------------------------------------------------------------------------------------
+
+// RegistrarAberturaService registra a abertura do email pelo pixel
+func (srv *Service) RegistrarAberturaService(token string) *exceptions.RestErr {
+	_, _, restErr := srv.registrarEventoEnvio(token, disparo.EventoAberto, "")
+	return restErr
+}
+
+// RegistrarCliqueService registra o clique em um link rastreado e retorna o endereço original. Com o link válido, uma
+// falha ao gravar o clique não impede o redirecionamento.
+func (srv *Service) RegistrarCliqueService(token, destino, assinatura string) (string, *exceptions.RestErr) {
+	rastreador := srv.rastreador()
+	if rastreador == nil {
+		return "", exceptions.NewNotFoundError("Tracking is not configured")
+	}
+	if destino == "" || !rastreador.ValidarClique(token, destino, assinatura) {
+		return "", exceptions.NewBadRequestError("Invalid tracking link")
+	}
+
+	if _, _, restErr := srv.registrarEventoEnvio(token, disparo.EventoClicado, ""); restErr != nil {
+		zap.L().Warn("Click not registered", zap.String("error", restErr.Error()))
+	}
+	return destino, nil
+}
+
+// RegistrarStatusEnvioService registra o status de entrega informado pelo provedor do canal. O descadastro pelo
+// provedor e a resposta do cliente com uma palavra de descadastro (ex: SAIR) revogam o consentimento no canal;
+// as demais respostas são ignoradas. A assinatura é a da URL de retorno, que só o provedor recebe.
+func (srv *Service) RegistrarStatusEnvioService(token, assinatura string, request dtos.StatusEnvioRequest) *exceptions.RestErr {
+	zap.L().Info("Starting registrar status envio service", zap.String("status", request.Status))
+
+	rastreador := srv.rastreador()
+	if rastreador == nil {
+		return exceptions.NewNotFoundError("Tracking is not configured")
+	}
+	if !rastreador.ValidarStatus(token, assinatura) {
+		return exceptions.NewBadRequestError("Invalid status callback signature")
+	}
+
+	switch request.Status {
+	case statusEnvioDescadastro:
+		return srv.descadastrarEnvio(token, entity.ConsentimentoOrigemProvedor, "")
+	case statusEnvioResposta:
+		if disparo.PalavraDescadastro(request.Message) {
+			return srv.descadastrarEnvio(token, entity.ConsentimentoOrigemPalavraChave, request.Message)
+		}
+		_, _, restErr := srv.lerTokenRastreio(token)
+		return restErr
+	}
+
+	_, _, restErr := srv.registrarEventoEnvio(token, eventosStatusEnvio[request.Status], request.Error)
+	return restErr
+}
+
+func (srv *Service) rastreador() *disparo.Rastreador {
+	if srv.disparador == nil {
+		return nil
+	}
+	return srv.disparador.Rastreador()
+}
+
+// lerTokenRastreio valida o token das rotas públicas de rastreio e retorna o tenant e o envio
+func (srv *Service) lerTokenRastreio(token string) (string, int, *exceptions.RestErr) {
+	rastreador := srv.rastreador()
+	if rastreador == nil {
+		return "", 0, exceptions.NewNotFoundError("Tracking is not configured")
+	}
+	userID, idEnvio, err := rastreador.LerToken(token)
+	if err != nil {
+		return "", 0, exceptions.NewBadRequestError("Invalid tracking token")
+	}
+	return userID, idEnvio, nil
+}
+
+// registrarEventoEnvio aplica o evento ao envio do token e retorna o tenant e o envio como estava antes do evento.
+// Eventos que não se aplicam (ex: entrega de um envio cancelado) são ignorados sem erro, para o provedor não
+// reenviar o retorno.
+func (srv *Service) registrarEventoEnvio(token, evento, erro string) (string, entity.CampanhaEnvio, *exceptions.RestErr) {
+	userID, idEnvio, restErr := srv.lerTokenRastreio(token)
+	if restErr != nil {
+		return "", entity.CampanhaEnvio{}, restErr
+	}
+
+	if runes := []rune(erro); len(runes) > tamanhoErroEnvio {
+		erro = string(runes[:tamanhoErroEnvio])
+	}
+	var lido entity.CampanhaEnvio
+	aplicado, dbErr := srv.dbClient.RegistrarEventoEnvio(userID, idEnvio, func(envio entity.CampanhaEnvio) (entity.CampanhaEnvio, bool) {
+		lido = envio
+		return disparo.AplicarEvento(envio, evento, erro, time.Now())
+	})
+	if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+		return "", entity.CampanhaEnvio{}, exceptions.NewNotFoundError("Envio not found")
+	}
+	if dbErr != nil {
+		return "", entity.CampanhaEnvio{}, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Envio evento registered", zap.String("userID", userID), zap.Int("idEnvio", idEnvio), zap.String("evento", evento), zap.Bool("aplicado", aplicado))
+	return userID, lido, nil
+}
+
+// FUNÇÕES DE MÉTRICAS DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+// metricasCampanha junta os totais dos envios e as vendas atribuídas da campanha e de cada um dos seus públicos
+func (srv *Service) metricasCampanha(userID string, idCampanha int, janelaDias int) (*dtos.MetricasCampanhaResponse, *exceptions.RestErr) {
+	envios, dbErr := srv.dbClient.GetMetricasEnviosCampanha(userID, idCampanha)
+	if dbErr != nil {
+		return nil, exceptions.NewInternalServerError("Error retrieving metricas of campanha")
+	}
+	vendas, dbErr := srv.dbClient.GetVendasAtribuidasCampanha(userID, idCampanha, janelaDias)
+	if dbErr != nil {
+		return nil, exceptions.NewInternalServerError("Error retrieving metricas of campanha")
+	}
+	enviosPublicos, dbErr := srv.dbClient.GetMetricasEnviosPublicos(userID, idCampanha)
+	if dbErr != nil {
+		return nil, exceptions.NewInternalServerError("Error retrieving metricas of campanha")
+	}
+	vendasPublicos, dbErr := srv.dbClient.GetVendasAtribuidasPublicos(userID, idCampanha, janelaDias)
+	if dbErr != nil {
+		return nil, exceptions.NewInternalServerError("Error retrieving metricas of campanha")
+	}
+
+	vendasPorPublico := make(map[int]entity.VendasAtribuidas, len(vendasPublicos))
+	for _, venda := range vendasPublicos {
+		vendasPorPublico[venda.IDPublico] = venda
+	}
+
+	response := &dtos.MetricasCampanhaResponse{
+		JanelaDias:             janelaDias,
+		MetricasEnviosResponse: buildMetricasEnviosResponse(*envios, *vendas),
+		Publicos:               make([]dtos.MetricasPublicoResponse, len(enviosPublicos)),
+	}
+	for i, publico := range enviosPublicos {
+		response.Publicos[i] = dtos.MetricasPublicoResponse{
+			IDPublico:              publico.IDPublico,
+			Nome:                   publico.NomePublico,
+			MetricasEnviosResponse: buildMetricasEnviosResponse(publico, vendasPorPublico[publico.IDPublico]),
+		}
+	}
+	return response, nil
+}
+
+// buildMetricasEnviosResponse calcula as taxas sobre os envios que saíram; a conversão é sobre os clientes
+func buildMetricasEnviosResponse(envios entity.MetricasEnvios, vendas entity.VendasAtribuidas) dtos.MetricasEnviosResponse {
+	return dtos.MetricasEnviosResponse{
+		Envios:          envios.Envios,
+		Destinatarios:   envios.Destinatarios,
+		NaFila:          envios.NaFila,
+		Enviados:        envios.Enviados,
+		Entregues:       envios.Entregues,
+		Abertos:         envios.Abertos,
+		Clicados:        envios.Clicados,
+		Falhas:          envios.Falhas,
+		Descadastros:    envios.Descadastros,
+		Cancelados:      envios.Cancelados,
+		TaxaEntrega:     disparo.Taxa(envios.Entregues, envios.Enviados),
+		TaxaAbertura:    disparo.Taxa(envios.Abertos, envios.Enviados),
+		TaxaClique:      disparo.Taxa(envios.Clicados, envios.Enviados),
+		TaxaDescadastro: disparo.Taxa(envios.Descadastros, envios.Enviados),
+		Vendas:          vendas.Vendas,
+		Compradores:     vendas.Compradores,
+		Receita:         vendas.Receita,
+		TaxaConversao:   disparo.Taxa(vendas.Compradores, envios.Destinatarios),
+	}
+}
+
+// janelaAtribuicao valida a janela de atribuição informada, em dias
+func janelaAtribuicao(janelaDias int) int {
+	if janelaDias < 1 || janelaDias > janelaAtribuicaoMaxima {
+		return janelaAtribuicaoPadrao
+	}
+	return janelaDias
+}
@@ -0,0 +1,320 @@
+package service
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/disparo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+const segredoRastreioTeste = "segredo-teste"
+
+// disparadorComRastreio monta um disparador com o rastreio ligado, como no main
+func disparadorComRastreio() *disparo.Disparador {
+	disparador := disparo.NewDisparador(new(MockFila), nil)
+	disparador.DefinirRastreador(disparo.NewRastreador("https://api.loja.com.br", segredoRastreioTeste))
+	return disparador
+}
+
+// assinaturaLink extrai a assinatura de um link de clique ou da URL de retorno de status
+func assinaturaLink(t *testing.T, link string) string {
+	endereco, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("invalid link %q: %v", link, err)
+	}
+	return endereco.Query().Get("assinatura")
+}
+
+// aplicarEventoEnvio executa, sobre o envio informado, a função que o serviço passa para RegistrarEventoEnvio e
+// guarda o envio resultante
+func aplicarEventoEnvio(envio entity.CampanhaEnvio, resultado *entity.CampanhaEnvio) func(mock.Arguments) {
+	return func(args mock.Arguments) {
+		aplicar := args.Get(2).(func(entity.CampanhaEnvio) (entity.CampanhaEnvio, bool))
+		*resultado, _ = aplicar(envio)
+	}
+}
+
+// TESTES PARA RegistrarAberturaService
+func TestService_RegistrarAberturaService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+	token := disparador.Rastreador().Token("1", 9)
+
+	var resultado entity.CampanhaEnvio
+	mockDBClient.On("RegistrarEventoEnvio", "1", 9, mock.Anything).
+		Run(aplicarEventoEnvio(entity.CampanhaEnvio{ID: 9, Status: entity.EnvioStatusEnviado}, &resultado)).
+		Return(true, nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	err := service.RegistrarAberturaService(token)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, entity.EnvioStatusAberto, resultado.Status)
+	assert.NotNil(t, resultado.DataAbertura)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarAberturaService_InvalidToken(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	outro := disparo.NewRastreador("https://api.loja.com.br", "outro-segredo")
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparadorComRastreio(),
+	}
+
+	// Act
+	err := service.RegistrarAberturaService(outro.Token("1", 9))
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, "Invalid tracking token", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarAberturaService_EnvioNotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+
+	mockDBClient.On("RegistrarEventoEnvio", "1", 9, mock.Anything).Return(false, gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	err := service.RegistrarAberturaService(disparador.Rastreador().Token("1", 9))
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, "Envio not found", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarAberturaService_RastreioDesligado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparo.NewDisparador(new(MockFila), nil),
+	}
+
+	// Act
+	err := service.RegistrarAberturaService("token")
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, "Tracking is not configured", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA RegistrarCliqueService
+func TestService_RegistrarCliqueService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+	token := disparador.Rastreador().Token("1", 9)
+	destino := "https://loja.com.br/banho"
+
+	assinatura := assinaturaLink(t, disparador.Rastreador().LinkClique(token, destino))
+
+	// A falha ao gravar o clique não impede o redirecionamento
+	mockDBClient.On("RegistrarEventoEnvio", "1", 9, mock.Anything).Return(false, errors.New("database error"))
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	result, err := service.RegistrarCliqueService(token, destino, assinatura)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, destino, result)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarCliqueService_DestinoAdulterado(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+	token := disparador.Rastreador().Token("1", 9)
+
+	assinatura := assinaturaLink(t, disparador.Rastreador().LinkClique(token, "https://loja.com.br/banho"))
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	result, err := service.RegistrarCliqueService(token, "https://outro.site", assinatura)
+
+	// Assert
+	assert.Equal(t, "", result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Invalid tracking link", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA RegistrarStatusEnvioService
+func TestService_RegistrarStatusEnvioService_Falhou(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+	token := disparador.Rastreador().Token("1", 9)
+
+	assinatura := assinaturaLink(t, disparador.Rastreador().RetornoStatus(token))
+
+	var resultado entity.CampanhaEnvio
+	mockDBClient.On("RegistrarEventoEnvio", "1", 9, mock.Anything).
+		Run(aplicarEventoEnvio(entity.CampanhaEnvio{ID: 9, Status: entity.EnvioStatusEnviado}, &resultado)).
+		Return(true, nil)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	err := service.RegistrarStatusEnvioService(token, assinatura, dtos.StatusEnvioRequest{Status: "failed", Error: "mailbox full"})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, entity.EnvioStatusFalhou, resultado.Status)
+	assert.Equal(t, "mailbox full", *resultado.Erro)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarStatusEnvioService_RespostaIgnorada(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+	token := disparador.Rastreador().Token("1", 9)
+
+	assinatura := assinaturaLink(t, disparador.Rastreador().RetornoStatus(token))
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	err := service.RegistrarStatusEnvioService(token, assinatura, dtos.StatusEnvioRequest{Status: "reply", Message: "Obrigado!"})
+
+	// Assert
+	assert.Nil(t, err)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_RegistrarStatusEnvioService_InvalidSignature(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+	disparador := disparadorComRastreio()
+	token := disparador.Rastreador().Token("1", 9)
+
+	service := &Service{
+		dbClient:   mockDBClient,
+		disparador: disparador,
+	}
+
+	// Act
+	err := service.RegistrarStatusEnvioService(token, "assinatura", dtos.StatusEnvioRequest{Status: "delivered"})
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Equal(t, "Invalid status callback signature", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA GetCampanhaByIDService (métricas)
+func TestService_GetCampanhaByIDService_Metricas(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetCampanhaByID", "5", "1").Return(&entity.Campanha{ID: 5, Nome: "Banho", Status: entity.CampanhaStatusAtiva})
+	mockDBClient.On("GetMetricasEnviosCampanha", "1", 5).Return(&entity.MetricasEnvios{Envios: 12, Destinatarios: 10, Enviados: 10, Entregues: 8, Abertos: 4}, nil)
+	mockDBClient.On("GetVendasAtribuidasCampanha", "1", 5, janelaAtribuicaoPadrao).Return(&entity.VendasAtribuidas{Vendas: 3, Compradores: 2, Receita: 150}, nil)
+	mockDBClient.On("GetMetricasEnviosPublicos", "1", 5).Return([]entity.MetricasEnvios{
+		{IDPublico: 1, NomePublico: "Cães", Envios: 8, Destinatarios: 6, Enviados: 6},
+		{IDPublico: 2, NomePublico: "Gatos", Envios: 4, Destinatarios: 4, Enviados: 4},
+	}, nil)
+	mockDBClient.On("GetVendasAtribuidasPublicos", "1", 5, janelaAtribuicaoPadrao).Return([]entity.VendasAtribuidas{
+		{IDPublico: 2, Vendas: 1, Compradores: 1, Receita: 50},
+	}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetCampanhaByIDService("1", "5", 0)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, janelaAtribuicaoPadrao, result.Metricas.JanelaDias)
+	assert.Equal(t, disparo.Taxa(8, 10), result.Metricas.TaxaEntrega)
+	assert.Equal(t, disparo.Taxa(2, 10), result.Metricas.TaxaConversao)
+	assert.Equal(t, 150.0, result.Metricas.Receita)
+	assert.Len(t, result.Metricas.Publicos, 2)
+	assert.Equal(t, 0, result.Metricas.Publicos[0].Vendas)
+	assert.Equal(t, 50.0, result.Metricas.Publicos[1].Receita)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_GetCampanhaByIDService_MetricasDBError(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetCampanhaByID", "5", "1").Return(&entity.Campanha{ID: 5})
+	mockDBClient.On("GetMetricasEnviosCampanha", "1", 5).Return(nil, errors.New("database error"))
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetCampanhaByIDService("1", "5", 30)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Error retrieving metricas of campanha", err.Message)
+	assert.Equal(t, 500, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
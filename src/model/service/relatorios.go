@@ -0,0 +1,218 @@
+package service
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/valorizacao"
+	"go.uber.org/zap"
+)
+
+// Agrupamentos aceitos pelo relatório de valorização
+const (
+	AgrupamentoProduto    = "produto"
+	AgrupamentoCategoria  = "categoria"
+	AgrupamentoFornecedor = "fornecedor"
+)
+
+// produtoValorizado guarda a valorização de um produto junto com os dados usados nos agrupamentos
+type produtoValorizado struct {
+	IDProduto      int
+	NomeProduto    string
+	Categoria      string
+	IDFornecedor   int
+	NomeFornecedor string
+	PrecoVenda     float64
+	Resultado      valorizacao.Resultado
+}
+
+// FUNÇÕES DE RELATÓRIOS ------------------------------------------------------------------------------------------------------------------------------------
+
+// GetValorizacaoEstoqueService valoriza o estoque na data de corte por custo médio ponderado e PEPS
+func (srv *Service) GetValorizacaoEstoqueService(userID string, dataCorte string, agrupamento string) (*dtos.ValorizacaoEstoqueResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting valorizacao estoque service", zap.String("data_corte", dataCorte), zap.String("agrupamento", agrupamento))
+
+	if agrupamento == "" {
+		agrupamento = AgrupamentoProduto
+	}
+	if agrupamento != AgrupamentoProduto && agrupamento != AgrupamentoCategoria && agrupamento != AgrupamentoFornecedor {
+		return nil, exceptions.NewBadRequestError("Invalid agrupamento, expected 'produto', 'categoria' or 'fornecedor'")
+	}
+
+	dataCorte, restErr := normalizarDataCorte(dataCorte)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	produtos, restErr := srv.valorizarProdutos(userID, dataCorte)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	// Agrupar os produtos já valorizados; a valorização é sempre feita por produto
+	grupos := map[string]*dtos.ValorizacaoEstoqueItemResponse{}
+	var ordem []string
+	for _, produto := range produtos {
+		var chave string
+		item := dtos.ValorizacaoEstoqueItemResponse{}
+		switch agrupamento {
+		case AgrupamentoProduto:
+			chave = strconv.Itoa(produto.IDProduto)
+			item.ID, item.Nome = produto.IDProduto, produto.NomeProduto
+		case AgrupamentoCategoria:
+			chave = produto.Categoria
+			item.Nome = produto.Categoria
+		case AgrupamentoFornecedor:
+			chave = strconv.Itoa(produto.IDFornecedor)
+			item.ID, item.Nome = produto.IDFornecedor, produto.NomeFornecedor
+		}
+
+		grupo, ok := grupos[chave]
+		if !ok {
+			grupo = &item
+			grupos[chave] = grupo
+			ordem = append(ordem, chave)
+		}
+		grupo.Quantidade += produto.Resultado.Quantidade
+		grupo.ValorCustoMedio += produto.Resultado.ValorCMP
+		grupo.ValorPEPS += produto.Resultado.ValorPEPS
+		grupo.ValorVenda += float64(produto.Resultado.Quantidade) * produto.PrecoVenda
+	}
+
+	response := &dtos.ValorizacaoEstoqueResponse{
+		DataCorte:   dataCorte,
+		Agrupamento: agrupamento,
+		Itens:       []dtos.ValorizacaoEstoqueItemResponse{},
+	}
+	for _, chave := range ordem {
+		grupo := grupos[chave]
+		if grupo.Quantidade > 0 {
+			grupo.CustoMedio = valorizacao.Arredondar(grupo.ValorCustoMedio / float64(grupo.Quantidade))
+		}
+		grupo.ValorCustoMedio = valorizacao.Arredondar(grupo.ValorCustoMedio)
+		grupo.ValorPEPS = valorizacao.Arredondar(grupo.ValorPEPS)
+		grupo.ValorVenda = valorizacao.Arredondar(grupo.ValorVenda)
+
+		response.TotalQuantidade += grupo.Quantidade
+		response.TotalCustoMedio += grupo.ValorCustoMedio
+		response.TotalPEPS += grupo.ValorPEPS
+		response.TotalVenda += grupo.ValorVenda
+		response.Itens = append(response.Itens, *grupo)
+	}
+
+	sort.SliceStable(response.Itens, func(i, j int) bool {
+		return response.Itens[i].Nome < response.Itens[j].Nome
+	})
+
+	response.TotalCustoMedio = valorizacao.Arredondar(response.TotalCustoMedio)
+	response.TotalPEPS = valorizacao.Arredondar(response.TotalPEPS)
+	response.TotalVenda = valorizacao.Arredondar(response.TotalVenda)
+
+	zap.L().Info("Valorizacao estoque service completed successfully", zap.Int("itens", len(response.Itens)), zap.Float64("total_custo_medio", response.TotalCustoMedio))
+	return response, nil
+}
+
+// GetMargemBrutaService calcula a margem bruta de cada produto comparando o PrecoVenda com o custo médio na data de corte
+func (srv *Service) GetMargemBrutaService(userID string, dataCorte string) (*dtos.MargemBrutaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting margem bruta service", zap.String("data_corte", dataCorte))
+
+	dataCorte, restErr := normalizarDataCorte(dataCorte)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	produtos, restErr := srv.valorizarProdutos(userID, dataCorte)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := &dtos.MargemBrutaResponse{
+		DataCorte: dataCorte,
+		Produtos:  []dtos.MargemBrutaItemResponse{},
+	}
+
+	var somaPercentuais float64
+	for _, produto := range produtos {
+		margemUnitaria, margemPercentual := valorizacao.Margem(produto.PrecoVenda, produto.Resultado.CustoMedio)
+		margemPotencial := valorizacao.Arredondar(margemUnitaria * float64(produto.Resultado.Quantidade))
+
+		response.Produtos = append(response.Produtos, dtos.MargemBrutaItemResponse{
+			IDProduto:        produto.IDProduto,
+			NomeProduto:      produto.NomeProduto,
+			Categoria:        produto.Categoria,
+			PrecoVenda:       produto.PrecoVenda,
+			CustoMedio:       produto.Resultado.CustoMedio,
+			MargemUnitaria:   margemUnitaria,
+			MargemPercentual: margemPercentual,
+			Quantidade:       produto.Resultado.Quantidade,
+			MargemPotencial:  margemPotencial,
+		})
+		response.MargemPotencialTotal += margemPotencial
+		somaPercentuais += margemPercentual
+	}
+
+	response.MargemPotencialTotal = valorizacao.Arredondar(response.MargemPotencialTotal)
+	if len(response.Produtos) > 0 {
+		response.MargemPercentualMedia = valorizacao.Arredondar(somaPercentuais / float64(len(response.Produtos)))
+	}
+
+	zap.L().Info("Margem bruta service completed successfully", zap.Int("produtos", len(response.Produtos)))
+	return response, nil
+}
+
+// valorizarProdutos busca os lotes até a data de corte e calcula a valorização de cada produto
+func (srv *Service) valorizarProdutos(userID string, dataCorte string) ([]produtoValorizado, *exceptions.RestErr) {
+	lotes, dbErr := srv.dbClient.GetLotesValorizacao(userID, dataCorte)
+	if dbErr != nil {
+		zap.L().Error("Error getting lotes for valorizacao", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	lotesPorProduto := map[int][]valorizacao.Lote{}
+	dadosProduto := map[int]entity.LoteValorizacao{}
+	var ordem []int
+	for _, lote := range lotes {
+		if _, ok := dadosProduto[lote.IDProduto]; !ok {
+			dadosProduto[lote.IDProduto] = lote
+			ordem = append(ordem, lote.IDProduto)
+		}
+		// O saldo atual menos as movimentações após o corte reconstrói o saldo do lote na data de corte
+		lotesPorProduto[lote.IDProduto] = append(lotesPorProduto[lote.IDProduto], valorizacao.Lote{
+			DataEntrada:        lote.DataEntrada,
+			QuantidadeRecebida: lote.QuantidadeRecebida,
+			SaldoNaData:        lote.Quantidade - lote.MovimentadoAposCorte,
+			CustoUnitario:      lote.CustoUnitario,
+		})
+	}
+
+	produtos := make([]produtoValorizado, 0, len(ordem))
+	for _, idProduto := range ordem {
+		dados := dadosProduto[idProduto]
+		produtos = append(produtos, produtoValorizado{
+			IDProduto:      dados.IDProduto,
+			NomeProduto:    dados.NomeProduto,
+			Categoria:      dados.Categoria,
+			IDFornecedor:   dados.IDFornecedor,
+			NomeFornecedor: dados.NomeFornecedor,
+			PrecoVenda:     dados.PrecoVenda,
+			Resultado:      valorizacao.Calcular(lotesPorProduto[idProduto]),
+		})
+	}
+
+	return produtos, nil
+}
+
+// normalizarDataCorte valida a data no formato YYYY-MM-DD; vazia significa hoje
+func normalizarDataCorte(dataCorte string) (string, *exceptions.RestErr) {
+	if dataCorte == "" {
+		return time.Now().Format("2006-01-02"), nil
+	}
+	if _, err := time.Parse("2006-01-02", dataCorte); err != nil {
+		return "", exceptions.NewBadRequestError("Invalid data_corte, expected format YYYY-MM-DD")
+	}
+	return dataCorte, nil
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/rfm"
+
+	redis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// rfmLimitesTTL é por quanto tempo os limites das notas ficam em cache para pontuar um cliente sozinho
+const rfmLimitesTTL = time.Hour
+
+// clienteRFM junta o resumo de compras do cliente com as notas calculadas
+type clienteRFM struct {
+	compras   entity.ComprasCliente
+	resultado rfm.Resultado
+}
+
+func (srv *Service) GetRFMClientesService(userID string, segmento string, page, limit int) (*dtos.RFMClienteListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get RFM clientes service", zap.String("segmento", segmento), zap.Int("page", page), zap.Int("limit", limit))
+
+	if restErr := validarSegmentoRFM(segmento); restErr != nil {
+		return nil, restErr
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	filtrados, segmentos, restErr := srv.filtrarRFMClientes(userID, segmento)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	total := len(filtrados)
+	inicio := (page - 1) * limit
+	if inicio > total {
+		inicio = total
+	}
+	fim := inicio + limit
+	if fim > total {
+		fim = total
+	}
+
+	clienteResponses := make([]dtos.RFMClienteResponse, 0, fim-inicio)
+	for _, cliente := range filtrados[inicio:fim] {
+		clienteResponses = append(clienteResponses, buildRFMClienteResponse(cliente))
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	response := &dtos.RFMClienteListResponse{
+		Clientes:   clienteResponses,
+		Segmentos:  segmentos,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+
+	zap.L().Info("Successfully retrieved RFM clientes", zap.Int("total", total), zap.Int("page", page))
+	return response, nil
+}
+
+func validarSegmentoRFM(segmento string) *exceptions.RestErr {
+	if segmento != "" && !rfm.ValidoSegmento(segmento) {
+		return exceptions.NewBadRequestError("Invalid segmento")
+	}
+	return nil
+}
+
+// filtrarRFMClientes devolve os clientes do segmento (todos, se vazio) ordenados e a distribuição de todos os clientes por segmento
+func (srv *Service) filtrarRFMClientes(userID string, segmento string) ([]clienteRFM, map[string]int, *exceptions.RestErr) {
+	clientes, restErr := srv.calcularRFMClientes(userID)
+	if restErr != nil {
+		return nil, nil, restErr
+	}
+
+	// Distribuição de todos os clientes, antes do filtro
+	segmentos := make(map[string]int, len(rfm.Segmentos))
+	for _, s := range rfm.Segmentos {
+		segmentos[s] = 0
+	}
+	var filtrados []clienteRFM
+	for _, cliente := range clientes {
+		segmentos[cliente.resultado.Segmento]++
+		if segmento == "" || cliente.resultado.Segmento == segmento {
+			filtrados = append(filtrados, cliente)
+		}
+	}
+
+	// Melhores segmentos primeiro e, dentro do segmento, quem mais gastou
+	ordem := make(map[string]int, len(rfm.Segmentos))
+	for i, s := range rfm.Segmentos {
+		ordem[s] = i
+	}
+	sort.SliceStable(filtrados, func(i, j int) bool {
+		a, b := filtrados[i].resultado, filtrados[j].resultado
+		if ordem[a.Segmento] != ordem[b.Segmento] {
+			return ordem[a.Segmento] < ordem[b.Segmento]
+		}
+		return a.Monetario > b.Monetario
+	})
+	return filtrados, segmentos, nil
+}
+
+// getRFMCliente pontua o cliente informado pelos limites das notas em cache, sem recalcular a base inteira
+func (srv *Service) getRFMCliente(userID string, idCliente int) (*dtos.RFMResponse, *exceptions.RestErr) {
+	compras, dbErr := srv.dbClient.GetComprasCliente(idCliente, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting compras of cliente from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	entrada, restErr := buildComprasRFM(*compras)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	limites, restErr := srv.limitesRFM(userID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := buildRFMResponse(clienteRFM{compras: *compras, resultado: rfm.Pontuar(entrada, limites, time.Now())})
+	return &response, nil
+}
+
+// limitesRFM lê do cache os limites das notas; sem cache, calcula a base inteira, o que também o preenche
+func (srv *Service) limitesRFM(userID string) (rfm.Limites, *exceptions.RestErr) {
+	var limites rfm.Limites
+	if srv.redis != nil {
+		cache, err := srv.redis.Get(context.Background(), chaveLimitesRFM(userID)).Result()
+		if err == nil && json.Unmarshal([]byte(cache), &limites) == nil {
+			return limites, nil
+		}
+		if err != nil && err != redis.Nil {
+			zap.L().Warn("Error getting RFM limites from Redis, recalculating", zap.Error(err))
+		}
+	}
+
+	entradas, restErr := srv.comprasRFM(userID)
+	if restErr != nil {
+		return limites, restErr
+	}
+	limites = rfm.CalcularLimites(entradas, time.Now())
+	srv.guardarLimitesRFM(userID, limites)
+	return limites, nil
+}
+
+func (srv *Service) guardarLimitesRFM(userID string, limites rfm.Limites) {
+	if srv.redis == nil {
+		return
+	}
+	dados, err := json.Marshal(limites)
+	if err == nil {
+		err = srv.redis.Set(context.Background(), chaveLimitesRFM(userID), dados, rfmLimitesTTL).Err()
+	}
+	if err != nil {
+		zap.L().Warn("Error storing RFM limites in Redis", zap.Error(err))
+	}
+}
+
+func chaveLimitesRFM(userID string) string {
+	return fmt.Sprintf("user:%s:rfm_limites", userID)
+}
+
+// segmentosRFM agrupa os IDs dos clientes por segmento RFM, para as regras de público que usam o segmento
+func (srv *Service) segmentosRFM(userID string) (map[string][]int, *exceptions.RestErr) {
+	clientes, restErr := srv.calcularRFMClientes(userID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	segmentos := make(map[string][]int, len(rfm.Segmentos))
+	for _, cliente := range clientes {
+		segmentos[cliente.resultado.Segmento] = append(segmentos[cliente.resultado.Segmento], cliente.compras.IDCliente)
+	}
+	return segmentos, nil
+}
+
+// calcularRFMClientes calcula as notas de todos os clientes, já que cada nota depende da comparação com os demais,
+// e renova no cache os limites usados por getRFMCliente
+func (srv *Service) calcularRFMClientes(userID string) ([]clienteRFM, *exceptions.RestErr) {
+	compras, dbErr := srv.dbClient.GetComprasClientes(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting compras of clientes from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	entradas, restErr := buildComprasRFMList(compras)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	referencia := time.Now()
+	resultados := rfm.Calcular(entradas, referencia)
+	srv.guardarLimitesRFM(userID, rfm.CalcularLimites(entradas, referencia))
+
+	clientes := make([]clienteRFM, len(compras))
+	for i := range compras {
+		clientes[i] = clienteRFM{compras: compras[i], resultado: resultados[i]}
+	}
+	return clientes, nil
+}
+
+// comprasRFM lê o resumo de compras de todos os clientes no formato do cálculo
+func (srv *Service) comprasRFM(userID string) ([]rfm.Compras, *exceptions.RestErr) {
+	compras, dbErr := srv.dbClient.GetComprasClientes(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting compras of clientes from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	return buildComprasRFMList(compras)
+}
+
+func buildComprasRFMList(compras []entity.ComprasCliente) ([]rfm.Compras, *exceptions.RestErr) {
+	entradas := make([]rfm.Compras, len(compras))
+	for i, c := range compras {
+		entrada, restErr := buildComprasRFM(c)
+		if restErr != nil {
+			return nil, restErr
+		}
+		entradas[i] = entrada
+	}
+	return entradas, nil
+}
+
+func buildComprasRFM(c entity.ComprasCliente) (rfm.Compras, *exceptions.RestErr) {
+	entrada := rfm.Compras{
+		IDCliente:  c.IDCliente,
+		Frequencia: c.Frequencia,
+		Monetario:  c.ValorVendas - c.ValorDevolvido,
+	}
+	if c.UltimaCompra != nil {
+		ultimaCompra, err := time.ParseInLocation(formatoDataHora, *c.UltimaCompra, time.Local)
+		if err != nil {
+			zap.L().Error("Error parsing ultima compra", zap.Int("id_cliente", c.IDCliente), zap.Error(err))
+			return entrada, exceptions.NewInternalServerError("Internal server error")
+		}
+		entrada.UltimaCompra = ultimaCompra
+	}
+	return entrada, nil
+}
+
+func buildRFMClienteResponse(cliente clienteRFM) dtos.RFMClienteResponse {
+	return dtos.RFMClienteResponse{
+		IDCliente:   cliente.compras.IDCliente,
+		NomeCliente: cliente.compras.NomeCliente,
+		RFMResponse: buildRFMResponse(cliente),
+	}
+}
+
+func buildRFMResponse(cliente clienteRFM) dtos.RFMResponse {
+	response := dtos.RFMResponse{
+		UltimaCompra: cliente.compras.UltimaCompra,
+		Frequencia:   cliente.resultado.Frequencia,
+		Monetario:    cliente.resultado.Monetario,
+		NotaR:        cliente.resultado.R,
+		NotaF:        cliente.resultado.F,
+		NotaM:        cliente.resultado.M,
+		Segmento:     cliente.resultado.Segmento,
+	}
+	if cliente.resultado.RecenciaDias >= 0 {
+		recencia := cliente.resultado.RecenciaDias
+		response.RecenciaDias = &recencia
+	}
+	if cliente.resultado.Frequencia > 0 {
+		response.TicketMedio = rfm.TicketMedio(cliente.resultado.Monetario, cliente.resultado.Frequencia)
+	}
+	return response
+}
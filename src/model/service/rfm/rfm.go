@@ -0,0 +1,239 @@
+package rfm
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Segmentos RFM
+const (
+	SegmentoCampeoes        = "campeoes"
+	SegmentoLeais           = "leais"
+	SegmentoNovos           = "novos"
+	SegmentoPrecisamAtencao = "precisam_atencao"
+	SegmentoEmRisco         = "em_risco"
+	SegmentoHibernando      = "hibernando"
+	SegmentoSemCompras      = "sem_compras"
+)
+
+// Segmentos na ordem do melhor para o pior
+var Segmentos = []string{
+	SegmentoCampeoes,
+	SegmentoLeais,
+	SegmentoNovos,
+	SegmentoPrecisamAtencao,
+	SegmentoEmRisco,
+	SegmentoHibernando,
+	SegmentoSemCompras,
+}
+
+// Criterios liga o nome do critério de público (tabela criterios) ao segmento
+var Criterios = map[string]string{
+	"RFM Campeões":            SegmentoCampeoes,
+	"RFM Leais":               SegmentoLeais,
+	"RFM Novos":               SegmentoNovos,
+	"RFM Precisam de Atenção": SegmentoPrecisamAtencao,
+	"RFM Em Risco":            SegmentoEmRisco,
+	"RFM Hibernando":          SegmentoHibernando,
+	"RFM Sem Compras":         SegmentoSemCompras,
+}
+
+// Compras resume o histórico de um cliente; UltimaCompra é zero se ele nunca comprou
+type Compras struct {
+	IDCliente    int
+	UltimaCompra time.Time
+	Frequencia   int
+	Monetario    float64
+}
+
+// Resultado do cliente; sem compras, RecenciaDias é -1 e as notas são zero
+type Resultado struct {
+	IDCliente    int
+	RecenciaDias int
+	Frequencia   int
+	Monetario    float64
+	R, F, M      int
+	Segmento     string
+}
+
+// Limites guarda, em cada dimensão, o menor valor que alcança as notas 2 a 5 na base de clientes
+// (maior é melhor; a recência é negativa, como em Calcular). Nota sem nenhum valor fica com math.MaxFloat64.
+type Limites struct {
+	Recencia   [4]float64 `json:"recencia"`
+	Frequencia [4]float64 `json:"frequencia"`
+	Monetario  [4]float64 `json:"monetario"`
+}
+
+// ValidoSegmento informa se o segmento existe
+func ValidoSegmento(segmento string) bool {
+	for _, s := range Segmentos {
+		if s == segmento {
+			return true
+		}
+	}
+	return false
+}
+
+// Calcular dá notas de 1 a 5 para recência, frequência e valor de cada cliente, comparando-o com os demais
+// clientes que compraram (quintis pelo posto médio, então empates recebem a mesma nota), e define o segmento.
+func Calcular(compras []Compras, referencia time.Time) []Resultado {
+	resultados := make([]Resultado, len(compras))
+	var recencias, frequencias, monetarios []float64
+	for i, c := range compras {
+		resultados[i] = Resultado{IDCliente: c.IDCliente, RecenciaDias: -1, Frequencia: c.Frequencia, Monetario: arredondar(c.Monetario)}
+		if c.Frequencia == 0 || c.UltimaCompra.IsZero() {
+			resultados[i].Frequencia = 0
+			resultados[i].Segmento = SegmentoSemCompras
+			continue
+		}
+		dias := recenciaDias(c.UltimaCompra, referencia)
+		resultados[i].RecenciaDias = dias
+		// Recência menor é melhor: negativa para que "maior é melhor" valha nas três dimensões
+		recencias = append(recencias, -float64(dias))
+		frequencias = append(frequencias, float64(c.Frequencia))
+		monetarios = append(monetarios, c.Monetario)
+	}
+
+	for i := range resultados {
+		if resultados[i].Segmento == SegmentoSemCompras {
+			continue
+		}
+		resultados[i].R = Nota(-float64(resultados[i].RecenciaDias), recencias)
+		resultados[i].F = Nota(float64(resultados[i].Frequencia), frequencias)
+		resultados[i].M = Nota(compras[i].Monetario, monetarios)
+		resultados[i].Segmento = Segmento(resultados[i].R, resultados[i].F, resultados[i].M)
+	}
+	return resultados
+}
+
+// CalcularLimites resume a base de clientes nos limites de cada nota, para pontuar um cliente com Pontuar
+// sem recalcular todos. Para quem está na base, Pontuar dá as mesmas notas de Calcular.
+func CalcularLimites(compras []Compras, referencia time.Time) Limites {
+	var recencias, frequencias, monetarios []float64
+	for _, c := range compras {
+		if c.Frequencia == 0 || c.UltimaCompra.IsZero() {
+			continue
+		}
+		recencias = append(recencias, -float64(recenciaDias(c.UltimaCompra, referencia)))
+		frequencias = append(frequencias, float64(c.Frequencia))
+		monetarios = append(monetarios, c.Monetario)
+	}
+	return Limites{
+		Recencia:   limitesNota(recencias),
+		Frequencia: limitesNota(frequencias),
+		Monetario:  limitesNota(monetarios),
+	}
+}
+
+// Pontuar dá as notas e o segmento de um cliente pelos limites da base calculados por CalcularLimites
+func Pontuar(c Compras, limites Limites, referencia time.Time) Resultado {
+	resultado := Resultado{IDCliente: c.IDCliente, RecenciaDias: -1, Frequencia: c.Frequencia, Monetario: arredondar(c.Monetario)}
+	if c.Frequencia == 0 || c.UltimaCompra.IsZero() {
+		resultado.Frequencia = 0
+		resultado.Segmento = SegmentoSemCompras
+		return resultado
+	}
+	resultado.RecenciaDias = recenciaDias(c.UltimaCompra, referencia)
+	resultado.R = notaPorLimites(-float64(resultado.RecenciaDias), limites.Recencia)
+	resultado.F = notaPorLimites(float64(c.Frequencia), limites.Frequencia)
+	resultado.M = notaPorLimites(c.Monetario, limites.Monetario)
+	resultado.Segmento = Segmento(resultado.R, resultado.F, resultado.M)
+	return resultado
+}
+
+// Nota retorna o quintil (1 a 5) do valor entre os valores informados, onde maior é melhor.
+// Usa o posto médio: metade dos empates conta como abaixo do valor.
+func Nota(valor float64, valores []float64) int {
+	if len(valores) == 0 {
+		return 0
+	}
+	var abaixo, iguais int
+	for _, v := range valores {
+		switch {
+		case v < valor:
+			abaixo++
+		case v == valor:
+			iguais++
+		}
+	}
+	return notaPercentil((float64(abaixo) + float64(iguais)/2) / float64(len(valores)))
+}
+
+func notaPercentil(percentil float64) int {
+	nota := 1 + int(percentil*5)
+	if nota > 5 {
+		nota = 5
+	}
+	return nota
+}
+
+// limitesNota percorre os valores em ordem, em grupos de empates, e guarda o primeiro que alcança cada nota
+func limitesNota(valores []float64) [4]float64 {
+	limites := [4]float64{math.MaxFloat64, math.MaxFloat64, math.MaxFloat64, math.MaxFloat64}
+	ordenados := append([]float64(nil), valores...)
+	sort.Float64s(ordenados)
+	for i := 0; i < len(ordenados); {
+		j := i
+		for j < len(ordenados) && ordenados[j] == ordenados[i] {
+			j++
+		}
+		nota := notaPercentil((float64(i) + float64(j-i)/2) / float64(len(ordenados)))
+		for k := 0; k <= nota-2; k++ {
+			if limites[k] == math.MaxFloat64 {
+				limites[k] = ordenados[i]
+			}
+		}
+		i = j
+	}
+	return limites
+}
+
+func notaPorLimites(valor float64, limites [4]float64) int {
+	nota := 1
+	for _, limite := range limites {
+		if valor >= limite {
+			nota++
+		}
+	}
+	return nota
+}
+
+func recenciaDias(ultimaCompra, referencia time.Time) int {
+	dias := int(referencia.Sub(ultimaCompra).Hours() / 24)
+	if dias < 0 {
+		dias = 0
+	}
+	return dias
+}
+
+// Segmento classifica o cliente pela recência e pela média de frequência e valor
+func Segmento(r, f, m int) string {
+	fm := (f + m + 1) / 2
+	switch {
+	case r >= 4 && fm >= 4:
+		return SegmentoCampeoes
+	case r >= 3 && fm >= 3:
+		return SegmentoLeais
+	case r >= 4:
+		return SegmentoNovos
+	case r == 3:
+		return SegmentoPrecisamAtencao
+	case fm >= 3:
+		return SegmentoEmRisco
+	default:
+		return SegmentoHibernando
+	}
+}
+
+// TicketMedio é o valor médio por compra
+func TicketMedio(monetario float64, frequencia int) float64 {
+	if frequencia <= 0 {
+		return 0
+	}
+	return arredondar(monetario / float64(frequencia))
+}
+
+func arredondar(valor float64) float64 {
+	return math.Round(valor*100) / 100
+}
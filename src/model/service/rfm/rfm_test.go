@@ -0,0 +1,107 @@
+package rfm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNota(t *testing.T) {
+	valores := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tests := []struct {
+		name     string
+		valor    float64
+		esperado int
+	}{
+		{"menor valor", 1, 1},
+		{"segundo quintil", 3, 2},
+		{"meio", 5, 3},
+		{"quarto quintil", 8, 4},
+		{"maior valor", 10, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.esperado, Nota(tt.valor, valores))
+		})
+	}
+}
+
+func TestNota_Empates(t *testing.T) {
+	assert.Equal(t, 3, Nota(1, []float64{1, 1, 1, 1}))
+	assert.Equal(t, 3, Nota(7, []float64{7}))
+	assert.Equal(t, 0, Nota(7, nil))
+}
+
+func TestSegmento(t *testing.T) {
+	tests := []struct {
+		name     string
+		r, f, m  int
+		esperado string
+	}{
+		{"campeão", 5, 5, 4, SegmentoCampeoes},
+		{"leal", 3, 4, 3, SegmentoLeais},
+		{"novo", 5, 1, 2, SegmentoNovos},
+		{"precisa de atenção", 3, 2, 2, SegmentoPrecisamAtencao},
+		{"em risco", 1, 5, 5, SegmentoEmRisco},
+		{"hibernando", 2, 1, 1, SegmentoHibernando},
+		{"média arredonda para cima", 4, 4, 3, SegmentoCampeoes},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.esperado, Segmento(tt.r, tt.f, tt.m))
+		})
+	}
+}
+
+func TestCalcular(t *testing.T) {
+	referencia := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	compras := []Compras{
+		{IDCliente: 1, UltimaCompra: referencia.AddDate(0, 0, -2), Frequencia: 12, Monetario: 2500},
+		{IDCliente: 2, UltimaCompra: referencia.AddDate(0, 0, -200), Frequencia: 1, Monetario: 40.123},
+		{IDCliente: 3},
+	}
+
+	resultados := Calcular(compras, referencia)
+
+	assert.Len(t, resultados, 3)
+	assert.Equal(t, Resultado{IDCliente: 1, RecenciaDias: 2, Frequencia: 12, Monetario: 2500, R: 4, F: 4, M: 4, Segmento: SegmentoCampeoes}, resultados[0])
+	assert.Equal(t, Resultado{IDCliente: 2, RecenciaDias: 200, Frequencia: 1, Monetario: 40.12, R: 2, F: 2, M: 2, Segmento: SegmentoHibernando}, resultados[1])
+	assert.Equal(t, Resultado{IDCliente: 3, RecenciaDias: -1, Segmento: SegmentoSemCompras}, resultados[2])
+}
+
+func TestPontuar_IgualACalcular(t *testing.T) {
+	referencia := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	var compras []Compras
+	for i := 1; i <= 23; i++ {
+		compras = append(compras, Compras{
+			IDCliente:    i,
+			UltimaCompra: referencia.AddDate(0, 0, -(i*7)%60),
+			Frequencia:   1 + i%6,
+			Monetario:    float64((i * 37) % 500),
+		})
+	}
+	compras = append(compras, Compras{IDCliente: 24})
+
+	resultados := Calcular(compras, referencia)
+	limites := CalcularLimites(compras, referencia)
+
+	for i, c := range compras {
+		assert.Equal(t, resultados[i], Pontuar(c, limites, referencia), "cliente %d", c.IDCliente)
+	}
+}
+
+func TestValidoSegmento(t *testing.T) {
+	assert.True(t, ValidoSegmento(SegmentoEmRisco))
+	assert.False(t, ValidoSegmento("vip"))
+	for _, segmento := range Criterios {
+		assert.True(t, ValidoSegmento(segmento))
+	}
+}
+
+func TestTicketMedio(t *testing.T) {
+	assert.Equal(t, 33.33, TicketMedio(100, 3))
+	assert.Equal(t, 0.0, TicketMedio(100, 0))
+}
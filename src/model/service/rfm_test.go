@@ -0,0 +1,54 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+)
+
+// TESTES PARA GetClienteByIDService (RFM)
+func TestService_GetClienteByIDService_RFMErrorRetornaSemRFM(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7, NomeCliente: "Ana"})
+	mockDBClient.On("GetComprasCliente", 7, "1").Return(nil, errors.New("database error"))
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetClienteByIDService("1", "7")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 7, result.ID)
+	assert.Equal(t, "Ana", result.NomeCliente)
+	assert.Nil(t, result.RFM)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_GetClienteByIDService_NotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{})
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.GetClienteByIDService("1", "7")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
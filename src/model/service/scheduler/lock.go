@@ -0,0 +1,118 @@
+package scheduler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// Lock garante que só uma réplica execute o job por vez
+type Lock interface {
+	Adquirir(ctx context.Context, chave string, ttl time.Duration) (bool, error)
+	Liberar(ctx context.Context, chave string) error
+	// Renovar estende o TTL do lock; retorna false se ele não pertence mais a esta instância
+	Renovar(ctx context.Context, chave string, ttl time.Duration) (bool, error)
+}
+
+// RedisLock usa SET NX com um token da instância. O TTL libera o lock se a réplica cair durante a execução;
+// enquanto o job roda, Exclusivo renova o TTL.
+type RedisLock struct {
+	client *redis.Client
+	token  string
+}
+
+// Só apaga a chave se ela ainda pertencer a esta instância
+var liberarLock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// Só estende a chave se ela ainda pertencer a esta instância
+var renovarLock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+func NewRedisLock(client *redis.Client) *RedisLock {
+	token := make([]byte, 16)
+	_, _ = rand.Read(token)
+	return &RedisLock{client: client, token: hex.EncodeToString(token)}
+}
+
+func (l *RedisLock) Adquirir(ctx context.Context, chave string, ttl time.Duration) (bool, error) {
+	return l.client.SetNX(ctx, "lock:"+chave, l.token, ttl).Result()
+}
+
+func (l *RedisLock) Liberar(ctx context.Context, chave string) error {
+	return liberarLock.Run(ctx, l.client, []string{"lock:" + chave}, l.token).Err()
+}
+
+func (l *RedisLock) Renovar(ctx context.Context, chave string, ttl time.Duration) (bool, error) {
+	renovado, err := renovarLock.Run(ctx, l.client, []string{"lock:" + chave}, l.token, ttl.Milliseconds()).Int()
+	return renovado == 1, err
+}
+
+// Exclusivo envolve o job para que ele só rode quando o lock for adquirido. Se o Redis falhar o job não roda,
+// para não correr o risco de duas réplicas executarem ao mesmo tempo. Enquanto o job roda, o lock é renovado a
+// cada terço do TTL, para não expirar em execuções mais longas que ele.
+func Exclusivo(lock Lock, nome string, ttl time.Duration, run func()) func() {
+	return func() {
+		ctx := context.Background()
+		adquirido, err := lock.Adquirir(ctx, nome, ttl)
+		if err != nil {
+			zap.L().Error("Error acquiring job lock", zap.String("job", nome), zap.Error(err))
+			return
+		}
+		if !adquirido {
+			zap.L().Info("Job already running in another instance", zap.String("job", nome))
+			return
+		}
+		defer func() {
+			if err := lock.Liberar(ctx, nome); err != nil {
+				zap.L().Error("Error releasing job lock", zap.String("job", nome), zap.Error(err))
+			}
+		}()
+
+		// A renovação para antes de o lock ser liberado
+		fim, parado := make(chan struct{}), make(chan struct{})
+		go func() {
+			defer close(parado)
+			renovar(ctx, lock, nome, ttl, fim)
+		}()
+		defer func() {
+			close(fim)
+			<-parado
+		}()
+
+		run()
+	}
+}
+
+// renovar estende o lock até o fim do job. Se o lock se perder, só registra: o job não tem como ser interrompido.
+func renovar(ctx context.Context, lock Lock, nome string, ttl time.Duration, fim <-chan struct{}) {
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-fim:
+			return
+		case <-ticker.C:
+			renovado, err := lock.Renovar(ctx, nome, ttl)
+			if err != nil {
+				zap.L().Error("Error renewing job lock", zap.String("job", nome), zap.Error(err))
+				continue
+			}
+			if !renovado {
+				zap.L().Warn("Job lock lost while running", zap.String("job", nome))
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Job representa uma tarefa executada periodicamente
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func()
+}
+
+// Scheduler executa jobs em intervalos fixos até ser parado
+type Scheduler struct {
+	jobs []Job
+	stop chan struct{}
+	wg   sync.WaitGroup
+	once sync.Once
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{stop: make(chan struct{})}
+}
+
+// Register adiciona um job; deve ser chamado antes de Start
+func (s *Scheduler) Register(name string, interval time.Duration, run func()) {
+	s.jobs = append(s.jobs, Job{Name: name, Interval: interval, Run: run})
+}
+
+// Start inicia uma goroutine por job. Cada job roda uma vez ao iniciar e depois a cada intervalo
+func (s *Scheduler) Start() {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(job)
+	}
+}
+
+// Stop sinaliza o fim dos jobs e aguarda as execuções em andamento
+func (s *Scheduler) Stop() {
+	s.once.Do(func() { close(s.stop) })
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	s.execute(job)
+	for {
+		select {
+		case <-ticker.C:
+			s.execute(job)
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+func (s *Scheduler) execute(job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			zap.L().Error("Scheduled job panicked", zap.String("job", job.Name), zap.Any("panic", r))
+		}
+	}()
+
+	zap.L().Info("Running scheduled job", zap.String("job", job.Name))
+	job.Run()
+}
@@ -0,0 +1,121 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_RunsJobOnStartAndOnInterval(t *testing.T) {
+	var count int32
+	s := NewScheduler()
+	s.Register("contador", 10*time.Millisecond, func() {
+		atomic.AddInt32(&count, 1)
+	})
+
+	s.Start()
+	time.Sleep(55 * time.Millisecond)
+	s.Stop()
+
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&count), int32(2))
+}
+
+func TestScheduler_StopIsIdempotent(t *testing.T) {
+	s := NewScheduler()
+	s.Register("noop", time.Hour, func() {})
+	s.Start()
+
+	assert.NotPanics(t, func() {
+		s.Stop()
+		s.Stop()
+	})
+}
+
+func TestScheduler_RecoversFromPanic(t *testing.T) {
+	var count int32
+	s := NewScheduler()
+	s.Register("panico", 10*time.Millisecond, func() {
+		atomic.AddInt32(&count, 1)
+		panic("falha")
+	})
+
+	s.Start()
+	time.Sleep(35 * time.Millisecond)
+	s.Stop()
+
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&count), int32(2))
+}
+
+type lockFake struct {
+	adquirido bool
+	err       error
+	liberado  int
+	renovado  int32
+}
+
+func (l *lockFake) Adquirir(ctx context.Context, chave string, ttl time.Duration) (bool, error) {
+	return l.adquirido, l.err
+}
+
+func (l *lockFake) Liberar(ctx context.Context, chave string) error {
+	l.liberado++
+	return nil
+}
+
+func (l *lockFake) Renovar(ctx context.Context, chave string, ttl time.Duration) (bool, error) {
+	atomic.AddInt32(&l.renovado, 1)
+	return true, nil
+}
+
+func TestExclusivo_RodaComLock(t *testing.T) {
+	lock := &lockFake{adquirido: true}
+	rodou := false
+
+	Exclusivo(lock, "campanhas", time.Minute, func() { rodou = true })()
+
+	assert.True(t, rodou)
+	assert.Equal(t, 1, lock.liberado)
+}
+
+func TestExclusivo_NaoRodaSemLock(t *testing.T) {
+	tests := []struct {
+		name string
+		lock *lockFake
+	}{
+		{"lock com outra instância", &lockFake{adquirido: false}},
+		{"erro no redis", &lockFake{err: errors.New("connection refused")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rodou := false
+			Exclusivo(tt.lock, "campanhas", time.Minute, func() { rodou = true })()
+
+			assert.False(t, rodou)
+			assert.Equal(t, 0, tt.lock.liberado)
+		})
+	}
+}
+
+func TestExclusivo_RenovaEnquantoRoda(t *testing.T) {
+	lock := &lockFake{adquirido: true}
+
+	Exclusivo(lock, "campanhas", 30*time.Millisecond, func() { time.Sleep(100 * time.Millisecond) })()
+	renovacoes := atomic.LoadInt32(&lock.renovado)
+	time.Sleep(50 * time.Millisecond)
+
+	assert.GreaterOrEqual(t, renovacoes, int32(2))
+	assert.Equal(t, renovacoes, atomic.LoadInt32(&lock.renovado), "para de renovar quando o job termina")
+	assert.Equal(t, 1, lock.liberado)
+}
+
+func TestExclusivo_LiberaAposPanico(t *testing.T) {
+	lock := &lockFake{adquirido: true}
+
+	assert.Panics(t, Exclusivo(lock, "campanhas", time.Minute, func() { panic("falha") }))
+	assert.Equal(t, 1, lock.liberado)
+}
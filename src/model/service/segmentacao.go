@@ -0,0 +1,329 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/segmentacao"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// AmostraPreviewPadrao é a quantidade de clientes na amostra da prévia quando nenhuma é informada
+const AmostraPreviewPadrao = 10
+
+// FUNÇÕES DE REGRAS DE PÚBLICOS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (srv *Service) GetCamposCriteriosService() *dtos.CampoCriterioListResponse {
+	zap.L().Info("Starting get campos criterios service")
+
+	campos := segmentacao.Campos()
+	response := &dtos.CampoCriterioListResponse{
+		Campos: make([]dtos.CampoCriterioResponse, len(campos)),
+		Total:  len(campos),
+	}
+	for i, campo := range campos {
+		response.Campos[i] = dtos.CampoCriterioResponse{
+			Campo:      campo.Nome,
+			Tipo:       campo.Tipo,
+			Operadores: campo.Operadores,
+			Valores:    campo.Valores,
+			Minimo:     campo.Minimo,
+			Maximo:     campo.Maximo,
+			Descricao:  campo.Descricao,
+		}
+	}
+
+	zap.L().Info("Campos criterios service completed successfully", zap.Int("total", len(campos)))
+	return response
+}
+
+// CreateCriterioService cadastra um critério parametrizado, definido por uma condição (ou grupo) sobre os campos
+func (srv *Service) CreateCriterioService(userID string, request dtos.CreateCriterioRequest) (*dtos.CriterioResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting create criterio service", zap.String("nome", request.NomeCondicao))
+
+	regra := regraSegmentacao(*request.Regra)
+	if err := segmentacao.ValidarDefinicao(regra); err != nil {
+		return nil, exceptions.NewBadRequestError(err.Error())
+	}
+
+	conteudo, err := json.Marshal(regra)
+	if err != nil {
+		zap.L().Error("Error encoding regra", zap.Error(err))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	definicao := string(conteudo)
+
+	criterio := &entity.Criterio{
+		NomeCondicao: request.NomeCondicao,
+		Regra:        &definicao,
+	}
+	if dbErr := srv.dbClient.CreateCriterio(criterio, userID); dbErr != nil {
+		zap.L().Error("Error creating criterio in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Criterio created successfully", zap.Int("id", criterio.ID))
+	return buildCriterioResponse(*criterio)
+}
+
+// PreviewPublicoService mostra quantos e quais clientes uma regra (ou lista de critérios) seleciona, sem gravar o público
+func (srv *Service) PreviewPublicoService(userID string, request dtos.PreviewPublicoRequest) (*dtos.PreviewPublicoResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting preview publico service", zap.Ints("criterios", request.Criterios), zap.Bool("regra", request.Regra != nil))
+
+	if request.Amostra == 0 {
+		request.Amostra = AmostraPreviewPadrao
+	}
+
+	criterios, restErr := srv.mapaCriterios(userID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	// Como na busca do público, a regra prevalece sobre os critérios combinados com OU
+	regra := segmentacao.RegraDosCriterios(request.Criterios)
+	if request.Regra != nil {
+		regra = regraSegmentacao(*request.Regra)
+	}
+	if err := segmentacao.Validar(regra, criterios); err != nil {
+		return nil, exceptions.NewBadRequestError(err.Error())
+	}
+
+	condicao, args, restErr := srv.compilarRegra(userID, regra, criterios)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	preview, dbErr := srv.dbClient.PreviewPublico(userID, condicao, args, request.Amostra)
+	if dbErr != nil {
+		zap.L().Error("Error getting publico preview from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.PreviewPublicoResponse{
+		Total:      preview.Total,
+		PorSexo:    buildContagensPublicoResponse(preview.PorSexo),
+		PorEspecie: buildContagensPublicoResponse(preview.PorEspecie),
+		PorCidade:  buildContagensPublicoResponse(preview.PorCidade),
+		Amostra:    make([]dtos.ClienteResponse, len(preview.Amostra)),
+	}
+	for i, cliente := range preview.Amostra {
+		response.Amostra[i] = buildClienteResponse(cliente)
+	}
+
+	zap.L().Info("Preview publico service completed successfully", zap.Int("total", preview.Total))
+	return response, nil
+}
+
+// validarCriteriosPublico confere a regra e os critérios enviados e monta a regra a gravar (nil quando só há critérios)
+func (srv *Service) validarCriteriosPublico(userID string, request dtos.AssociarCriteriosRequest) (*entity.PublicoRegra, *exceptions.RestErr) {
+	criterios, restErr := srv.mapaCriterios(userID)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	if len(request.Criterios) > 0 {
+		if err := segmentacao.Validar(segmentacao.RegraDosCriterios(request.Criterios), criterios); err != nil {
+			return nil, exceptions.NewBadRequestError(err.Error())
+		}
+	}
+
+	if request.Regra == nil {
+		return nil, nil
+	}
+
+	regra := regraSegmentacao(*request.Regra)
+	if err := segmentacao.Validar(regra, criterios); err != nil {
+		return nil, exceptions.NewBadRequestError(err.Error())
+	}
+
+	conteudo, err := json.Marshal(regra)
+	if err != nil {
+		zap.L().Error("Error encoding regra", zap.Error(err))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	return &entity.PublicoRegra{
+		Regra:           string(conteudo),
+		DataAtualizacao: time.Now().Format(formatoDataHora),
+	}, nil
+}
+
+// regraDoPublico retorna a regra que define o público: a árvore gravada ou, sem ela, os critérios associados
+// combinados com OU. Retorna nil quando o público não tem nenhum dos dois.
+func (srv *Service) regraDoPublico(userID string, idPublico string) (*segmentacao.Regra, map[int]segmentacao.Criterio, *exceptions.RestErr) {
+	criterios, restErr := srv.mapaCriterios(userID)
+	if restErr != nil {
+		return nil, nil, restErr
+	}
+
+	regra, restErr := srv.getRegraPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, nil, restErr
+	}
+	if regra != nil {
+		return regra, criterios, nil
+	}
+
+	associados, dbErr := srv.dbClient.GetCriteriosPublico(idPublico, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting criterios for publico", zap.Error(dbErr))
+		return nil, nil, exceptions.NewInternalServerError("Error retrieving criterios for publico")
+	}
+	if len(associados) == 0 {
+		return nil, criterios, nil
+	}
+
+	ids := make([]int, len(associados))
+	for i, criterio := range associados {
+		ids[i] = criterio.IDCriterio
+	}
+	legado := segmentacao.RegraDosCriterios(ids)
+	return &legado, criterios, nil
+}
+
+// getRegraPublico lê a árvore gravada do público; retorna nil quando o público não tem regra
+func (srv *Service) getRegraPublico(userID string, idPublico string) (*segmentacao.Regra, *exceptions.RestErr) {
+	idPublicoInt := 0
+	if _, err := fmt.Sscanf(idPublico, "%d", &idPublicoInt); err != nil {
+		zap.L().Error("Error converting idPublico to int", zap.Error(err))
+		return nil, exceptions.NewBadRequestError("Invalid publico ID")
+	}
+
+	publicoRegra, dbErr := srv.dbClient.GetRegraPublico(idPublicoInt, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		zap.L().Error("Error getting regra for publico", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	var regra segmentacao.Regra
+	if err := json.Unmarshal([]byte(publicoRegra.Regra), &regra); err != nil {
+		zap.L().Error("Error decoding regra of publico", zap.Int("idPublico", idPublicoInt), zap.Error(err))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	return &regra, nil
+}
+
+// buscarClientesPorRegra compila a regra e busca os clientes que a atendem, sem os clientes excluídos do público
+func (srv *Service) buscarClientesPorRegra(userID string, idPublico int, regra segmentacao.Regra, criterios map[int]segmentacao.Criterio) ([]entity.Cliente, *exceptions.RestErr) {
+	condicao, args, restErr := srv.compilarRegra(userID, regra, criterios)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	clientes, dbErr := srv.dbClient.BuscarClientesPorRegra(userID, condicao, args, idPublico)
+	if dbErr != nil {
+		zap.L().Error("Error searching clientes by regra", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Error searching clientes by regra")
+	}
+	return clientes, nil
+}
+
+// compilarRegra gera a condição SQL da regra. O RFM só é calculado se a regra usar segmentos.
+func (srv *Service) compilarRegra(userID string, regra segmentacao.Regra, criterios map[int]segmentacao.Criterio) (string, []interface{}, *exceptions.RestErr) {
+	contexto := segmentacao.Contexto{Criterios: criterios}
+	if segmentacao.UsaSegmentoRFM(regra, criterios) {
+		segmentos, restErr := srv.segmentosRFM(userID)
+		if restErr != nil {
+			return "", nil, restErr
+		}
+		contexto.SegmentosRFM = segmentos
+	}
+
+	condicao, args, err := segmentacao.Compilar(regra, contexto)
+	if err != nil {
+		// Um critério removido depois de associado invalida a regra gravada
+		zap.L().Error("Error compiling regra of publico", zap.Error(err))
+		return "", nil, exceptions.NewBadRequestError(err.Error())
+	}
+	return condicao, args, nil
+}
+
+// mapaCriterios liga o ID de cada critério cadastrado à sua definição
+func (srv *Service) mapaCriterios(userID string) (map[int]segmentacao.Criterio, *exceptions.RestErr) {
+	criterios, dbErr := srv.dbClient.GetAllCriterios(userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting criterios from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Error retrieving criterios")
+	}
+
+	mapa := make(map[int]segmentacao.Criterio, len(criterios))
+	for _, criterio := range criterios {
+		definicao, restErr := definicaoCriterio(criterio)
+		if restErr != nil {
+			return nil, restErr
+		}
+		mapa[criterio.ID] = segmentacao.Criterio{Nome: criterio.NomeCondicao, Definicao: definicao}
+	}
+	return mapa, nil
+}
+
+// definicaoCriterio lê a condição gravada do critério; retorna nil nos critérios fixos
+func definicaoCriterio(criterio entity.Criterio) (*segmentacao.Regra, *exceptions.RestErr) {
+	if criterio.Regra == nil || *criterio.Regra == "" {
+		return nil, nil
+	}
+	var regra segmentacao.Regra
+	if err := json.Unmarshal([]byte(*criterio.Regra), &regra); err != nil {
+		zap.L().Error("Error decoding regra of criterio", zap.Int("id", criterio.ID), zap.Error(err))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	return &regra, nil
+}
+
+func buildCriterioResponse(criterio entity.Criterio) (*dtos.CriterioResponse, *exceptions.RestErr) {
+	definicao, restErr := definicaoCriterio(criterio)
+	if restErr != nil {
+		return nil, restErr
+	}
+	response := &dtos.CriterioResponse{
+		ID:           criterio.ID,
+		NomeCondicao: criterio.NomeCondicao,
+	}
+	if definicao != nil {
+		response.Regra = buildRegraPublicoResponse(*definicao)
+	}
+	return response, nil
+}
+
+func buildContagensPublicoResponse(contagens []entity.ContagemPublico) []dtos.ContagemPublicoResponse {
+	response := make([]dtos.ContagemPublicoResponse, len(contagens))
+	for i, contagem := range contagens {
+		response[i] = dtos.ContagemPublicoResponse{Valor: contagem.Valor, Total: contagem.Total}
+	}
+	return response
+}
+
+func regraSegmentacao(regra dtos.RegraPublico) segmentacao.Regra {
+	convertida := segmentacao.Regra{
+		Operador: regra.Operador,
+		Campo:    regra.Campo,
+		Valor:    regra.Valor,
+		Criterio: regra.Criterio,
+	}
+	for _, filha := range regra.Regras {
+		convertida.Regras = append(convertida.Regras, regraSegmentacao(filha))
+	}
+	return convertida
+}
+
+func buildRegraPublicoResponse(regra segmentacao.Regra) *dtos.RegraPublico {
+	response := &dtos.RegraPublico{
+		Operador: regra.Operador,
+		Campo:    regra.Campo,
+		Valor:    regra.Valor,
+		Criterio: regra.Criterio,
+	}
+	for _, filha := range regra.Regras {
+		response.Regras = append(response.Regras, *buildRegraPublicoResponse(filha))
+	}
+	return response
+}
@@ -0,0 +1,476 @@
+package segmentacao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/betine97/back-project.git/src/model/service/rfm"
+)
+
+// Operadores de grupo
+const (
+	OperadorE   = "and"
+	OperadorOu  = "or"
+	OperadorNao = "not"
+)
+
+// Operadores de comparação das condições
+const (
+	Igual      = "eq"
+	Diferente  = "neq"
+	Em         = "in"
+	ForaDe     = "not_in"
+	Contem     = "contains"
+	Maior      = "gt"
+	MaiorIgual = "gte"
+	Menor      = "lt"
+	MenorIgual = "lte"
+	Entre      = "between"
+)
+
+// Tipos de valor dos campos
+const (
+	TipoTexto  = "texto"
+	TipoNumero = "numero"
+	TipoData   = "data"
+)
+
+// FormatoData é o formato dos valores do tipo data
+const FormatoData = "2006-01-02"
+
+// Limites da árvore, para que uma regra não gere uma consulta grande demais
+const (
+	ProfundidadeMaxima = 6
+	CondicoesMaximas   = 50
+	ValoresMaximos     = 100
+)
+
+// Regra é um nó da árvore de critérios. Um grupo tem operador and, or ou not e as regras filhas (not tem uma só).
+// Uma condição compara um campo com o valor, ou referencia um critério cadastrado (tabela criterios).
+type Regra struct {
+	Operador string      `json:"operador,omitempty"`
+	Regras   []Regra     `json:"regras,omitempty"`
+	Campo    string      `json:"campo,omitempty"`
+	Valor    interface{} `json:"valor,omitempty"`
+	Criterio int         `json:"criterio,omitempty"`
+}
+
+// Criterio é um critério cadastrado na tabela criterios. Definicao é a condição parametrizada gravada com o critério;
+// sem ela, o nome precisa ser um dos critérios fixos (ex: Possui Gato).
+type Criterio struct {
+	Nome      string
+	Definicao *Regra
+}
+
+// Regra retorna a condição equivalente ao critério
+func (c Criterio) Regra() (Regra, bool) {
+	if c.Definicao != nil {
+		return *c.Definicao, true
+	}
+	return RegraDoCriterio(c.Nome)
+}
+
+// Contexto traz o que a compilação precisa resolver fora do SQL
+type Contexto struct {
+	// Criterios liga o ID do critério cadastrado à sua definição
+	Criterios map[int]Criterio
+	// SegmentosRFM liga cada segmento RFM aos IDs dos clientes, calculados a partir das vendas
+	SegmentosRFM map[string][]int
+}
+
+// Campo descreve um campo que pode ser usado nas condições
+type Campo struct {
+	Nome       string   `json:"campo"`
+	Tipo       string   `json:"tipo"`
+	Operadores []string `json:"operadores"`
+	Valores    []string `json:"valores,omitempty"`
+	Minimo     *float64 `json:"minimo,omitempty"`
+	Maximo     *float64 `json:"maximo,omitempty"`
+	Descricao  string   `json:"descricao"`
+
+	// relacao é a tabela ligada ao cliente (pet, endereco, tag); vazia para colunas de clientes
+	relacao string
+	// expressao é a coluna ou o cálculo SQL comparado com o valor
+	expressao string
+}
+
+var (
+	operadoresTexto  = []string{Igual, Diferente, Em, ForaDe, Contem}
+	operadoresLista  = []string{Igual, Diferente, Em, ForaDe}
+	operadoresNumero = []string{Igual, Diferente, Maior, MaiorIgual, Menor, MenorIgual, Entre, Em, ForaDe}
+	operadoresData   = []string{Igual, Diferente, Maior, MaiorIgual, Menor, MenorIgual, Entre}
+)
+
+func limite(valor float64) *float64 {
+	return &valor
+}
+
+// Subconsultas das tabelas ligadas ao cliente; a condição vale se ao menos uma linha atender
+var relacoes = map[string]string{
+	"pet":      "EXISTS (SELECT 1 FROM pets WHERE pets.cliente_id = clientes.id AND %s)",
+	"endereco": "EXISTS (SELECT 1 FROM enderecos WHERE enderecos.id_cliente = clientes.id AND %s)",
+	"tag":      "EXISTS (SELECT 1 FROM tags_clientes INNER JOIN tags ON tags.id_tag = tags_clientes.id_tag WHERE tags_clientes.cliente_id = clientes.id AND %s)",
+}
+
+// CampoSegmentoRFM é resolvido com os IDs calculados em Contexto.SegmentosRFM
+const CampoSegmentoRFM = "rfm.segmento"
+
+var campos = map[string]Campo{
+	"cliente.tipo_cliente":       {Tipo: TipoTexto, Operadores: operadoresLista, Valores: []string{"PF", "PJ"}, Descricao: "Tipo de cliente", expressao: "clientes.tipo_cliente"},
+	"cliente.sexo":               {Tipo: TipoTexto, Operadores: operadoresLista, Valores: []string{"M", "F"}, Descricao: "Sexo do cliente", expressao: "clientes.sexo"},
+	"cliente.nome":               {Tipo: TipoTexto, Operadores: operadoresTexto, Descricao: "Nome do cliente", expressao: "clientes.nome_cliente"},
+	"cliente.idade":              {Tipo: TipoNumero, Operadores: operadoresNumero, Minimo: limite(0), Descricao: "Idade do cliente em anos, pela data de nascimento", expressao: "TIMESTAMPDIFF(YEAR, clientes.data_nascimento, CURDATE())"},
+	"cliente.data_cadastro":      {Tipo: TipoData, Operadores: operadoresData, Descricao: "Data de cadastro do cliente", expressao: "DATE(clientes.data_cadastro)"},
+	"cliente.dias_cadastro":      {Tipo: TipoNumero, Operadores: operadoresNumero, Minimo: limite(0), Descricao: "Dias desde o cadastro do cliente", expressao: "DATEDIFF(CURDATE(), clientes.data_cadastro)"},
+	"pet.especie":                {Tipo: TipoTexto, Operadores: operadoresTexto, Descricao: "Espécie de algum pet do cliente", relacao: "pet", expressao: "pets.especie"},
+	"pet.raca":                   {Tipo: TipoTexto, Operadores: operadoresTexto, Descricao: "Raça de algum pet do cliente", relacao: "pet", expressao: "pets.raca"},
+	"pet.porte":                  {Tipo: TipoTexto, Operadores: operadoresLista, Valores: []string{"Pequeno", "Médio", "Grande"}, Descricao: "Porte de algum pet do cliente", relacao: "pet", expressao: "pets.porte"},
+	"pet.idade":                  {Tipo: TipoNumero, Operadores: operadoresNumero, Minimo: limite(0), Descricao: "Idade em anos de algum pet do cliente, pela data de aniversário ou pela idade informada", relacao: "pet", expressao: "COALESCE(TIMESTAMPDIFF(YEAR, pets.data_aniversario, CURDATE()), pets.idade)"},
+	"pet.mes_aniversario":        {Tipo: TipoNumero, Operadores: operadoresNumero, Minimo: limite(1), Maximo: limite(12), Descricao: "Mês do aniversário de algum pet do cliente (1 a 12)", relacao: "pet", expressao: "MONTH(pets.data_aniversario)"},
+	"pet.meses_para_aniversario": {Tipo: TipoNumero, Operadores: operadoresNumero, Minimo: limite(0), Maximo: limite(11), Descricao: "Meses até o aniversário de algum pet do cliente (0 é o mês atual)", relacao: "pet", expressao: "MOD(MONTH(pets.data_aniversario) - MONTH(CURDATE()) + 12, 12)"},
+	"endereco.cidade":            {Tipo: TipoTexto, Operadores: operadoresTexto, Descricao: "Cidade de algum endereço do cliente", relacao: "endereco", expressao: "enderecos.cidade"},
+	"endereco.estado":            {Tipo: TipoTexto, Operadores: operadoresTexto, Descricao: "Estado de algum endereço do cliente", relacao: "endereco", expressao: "enderecos.estado"},
+	"endereco.bairro":            {Tipo: TipoTexto, Operadores: operadoresTexto, Descricao: "Bairro de algum endereço do cliente", relacao: "endereco", expressao: "enderecos.bairro"},
+	"tag.nome":                   {Tipo: TipoTexto, Operadores: operadoresTexto, Descricao: "Nome de alguma tag do cliente", relacao: "tag", expressao: "tags.nome_tag"},
+	"tag.id":                     {Tipo: TipoNumero, Operadores: operadoresLista, Descricao: "ID de alguma tag do cliente", relacao: "tag", expressao: "tags_clientes.id_tag"},
+	CampoSegmentoRFM:             {Tipo: TipoTexto, Operadores: operadoresLista, Valores: rfm.Segmentos, Descricao: "Segmento RFM do cliente"},
+}
+
+// Critérios cadastrados na tabela criterios, traduzidos para condições
+var regrasCriterios = map[string]Regra{
+	"Pessoa Física":   {Campo: "cliente.tipo_cliente", Operador: Igual, Valor: "PF"},
+	"Pessoa Jurídica": {Campo: "cliente.tipo_cliente", Operador: Igual, Valor: "PJ"},
+	"Sexo Masculino":  {Campo: "cliente.sexo", Operador: Igual, Valor: "M"},
+	"Sexo Feminino":   {Campo: "cliente.sexo", Operador: Igual, Valor: "F"},
+	"Possui Gato":     {Campo: "pet.especie", Operador: Igual, Valor: "Gato"},
+	"Possui Cachorro": {Campo: "pet.especie", Operador: Igual, Valor: "Cachorro"},
+}
+
+// Campos retorna o catálogo de campos em ordem alfabética
+func Campos() []Campo {
+	lista := make([]Campo, 0, len(campos))
+	for nome, campo := range campos {
+		campo.Nome = nome
+		lista = append(lista, campo)
+	}
+	sort.Slice(lista, func(i, j int) bool { return lista[i].Nome < lista[j].Nome })
+	return lista
+}
+
+// RegraDoCriterio traduz o nome de um critério cadastrado para a condição equivalente
+func RegraDoCriterio(nome string) (Regra, bool) {
+	if regra, ok := regrasCriterios[nome]; ok {
+		return regra, true
+	}
+	if segmento, ok := rfm.Criterios[nome]; ok {
+		return Regra{Campo: CampoSegmentoRFM, Operador: Igual, Valor: segmento}, true
+	}
+	return Regra{}, false
+}
+
+// RegraDosCriterios combina com OU os critérios cadastrados, como eram associados aos públicos antes das regras
+func RegraDosCriterios(ids []int) Regra {
+	regra := Regra{Operador: OperadorOu, Regras: make([]Regra, len(ids))}
+	for i, id := range ids {
+		regra.Regras[i] = Regra{Criterio: id}
+	}
+	return regra
+}
+
+// Validar confere a árvore: operadores, campos, valores, critérios referenciados e limites de tamanho.
+// O erro indica o caminho do nó inválido (ex: regras[1].valor).
+func Validar(regra Regra, criterios map[int]Criterio) error {
+	condicoes := 0
+	return validar(regra, criterios, true, "regra", 1, &condicoes)
+}
+
+// ValidarDefinicao confere a condição de um critério cadastrado. Ela não pode referenciar outros critérios.
+func ValidarDefinicao(regra Regra) error {
+	condicoes := 0
+	return validar(regra, nil, false, "regra", 1, &condicoes)
+}
+
+func validar(regra Regra, criterios map[int]Criterio, aceitaCriterios bool, caminho string, profundidade int, condicoes *int) error {
+	if profundidade > ProfundidadeMaxima {
+		return fmt.Errorf("%s: maximum depth of %d levels exceeded", caminho, ProfundidadeMaxima)
+	}
+
+	switch regra.Operador {
+	case OperadorE, OperadorOu, OperadorNao:
+		if regra.Campo != "" || regra.Valor != nil || regra.Criterio != 0 {
+			return fmt.Errorf("%s: group '%s' accepts only regras", caminho, regra.Operador)
+		}
+		if len(regra.Regras) == 0 {
+			return fmt.Errorf("%s: group '%s' requires at least one regra", caminho, regra.Operador)
+		}
+		if regra.Operador == OperadorNao && len(regra.Regras) != 1 {
+			return fmt.Errorf("%s: group 'not' requires exactly one regra", caminho)
+		}
+		for i, filha := range regra.Regras {
+			if err := validar(filha, criterios, aceitaCriterios, fmt.Sprintf("%s.regras[%d]", caminho, i), profundidade+1, condicoes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	*condicoes++
+	if *condicoes > CondicoesMaximas {
+		return fmt.Errorf("%s: maximum of %d conditions exceeded", caminho, CondicoesMaximas)
+	}
+	if len(regra.Regras) > 0 {
+		return fmt.Errorf("%s: regras require operador 'and', 'or' or 'not'", caminho)
+	}
+
+	if regra.Criterio != 0 {
+		if !aceitaCriterios {
+			return fmt.Errorf("%s.criterio: a criterio definition cannot reference other criterios", caminho)
+		}
+		if regra.Campo != "" || regra.Operador != "" || regra.Valor != nil {
+			return fmt.Errorf("%s: criterio cannot be combined with campo, operador or valor", caminho)
+		}
+		criterio, ok := criterios[regra.Criterio]
+		if !ok {
+			return fmt.Errorf("%s.criterio: criterio %d not found", caminho, regra.Criterio)
+		}
+		if _, ok := criterio.Regra(); !ok {
+			return fmt.Errorf("%s.criterio: criterio '%s' is not supported", caminho, criterio.Nome)
+		}
+		return nil
+	}
+
+	campo, ok := campos[regra.Campo]
+	if !ok {
+		return fmt.Errorf("%s.campo: unknown campo '%s'", caminho, regra.Campo)
+	}
+	if !contem(campo.Operadores, regra.Operador) {
+		return fmt.Errorf("%s.operador: operador '%s' is not valid for campo '%s'", caminho, regra.Operador, regra.Campo)
+	}
+	if _, err := valores(regra, campo); err != nil {
+		return fmt.Errorf("%s.valor: %v", caminho, err)
+	}
+	return nil
+}
+
+// Compilar gera a condição SQL parametrizada sobre a tabela clientes. A regra deve ter passado por Validar.
+func Compilar(regra Regra, ctx Contexto) (string, []interface{}, error) {
+	switch regra.Operador {
+	case OperadorE, OperadorOu:
+		partes := make([]string, 0, len(regra.Regras))
+		var args []interface{}
+		for _, filha := range regra.Regras {
+			sql, filhaArgs, err := Compilar(filha, ctx)
+			if err != nil {
+				return "", nil, err
+			}
+			partes = append(partes, sql)
+			args = append(args, filhaArgs...)
+		}
+		juncao := " AND "
+		if regra.Operador == OperadorOu {
+			juncao = " OR "
+		}
+		return "(" + strings.Join(partes, juncao) + ")", args, nil
+	case OperadorNao:
+		sql, args, err := Compilar(regra.Regras[0], ctx)
+		if err != nil {
+			return "", nil, err
+		}
+		// Comparações com NULL (ex: cliente sem data de nascimento) ficam desconhecidas; a negação inclui esses clientes
+		return "NOT COALESCE(" + sql + ", FALSE)", args, nil
+	}
+
+	if regra.Criterio != 0 {
+		criterio, ok := ctx.Criterios[regra.Criterio]
+		if !ok {
+			return "", nil, fmt.Errorf("criterio %d not found", regra.Criterio)
+		}
+		equivalente, ok := criterio.Regra()
+		if !ok {
+			return "", nil, fmt.Errorf("criterio '%s' is not supported", criterio.Nome)
+		}
+		return Compilar(equivalente, ctx)
+	}
+
+	campo, ok := campos[regra.Campo]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown campo '%s'", regra.Campo)
+	}
+	lista, err := valores(regra, campo)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if regra.Campo == CampoSegmentoRFM {
+		return compilarSegmentoRFM(regra.Operador, lista, ctx.SegmentosRFM)
+	}
+
+	var sql string
+	var args []interface{}
+	switch regra.Operador {
+	case Igual:
+		sql, args = campo.expressao+" = ?", []interface{}{lista[0]}
+	case Diferente:
+		sql, args = campo.expressao+" <> ?", []interface{}{lista[0]}
+	case Maior:
+		sql, args = campo.expressao+" > ?", []interface{}{lista[0]}
+	case MaiorIgual:
+		sql, args = campo.expressao+" >= ?", []interface{}{lista[0]}
+	case Menor:
+		sql, args = campo.expressao+" < ?", []interface{}{lista[0]}
+	case MenorIgual:
+		sql, args = campo.expressao+" <= ?", []interface{}{lista[0]}
+	case Entre:
+		sql, args = campo.expressao+" BETWEEN ? AND ?", []interface{}{lista[0], lista[1]}
+	case Em:
+		sql, args = campo.expressao+" IN ?", []interface{}{lista}
+	case ForaDe:
+		sql, args = campo.expressao+" NOT IN ?", []interface{}{lista}
+	case Contem:
+		sql, args = campo.expressao+" LIKE ?", []interface{}{"%" + escaparLike(fmt.Sprint(lista[0])) + "%"}
+	default:
+		return "", nil, fmt.Errorf("operador '%s' is not supported", regra.Operador)
+	}
+
+	if campo.relacao != "" {
+		sql = fmt.Sprintf(relacoes[campo.relacao], sql)
+	}
+	return "(" + sql + ")", args, nil
+}
+
+// UsaSegmentoRFM informa se a regra depende do cálculo de RFM, direta ou indiretamente por um critério cadastrado
+func UsaSegmentoRFM(regra Regra, criterios map[int]Criterio) bool {
+	if regra.Campo == CampoSegmentoRFM {
+		return true
+	}
+	if regra.Criterio != 0 {
+		if equivalente, ok := criterios[regra.Criterio].Regra(); ok {
+			return UsaSegmentoRFM(equivalente, nil)
+		}
+	}
+	for _, filha := range regra.Regras {
+		if UsaSegmentoRFM(filha, criterios) {
+			return true
+		}
+	}
+	return false
+}
+
+// compilarSegmentoRFM troca os segmentos pelos IDs dos clientes; sem clientes, a condição é constante
+func compilarSegmentoRFM(operador string, segmentos []interface{}, segmentosRFM map[string][]int) (string, []interface{}, error) {
+	ids := []int{}
+	for _, segmento := range segmentos {
+		ids = append(ids, segmentosRFM[fmt.Sprint(segmento)]...)
+	}
+	negar := operador == Diferente || operador == ForaDe
+	if len(ids) == 0 {
+		if negar {
+			return "(1 = 1)", nil, nil
+		}
+		return "(1 = 0)", nil, nil
+	}
+	if negar {
+		return "(clientes.id NOT IN ?)", []interface{}{ids}, nil
+	}
+	return "(clientes.id IN ?)", []interface{}{ids}, nil
+}
+
+// valores normaliza o valor da condição: uma lista para in e not_in, o intervalo [início, fim] para between
+// e um único valor para os demais operadores
+func valores(regra Regra, campo Campo) ([]interface{}, error) {
+	var lista []interface{}
+	switch regra.Operador {
+	case Em, ForaDe:
+		itens, ok := regra.Valor.([]interface{})
+		if !ok || len(itens) == 0 {
+			return nil, fmt.Errorf("operador '%s' requires a non-empty list", regra.Operador)
+		}
+		if len(itens) > ValoresMaximos {
+			return nil, fmt.Errorf("maximum of %d values exceeded", ValoresMaximos)
+		}
+		lista = itens
+	case Entre:
+		itens, ok := regra.Valor.([]interface{})
+		if !ok || len(itens) != 2 {
+			return nil, fmt.Errorf("operador 'between' requires a list with start and end")
+		}
+		lista = itens
+	default:
+		if regra.Valor == nil {
+			return nil, fmt.Errorf("valor is required")
+		}
+		lista = []interface{}{regra.Valor}
+	}
+
+	normalizados := make([]interface{}, len(lista))
+	for i, item := range lista {
+		valor, err := normalizar(item, campo)
+		if err != nil {
+			return nil, err
+		}
+		normalizados[i] = valor
+	}
+
+	if regra.Operador == Entre && maior(normalizados[0], normalizados[1]) {
+		return nil, fmt.Errorf("start of the interval must not be after the end")
+	}
+	return normalizados, nil
+}
+
+func normalizar(valor interface{}, campo Campo) (interface{}, error) {
+	switch campo.Tipo {
+	case TipoNumero:
+		numero, ok := valor.(float64)
+		if !ok {
+			return nil, fmt.Errorf("expected a number")
+		}
+		if campo.Minimo != nil && numero < *campo.Minimo {
+			return nil, fmt.Errorf("%v is less than the minimum of %v", numero, *campo.Minimo)
+		}
+		if campo.Maximo != nil && numero > *campo.Maximo {
+			return nil, fmt.Errorf("%v is greater than the maximum of %v", numero, *campo.Maximo)
+		}
+		return numero, nil
+	case TipoData:
+		texto, ok := valor.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a date in the format YYYY-MM-DD")
+		}
+		if _, err := time.Parse(FormatoData, texto); err != nil {
+			return nil, fmt.Errorf("expected a date in the format YYYY-MM-DD")
+		}
+		return texto, nil
+	default:
+		texto, ok := valor.(string)
+		if !ok || strings.TrimSpace(texto) == "" {
+			return nil, fmt.Errorf("expected a non-empty text")
+		}
+		if len(campo.Valores) > 0 && !contem(campo.Valores, texto) {
+			return nil, fmt.Errorf("'%s' is not one of %s", texto, strings.Join(campo.Valores, ", "))
+		}
+		return texto, nil
+	}
+}
+
+// maior compara dois valores já normalizados do mesmo tipo; datas no formato YYYY-MM-DD se comparam como texto
+func maior(a, b interface{}) bool {
+	if numero, ok := a.(float64); ok {
+		return numero > b.(float64)
+	}
+	return fmt.Sprint(a) > fmt.Sprint(b)
+}
+
+func escaparLike(texto string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(texto)
+}
+
+func contem(lista []string, valor string) bool {
+	for _, item := range lista {
+		if item == valor {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package segmentacao
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func parse(t *testing.T, texto string) Regra {
+	t.Helper()
+	var regra Regra
+	assert.NoError(t, json.Unmarshal([]byte(texto), &regra))
+	return regra
+}
+
+func TestCompilar(t *testing.T) {
+	ctx := Contexto{Criterios: map[int]Criterio{
+		1: {Nome: "Pessoa Física"},
+		6: {Nome: "Possui Gato"},
+		8: {Nome: "Clientes em Campinas", Definicao: &Regra{Campo: "endereco.cidade", Operador: Igual, Valor: "Campinas"}},
+	}}
+	tests := []struct {
+		name  string
+		regra string
+		sql   string
+		args  []interface{}
+	}{
+		{
+			"mulheres com gato",
+			`{"operador":"and","regras":[{"campo":"cliente.sexo","operador":"eq","valor":"F"},{"campo":"pet.especie","operador":"eq","valor":"Gato"}]}`,
+			"((clientes.sexo = ?) AND (EXISTS (SELECT 1 FROM pets WHERE pets.cliente_id = clientes.id AND pets.especie = ?)))",
+			[]interface{}{"F", "Gato"},
+		},
+		{
+			"negação e lista",
+			`{"operador":"not","regras":[{"campo":"endereco.cidade","operador":"in","valor":["Campinas","Jundiaí"]}]}`,
+			"NOT COALESCE((EXISTS (SELECT 1 FROM enderecos WHERE enderecos.id_cliente = clientes.id AND enderecos.cidade IN ?)), FALSE)",
+			[]interface{}{[]interface{}{"Campinas", "Jundiaí"}},
+		},
+		{
+			"contém escapa curingas",
+			`{"campo":"cliente.nome","operador":"contains","valor":"50%_off"}`,
+			"(clientes.nome_cliente LIKE ?)",
+			[]interface{}{`%50\%\_off%`},
+		},
+		{
+			"critérios cadastrados",
+			`{"operador":"or","regras":[{"criterio":1},{"criterio":6}]}`,
+			"((clientes.tipo_cliente = ?) OR (EXISTS (SELECT 1 FROM pets WHERE pets.cliente_id = clientes.id AND pets.especie = ?)))",
+			[]interface{}{"PF", "Gato"},
+		},
+		{
+			"tag por ID",
+			`{"campo":"tag.id","operador":"eq","valor":3}`,
+			"(EXISTS (SELECT 1 FROM tags_clientes INNER JOIN tags ON tags.id_tag = tags_clientes.id_tag WHERE tags_clientes.cliente_id = clientes.id AND tags_clientes.id_tag = ?))",
+			[]interface{}{3.0},
+		},
+		{
+			"critério com definição",
+			`{"operador":"and","regras":[{"criterio":8},{"campo":"cliente.sexo","operador":"eq","valor":"F"}]}`,
+			"((EXISTS (SELECT 1 FROM enderecos WHERE enderecos.id_cliente = clientes.id AND enderecos.cidade = ?)) AND (clientes.sexo = ?))",
+			[]interface{}{"Campinas", "F"},
+		},
+		{
+			"idade entre",
+			`{"campo":"cliente.idade","operador":"between","valor":[25,40]}`,
+			"(TIMESTAMPDIFF(YEAR, clientes.data_nascimento, CURDATE()) BETWEEN ? AND ?)",
+			[]interface{}{25.0, 40.0},
+		},
+		{
+			"cadastrados a partir de uma data",
+			`{"campo":"cliente.data_cadastro","operador":"gte","valor":"2025-01-01"}`,
+			"(DATE(clientes.data_cadastro) >= ?)",
+			[]interface{}{"2025-01-01"},
+		},
+		{
+			"pets aniversariantes do mês",
+			`{"campo":"pet.meses_para_aniversario","operador":"eq","valor":0}`,
+			"(EXISTS (SELECT 1 FROM pets WHERE pets.cliente_id = clientes.id AND MOD(MONTH(pets.data_aniversario) - MONTH(CURDATE()) + 12, 12) = ?))",
+			[]interface{}{0.0},
+		},
+		{
+			"porte e bairro",
+			`{"operador":"or","regras":[{"campo":"pet.porte","operador":"eq","valor":"Grande"},{"campo":"endereco.bairro","operador":"contains","valor":"Centro"}]}`,
+			"((EXISTS (SELECT 1 FROM pets WHERE pets.cliente_id = clientes.id AND pets.porte = ?)) OR (EXISTS (SELECT 1 FROM enderecos WHERE enderecos.id_cliente = clientes.id AND enderecos.bairro LIKE ?)))",
+			[]interface{}{"Grande", "%Centro%"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regra := parse(t, tt.regra)
+			assert.NoError(t, Validar(regra, ctx.Criterios))
+
+			sql, args, err := Compilar(regra, ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.sql, sql)
+			assert.Equal(t, tt.args, args)
+		})
+	}
+}
+
+func TestCompilar_SegmentoRFM(t *testing.T) {
+	ctx := Contexto{SegmentosRFM: map[string][]int{"campeoes": {4, 9}, "em_risco": {}}}
+
+	sql, args, err := Compilar(parse(t, `{"campo":"rfm.segmento","operador":"eq","valor":"campeoes"}`), ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "(clientes.id IN ?)", sql)
+	assert.Equal(t, []interface{}{[]int{4, 9}}, args)
+
+	sql, args, err = Compilar(parse(t, `{"campo":"rfm.segmento","operador":"eq","valor":"em_risco"}`), ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "(1 = 0)", sql)
+	assert.Nil(t, args)
+
+	sql, _, err = Compilar(parse(t, `{"campo":"rfm.segmento","operador":"neq","valor":"em_risco"}`), ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "(1 = 1)", sql)
+}
+
+func TestValidar_Erros(t *testing.T) {
+	criterios := map[int]Criterio{1: {Nome: "Pessoa Física"}, 2: {Nome: "Critério Antigo"}}
+	tests := []struct {
+		name  string
+		regra string
+		erro  string
+	}{
+		{"grupo vazio", `{"operador":"and","regras":[]}`, "regra: group 'and' requires at least one regra"},
+		{"not com duas regras", `{"operador":"not","regras":[{"criterio":1},{"criterio":1}]}`, "regra: group 'not' requires exactly one regra"},
+		{"campo desconhecido", `{"operador":"or","regras":[{"criterio":1},{"campo":"cliente.cpf","operador":"eq","valor":"1"}]}`, "regra.regras[1].campo: unknown campo 'cliente.cpf'"},
+		{"operador inválido para o campo", `{"campo":"cliente.sexo","operador":"contains","valor":"F"}`, "regra.operador: operador 'contains' is not valid for campo 'cliente.sexo'"},
+		{"valor fora da lista", `{"campo":"cliente.sexo","operador":"eq","valor":"X"}`, "regra.valor: 'X' is not one of M, F"},
+		{"lista vazia", `{"campo":"pet.especie","operador":"in","valor":[]}`, "regra.valor: operador 'in' requires a non-empty list"},
+		{"tipo do valor", `{"campo":"tag.id","operador":"eq","valor":"3"}`, "regra.valor: expected a number"},
+		{"critério inexistente", `{"criterio":99}`, "regra.criterio: criterio 99 not found"},
+		{"critério sem tradução", `{"criterio":2}`, "regra.criterio: criterio 'Critério Antigo' is not supported"},
+		{"critério com campo", `{"criterio":1,"campo":"cliente.sexo"}`, "regra: criterio cannot be combined with campo, operador or valor"},
+		{"grupo com campo", `{"operador":"and","campo":"cliente.sexo","regras":[{"criterio":1}]}`, "regra: group 'and' accepts only regras"},
+		{"operador numérico em texto", `{"campo":"cliente.nome","operador":"gt","valor":"A"}`, "regra.operador: operador 'gt' is not valid for campo 'cliente.nome'"},
+		{"intervalo incompleto", `{"campo":"cliente.idade","operador":"between","valor":[18]}`, "regra.valor: operador 'between' requires a list with start and end"},
+		{"intervalo invertido", `{"campo":"pet.idade","operador":"between","valor":[10,2]}`, "regra.valor: start of the interval must not be after the end"},
+		{"mês fora do limite", `{"campo":"pet.mes_aniversario","operador":"eq","valor":13}`, "regra.valor: 13 is greater than the maximum of 12"},
+		{"data inválida", `{"campo":"cliente.data_cadastro","operador":"lt","valor":"01/02/2025"}`, "regra.valor: expected a date in the format YYYY-MM-DD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validar(parse(t, tt.regra), criterios)
+			assert.EqualError(t, err, tt.erro)
+		})
+	}
+}
+
+func TestValidarDefinicao(t *testing.T) {
+	assert.NoError(t, ValidarDefinicao(parse(t, `{"campo":"tag.nome","operador":"eq","valor":"VIP"}`)))
+	assert.EqualError(t, ValidarDefinicao(parse(t, `{"operador":"or","regras":[{"criterio":1}]}`)),
+		"regra.regras[0].criterio: a criterio definition cannot reference other criterios")
+}
+
+func TestValidar_Profundidade(t *testing.T) {
+	regra := Regra{Campo: "cliente.sexo", Operador: Igual, Valor: "F"}
+	for i := 0; i < ProfundidadeMaxima; i++ {
+		regra = Regra{Operador: OperadorNao, Regras: []Regra{regra}}
+	}
+
+	assert.Error(t, Validar(regra, nil))
+}
+
+func TestRegraDosCriterios(t *testing.T) {
+	regra := RegraDosCriterios([]int{1, 6})
+
+	assert.Equal(t, Regra{Operador: OperadorOu, Regras: []Regra{{Criterio: 1}, {Criterio: 6}}}, regra)
+}
+
+func TestUsaSegmentoRFM(t *testing.T) {
+	criterios := map[int]Criterio{
+		1: {Nome: "Pessoa Física"},
+		7: {Nome: "RFM Campeões"},
+		9: {Nome: "Fiéis de Campinas", Definicao: &Regra{Operador: OperadorE, Regras: []Regra{{Campo: CampoSegmentoRFM, Operador: Igual, Valor: "leais"}}}},
+	}
+
+	assert.False(t, UsaSegmentoRFM(RegraDosCriterios([]int{1}), criterios))
+	assert.True(t, UsaSegmentoRFM(RegraDosCriterios([]int{1, 7}), criterios))
+	assert.True(t, UsaSegmentoRFM(RegraDosCriterios([]int{9}), criterios))
+	assert.True(t, UsaSegmentoRFM(Regra{Operador: OperadorNao, Regras: []Regra{{Campo: CampoSegmentoRFM, Operador: Igual, Valor: "novos"}}}, nil))
+}
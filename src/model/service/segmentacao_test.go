@@ -0,0 +1,340 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// criteriosFixos simula os critérios cadastrados sem definição, resolvidos pelo nome
+func criteriosFixos() []entity.Criterio {
+	return []entity.Criterio{
+		{ID: 1, NomeCondicao: "Pessoa Física"},
+		{ID: 6, NomeCondicao: "Possui Gato"},
+	}
+}
+
+// TESTES PARA AssociarCriteriosPublicoService (regra)
+func TestService_AssociarCriteriosPublicoService_GravaRegra(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetAllCriterios", "1").Return(criteriosFixos(), nil)
+	mockDBClient.On("AssociarCriteriosPublico", 4, []int(nil), mock.MatchedBy(func(regra *entity.PublicoRegra) bool {
+		return regra != nil && regra.Regra == `{"operador":"and","regras":[{"criterio":1},{"operador":"eq","campo":"cliente.sexo","valor":"F"}]}`
+	}), "1").Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.AssociarCriteriosRequest{Regra: &dtos.RegraPublico{
+		Operador: "and",
+		Regras: []dtos.RegraPublico{
+			{Criterio: 1},
+			{Campo: "cliente.sexo", Operador: "eq", Valor: "F"},
+		},
+	}}
+
+	// Act
+	success, err := service.AssociarCriteriosPublicoService("1", "4", request)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, success)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_AssociarCriteriosPublicoService_CriterioInexistente(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetAllCriterios", "1").Return(criteriosFixos(), nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.AssociarCriteriosRequest{Regra: &dtos.RegraPublico{
+		Operador: "or",
+		Regras:   []dtos.RegraPublico{{Criterio: 1}, {Criterio: 99}},
+	}}
+
+	// Act
+	success, err := service.AssociarCriteriosPublicoService("1", "4", request)
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+	assert.Equal(t, "regra.regras[1].criterio: criterio 99 not found", err.Message)
+
+	mockDBClient.AssertNotCalled(t, "AssociarCriteriosPublico", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA BuscarClientesCriteriosService (regra)
+func TestService_BuscarClientesCriteriosService_RegraPrevaleceSobreCriterios(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetAllCriterios", "1").Return(criteriosFixos(), nil)
+	mockDBClient.On("GetRegraPublico", 4, "1").Return(&entity.PublicoRegra{IDPublico: 4, Regra: `{"operador":"not","regras":[{"criterio":6}]}`}, nil)
+	mockDBClient.On("BuscarClientesPorRegra", "1", "NOT COALESCE((EXISTS (SELECT 1 FROM pets WHERE pets.cliente_id = clientes.id AND pets.especie = ?)), FALSE)", []interface{}{"Gato"}, 4).
+		Return([]entity.Cliente{{ID: 2, TipoCliente: "PF", Sexo: "F"}}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.BuscarClientesCriteriosService("1", "4")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.Total)
+	assert.Equal(t, 2, result.Clientes[0].ID)
+
+	mockDBClient.AssertNotCalled(t, "GetCriteriosPublico", mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_BuscarClientesCriteriosService_SemRegraUsaCriterios(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetAllCriterios", "1").Return(criteriosFixos(), nil)
+	mockDBClient.On("GetRegraPublico", 4, "1").Return(nil, gorm.ErrRecordNotFound)
+	mockDBClient.On("GetCriteriosPublico", "4", "1").Return([]entity.PublicoCriterioJoin{{IDPublico: 4, IDCriterio: 1}, {IDPublico: 4, IDCriterio: 6}}, nil)
+	mockDBClient.On("BuscarClientesPorRegra", "1", "((clientes.tipo_cliente = ?) OR (EXISTS (SELECT 1 FROM pets WHERE pets.cliente_id = clientes.id AND pets.especie = ?)))", []interface{}{"PF", "Gato"}, 4).
+		Return([]entity.Cliente{}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.BuscarClientesCriteriosService("1", "4")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 0, result.Total)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA CreateCriterioService
+func TestService_CreateCriterioService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("CreateCriterio", mock.MatchedBy(func(criterio *entity.Criterio) bool {
+		return criterio.NomeCondicao == "Clientes em Campinas" && criterio.Regra != nil &&
+			*criterio.Regra == `{"operador":"eq","campo":"endereco.cidade","valor":"Campinas"}`
+	}), "1").Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.Criterio).ID = 8
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.CreateCriterioRequest{
+		NomeCondicao: "Clientes em Campinas",
+		Regra:        &dtos.RegraPublico{Campo: "endereco.cidade", Operador: "eq", Valor: "Campinas"},
+	}
+
+	// Act
+	result, err := service.CreateCriterioService("1", request)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 8, result.ID)
+	assert.Equal(t, "endereco.cidade", result.Regra.Campo)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateCriterioService_ReferenciaOutroCriterio(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.CreateCriterioRequest{
+		NomeCondicao: "Pessoa Física em Campinas",
+		Regra: &dtos.RegraPublico{
+			Operador: "and",
+			Regras: []dtos.RegraPublico{
+				{Criterio: 1},
+				{Campo: "endereco.cidade", Operador: "eq", Valor: "Campinas"},
+			},
+		},
+	}
+
+	// Act
+	result, err := service.CreateCriterioService("1", request)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertNotCalled(t, "CreateCriterio", mock.Anything, mock.Anything)
+}
+
+// TESTES PARA BuscarClientesCriteriosService (critério com definição)
+func TestService_BuscarClientesCriteriosService_CriterioComDefinicao(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	definicao := `{"operador":"eq","campo":"endereco.cidade","valor":"Campinas"}`
+	mockDBClient.On("GetAllCriterios", "1").Return(append(criteriosFixos(), entity.Criterio{ID: 9, NomeCondicao: "Clientes em Campinas", Regra: &definicao}), nil)
+	mockDBClient.On("GetRegraPublico", 4, "1").Return(nil, gorm.ErrRecordNotFound)
+	mockDBClient.On("GetCriteriosPublico", "4", "1").Return([]entity.PublicoCriterioJoin{{IDPublico: 4, IDCriterio: 9}}, nil)
+	mockDBClient.On("BuscarClientesPorRegra", "1", "((EXISTS (SELECT 1 FROM enderecos WHERE enderecos.id_cliente = clientes.id AND enderecos.cidade = ?)))", []interface{}{"Campinas"}, 4).Return([]entity.Cliente{{ID: 3}}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.BuscarClientesCriteriosService("1", "4")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.Total)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA PreviewPublicoService
+func TestService_PreviewPublicoService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetAllCriterios", "1").Return(criteriosFixos(), nil)
+	mockDBClient.On("PreviewPublico", "1", "((clientes.tipo_cliente = ?) OR (EXISTS (SELECT 1 FROM pets WHERE pets.cliente_id = clientes.id AND pets.especie = ?)))", []interface{}{"PF", "Gato"}, AmostraPreviewPadrao).
+		Return(&entity.PreviewPublico{
+			Total:      2,
+			PorSexo:    []entity.ContagemPublico{{Valor: "F", Total: 2}},
+			PorEspecie: []entity.ContagemPublico{{Valor: "Gato", Total: 1}},
+			PorCidade:  []entity.ContagemPublico{},
+			Amostra:    []entity.Cliente{{ID: 2, NomeCliente: "Ana"}, {ID: 5, NomeCliente: "Bia"}},
+		}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.PreviewPublicoService("1", dtos.PreviewPublicoRequest{Criterios: []int{1, 6}})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 2, result.Total)
+	assert.Equal(t, []dtos.ContagemPublicoResponse{{Valor: "F", Total: 2}}, result.PorSexo)
+	assert.Len(t, result.Amostra, 2)
+	assert.Equal(t, "Bia", result.Amostra[1].NomeCliente)
+
+	// Sem segmento RFM na regra, as vendas dos clientes não são consultadas
+	mockDBClient.AssertNotCalled(t, "GetComprasClientes", mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_PreviewPublicoService_SegmentoRFMSemClientes(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetAllCriterios", "1").Return(criteriosFixos(), nil)
+	mockDBClient.On("GetComprasClientes", "1").Return([]entity.ComprasCliente{}, nil)
+	mockDBClient.On("PreviewPublico", "1", "((1 = 0) AND (clientes.sexo = ?))", []interface{}{"F"}, 5).
+		Return(&entity.PreviewPublico{}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.PreviewPublicoRequest{
+		Regra: &dtos.RegraPublico{
+			Operador: "and",
+			Regras: []dtos.RegraPublico{
+				{Campo: "rfm.segmento", Operador: "eq", Valor: "campeoes"},
+				{Campo: "cliente.sexo", Operador: "eq", Valor: "F"},
+			},
+		},
+		Amostra: 5,
+	}
+
+	// Act
+	result, err := service.PreviewPublicoService("1", request)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 0, result.Total)
+	assert.Empty(t, result.Amostra)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_PreviewPublicoService_RegraInvalida(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetAllCriterios", "1").Return(criteriosFixos(), nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.PreviewPublicoRequest{
+		Regra: &dtos.RegraPublico{Operador: "not", Regras: []dtos.RegraPublico{{Criterio: 1}, {Criterio: 6}}},
+	}
+
+	// Act
+	result, err := service.PreviewPublicoService("1", request)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+	assert.Equal(t, "regra: group 'not' requires exactly one regra", err.Message)
+
+	mockDBClient.AssertNotCalled(t, "PreviewPublico", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_PreviewPublicoService_ErroNoRFM(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetAllCriterios", "1").Return(criteriosFixos(), nil)
+	mockDBClient.On("GetComprasClientes", "1").Return(nil, errors.New("database error"))
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.PreviewPublicoRequest{
+		Regra: &dtos.RegraPublico{Campo: "rfm.segmento", Operador: "in", Valor: []interface{}{"campeoes", "leais"}},
+	}
+
+	// Act
+	result, err := service.PreviewPublicoService("1", request)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 500, err.Code)
+
+	mockDBClient.AssertNotCalled(t, "PreviewPublico", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/betine97/back-project.git/cmd/config/exceptions"
@@ -13,9 +15,13 @@ import (
 	entity "github.com/betine97/back-project.git/src/model/entitys"
 	"github.com/betine97/back-project.git/src/model/interfaces"
 	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/betine97/back-project.git/src/model/service/agenda"
+	"github.com/betine97/back-project.git/src/model/service/catalogo"
 	"github.com/betine97/back-project.git/src/model/service/crypto"
+	"github.com/betine97/back-project.git/src/model/service/disparo"
 	redis "github.com/go-redis/redis/v8"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 type ServiceInterface interface {
@@ -31,6 +37,17 @@ type ServiceInterface interface {
 	GetAllProductsService(userID string, page, limit int) (*dtos.ProductListResponse, *exceptions.RestErr)
 	CreateProductService(userID string, request dtos.CreateProductRequest) (bool, *exceptions.RestErr)
 	DeleteProductService(userID string, id string) (bool, *exceptions.RestErr)
+	GetProductByIDService(userID string, id string) (*dtos.ProductResponse, *exceptions.RestErr)
+	GetProductByBarcodeService(userID string, barcode string) (*dtos.ProductResponse, *exceptions.RestErr)
+	GetProductBySKUService(userID string, sku string) (*dtos.ProductResponse, *exceptions.RestErr)
+	UpdateProductService(userID string, id string, request dtos.UpdateProductRequest) (*dtos.ProductResponse, *exceptions.RestErr)
+
+	// Variações e Kits
+	GetVariacoesService(userID string, id string) (*dtos.VariacaoListResponse, *exceptions.RestErr)
+	CreateVariacaoService(userID string, id string, request dtos.CreateVariacaoRequest) (int, *exceptions.RestErr)
+	VincularVariacaoService(userID string, id string, idVariacao string) (bool, *exceptions.RestErr)
+	GetKitComponentesService(userID string, id string) (*dtos.KitComponentesResponse, *exceptions.RestErr)
+	SetKitComponentesService(userID string, id string, request dtos.SetKitComponentesRequest) (*dtos.KitComponentesResponse, *exceptions.RestErr)
 
 	GetAllPedidosService(userID string, page, limit int) (*dtos.PedidoListResponse, *exceptions.RestErr)
 	GetPedidoByIdService(userID string, id string) (*dtos.PedidoResponse, *exceptions.RestErr)
@@ -40,9 +57,45 @@ type ServiceInterface interface {
 	GetItensPedidoService(userID string, idPedido string, page, limit int) (*dtos.DetalhesPedidoListResponse, *exceptions.RestErr)
 	CreateItemPedidoService(userID string, idPedido string, request dtos.CreateItemPedidoRequest) (bool, *exceptions.RestErr)
 
+	// Vendas
+	GetAllVendasService(userID string, status string, idCliente string, page, limit int) (*dtos.VendaListResponse, *exceptions.RestErr)
+	GetVendaByIDService(userID string, id string) (*dtos.VendaDetalheResponse, *exceptions.RestErr)
+	GetItensVendaService(userID string, id string) (*dtos.ItemVendaListResponse, *exceptions.RestErr)
+	CreateVendaService(userID string, request dtos.CreateVendaRequest) (int, *exceptions.RestErr)
+	AdicionarItemVendaService(userID string, id string, request dtos.ItemVendaRequest) (*dtos.VendaDetalheResponse, *exceptions.RestErr)
+	PagarVendaService(userID string, id string, request dtos.PagarVendaRequest) (*dtos.VendaDetalheResponse, *exceptions.RestErr)
+	CancelarVendaService(userID string, id string) (bool, *exceptions.RestErr)
+
+	// Devoluções e crédito na loja
+	CreateDevolucaoService(userID string, id string, request dtos.CreateDevolucaoRequest) (*dtos.DevolucaoDetalheResponse, *exceptions.RestErr)
+	GetAllDevolucoesService(userID string, idVenda string, idCliente string, page, limit int) (*dtos.DevolucaoListResponse, *exceptions.RestErr)
+	GetDevolucaoByIDService(userID string, id string) (*dtos.DevolucaoDetalheResponse, *exceptions.RestErr)
+	GetCreditosClienteService(userID string, id string) (*dtos.ExtratoCreditoResponse, *exceptions.RestErr)
+
+	// RFM de clientes
+	GetRFMClientesService(userID string, segmento string, page, limit int) (*dtos.RFMClienteListResponse, *exceptions.RestErr)
+
+	// Fidelidade
+	GetFidelidadeConfigService(userID string) (*dtos.FidelidadeConfigResponse, *exceptions.RestErr)
+	UpdateFidelidadeConfigService(userID string, request dtos.UpdateFidelidadeConfigRequest) (*dtos.FidelidadeConfigResponse, *exceptions.RestErr)
+	GetPontosClienteService(userID string, id string) (*dtos.ExtratoPontosResponse, *exceptions.RestErr)
+	ResgatarPontosService(userID string, id string, request dtos.ResgatarPontosRequest) (*dtos.ResgatePontosResponse, *exceptions.RestErr)
+	ExpirarPontosService(userID string) (*dtos.ExpiracaoPontosResponse, *exceptions.RestErr)
+
+	// Caixa
+	AbrirCaixaService(userID string, request dtos.AbrirCaixaRequest) (*dtos.CaixaSessaoResponse, *exceptions.RestErr)
+	GetAllCaixasService(userID string, status string, page, limit int) (*dtos.CaixaSessaoListResponse, *exceptions.RestErr)
+	GetCaixaAtualService(userID string, operador string) (*dtos.CaixaSessaoResponse, *exceptions.RestErr)
+	GetCaixaByIDService(userID string, id string) (*dtos.CaixaSessaoResponse, *exceptions.RestErr)
+	GetCaixaMovimentosService(userID string, id string) (*dtos.CaixaMovimentoListResponse, *exceptions.RestErr)
+	RegistrarMovimentoCaixaService(userID string, id string, tipo string, request dtos.MovimentoCaixaRequest) (*dtos.CaixaMovimentoResponse, *exceptions.RestErr)
+	FecharCaixaService(userID string, id string, request dtos.FecharCaixaRequest) (*dtos.RelatorioCaixaResponse, *exceptions.RestErr)
+	GetRelatorioCaixaService(userID string, id string) (*dtos.RelatorioCaixaResponse, *exceptions.RestErr)
+
 	// Estoque
 	GetAllEstoqueService(userID string, page, limit int) (*dtos.DetalhesEstoqueListResponse, *exceptions.RestErr)
 	CreateEstoqueService(userID string, request dtos.CreateEstoqueRequest) (bool, *exceptions.RestErr)
+	CreateSaidaEstoqueService(userID string, request dtos.CreateSaidaEstoqueRequest) (*dtos.SaidaEstoqueResponse, *exceptions.RestErr)
 
 	// Clientes
 	GetAllClientesService(userID string, page, limit int) (*dtos.ClienteListResponse, *exceptions.RestErr)
@@ -68,6 +121,18 @@ type ServiceInterface interface {
 
 	// Critérios
 	GetAllCriteriosService(userID string) (*dtos.CriterioListResponse, *exceptions.RestErr)
+	GetCamposCriteriosService() *dtos.CampoCriterioListResponse
+	CreateCriterioService(userID string, request dtos.CreateCriterioRequest) (*dtos.CriterioResponse, *exceptions.RestErr)
+	PreviewPublicoService(userID string, request dtos.PreviewPublicoRequest) (*dtos.PreviewPublicoResponse, *exceptions.RestErr)
+	UpdateTipoPublicoService(userID string, idPublico string, request dtos.UpdateTipoPublicoRequest) (*dtos.PublicoResponse, *exceptions.RestErr)
+	AtualizarPublicoService(userID string, idPublico string) (*dtos.AtualizacaoPublicoResponse, *exceptions.RestErr)
+	AdicionarMembrosPublicoService(userID string, idPublico string, request dtos.MembrosPublicoRequest) (*dtos.AdicionarMembrosPublicoResponse, *exceptions.RestErr)
+	RemoverMembrosPublicoService(userID string, idPublico string, request dtos.MembrosPublicoRequest) (*dtos.RemoverMembrosPublicoResponse, *exceptions.RestErr)
+	ExcluirClientesPublicoService(userID string, idPublico string, request dtos.ExcluirClientesPublicoRequest) (*dtos.ExcluirClientesPublicoResponse, *exceptions.RestErr)
+	RemoverExclusaoPublicoService(userID string, idPublico string, idCliente string) (bool, *exceptions.RestErr)
+	GetExclusoesPublicoService(userID string, idPublico string, page, limit int) (*dtos.PublicoExclusaoListResponse, *exceptions.RestErr)
+	OperacaoPublicosService(userID string, request dtos.OperacaoPublicosRequest) (*dtos.OperacaoPublicosResponse, *exceptions.RestErr)
+	GetHistoricoPublicoService(userID string, idPublico string, idCliente int, tipo string, page, limit int) (*dtos.PublicoHistoricoListResponse, *exceptions.RestErr)
 
 	// Públicos
 	GetAllPublicosService(userID string, page, limit int) (*dtos.PublicoListResponse, *exceptions.RestErr)
@@ -85,10 +150,116 @@ type ServiceInterface interface {
 
 	// Campanhas
 	GetAllCampanhasService(userID string, page, limit int) (*dtos.CampanhaListResponse, *exceptions.RestErr)
-	GetCampanhaByIDService(userID string, id string) (*dtos.CampanhaResponse, *exceptions.RestErr)
+	GetCampanhaByIDService(userID string, id string, janelaDias int) (*dtos.CampanhaResponse, *exceptions.RestErr)
 	CreateCampanhaService(userID string, request dtos.CreateCampanhaRequest) (int, *exceptions.RestErr)
 	AssociarPublicosCampanhaService(userID string, idCampanha string, request dtos.AssociarPublicosCampanhaRequest) (bool, *exceptions.RestErr)
 	GetPublicosCampanhaService(userID string, idCampanha string) (*dtos.PublicosCampanhaListResponse, *exceptions.RestErr)
+	DispararCampanhaService(userID string, idCampanha string, request dtos.DispararCampanhaRequest) (*dtos.DisparoCampanhaResponse, *exceptions.RestErr)
+	GetEnviosCampanhaService(userID string, idCampanha string, canal, status string, page, limit int) (*dtos.CampanhaEnvioListResponse, *exceptions.RestErr)
+	GetCanaisCampanhaService(userID string, idCampanha string) (*dtos.CanaisCampanhaResponse, *exceptions.RestErr)
+	SetCanaisCampanhaService(userID string, idCampanha string, request dtos.CanaisCampanhaRequest) (*dtos.CanaisCampanhaResponse, *exceptions.RestErr)
+	ValidarCampanhaService(userID string, idCampanha string, canais []string) (*dtos.ValidacaoCampanhaResponse, *exceptions.RestErr)
+	PausarCampanhaService(userID string, idCampanha string) (*dtos.CampanhaResponse, *exceptions.RestErr)
+	RetomarCampanhaService(userID string, idCampanha string) (*dtos.CampanhaResponse, *exceptions.RestErr)
+
+	// Rastreio de envios
+	RegistrarAberturaService(token string) *exceptions.RestErr
+	RegistrarCliqueService(token, destino, assinatura string) (string, *exceptions.RestErr)
+	RegistrarStatusEnvioService(token, assinatura string, request dtos.StatusEnvioRequest) *exceptions.RestErr
+	ValidarDescadastroService(token string) *exceptions.RestErr
+	RegistrarDescadastroService(token string) *exceptions.RestErr
+
+	// Consentimentos de clientes
+	GetConsentimentosClienteService(userID string, idCliente string) (*dtos.ConsentimentosClienteResponse, *exceptions.RestErr)
+	SetConsentimentosClienteService(userID string, idCliente string, request dtos.ConsentimentosClienteRequest) (*dtos.ConsentimentosClienteResponse, *exceptions.RestErr)
+	GetHistoricoConsentimentosService(userID string, idCliente string, canal string, page, limit int) (*dtos.ConsentimentoHistoricoListResponse, *exceptions.RestErr)
+
+	// Templates de mensagens
+	GetTemplatesMensagensService(userID string, canal string, page, limit int) (*dtos.TemplateMensagemListResponse, *exceptions.RestErr)
+	GetTemplateMensagemByIDService(userID string, id string) (*dtos.TemplateMensagemResponse, *exceptions.RestErr)
+	CreateTemplateMensagemService(userID string, request dtos.TemplateMensagemRequest) (*dtos.TemplateMensagemResponse, *exceptions.RestErr)
+	UpdateTemplateMensagemService(userID string, id string, request dtos.TemplateMensagemRequest) (*dtos.TemplateMensagemResponse, *exceptions.RestErr)
+	DeleteTemplateMensagemService(userID string, id string) (bool, *exceptions.RestErr)
+	GetCamposTemplateService() *dtos.CamposTemplateResponse
+	PreviewTemplateMensagemService(userID string, id string, idCliente string, idCampanha string) (*dtos.PreviewTemplateResponse, *exceptions.RestErr)
+
+	// Alertas de Estoque
+	GetAlertasEstoqueService(userID string, tipo, status string, page, limit int) (*dtos.AlertaEstoqueListResponse, *exceptions.RestErr)
+	GerarAlertasEstoqueService(userID string, dias int) (*dtos.GerarAlertasResponse, *exceptions.RestErr)
+	ResolverAlertaEstoqueService(userID string, id string) (bool, *exceptions.RestErr)
+	UpdateEstoqueMinimoService(userID string, id string, request dtos.UpdateEstoqueMinimoRequest) (bool, *exceptions.RestErr)
+
+	// Inventários
+	CreateInventarioService(userID string, request dtos.CreateInventarioRequest) (int, *exceptions.RestErr)
+	GetAllInventariosService(userID string, status string, page, limit int) (*dtos.InventarioListResponse, *exceptions.RestErr)
+	GetInventarioByIDService(userID string, id string) (*dtos.InventarioResponse, *exceptions.RestErr)
+	RegistrarContagemService(userID string, id string, request dtos.RegistrarContagemRequest) (*dtos.RegistrarContagemResponse, *exceptions.RestErr)
+	GetDivergenciasInventarioService(userID string, id string) (*dtos.InventarioDivergenciasResponse, *exceptions.RestErr)
+	FecharInventarioService(userID string, id string, request dtos.FecharInventarioRequest) (*dtos.FecharInventarioResponse, *exceptions.RestErr)
+	CancelarInventarioService(userID string, id string) (bool, *exceptions.RestErr)
+
+	// Relatórios
+	GetValorizacaoEstoqueService(userID string, dataCorte string, agrupamento string) (*dtos.ValorizacaoEstoqueResponse, *exceptions.RestErr)
+	GetMargemBrutaService(userID string, dataCorte string) (*dtos.MargemBrutaResponse, *exceptions.RestErr)
+
+	// Preços
+	GetHistoricoPrecosService(userID string, idProduto string, page, limit int) (*dtos.HistoricoPrecoListResponse, *exceptions.RestErr)
+	CreatePrecoAgendadoService(userID string, idProduto string, request dtos.CreatePrecoAgendadoRequest) (int, *exceptions.RestErr)
+	GetPrecosAgendadosService(userID string, idProduto string, status string) (*dtos.PrecoAgendadoListResponse, *exceptions.RestErr)
+	CancelarPrecoAgendadoService(userID string, idProduto string, idAgendamento string) (bool, *exceptions.RestErr)
+	GetPrecoVigenteService(userID string, idProduto string, data string) (*dtos.PrecoVigenteResponse, *exceptions.RestErr)
+	AplicarPrecosAgendadosService(userID string) (int, *exceptions.RestErr)
+
+	// Categorias
+	GetCategoriasService(userID string) (*dtos.CategoriaTreeResponse, *exceptions.RestErr)
+	GetCategoriaByIDService(userID string, id string) (*dtos.CategoriaResponse, *exceptions.RestErr)
+	CreateCategoriaService(userID string, request dtos.CreateCategoriaRequest) (int, *exceptions.RestErr)
+	UpdateCategoriaService(userID string, id string, request dtos.UpdateCategoriaRequest) (bool, *exceptions.RestErr)
+	MoverCategoriaService(userID string, id string, request dtos.MoverCategoriaRequest) (bool, *exceptions.RestErr)
+	MesclarCategoriaService(userID string, id string, request dtos.MesclarCategoriaRequest) (bool, *exceptions.RestErr)
+	DeleteCategoriaService(userID string, id string) (bool, *exceptions.RestErr)
+	GetEspeciesService() *dtos.EspeciesResponse
+	NormalizarCatalogoService(userID string, dryRun bool) (*dtos.NormalizacaoCatalogoResponse, *exceptions.RestErr)
+
+	// Exportações
+	ExportarFornecedoresService(userID string) (ExportarFunc, *exceptions.RestErr)
+	ExportarProductsService(userID string) (ExportarFunc, *exceptions.RestErr)
+	ExportarPedidosService(userID string) (ExportarFunc, *exceptions.RestErr)
+	ExportarEstoqueService(userID string) (ExportarFunc, *exceptions.RestErr)
+	ExportarAlertasEstoqueService(userID string, tipo, status string) (ExportarFunc, *exceptions.RestErr)
+	ExportarInventariosService(userID string, status string) (ExportarFunc, *exceptions.RestErr)
+	ExportarClientesService(userID string) (ExportarFunc, *exceptions.RestErr)
+	ExportarEnderecosService(userID string) (ExportarFunc, *exceptions.RestErr)
+	ExportarPetsService(userID string) (ExportarFunc, *exceptions.RestErr)
+	ExportarTagsService(userID string) (ExportarFunc, *exceptions.RestErr)
+	ExportarPublicosService(userID string) (ExportarFunc, *exceptions.RestErr)
+	ExportarClientesPublicoService(userID string, idPublico string, canal string) (ExportarFunc, *exceptions.RestErr)
+	ExportarCampanhasService(userID string) (ExportarFunc, *exceptions.RestErr)
+	ExportarVendasService(userID string, status string, idCliente string) (ExportarFunc, *exceptions.RestErr)
+	ExportarCaixasService(userID string, status string) (ExportarFunc, *exceptions.RestErr)
+	ExportarDevolucoesService(userID string, idVenda string, idCliente string) (ExportarFunc, *exceptions.RestErr)
+	ExportarRFMClientesService(userID string, segmento string) (ExportarFunc, *exceptions.RestErr)
+	ExportarHistoricoPrecosService(userID string, idProduto string) (ExportarFunc, *exceptions.RestErr)
+	ExportarImportacoesService(userID string, recurso string) (ExportarFunc, *exceptions.RestErr)
+	ExportarImportacaoErrosService(userID string, id string) (ExportarFunc, *exceptions.RestErr)
+	ExportarEnviosCampanhaService(userID string, idCampanha string, canal, status string) (ExportarFunc, *exceptions.RestErr)
+	ExportarTemplatesMensagensService(userID string, canal string) (ExportarFunc, *exceptions.RestErr)
+	ExportarHistoricoConsentimentosService(userID string, idCliente string, canal string) (ExportarFunc, *exceptions.RestErr)
+
+	// Importações
+	ImportarService(userID string, recurso string, request dtos.ImportacaoRequest, validar ValidadorCampos) (*dtos.ImportacaoIniciadaResponse, *exceptions.RestErr)
+	GetImportacoesService(userID string, recurso string, page, limit int) (*dtos.ImportacaoListResponse, *exceptions.RestErr)
+	GetImportacaoByIDService(userID string, id string) (*dtos.ImportacaoResponse, *exceptions.RestErr)
+	GetImportacaoErrosService(userID string, id string, page, limit int) (*dtos.ImportacaoErroListResponse, *exceptions.RestErr)
+
+	// Jobs
+	ExecutarAlertasEstoqueJob(dias int)
+	ExecutarPrecosAgendadosJob()
+	ExecutarFidelidadeJob()
+	ExecutarPublicosDinamicosJob()
+	ExecutarDisparosJob()
+	ExecutarEnviosParadosJob()
+	ExecutarCampanhasJob()
 }
 
 var ctx = context.Background()
@@ -99,15 +270,20 @@ type Service struct {
 	dbClient persistence.PersistenceInterfaceDBClient
 	redis    interfaces.RedisInterface
 	tokenGen interfaces.TokenGeneratorInterface
+	notifier interfaces.NotifierInterface
+	// Disparo de campanhas; nil quando nenhum canal está configurado
+	disparador *disparo.Disparador
 }
 
-func NewServiceInstance(crypto crypto.CryptoInterface, dbmaster persistence.PersistenceInterfaceDBMaster, dbClient persistence.PersistenceInterfaceDBClient, redisClient interfaces.RedisInterface, tokenGen interfaces.TokenGeneratorInterface) ServiceInterface {
+func NewServiceInstance(crypto crypto.CryptoInterface, dbmaster persistence.PersistenceInterfaceDBMaster, dbClient persistence.PersistenceInterfaceDBClient, redisClient interfaces.RedisInterface, tokenGen interfaces.TokenGeneratorInterface, notifier interfaces.NotifierInterface, disparador *disparo.Disparador) ServiceInterface {
 	return &Service{
-		crypto:   crypto,
-		dbmaster: dbmaster,
-		dbClient: dbClient,
-		redis:    redisClient,
-		tokenGen: tokenGen,
+		crypto:     crypto,
+		dbmaster:   dbmaster,
+		dbClient:   dbClient,
+		redis:      redisClient,
+		tokenGen:   tokenGen,
+		notifier:   notifier,
+		disparador: disparador,
 	}
 }
 
@@ -262,16 +438,7 @@ func (srv *Service) GetAllFornecedoresService(userID string, page, limit int) (*
 
 	fornecedorResponses := make([]dtos.FornecedorResponse, len(fornecedores))
 	for i, fornecedor := range fornecedores {
-		fornecedorResponses[i] = dtos.FornecedorResponse{
-			ID:           fornecedor.ID,
-			Nome:         fornecedor.Nome,
-			Telefone:     fornecedor.Telefone,
-			Email:        fornecedor.Email,
-			Cidade:       fornecedor.Cidade,
-			Estado:       fornecedor.Estado,
-			Status:       fornecedor.Status,
-			DataCadastro: fornecedor.DataCadastro,
-		}
+		fornecedorResponses[i] = buildFornecedorResponse(fornecedor)
 	}
 
 	// Calcular total de páginas
@@ -289,6 +456,19 @@ func (srv *Service) GetAllFornecedoresService(userID string, page, limit int) (*
 	return response, nil
 }
 
+func buildFornecedorResponse(fornecedor entity.Fornecedores) dtos.FornecedorResponse {
+	return dtos.FornecedorResponse{
+		ID:           fornecedor.ID,
+		Nome:         fornecedor.Nome,
+		Telefone:     fornecedor.Telefone,
+		Email:        fornecedor.Email,
+		Cidade:       fornecedor.Cidade,
+		Estado:       fornecedor.Estado,
+		Status:       fornecedor.Status,
+		DataCadastro: fornecedor.DataCadastro,
+	}
+}
+
 func (srv *Service) CreateFornecedorService(userID string, request dtos.CreateFornecedorRequest) (bool, *exceptions.RestErr) {
 	zap.L().Info("Starting fornecedor creation service")
 
@@ -396,20 +576,7 @@ func (srv *Service) GetAllProductsService(userID string, page, limit int) (*dtos
 
 	productResponses := make([]dtos.ProductResponse, len(products))
 	for i, product := range products {
-		productResponses[i] = dtos.ProductResponse{
-			ID:            product.IDProduto,
-			CodigoBarra:   product.CodigoBarra,
-			NomeProduto:   product.NomeProduto,
-			SKU:           product.SKU,
-			Categoria:     product.Categoria,
-			DestinadoPara: product.DestinadoPara,
-			Variacao:      product.Variacao,
-			Marca:         product.Marca,
-			Descricao:     product.Descricao,
-			Status:        product.Status,
-			PrecoVenda:    product.PrecoVenda,
-			IDFornecedor:  product.IDFornecedor,
-		}
+		productResponses[i] = buildProductResponse(product)
 	}
 
 	// Calcular total de páginas
@@ -430,31 +597,102 @@ func (srv *Service) GetAllProductsService(userID string, page, limit int) (*dtos
 func (srv *Service) CreateProductService(userID string, request dtos.CreateProductRequest) (bool, *exceptions.RestErr) {
 	zap.L().Info("Starting product creation service")
 
-	// Validar se o código de barras já existe (apenas se não estiver vazio)
-	if request.CodigoBarra != "" {
-		existingProduct := srv.dbClient.GetProductByBarcode(request.CodigoBarra, userID)
-		if existingProduct.CodigoBarra != "" {
-			zap.L().Warn("Barcode already associated with an existing product", zap.String("barcode", request.CodigoBarra))
-			return false, exceptions.NewBadRequestError("Este código de barras já está sendo usado por outro produto. Por favor, verifique e tente novamente.")
-		}
+	// Validar se o código de barras e o SKU já existem (apenas se não estiverem vazios)
+	if restErr := srv.validarCodigosUnicos(userID, 0, request.CodigoBarra, request.SKU); restErr != nil {
+		return false, restErr
 	}
 
 	product := entity.BuildProductEntity(request)
 
+	// Categoria e destinado_para passam a apontar para as referências controladas
+	if request.IDCategoria != nil || strings.TrimSpace(request.Categoria) != "" {
+		categoria, restErr := srv.resolverCategoria(userID, request.IDCategoria, request.Categoria)
+		if restErr != nil {
+			return false, restErr
+		}
+		product.IDCategoria = &categoria.ID
+		product.Categoria = categoria.Nome
+	}
+	if destinado, ok := catalogo.NormalizarDestinadoPara(request.DestinadoPara); ok {
+		product.DestinadoPara = destinado
+	}
+
 	dbErr := srv.dbClient.CreateProduct(*product, userID)
 	if dbErr != nil {
 		zap.L().Error("Error creating product in database", zap.Error(dbErr))
-		return false, exceptions.NewInternalServerError("Internal server error")
+		return false, erroGravacaoProduto(dbErr)
 	}
 
 	zap.L().Info("Product created successfully", zap.String("product", product.NomeProduto))
 	return true, nil
 }
 
+const (
+	msgCodigoBarraEmUso = "Este código de barras já está sendo usado por outro produto. Por favor, verifique e tente novamente."
+	msgSKUEmUso         = "Este SKU já está sendo usado por outro produto. Por favor, verifique e tente novamente."
+)
+
+// validarCodigosUnicos garante que o código de barras e o SKU não estejam em uso por outro produto que não o
+// idProduto (0 na criação); valores vazios são ignorados. Responde 409, como a violação do índice único em
+// erroGravacaoProduto, para que o cliente receba o mesmo código qualquer que seja o momento da duplicidade.
+func (srv *Service) validarCodigosUnicos(userID string, idProduto int, codigoBarra string, sku string) *exceptions.RestErr {
+	if codigoBarra != "" {
+		existingProduct := srv.dbClient.GetProductByBarcode(codigoBarra, userID)
+		if existingProduct.IDProduto != 0 && existingProduct.IDProduto != idProduto {
+			zap.L().Warn("Barcode already associated with an existing product", zap.String("barcode", codigoBarra))
+			return exceptions.NewConflictError(msgCodigoBarraEmUso)
+		}
+	}
+	if sku != "" {
+		existingProduct := srv.dbClient.GetProductBySKU(sku, userID)
+		if existingProduct.IDProduto != 0 && existingProduct.IDProduto != idProduto {
+			zap.L().Warn("SKU already associated with an existing product", zap.String("sku", sku))
+			return exceptions.NewConflictError(msgSKUEmUso)
+		}
+	}
+	return nil
+}
+
+// erroGravacaoProduto converte o erro da gravação do produto: código de barras ou SKU gravado por uma
+// requisição concorrente (índice único violado) vira 409, o resto 500
+func erroGravacaoProduto(dbErr error) *exceptions.RestErr {
+	indice, duplicado := persistence.IndiceDuplicado(dbErr)
+	if !duplicado {
+		return exceptions.NewInternalServerError("Internal server error")
+	}
+	if indice == persistence.IndiceProdutoSKU {
+		return exceptions.NewConflictError(msgSKUEmUso)
+	}
+	return exceptions.NewConflictError(msgCodigoBarraEmUso)
+}
+
 func (srv *Service) DeleteProductService(userID string, id string) (bool, *exceptions.RestErr) {
 	zap.L().Info("Starting delete product service")
 
-	dbErr := srv.dbClient.DeleteProduct(id, userID)
+	idProduto := 0
+	if _, err := fmt.Sscanf(id, "%d", &idProduto); err != nil {
+		zap.L().Error("Error converting product id to int", zap.Error(err))
+		return false, exceptions.NewBadRequestError("Invalid product ID")
+	}
+
+	temVariacoes, dbErr := srv.dbClient.ProdutoTemVariacoes(idProduto, userID)
+	if dbErr != nil {
+		zap.L().Error("Error checking produto variacoes", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+	if temVariacoes {
+		return false, exceptions.NewConflictError("Product has variations")
+	}
+	emKit, dbErr := srv.dbClient.ProdutoEmKit(idProduto, userID)
+	if dbErr != nil {
+		zap.L().Error("Error checking produto em kit", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+	if emKit {
+		return false, exceptions.NewConflictError("Product is a component of a kit")
+	}
+
+	dbErr = srv.dbClient.DeleteProduct(id, userID)
 	if dbErr != nil {
 		zap.L().Error("Error deleting product in database", zap.Error(dbErr))
 		return false, exceptions.NewInternalServerError("Internal server error")
@@ -464,6 +702,214 @@ func (srv *Service) DeleteProductService(userID string, id string) (bool, *excep
 	return true, nil
 }
 
+func (srv *Service) GetProductByIDService(userID string, id string) (*dtos.ProductResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get product by ID service", zap.String("id", id))
+
+	product, dbErr := srv.dbClient.GetProductByID(id, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			zap.L().Warn("Product not found by ID", zap.String("id", id))
+			return nil, exceptions.NewNotFoundError("Product not found")
+		}
+		zap.L().Error("Error getting product by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := buildProductResponse(*product)
+
+	zap.L().Info("Successfully retrieved product by ID", zap.String("id", id))
+	return &response, nil
+}
+
+func (srv *Service) GetProductByBarcodeService(userID string, barcode string) (*dtos.ProductResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get product by barcode service", zap.String("barcode", barcode))
+
+	product := srv.dbClient.GetProductByBarcode(barcode, userID)
+	if product.IDProduto == 0 {
+		zap.L().Warn("Product not found by barcode", zap.String("barcode", barcode))
+		return nil, exceptions.NewNotFoundError("Product not found")
+	}
+
+	response := buildProductResponse(*product)
+
+	zap.L().Info("Successfully retrieved product by barcode", zap.String("barcode", barcode))
+	return &response, nil
+}
+
+func (srv *Service) GetProductBySKUService(userID string, sku string) (*dtos.ProductResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get product by SKU service", zap.String("sku", sku))
+
+	product := srv.dbClient.GetProductBySKU(sku, userID)
+	if product.IDProduto == 0 {
+		zap.L().Warn("Product not found by SKU", zap.String("sku", sku))
+		return nil, exceptions.NewNotFoundError("Product not found")
+	}
+
+	response := buildProductResponse(*product)
+
+	zap.L().Info("Successfully retrieved product by SKU", zap.String("sku", sku))
+	return &response, nil
+}
+
+// UpdateProductService altera apenas os campos enviados, mantendo código de barras e SKU únicos
+func (srv *Service) UpdateProductService(userID string, id string, request dtos.UpdateProductRequest) (*dtos.ProductResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting update product service", zap.String("id", id))
+
+	product, dbErr := srv.dbClient.GetProductByID(id, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Product not found")
+		}
+		zap.L().Error("Error getting product by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	// Variações herdam marca, categoria, destinado_para e fornecedor do produto pai
+	if product.Tipo == entity.ProdutoTipoVariacao && (request.Marca != nil || request.Categoria != nil || request.IDCategoria != nil || request.DestinadoPara != nil || request.IDFornecedor != nil) {
+		return nil, exceptions.NewBadRequestError("marca, categoria, destinado_para and id_fornecedor are inherited from the parent product; update the parent instead")
+	}
+
+	campos := map[string]interface{}{}
+
+	// Só os códigos alterados precisam ser conferidos
+	novoCodigoBarra, novoSKU := "", ""
+	if request.CodigoBarra != nil {
+		if *request.CodigoBarra != product.CodigoBarra {
+			novoCodigoBarra = *request.CodigoBarra
+		}
+		campos["codigo_barra"] = *request.CodigoBarra
+	}
+	if request.SKU != nil {
+		if *request.SKU != product.SKU {
+			novoSKU = *request.SKU
+		}
+		campos["sku"] = *request.SKU
+	}
+	if restErr := srv.validarCodigosUnicos(userID, product.IDProduto, novoCodigoBarra, novoSKU); restErr != nil {
+		return nil, restErr
+	}
+	if request.NomeProduto != nil {
+		if *request.NomeProduto == "" {
+			return nil, exceptions.NewBadRequestError("nome_produto cannot be empty")
+		}
+		campos["nome_produto"] = *request.NomeProduto
+	}
+	if request.Status != nil {
+		if *request.Status == "" {
+			return nil, exceptions.NewBadRequestError("status cannot be empty")
+		}
+		campos["status"] = *request.Status
+	}
+	if request.PrecoVenda != nil {
+		if *request.PrecoVenda <= 0 {
+			return nil, exceptions.NewBadRequestError("preco_venda must be greater than 0")
+		}
+		campos["preco_venda"] = *request.PrecoVenda
+	}
+	if request.IDFornecedor != nil {
+		if *request.IDFornecedor <= 0 {
+			return nil, exceptions.NewBadRequestError("id_fornecedor must be greater than 0")
+		}
+		campos["id_fornecedor"] = *request.IDFornecedor
+	}
+	if request.EstoqueMinimo != nil {
+		campos["estoque_minimo"] = *request.EstoqueMinimo
+	}
+	if request.IDCategoria != nil || request.Categoria != nil {
+		nome := ""
+		if request.Categoria != nil {
+			nome = *request.Categoria
+		}
+		if request.IDCategoria == nil && strings.TrimSpace(nome) == "" {
+			campos["id_categoria"] = nil
+			campos["categoria"] = ""
+		} else {
+			categoria, restErr := srv.resolverCategoria(userID, request.IDCategoria, nome)
+			if restErr != nil {
+				return nil, restErr
+			}
+			campos["id_categoria"] = categoria.ID
+			campos["categoria"] = categoria.Nome
+		}
+	}
+	if request.DestinadoPara != nil {
+		destinado := *request.DestinadoPara
+		if controlado, ok := catalogo.NormalizarDestinadoPara(destinado); ok {
+			destinado = controlado
+		}
+		campos["destinado_para"] = destinado
+	}
+	if request.Variacao != nil {
+		campos["variacao"] = *request.Variacao
+	}
+	if request.Marca != nil {
+		campos["marca"] = *request.Marca
+	}
+	if request.Descricao != nil {
+		campos["descricao"] = *request.Descricao
+	}
+
+	if len(campos) == 0 {
+		return nil, exceptions.NewBadRequestError("No fields to update")
+	}
+
+	// Mudanças de preço ficam registradas no histórico para consultas do preço vigente em datas passadas
+	var historico *entity.HistoricoPreco
+	if request.PrecoVenda != nil && *request.PrecoVenda != product.PrecoVenda {
+		historico = &entity.HistoricoPreco{
+			IDProduto:     product.IDProduto,
+			PrecoAnterior: product.PrecoVenda,
+			PrecoNovo:     *request.PrecoVenda,
+			Origem:        entity.PrecoOrigemManual,
+			AlteradoPor:   userID,
+			DataAlteracao: time.Now().Format("2006-01-02 15:04:05"),
+		}
+	}
+
+	dbErr = srv.dbClient.UpdateProduct(id, campos, historico, userID)
+	if dbErr != nil {
+		zap.L().Error("Error updating product in database", zap.Error(dbErr))
+		return nil, erroGravacaoProduto(dbErr)
+	}
+
+	updated, dbErr := srv.dbClient.GetProductByID(id, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting updated product", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := buildProductResponse(*updated)
+
+	zap.L().Info("Product updated successfully", zap.String("id", id), zap.Int("campos", len(campos)))
+	return &response, nil
+}
+
+func buildProductResponse(product entity.Produto) dtos.ProductResponse {
+	response := dtos.ProductResponse{
+		ID:            product.IDProduto,
+		CodigoBarra:   product.CodigoBarra,
+		NomeProduto:   product.NomeProduto,
+		SKU:           product.SKU,
+		Categoria:     product.Categoria,
+		DestinadoPara: product.DestinadoPara,
+		Variacao:      product.Variacao,
+		Marca:         product.Marca,
+		Descricao:     product.Descricao,
+		Status:        product.Status,
+		PrecoVenda:    product.PrecoVenda,
+		IDFornecedor:  product.IDFornecedor,
+		EstoqueMinimo: product.EstoqueMinimo,
+		Tipo:          product.Tipo,
+	}
+	if product.IDProdutoPai != nil {
+		response.IDProdutoPai = *product.IDProdutoPai
+	}
+	if product.IDCategoria != nil {
+		response.IDCategoria = *product.IDCategoria
+	}
+	return response
+}
+
 // FUNÇÕES DE PEDIDOS ------------------------------------------------------------------------------------------------------------------------------------
 
 func (srv *Service) GetAllPedidosService(userID string, page, limit int) (*dtos.PedidoListResponse, *exceptions.RestErr) {
@@ -488,17 +934,7 @@ func (srv *Service) GetAllPedidosService(userID string, page, limit int) (*dtos.
 
 	pedidoResponses := make([]dtos.PedidoResponse, len(pedidos))
 	for i, pedido := range pedidos {
-		pedidoResponses[i] = dtos.PedidoResponse{
-			ID:           pedido.IDPedido,
-			IDFornecedor: pedido.IDFornecedor,
-			DataPedido:   pedido.DataPedido,
-			DataEntrega:  pedido.DataEntrega,
-			ValorFrete:   pedido.ValorFrete,
-			CustoPedido:  pedido.CustoPedido,
-			ValorTotal:   pedido.ValorTotal,
-			Descricao:    pedido.Descricao,
-			Status:       pedido.Status,
-		}
+		pedidoResponses[i] = buildPedidoResponse(pedido)
 	}
 
 	// Calcular total de páginas
@@ -516,6 +952,20 @@ func (srv *Service) GetAllPedidosService(userID string, page, limit int) (*dtos.
 	return response, nil
 }
 
+func buildPedidoResponse(pedido entity.Pedido) dtos.PedidoResponse {
+	return dtos.PedidoResponse{
+		ID:           pedido.IDPedido,
+		IDFornecedor: pedido.IDFornecedor,
+		DataPedido:   pedido.DataPedido,
+		DataEntrega:  pedido.DataEntrega,
+		ValorFrete:   pedido.ValorFrete,
+		CustoPedido:  pedido.CustoPedido,
+		ValorTotal:   pedido.ValorTotal,
+		Descricao:    pedido.Descricao,
+		Status:       pedido.Status,
+	}
+}
+
 func (srv *Service) GetPedidoByIdService(userID string, id string) (*dtos.PedidoResponse, *exceptions.RestErr) {
 	zap.L().Info("Starting get pedido by ID service", zap.String("id", id))
 
@@ -657,16 +1107,7 @@ func (srv *Service) GetAllEstoqueService(userID string, page, limit int) (*dtos.
 	// Converter entidades da view para DTOs
 	var estoqueResponse []dtos.DetalhesEstoqueResponse
 	for _, item := range detalhesEstoque {
-		estoqueResponse = append(estoqueResponse, dtos.DetalhesEstoqueResponse{
-			NomeProduto:         item.NomeProduto,
-			Lote:                item.Lote,
-			Quantidade:          item.Quantidade,
-			DataEntrada:         item.DataEntrada,
-			DataSaida:           item.DataSaida,
-			Vencimento:          item.Vencimento,
-			DocumentoReferencia: item.DocumentoReferencia,
-			Status:              item.Status,
-		})
+		estoqueResponse = append(estoqueResponse, buildDetalhesEstoqueResponse(item))
 	}
 
 	totalPages := (total + limit - 1) / limit
@@ -683,9 +1124,31 @@ func (srv *Service) GetAllEstoqueService(userID string, page, limit int) (*dtos.
 	return response, nil
 }
 
+func buildDetalhesEstoqueResponse(item entity.ViewDetalhesEstoque) dtos.DetalhesEstoqueResponse {
+	return dtos.DetalhesEstoqueResponse{
+		NomeProduto:         item.NomeProduto,
+		Lote:                item.Lote,
+		Quantidade:          item.Quantidade,
+		DataEntrada:         item.DataEntrada,
+		DataSaida:           item.DataSaida,
+		Vencimento:          item.Vencimento,
+		DocumentoReferencia: item.DocumentoReferencia,
+		Status:              item.Status,
+	}
+}
+
 func (srv *Service) CreateEstoqueService(userID string, request dtos.CreateEstoqueRequest) (bool, *exceptions.RestErr) {
 	zap.L().Info("Starting estoque creation service", zap.Int("id_produto", request.IDProduto))
 
+	// Kits e produtos pai não têm estoque próprio
+	product, restErr := srv.getProduto(userID, strconv.Itoa(request.IDProduto))
+	if restErr != nil {
+		return false, restErr
+	}
+	if product.Tipo == entity.ProdutoTipoKit || product.Tipo == entity.ProdutoTipoPai {
+		return false, exceptions.NewBadRequestError(fmt.Sprintf("Products of type '%s' do not have their own stock", product.Tipo))
+	}
+
 	estoque := entity.BuildEstoqueEntity(request)
 
 	dbErr := srv.dbClient.CreateEstoque(*estoque, userID)
@@ -722,16 +1185,7 @@ func (srv *Service) GetAllClientesService(userID string, page, limit int) (*dtos
 
 	clienteResponses := make([]dtos.ClienteResponse, len(clientes))
 	for i, cliente := range clientes {
-		clienteResponses[i] = dtos.ClienteResponse{
-			ID:             cliente.ID,
-			TipoCliente:    cliente.TipoCliente,
-			NomeCliente:    cliente.NomeCliente,
-			NumeroCelular:  cliente.NumeroCelular,
-			Sexo:           cliente.Sexo,
-			Email:          cliente.Email,
-			DataNascimento: cliente.DataNascimento,
-			DataCadastro:   cliente.DataCadastro,
-		}
+		clienteResponses[i] = buildClienteResponse(cliente)
 	}
 
 	// Calcular total de páginas
@@ -749,17 +1203,36 @@ func (srv *Service) GetAllClientesService(userID string, page, limit int) (*dtos
 	return response, nil
 }
 
+func buildClienteResponse(cliente entity.Cliente) dtos.ClienteResponse {
+	return dtos.ClienteResponse{
+		ID:             cliente.ID,
+		TipoCliente:    cliente.TipoCliente,
+		NomeCliente:    cliente.NomeCliente,
+		NumeroCelular:  cliente.NumeroCelular,
+		Sexo:           cliente.Sexo,
+		Email:          cliente.Email,
+		DataNascimento: cliente.DataNascimento,
+		DataCadastro:   cliente.DataCadastro,
+	}
+}
+
 func (srv *Service) BuscarClientesCriteriosService(userID string, idPublico string) (*dtos.ClienteCriterioListResponse, *exceptions.RestErr) {
 	zap.L().Info("Starting buscar clientes criterios service", zap.String("idPublico", idPublico))
 
-	// Primeiro, buscar os critérios do público
-	criterios, dbErr := srv.dbClient.GetCriteriosPublico(idPublico, userID)
-	if dbErr != nil {
-		zap.L().Error("Error getting criterios for publico", zap.Error(dbErr))
-		return nil, exceptions.NewInternalServerError("Error retrieving criterios for publico")
+	// Converter idPublico string para int
+	idPublicoInt := 0
+	if _, err := fmt.Sscanf(idPublico, "%d", &idPublicoInt); err != nil {
+		zap.L().Error("Error converting idPublico to int", zap.Error(err))
+		return nil, exceptions.NewBadRequestError("Invalid publico ID")
+	}
+
+	// Primeiro, buscar a regra do público (ou os critérios associados)
+	regra, criterios, restErr := srv.regraDoPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
 	}
 
-	if len(criterios) == 0 {
+	if regra == nil {
 		zap.L().Warn("No criterios found for publico", zap.String("idPublico", idPublico))
 		return &dtos.ClienteCriterioListResponse{
 			Clientes: []dtos.ClienteCriterioResponse{},
@@ -767,11 +1240,10 @@ func (srv *Service) BuscarClientesCriteriosService(userID string, idPublico stri
 		}, nil
 	}
 
-	// Buscar clientes que atendem aos critérios
-	clientes, dbErr := srv.dbClient.BuscarClientesPorCriterios(userID, criterios)
-	if dbErr != nil {
-		zap.L().Error("Error getting clientes by criterios from database", zap.Error(dbErr))
-		return nil, exceptions.NewInternalServerError("Error retrieving clientes for criterios")
+	// Buscar clientes que atendem à regra
+	clientes, restErr := srv.buscarClientesPorRegra(userID, idPublicoInt, *regra, criterios)
+	if restErr != nil {
+		return nil, restErr
 	}
 
 	clienteResponses := make([]dtos.ClienteCriterioResponse, len(clientes))
@@ -788,7 +1260,7 @@ func (srv *Service) BuscarClientesCriteriosService(userID string, idPublico stri
 		Total:    len(clientes),
 	}
 
-	zap.L().Info("Successfully retrieved clientes for criterios", zap.Int("total", len(clientes)), zap.Int("criterios_count", len(criterios)))
+	zap.L().Info("Successfully retrieved clientes for criterios", zap.Int("total", len(clientes)))
 	return response, nil
 }
 
@@ -802,14 +1274,13 @@ func (srv *Service) AdicionarClientesAoPublicoService(userID string, idPublico s
 		return nil, exceptions.NewBadRequestError("Invalid publico ID")
 	}
 
-	// Primeiro, buscar os critérios do público
-	criterios, dbErr := srv.dbClient.GetCriteriosPublico(idPublico, userID)
-	if dbErr != nil {
-		zap.L().Error("Error getting criterios for publico", zap.Error(dbErr))
-		return nil, exceptions.NewInternalServerError("Error retrieving criterios for publico")
+	// Primeiro, buscar a regra do público (ou os critérios associados)
+	regra, criterios, restErr := srv.regraDoPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
 	}
 
-	if len(criterios) == 0 {
+	if regra == nil {
 		zap.L().Warn("No criterios found for publico", zap.String("idPublico", idPublico))
 		return &dtos.AdicionarClientesPublicoResponse{
 			ClientesAdicionados: 0,
@@ -819,11 +1290,10 @@ func (srv *Service) AdicionarClientesAoPublicoService(userID string, idPublico s
 		}, nil
 	}
 
-	// Buscar clientes que atendem aos critérios
-	clientes, dbErr := srv.dbClient.BuscarClientesPorCriterios(userID, criterios)
-	if dbErr != nil {
-		zap.L().Error("Error getting clientes by criterios from database", zap.Error(dbErr))
-		return nil, exceptions.NewInternalServerError("Error retrieving clientes for criterios")
+	// Buscar clientes que atendem à regra
+	clientes, restErr := srv.buscarClientesPorRegra(userID, idPublicoInt, *regra, criterios)
+	if restErr != nil {
+		return nil, restErr
 	}
 
 	if len(clientes) == 0 {
@@ -837,8 +1307,15 @@ func (srv *Service) AdicionarClientesAoPublicoService(userID string, idPublico s
 	}
 
 	// Adicionar clientes ao público
-	clientesAdicionados, clientesJaExistiam, dbErr := srv.dbClient.AdicionarClientesAoPublico(userID, idPublicoInt, clientes)
+	clienteIDs := make([]int, len(clientes))
+	for i, cliente := range clientes {
+		clienteIDs[i] = cliente.ID
+	}
+	clientesAdicionados, clientesJaExistiam, dbErr := srv.dbClient.AdicionarMembrosPublico(userID, idPublicoInt, distintos(clienteIDs), entity.PublicoEventoOrigemManual, time.Now().Format(formatoDataHora))
 	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Publico not found")
+		}
 		zap.L().Error("Error adding clientes to publico", zap.Error(dbErr))
 		return nil, exceptions.NewInternalServerError("Error adding clientes to publico")
 	}
@@ -863,8 +1340,7 @@ func (srv *Service) AdicionarClientesAoPublicoService(userID string, idPublico s
 	zap.L().Info("Successfully added clientes to publico",
 		zap.Int("total_encontrados", len(clientes)),
 		zap.Int("adicionados", clientesAdicionados),
-		zap.Int("ja_existiam", clientesJaExistiam),
-		zap.Int("criterios_count", len(criterios)))
+		zap.Int("ja_existiam", clientesJaExistiam))
 	return response, nil
 }
 
@@ -888,6 +1364,14 @@ func (srv *Service) GetClienteByIDService(userID string, id string) (*dtos.Clien
 		DataCadastro:   cliente.DataCadastro,
 	}
 
+	// O RFM é um complemento do cadastro: se falhar, o cliente é retornado sem ele
+	rfmCliente, restErr := srv.getRFMCliente(userID, cliente.ID)
+	if restErr != nil {
+		zap.L().Warn("Error getting RFM of cliente, returning cliente without it", zap.String("id", id), zap.String("error", restErr.Error()))
+	} else {
+		response.RFM = rfmCliente
+	}
+
 	zap.L().Info("Successfully retrieved cliente by ID", zap.String("id", id))
 	return response, nil
 }
@@ -956,18 +1440,7 @@ func (srv *Service) GetAllEnderecosService(userID string, page, limit int) (*dto
 
 	enderecoResponses := make([]dtos.EnderecoResponse, len(enderecos))
 	for i, endereco := range enderecos {
-		enderecoResponses[i] = dtos.EnderecoResponse{
-			IDEndereco:  endereco.IDEndereco,
-			IDCliente:   endereco.IDCliente,
-			CEP:         endereco.CEP,
-			Cidade:      endereco.Cidade,
-			Estado:      endereco.Estado,
-			Bairro:      endereco.Bairro,
-			Logradouro:  endereco.Logradouro,
-			Numero:      endereco.Numero,
-			Complemento: endereco.Complemento,
-			Obs:         endereco.Obs,
-		}
+		enderecoResponses[i] = buildEnderecoResponse(endereco)
 	}
 
 	// Calcular total de páginas
@@ -985,6 +1458,21 @@ func (srv *Service) GetAllEnderecosService(userID string, page, limit int) (*dto
 	return response, nil
 }
 
+func buildEnderecoResponse(endereco entity.Endereco) dtos.EnderecoResponse {
+	return dtos.EnderecoResponse{
+		IDEndereco:  endereco.IDEndereco,
+		IDCliente:   endereco.IDCliente,
+		CEP:         endereco.CEP,
+		Cidade:      endereco.Cidade,
+		Estado:      endereco.Estado,
+		Bairro:      endereco.Bairro,
+		Logradouro:  endereco.Logradouro,
+		Numero:      endereco.Numero,
+		Complemento: endereco.Complemento,
+		Obs:         endereco.Obs,
+	}
+}
+
 func (srv *Service) CreateEnderecoService(userID string, request dtos.CreateEnderecoRequest) (bool, *exceptions.RestErr) {
 	zap.L().Info("Starting endereco creation service")
 
@@ -1039,10 +1527,11 @@ func (srv *Service) GetAllCriteriosService(userID string) (*dtos.CriterioListRes
 
 	criterioResponses := make([]dtos.CriterioResponse, len(criterios))
 	for i, criterio := range criterios {
-		criterioResponses[i] = dtos.CriterioResponse{
-			ID:           criterio.ID,
-			NomeCondicao: criterio.NomeCondicao,
+		response, restErr := buildCriterioResponse(criterio)
+		if restErr != nil {
+			return nil, restErr
 		}
+		criterioResponses[i] = *response
 	}
 
 	response := &dtos.CriterioListResponse{
@@ -1078,13 +1567,7 @@ func (srv *Service) GetAllPublicosService(userID string, page, limit int) (*dtos
 
 	publicoResponses := make([]dtos.PublicoResponse, len(publicos))
 	for i, publico := range publicos {
-		publicoResponses[i] = dtos.PublicoResponse{
-			ID:          publico.ID,
-			Nome:        publico.Nome,
-			Descricao:   publico.Descricao,
-			DataCriacao: publico.DataCriacao,
-			Status:      publico.Status,
-		}
+		publicoResponses[i] = buildPublicoResponse(publico)
 	}
 
 	// Calcular total de páginas
@@ -1102,6 +1585,18 @@ func (srv *Service) GetAllPublicosService(userID string, page, limit int) (*dtos
 	return response, nil
 }
 
+func buildPublicoResponse(publico entity.PublicoCliente) dtos.PublicoResponse {
+	return dtos.PublicoResponse{
+		ID:                publico.ID,
+		Nome:              publico.Nome,
+		Descricao:         publico.Descricao,
+		DataCriacao:       publico.DataCriacao,
+		Status:            publico.Status,
+		Tipo:              publico.Tipo,
+		UltimaAtualizacao: publico.UltimaAtualizacao,
+	}
+}
+
 func (srv *Service) CreatePublicoService(userID string, request dtos.CreatePublicoRequest) (int, *exceptions.RestErr) {
 	zap.L().Info("Starting publico creation service")
 
@@ -1127,7 +1622,16 @@ func (srv *Service) AssociarCriteriosPublicoService(userID string, idPublico str
 		return false, exceptions.NewBadRequestError("Invalid publico ID")
 	}
 
-	dbErr := srv.dbClient.AssociarCriteriosPublico(idPublicoInt, request.Criterios, userID)
+	if len(request.Criterios) == 0 && request.Regra == nil {
+		return false, exceptions.NewBadRequestError("criterios or regra is required")
+	}
+
+	publicoRegra, restErr := srv.validarCriteriosPublico(userID, request)
+	if restErr != nil {
+		return false, restErr
+	}
+
+	dbErr := srv.dbClient.AssociarCriteriosPublico(idPublicoInt, request.Criterios, publicoRegra, userID)
 	if dbErr != nil {
 		zap.L().Error("Error associating criterios to publico", zap.Error(dbErr))
 		return false, exceptions.NewInternalServerError("Internal server error")
@@ -1160,6 +1664,14 @@ func (srv *Service) GetCriteriosPublicoService(userID string, idPublico string)
 		Total:     len(criterios),
 	}
 
+	regra, restErr := srv.getRegraPublico(userID, idPublico)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if regra != nil {
+		response.Regra = buildRegraPublicoResponse(*regra)
+	}
+
 	zap.L().Info("Successfully retrieved criterios for publico", zap.String("idPublico", idPublico), zap.Int("count", len(criterios)))
 	return response, nil
 }
@@ -1246,23 +1758,7 @@ func (srv *Service) GetAllPetsService(userID string, page, limit int) (*dtos.Pet
 
 	petResponses := make([]dtos.PetResponse, len(pets))
 	for i, pet := range pets {
-		petResponse := dtos.PetResponse{
-			IDPet:        pet.IDPet,
-			ClienteID:    pet.ClienteID,
-			NomePet:      pet.NomePet,
-			Especie:      pet.Especie,
-			Raca:         pet.Raca,
-			Porte:        pet.Porte,
-			Idade:        pet.Idade,
-			DataRegistro: pet.DataRegistro.Format("2006-01-02 15:04:05"),
-		}
-
-		// Formatar data de aniversário se existir
-		if pet.DataAniversario != nil {
-			petResponse.DataAniversario = pet.DataAniversario.Format("2006-01-02")
-		}
-
-		petResponses[i] = petResponse
+		petResponses[i] = buildPetResponse(pet)
 	}
 
 	// Calcular total de páginas
@@ -1280,6 +1776,26 @@ func (srv *Service) GetAllPetsService(userID string, page, limit int) (*dtos.Pet
 	return response, nil
 }
 
+func buildPetResponse(pet entity.Pet) dtos.PetResponse {
+	petResponse := dtos.PetResponse{
+		IDPet:        pet.IDPet,
+		ClienteID:    pet.ClienteID,
+		NomePet:      pet.NomePet,
+		Especie:      pet.Especie,
+		Raca:         pet.Raca,
+		Porte:        pet.Porte,
+		Idade:        pet.Idade,
+		DataRegistro: pet.DataRegistro.Format("2006-01-02 15:04:05"),
+	}
+
+	// Formatar data de aniversário se existir
+	if pet.DataAniversario != nil {
+		petResponse.DataAniversario = pet.DataAniversario.Format("2006-01-02")
+	}
+
+	return petResponse
+}
+
 func (srv *Service) CreatePetService(userID string, request dtos.CreatePetRequest) (int, *exceptions.RestErr) {
 	zap.L().Info("Starting pet creation service")
 
@@ -1291,6 +1807,9 @@ func (srv *Service) CreatePetService(userID string, request dtos.CreatePetReques
 	}
 
 	pet := entity.BuildPetEntity(request)
+	if especie, ok := catalogo.NormalizarEspecie(pet.Especie); ok {
+		pet.Especie = especie
+	}
 
 	dbErr := srv.dbClient.CreatePet(pet, userID)
 	if dbErr != nil {
@@ -1578,11 +2097,7 @@ func (srv *Service) GetAllTagsService(userID string, page, limit int) (*dtos.Tag
 
 	tagResponses := make([]dtos.TagResponse, len(tags))
 	for i, tag := range tags {
-		tagResponses[i] = dtos.TagResponse{
-			IDTag:        tag.IDTag,
-			CategoriaTag: tag.CategoriaTag,
-			NomeTag:      tag.NomeTag,
-		}
+		tagResponses[i] = buildTagResponse(tag)
 	}
 
 	// Calcular total de páginas
@@ -1600,6 +2115,14 @@ func (srv *Service) GetAllTagsService(userID string, page, limit int) (*dtos.Tag
 	return response, nil
 }
 
+func buildTagResponse(tag entity.Tag) dtos.TagResponse {
+	return dtos.TagResponse{
+		IDTag:        tag.IDTag,
+		CategoriaTag: tag.CategoriaTag,
+		NomeTag:      tag.NomeTag,
+	}
+}
+
 func (srv *Service) CreateTagService(userID string, request dtos.CreateTagRequest) (bool, *exceptions.RestErr) {
 	zap.L().Info("Starting tag creation service")
 
@@ -1639,15 +2162,7 @@ func (srv *Service) GetAllCampanhasService(userID string, page, limit int) (*dto
 
 	campanhaResponses := make([]dtos.CampanhaResponse, len(campanhas))
 	for i, campanha := range campanhas {
-		campanhaResponses[i] = dtos.CampanhaResponse{
-			ID:             campanha.ID,
-			Nome:           campanha.Nome,
-			Desc:           campanha.Desc,
-			DataCriacao:    campanha.DataCriacao,
-			DataLancamento: campanha.DataLancamento,
-			DataFim:        campanha.DataFim,
-			Status:         campanha.Status,
-		}
+		campanhaResponses[i] = buildCampanhaResponse(campanha)
 	}
 
 	// Calcular total de páginas
@@ -1665,16 +2180,8 @@ func (srv *Service) GetAllCampanhasService(userID string, page, limit int) (*dto
 	return response, nil
 }
 
-func (srv *Service) GetCampanhaByIDService(userID string, id string) (*dtos.CampanhaResponse, *exceptions.RestErr) {
-	zap.L().Info("Starting get campanha by ID service", zap.String("id", id))
-
-	campanha := srv.dbClient.GetCampanhaByID(id, userID)
-	if campanha.ID == 0 {
-		zap.L().Warn("Campanha not found by ID", zap.String("id", id))
-		return nil, exceptions.NewNotFoundError("Campanha not found")
-	}
-
-	response := &dtos.CampanhaResponse{
+func buildCampanhaResponse(campanha entity.Campanha) dtos.CampanhaResponse {
+	return dtos.CampanhaResponse{
 		ID:             campanha.ID,
 		Nome:           campanha.Nome,
 		Desc:           campanha.Desc,
@@ -1682,16 +2189,46 @@ func (srv *Service) GetCampanhaByIDService(userID string, id string) (*dtos.Camp
 		DataLancamento: campanha.DataLancamento,
 		DataFim:        campanha.DataFim,
 		Status:         campanha.Status,
+		DataDisparo:    campanha.DataDisparo,
+		ErroDisparo:    campanha.ErroDisparo,
+	}
+}
+
+// GetCampanhaByIDService retorna a campanha com as métricas dos envios e as vendas atribuídas até janelaDias depois
+// do envio
+func (srv *Service) GetCampanhaByIDService(userID string, id string, janelaDias int) (*dtos.CampanhaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get campanha by ID service", zap.String("id", id), zap.Int("janelaDias", janelaDias))
+
+	campanha := srv.dbClient.GetCampanhaByID(id, userID)
+	if campanha.ID == 0 {
+		zap.L().Warn("Campanha not found by ID", zap.String("id", id))
+		return nil, exceptions.NewNotFoundError("Campanha not found")
 	}
 
+	response := buildCampanhaResponse(*campanha)
+	metricas, restErr := srv.metricasCampanha(userID, campanha.ID, janelaAtribuicao(janelaDias))
+	if restErr != nil {
+		return nil, restErr
+	}
+	response.Metricas = metricas
+
 	zap.L().Info("Successfully retrieved campanha by ID", zap.String("id", id))
-	return response, nil
+	return &response, nil
 }
 
 func (srv *Service) CreateCampanhaService(userID string, request dtos.CreateCampanhaRequest) (int, *exceptions.RestErr) {
 	zap.L().Info("Starting campanha creation service")
 
+	inicio, fim, err := agenda.Periodo(request.DataLancamento, request.DataFim)
+	if err != nil {
+		return 0, exceptions.NewBadRequestError(fmt.Sprintf("Invalid campanha period: %s", err.Error()))
+	}
+
 	campanha := entity.BuildCampanhaEntity(request)
+	// Campanha agendada ou ativa já nasce no status do período: com lançamento futuro fica agendada
+	if campanha.Status == entity.CampanhaStatusAgendada || campanha.Status == entity.CampanhaStatusAtiva {
+		campanha.Status = agenda.Retomar(inicio, fim, time.Now())
+	}
 
 	dbErr := srv.dbClient.CreateCampanha(campanha, userID)
 	if dbErr != nil {
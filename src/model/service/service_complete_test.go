@@ -10,73 +10,6 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-// Mock para PersistenceInterfaceDBClient
-type MockDBClient struct {
-	mock.Mock
-}
-
-func (m *MockDBClient) GetAllFornecedores(userID string) ([]entity.Fornecedores, error) {
-	args := m.Called(userID)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).([]entity.Fornecedores), args.Error(1)
-}
-
-func (m *MockDBClient) CreateFornecedor(fornecedor entity.Fornecedores, userID string) error {
-	args := m.Called(fornecedor, userID)
-	return args.Error(0)
-}
-
-func (m *MockDBClient) GetFornecedorById(id string, userID string) (*entity.Fornecedores, error) {
-	args := m.Called(id, userID)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).(*entity.Fornecedores), args.Error(1)
-}
-
-func (m *MockDBClient) UpdateFornecedor(fornecedor entity.Fornecedores, userID string) error {
-	args := m.Called(fornecedor, userID)
-	return args.Error(0)
-}
-
-func (m *MockDBClient) UpdateFornecedorField(id string, campo string, valor string, userID string) error {
-	args := m.Called(id, campo, valor, userID)
-	return args.Error(0)
-}
-
-func (m *MockDBClient) DeleteFornecedor(id string, userID string) error {
-	args := m.Called(id, userID)
-	return args.Error(0)
-}
-
-func (m *MockDBClient) GetAllProducts(userID string) ([]entity.Produto, error) {
-	args := m.Called(userID)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).([]entity.Produto), args.Error(1)
-}
-
-func (m *MockDBClient) CreateProduct(product entity.Produto, userID string) error {
-	args := m.Called(product, userID)
-	return args.Error(0)
-}
-
-func (m *MockDBClient) DeleteProduct(id string, userID string) error {
-	args := m.Called(id, userID)
-	return args.Error(0)
-}
-
-func (m *MockDBClient) GetAllPedidos(userID string) ([]entity.Pedido, error) {
-	args := m.Called(userID)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).([]entity.Pedido), args.Error(1)
-}
-
 // TESTES PARA CreateUserService
 func TestService_CreateUserService_Success(t *testing.T) {
 	// Arrange
@@ -232,7 +165,7 @@ func TestService_GetAllFornecedoresService_Success(t *testing.T) {
 		},
 	}
 
-	mockDBClient.On("GetAllFornecedores", "1").Return(fornecedores, nil)
+	mockDBClient.On("GetAllFornecedoresPaginated", "1", 30, 0).Return(fornecedores, len(fornecedores), nil)
 
 	service := &Service{
 		crypto:   nil,
@@ -243,7 +176,7 @@ func TestService_GetAllFornecedoresService_Success(t *testing.T) {
 	}
 
 	// Act
-	result, err := service.GetAllFornecedoresService(userID)
+	result, err := service.GetAllFornecedoresService(userID, 1, 30)
 
 	// Assert
 	assert.Nil(t, err)
@@ -263,7 +196,7 @@ func TestService_GetAllFornecedoresService_DBError(t *testing.T) {
 
 	userID := "1"
 
-	mockDBClient.On("GetAllFornecedores", userID).Return(nil, errors.New("database error"))
+	mockDBClient.On("GetAllFornecedoresPaginated", "1", 30, 0).Return(nil, 0, errors.New("database error"))
 
 	service := &Service{
 		crypto:   nil,
@@ -274,7 +207,7 @@ func TestService_GetAllFornecedoresService_DBError(t *testing.T) {
 	}
 
 	// Act
-	result, err := service.GetAllFornecedoresService(userID)
+	result, err := service.GetAllFornecedoresService(userID, 1, 30)
 
 	// Assert
 	assert.NotNil(t, err)
@@ -292,10 +225,6 @@ func TestService_CreateFornecedorService_Success(t *testing.T) {
 	mockDBClient := new(MockDBClient)
 
 	userID := "1"
-	validTenant := &entity.Tenants{
-		ID:     1,
-		UserID: 1,
-	}
 
 	request := dtos.CreateFornecedorRequest{
 		Nome:     "Novo Fornecedor",
@@ -306,7 +235,6 @@ func TestService_CreateFornecedorService_Success(t *testing.T) {
 		Status:   "Ativo",
 	}
 
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(validTenant)
 	mockDBClient.On("CreateFornecedor", mock.AnythingOfType("entity.Fornecedores"), "1").Return(nil)
 
 	service := &Service{
@@ -336,10 +264,6 @@ func TestService_ChangeStatusFornecedorService_Success(t *testing.T) {
 
 	userID := "1"
 	fornecedorID := "1"
-	validTenant := &entity.Tenants{
-		ID:     1,
-		UserID: 1,
-	}
 
 	fornecedor := &entity.Fornecedores{
 		ID:     1,
@@ -347,7 +271,6 @@ func TestService_ChangeStatusFornecedorService_Success(t *testing.T) {
 		Status: "Ativo",
 	}
 
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(validTenant)
 	mockDBClient.On("GetFornecedorById", fornecedorID, "1").Return(fornecedor, nil)
 	mockDBClient.On("UpdateFornecedor", mock.AnythingOfType("entity.Fornecedores"), "1").Return(nil)
 
@@ -380,12 +303,7 @@ func TestService_UpdateFornecedorFieldService_Success(t *testing.T) {
 	fornecedorID := "1"
 	campo := "nome"
 	valor := "Novo Nome"
-	validTenant := &entity.Tenants{
-		ID:     1,
-		UserID: 1,
-	}
 
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(validTenant)
 	mockDBClient.On("UpdateFornecedorField", fornecedorID, campo, valor, "1").Return(nil)
 
 	service := &Service{
@@ -443,12 +361,7 @@ func TestService_DeleteFornecedorService_Success(t *testing.T) {
 
 	userID := "1"
 	fornecedorID := "1"
-	validTenant := &entity.Tenants{
-		ID:     1,
-		UserID: 1,
-	}
 
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(validTenant)
 	mockDBClient.On("DeleteFornecedor", fornecedorID, "1").Return(nil)
 
 	service := &Service{
@@ -477,10 +390,6 @@ func TestService_GetAllProductsService_Success(t *testing.T) {
 	mockDBClient := new(MockDBClient)
 
 	userID := "1"
-	validTenant := &entity.Tenants{
-		ID:     1,
-		UserID: 1,
-	}
 
 	products := []entity.Produto{
 		{
@@ -499,8 +408,7 @@ func TestService_GetAllProductsService_Success(t *testing.T) {
 		},
 	}
 
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(validTenant)
-	mockDBClient.On("GetAllProducts", "1").Return(products, nil)
+	mockDBClient.On("GetAllProductsPaginated", "1", 30, 0).Return(products, len(products), nil)
 
 	service := &Service{
 		crypto:   nil,
@@ -511,7 +419,7 @@ func TestService_GetAllProductsService_Success(t *testing.T) {
 	}
 
 	// Act
-	result, err := service.GetAllProductsService(userID)
+	result, err := service.GetAllProductsService(userID, 1, 30)
 
 	// Assert
 	assert.Nil(t, err)
@@ -532,10 +440,6 @@ func TestService_CreateProductService_Success(t *testing.T) {
 	mockDBClient := new(MockDBClient)
 
 	userID := "1"
-	validTenant := &entity.Tenants{
-		ID:     1,
-		UserID: 1,
-	}
 
 	request := dtos.CreateProductRequest{
 		NomeProduto: "Novo Produto",
@@ -544,7 +448,7 @@ func TestService_CreateProductService_Success(t *testing.T) {
 		Status:      "Ativo",
 	}
 
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(validTenant)
+	mockDBClient.On("GetProductBySKU", "SKU003", "1").Return(&entity.Produto{})
 	mockDBClient.On("CreateProduct", mock.AnythingOfType("entity.Produto"), "1").Return(nil)
 
 	service := &Service{
@@ -574,12 +478,9 @@ func TestService_DeleteProductService_Success(t *testing.T) {
 
 	userID := "1"
 	productID := "1"
-	validTenant := &entity.Tenants{
-		ID:     1,
-		UserID: 1,
-	}
 
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(validTenant)
+	mockDBClient.On("ProdutoTemVariacoes", 1, "1").Return(false, nil)
+	mockDBClient.On("ProdutoEmKit", 1, "1").Return(false, nil)
 	mockDBClient.On("DeleteProduct", productID, "1").Return(nil)
 
 	service := &Service{
@@ -608,10 +509,6 @@ func TestService_GetAllPedidosService_Success(t *testing.T) {
 	mockDBClient := new(MockDBClient)
 
 	userID := "1"
-	validTenant := &entity.Tenants{
-		ID:     1,
-		UserID: 1,
-	}
 
 	pedidos := []entity.Pedido{
 		{
@@ -630,8 +527,7 @@ func TestService_GetAllPedidosService_Success(t *testing.T) {
 		},
 	}
 
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(validTenant)
-	mockDBClient.On("GetAllPedidos", "1").Return(pedidos, nil)
+	mockDBClient.On("GetAllPedidosPaginated", "1", 30, 0).Return(pedidos, len(pedidos), nil)
 
 	service := &Service{
 		crypto:   nil,
@@ -642,7 +538,7 @@ func TestService_GetAllPedidosService_Success(t *testing.T) {
 	}
 
 	// Act
-	result, err := service.GetAllPedidosService(userID)
+	result, err := service.GetAllPedidosService(userID, 1, 30)
 
 	// Assert
 	assert.Nil(t, err)
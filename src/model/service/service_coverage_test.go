@@ -66,7 +66,7 @@ func TestService_GetAllFornecedoresService_DatabaseError(t *testing.T) {
 	mockDBMaster := new(MockDBMaster)
 	mockDBClient := new(MockDBClient)
 
-	mockDBClient.On("GetAllFornecedores", "1").Return(nil, errors.New("database error"))
+	mockDBClient.On("GetAllFornecedoresPaginated", "1", 30, 0).Return(nil, 0, errors.New("database error"))
 
 	service := &Service{
 		dbmaster: mockDBMaster,
@@ -74,7 +74,7 @@ func TestService_GetAllFornecedoresService_DatabaseError(t *testing.T) {
 	}
 
 	// Act
-	result, err := service.GetAllFornecedoresService("1")
+	result, err := service.GetAllFornecedoresService("1", 1, 30)
 
 	// Assert
 	assert.Nil(t, result)
@@ -91,15 +91,7 @@ func TestService_CreateFornecedorService_DatabaseError(t *testing.T) {
 	mockDBMaster := new(MockDBMaster)
 	mockDBClient := new(MockDBClient)
 
-	tenant := &entity.Tenants{
-		ID:          1,
-		UserID:      1,
-		NomeEmpresa: "Test Company",
-		DBName:      "test_db",
-	}
-
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(tenant)
-	mockDBClient.On("CreateFornecedor", mock.AnythingOfType("entity.Fornecedores"), mock.AnythingOfType("*context.valueCtx")).Return(errors.New("database error"))
+	mockDBClient.On("CreateFornecedor", mock.AnythingOfType("entity.Fornecedores"), "1").Return(errors.New("database error"))
 
 	service := &Service{
 		dbmaster: mockDBMaster,
@@ -131,15 +123,7 @@ func TestService_ChangeStatusFornecedorService_GetFornecedorError(t *testing.T)
 	mockDBMaster := new(MockDBMaster)
 	mockDBClient := new(MockDBClient)
 
-	tenant := &entity.Tenants{
-		ID:          1,
-		UserID:      1,
-		NomeEmpresa: "Test Company",
-		DBName:      "test_db",
-	}
-
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(tenant)
-	mockDBClient.On("GetFornecedorById", "1", mock.AnythingOfType("*context.valueCtx")).Return(nil, errors.New("fornecedor not found"))
+	mockDBClient.On("GetFornecedorById", "1", "1").Return(nil, errors.New("fornecedor not found"))
 
 	service := &Service{
 		dbmaster: mockDBMaster,
@@ -164,22 +148,14 @@ func TestService_ChangeStatusFornecedorService_UpdateError(t *testing.T) {
 	mockDBMaster := new(MockDBMaster)
 	mockDBClient := new(MockDBClient)
 
-	tenant := &entity.Tenants{
-		ID:          1,
-		UserID:      1,
-		NomeEmpresa: "Test Company",
-		DBName:      "test_db",
-	}
-
 	fornecedor := &entity.Fornecedores{
 		ID:     1,
 		Nome:   "Fornecedor Teste",
 		Status: "Ativo",
 	}
 
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(tenant)
-	mockDBClient.On("GetFornecedorById", "1", mock.AnythingOfType("*context.valueCtx")).Return(fornecedor, nil)
-	mockDBClient.On("UpdateFornecedor", mock.AnythingOfType("entity.Fornecedores"), mock.AnythingOfType("*context.valueCtx")).Return(errors.New("update failed"))
+	mockDBClient.On("GetFornecedorById", "1", "1").Return(fornecedor, nil)
+	mockDBClient.On("UpdateFornecedor", mock.AnythingOfType("entity.Fornecedores"), "1").Return(errors.New("update failed"))
 
 	service := &Service{
 		dbmaster: mockDBMaster,
@@ -204,15 +180,7 @@ func TestService_UpdateFornecedorFieldService_DatabaseError(t *testing.T) {
 	mockDBMaster := new(MockDBMaster)
 	mockDBClient := new(MockDBClient)
 
-	tenant := &entity.Tenants{
-		ID:          1,
-		UserID:      1,
-		NomeEmpresa: "Test Company",
-		DBName:      "test_db",
-	}
-
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(tenant)
-	mockDBClient.On("UpdateFornecedorField", "1", "nome", "Novo Nome", mock.AnythingOfType("*context.valueCtx")).Return(errors.New("update failed"))
+	mockDBClient.On("UpdateFornecedorField", "1", "nome", "Novo Nome", "1").Return(errors.New("update failed"))
 
 	service := &Service{
 		dbmaster: mockDBMaster,
@@ -237,15 +205,7 @@ func TestService_DeleteFornecedorService_DatabaseError(t *testing.T) {
 	mockDBMaster := new(MockDBMaster)
 	mockDBClient := new(MockDBClient)
 
-	tenant := &entity.Tenants{
-		ID:          1,
-		UserID:      1,
-		NomeEmpresa: "Test Company",
-		DBName:      "test_db",
-	}
-
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(tenant)
-	mockDBClient.On("DeleteFornecedor", "1", mock.AnythingOfType("*context.valueCtx")).Return(errors.New("delete failed"))
+	mockDBClient.On("DeleteFornecedor", "1", "1").Return(errors.New("delete failed"))
 
 	service := &Service{
 		dbmaster: mockDBMaster,
@@ -270,15 +230,7 @@ func TestService_GetAllProductsService_DatabaseError(t *testing.T) {
 	mockDBMaster := new(MockDBMaster)
 	mockDBClient := new(MockDBClient)
 
-	tenant := &entity.Tenants{
-		ID:          1,
-		UserID:      1,
-		NomeEmpresa: "Test Company",
-		DBName:      "test_db",
-	}
-
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(tenant)
-	mockDBClient.On("GetAllProducts", mock.AnythingOfType("*context.valueCtx")).Return(nil, errors.New("database error"))
+	mockDBClient.On("GetAllProductsPaginated", "1", 30, 0).Return(nil, 0, errors.New("database error"))
 
 	service := &Service{
 		dbmaster: mockDBMaster,
@@ -286,7 +238,7 @@ func TestService_GetAllProductsService_DatabaseError(t *testing.T) {
 	}
 
 	// Act
-	result, err := service.GetAllProductsService("1")
+	result, err := service.GetAllProductsService("1", 1, 30)
 
 	// Assert
 	assert.Nil(t, result)
@@ -303,15 +255,8 @@ func TestService_CreateProductService_DatabaseError(t *testing.T) {
 	mockDBMaster := new(MockDBMaster)
 	mockDBClient := new(MockDBClient)
 
-	tenant := &entity.Tenants{
-		ID:          1,
-		UserID:      1,
-		NomeEmpresa: "Test Company",
-		DBName:      "test_db",
-	}
-
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(tenant)
-	mockDBClient.On("CreateProduct", mock.AnythingOfType("entity.Produto"), mock.AnythingOfType("*context.valueCtx")).Return(errors.New("database error"))
+	mockDBClient.On("GetProductBySKU", "SKU001", "1").Return(&entity.Produto{})
+	mockDBClient.On("CreateProduct", mock.AnythingOfType("entity.Produto"), "1").Return(errors.New("database error"))
 
 	service := &Service{
 		dbmaster: mockDBMaster,
@@ -343,15 +288,9 @@ func TestService_DeleteProductService_DatabaseError(t *testing.T) {
 	mockDBMaster := new(MockDBMaster)
 	mockDBClient := new(MockDBClient)
 
-	tenant := &entity.Tenants{
-		ID:          1,
-		UserID:      1,
-		NomeEmpresa: "Test Company",
-		DBName:      "test_db",
-	}
-
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(tenant)
-	mockDBClient.On("DeleteProduct", "1", mock.AnythingOfType("*context.valueCtx")).Return(errors.New("delete failed"))
+	mockDBClient.On("ProdutoTemVariacoes", 1, "1").Return(false, nil)
+	mockDBClient.On("ProdutoEmKit", 1, "1").Return(false, nil)
+	mockDBClient.On("DeleteProduct", "1", "1").Return(errors.New("delete failed"))
 
 	service := &Service{
 		dbmaster: mockDBMaster,
@@ -376,15 +315,7 @@ func TestService_GetAllPedidosService_DatabaseError(t *testing.T) {
 	mockDBMaster := new(MockDBMaster)
 	mockDBClient := new(MockDBClient)
 
-	tenant := &entity.Tenants{
-		ID:          1,
-		UserID:      1,
-		NomeEmpresa: "Test Company",
-		DBName:      "test_db",
-	}
-
-	mockDBMaster.On("GetTenantByUserID", uint(1)).Return(tenant)
-	mockDBClient.On("GetAllPedidos", mock.AnythingOfType("*context.valueCtx")).Return(nil, errors.New("database error"))
+	mockDBClient.On("GetAllPedidosPaginated", "1", 30, 0).Return(nil, 0, errors.New("database error"))
 
 	service := &Service{
 		dbmaster: mockDBMaster,
@@ -392,7 +323,7 @@ func TestService_GetAllPedidosService_DatabaseError(t *testing.T) {
 	}
 
 	// Act
-	result, err := service.GetAllPedidosService("1")
+	result, err := service.GetAllPedidosService("1", 1, 30)
 
 	// Assert
 	assert.Nil(t, result)
@@ -426,24 +357,16 @@ func TestService_ChangeStatusFornecedorService_StatusToggle(t *testing.T) {
 			mockDBMaster := new(MockDBMaster)
 			mockDBClient := new(MockDBClient)
 
-			tenant := &entity.Tenants{
-				ID:          1,
-				UserID:      1,
-				NomeEmpresa: "Test Company",
-				DBName:      "test_db",
-			}
-
 			fornecedor := &entity.Fornecedores{
 				ID:     1,
 				Nome:   "Fornecedor Teste",
 				Status: tt.currentStatus,
 			}
 
-			mockDBMaster.On("GetTenantByUserID", uint(1)).Return(tenant)
-			mockDBClient.On("GetFornecedorById", "1", mock.AnythingOfType("*context.valueCtx")).Return(fornecedor, nil)
+			mockDBClient.On("GetFornecedorById", "1", "1").Return(fornecedor, nil)
 			mockDBClient.On("UpdateFornecedor", mock.MatchedBy(func(f entity.Fornecedores) bool {
 				return f.Status == tt.expectedStatus
-			}), mock.AnythingOfType("*context.valueCtx")).Return(nil)
+			}), "1").Return(nil)
 
 			service := &Service{
 				dbmaster: mockDBMaster,
@@ -472,7 +395,7 @@ func TestService_NewServiceInstance(t *testing.T) {
 	mockTokenGen := new(MockTokenGenerator)
 
 	// Act
-	service := NewServiceInstance(mockCrypto, mockDBMaster, mockDBClient, mockRedis, mockTokenGen)
+	service := NewServiceInstance(mockCrypto, mockDBMaster, mockDBClient, mockRedis, mockTokenGen, nil, nil)
 
 	// Assert
 	assert.NotNil(t, service)
@@ -29,30 +29,6 @@ func (m *MockCryptoImproved) CheckPassword(password, hashedPassword string) (boo
 	return args.Bool(0), args.Error(1)
 }
 
-type MockDBMasterImproved struct {
-	mock.Mock
-}
-
-func (m *MockDBMasterImproved) CreateUser(user entity.User) error {
-	args := m.Called(user)
-	return args.Error(0)
-}
-
-func (m *MockDBMasterImproved) VerifyExist(email string) (bool, error) {
-	args := m.Called(email)
-	return args.Bool(0), args.Error(1)
-}
-
-func (m *MockDBMasterImproved) GetUser(email string) *entity.User {
-	args := m.Called(email)
-	return args.Get(0).(*entity.User)
-}
-
-func (m *MockDBMasterImproved) GetTenantByUserID(userID uint) *entity.Tenants {
-	args := m.Called(userID)
-	return args.Get(0).(*entity.Tenants)
-}
-
 type MockRedisImproved struct {
 	mock.Mock
 }
@@ -125,7 +101,7 @@ func TestService_LoginUserService_Complete(t *testing.T) {
 	tests := []struct {
 		name          string
 		request       dtos.UserLogin
-		setupMocks    func(*MockCryptoImproved, *MockDBMasterImproved, *MockRedisImproved, *MockTokenGenerator)
+		setupMocks    func(*MockCryptoImproved, *MockDBMaster, *MockRedisImproved, *MockTokenGenerator)
 		expectedError *exceptions.RestErr
 		expectError   bool
 	}{
@@ -135,7 +111,7 @@ func TestService_LoginUserService_Complete(t *testing.T) {
 				Email:    "john@example.com",
 				Password: "password123",
 			},
-			setupMocks: func(crypto *MockCryptoImproved, dbMaster *MockDBMasterImproved, redisClient *MockRedisImproved, tokenGen *MockTokenGenerator) {
+			setupMocks: func(crypto *MockCryptoImproved, dbMaster *MockDBMaster, redisClient *MockRedisImproved, tokenGen *MockTokenGenerator) {
 				// User exists
 				dbMaster.On("GetUser", "john@example.com").Return(validUser)
 
@@ -159,7 +135,7 @@ func TestService_LoginUserService_Complete(t *testing.T) {
 				Email:    "john@example.com",
 				Password: "password123",
 			},
-			setupMocks: func(crypto *MockCryptoImproved, dbMaster *MockDBMasterImproved, redisClient *MockRedisImproved, tokenGen *MockTokenGenerator) {
+			setupMocks: func(crypto *MockCryptoImproved, dbMaster *MockDBMaster, redisClient *MockRedisImproved, tokenGen *MockTokenGenerator) {
 				// User exists
 				dbMaster.On("GetUser", "john@example.com").Return(validUser)
 
@@ -184,7 +160,7 @@ func TestService_LoginUserService_Complete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange - Setup mocks
 			mockCrypto := new(MockCryptoImproved)
-			mockDBMaster := new(MockDBMasterImproved)
+			mockDBMaster := new(MockDBMaster)
 			mockRedis := new(MockRedisImproved)
 			mockTokenGen := new(MockTokenGenerator)
 
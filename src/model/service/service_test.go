@@ -30,31 +30,6 @@ func (m *MockCrypto) CheckPassword(password, hashedPassword string) (bool, error
 	return args.Bool(0), args.Error(1)
 }
 
-// Mock para PersistenceInterfaceDBMaster
-type MockDBMaster struct {
-	mock.Mock
-}
-
-func (m *MockDBMaster) CreateUser(user entity.User) error {
-	args := m.Called(user)
-	return args.Error(0)
-}
-
-func (m *MockDBMaster) VerifyExist(email string) (bool, error) {
-	args := m.Called(email)
-	return args.Bool(0), args.Error(1)
-}
-
-func (m *MockDBMaster) GetUser(email string) *entity.User {
-	args := m.Called(email)
-	return args.Get(0).(*entity.User)
-}
-
-func (m *MockDBMaster) GetTenantByUserID(userID uint) *entity.Tenants {
-	args := m.Called(userID)
-	return args.Get(0).(*entity.Tenants)
-}
-
 // Teste para casos de erro básicos do LoginUserService
 func TestService_LoginUserService_UserNotFound(t *testing.T) {
 	// Arrange
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/service/disparo"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE TEMPLATES DE MENSAGENS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (srv *Service) GetTemplatesMensagensService(userID string, canal string, page, limit int) (*dtos.TemplateMensagemListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get templates service", zap.String("canal", canal), zap.Int("page", page), zap.Int("limit", limit))
+
+	if restErr := validarCanal(canal); restErr != nil {
+		return nil, restErr
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	templates, total, dbErr := srv.dbClient.GetTemplatesMensagensPaginated(userID, canal, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting templates from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.TemplateMensagemListResponse{
+		Templates:  make([]dtos.TemplateMensagemResponse, len(templates)),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: (total + limit - 1) / limit,
+	}
+	for i, template := range templates {
+		response.Templates[i] = buildTemplateMensagemResponse(template)
+	}
+
+	zap.L().Info("Templates service completed successfully", zap.Int("total", total))
+	return response, nil
+}
+
+func (srv *Service) GetTemplateMensagemByIDService(userID string, id string) (*dtos.TemplateMensagemResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get template by ID service", zap.String("id", id))
+
+	template, restErr := srv.getTemplateMensagem(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := buildTemplateMensagemResponse(*template)
+	return &response, nil
+}
+
+func (srv *Service) CreateTemplateMensagemService(userID string, request dtos.TemplateMensagemRequest) (*dtos.TemplateMensagemResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting create template service", zap.String("nome", request.Nome), zap.String("canal", request.Canal))
+
+	if restErr := validarTemplateMensagem(request); restErr != nil {
+		return nil, restErr
+	}
+
+	template := entity.BuildTemplateMensagemEntity(request)
+	template.DataCriacao = time.Now().Format(formatoDataHora)
+	template.DataAtualizacao = template.DataCriacao
+
+	if dbErr := srv.dbClient.CreateTemplateMensagem(userID, template); dbErr != nil {
+		zap.L().Error("Error creating template", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Template created successfully", zap.Int("id", template.ID), zap.String("nome", template.Nome))
+	response := buildTemplateMensagemResponse(*template)
+	return &response, nil
+}
+
+// UpdateTemplateMensagemService substitui o template. O canal não pode mudar enquanto alguma campanha usa o template.
+// Envios já disparados mantêm a mensagem antiga.
+func (srv *Service) UpdateTemplateMensagemService(userID string, id string, request dtos.TemplateMensagemRequest) (*dtos.TemplateMensagemResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting update template service", zap.String("id", id))
+
+	atual, restErr := srv.getTemplateMensagem(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if restErr := validarTemplateMensagem(request); restErr != nil {
+		return nil, restErr
+	}
+
+	if request.Canal != atual.Canal {
+		emUso, dbErr := srv.dbClient.CountCampanhasTemplate(userID, atual.ID)
+		if dbErr != nil {
+			return nil, exceptions.NewInternalServerError("Internal server error")
+		}
+		if emUso > 0 {
+			return nil, exceptions.NewConflictError("Template is used by campanhas, its channel cannot be changed")
+		}
+	}
+
+	template := entity.BuildTemplateMensagemEntity(request)
+	template.ID = atual.ID
+	template.DataCriacao = atual.DataCriacao
+	template.DataAtualizacao = time.Now().Format(formatoDataHora)
+
+	if dbErr := srv.dbClient.UpdateTemplateMensagem(userID, *template); dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Template not found")
+		}
+		zap.L().Error("Error updating template", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Template updated successfully", zap.Int("id", template.ID))
+	response := buildTemplateMensagemResponse(*template)
+	return &response, nil
+}
+
+func (srv *Service) DeleteTemplateMensagemService(userID string, id string) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting delete template service", zap.String("id", id))
+
+	template, restErr := srv.getTemplateMensagem(userID, id)
+	if restErr != nil {
+		return false, restErr
+	}
+
+	emUso, dbErr := srv.dbClient.CountCampanhasTemplate(userID, template.ID)
+	if dbErr != nil {
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+	if emUso > 0 {
+		return false, exceptions.NewConflictError("Template is used by campanhas")
+	}
+
+	if dbErr := srv.dbClient.DeleteTemplateMensagem(userID, template.ID); dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return false, exceptions.NewNotFoundError("Template not found")
+		}
+		zap.L().Error("Error deleting template", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Template deleted successfully", zap.Int("id", template.ID))
+	return true, nil
+}
+
+func (srv *Service) GetCamposTemplateService() *dtos.CamposTemplateResponse {
+	return &dtos.CamposTemplateResponse{Campos: disparo.CamposTemplate()}
+}
+
+// PreviewTemplateMensagemService renderiza o template com os dados do cliente. Com a campanha, os campos campanha.*
+// também são preenchidos.
+func (srv *Service) PreviewTemplateMensagemService(userID string, id string, idCliente string, idCampanha string) (*dtos.PreviewTemplateResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting preview template service", zap.String("id", id), zap.String("idCliente", idCliente), zap.String("idCampanha", idCampanha))
+
+	template, restErr := srv.getTemplateMensagem(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	assunto, corpo, err := compilarTemplateMensagem(*template)
+	if err != nil {
+		return nil, exceptions.NewConflictError(fmt.Sprintf("Template is invalid: %v", err))
+	}
+
+	if _, err := strconv.Atoi(idCliente); err != nil {
+		return nil, exceptions.NewBadRequestError("Invalid cliente ID")
+	}
+	cliente := srv.dbClient.GetClienteByID(idCliente, userID)
+	if cliente.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Cliente not found")
+	}
+
+	dados := disparo.DadosTemplate{Cliente: *cliente, Loja: srv.dadosLoja(userID)}
+	if idCampanha != "" {
+		campanha := srv.dbClient.GetCampanhaByID(idCampanha, userID)
+		if campanha.ID == 0 {
+			return nil, exceptions.NewNotFoundError("Campanha not found")
+		}
+		dados.Campanha = disparo.Campanha{Nome: campanha.Nome, Descricao: campanha.Desc}
+	}
+
+	pets, enderecos, dbErr := srv.dbClient.GetPetsEnderecosClientes(userID, []int{cliente.ID})
+	if dbErr != nil {
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	if len(pets) > 0 {
+		dados.Pet = &pets[0]
+	}
+	if len(enderecos) > 0 {
+		dados.Endereco = &enderecos[0]
+	}
+
+	destino := disparo.Destino(template.Canal, disparo.Destinatario{Nome: cliente.NomeCliente, Email: cliente.Email, Celular: cliente.NumeroCelular})
+	mensagem, naoResolvidos := disparo.RenderizarTemplate(template.Canal, assunto, corpo, dados, destino)
+
+	response := &dtos.PreviewTemplateResponse{
+		IDTemplate:          template.ID,
+		IDCliente:           cliente.ID,
+		Canal:               template.Canal,
+		Destino:             destino,
+		Assunto:             mensagem.Assunto,
+		Corpo:               mensagem.Corpo,
+		CamposNaoResolvidos: naoResolvidos,
+	}
+	if response.CamposNaoResolvidos == nil {
+		response.CamposNaoResolvidos = []string{}
+	}
+
+	zap.L().Info("Template preview rendered successfully", zap.Int("id", template.ID), zap.Int("idCliente", cliente.ID), zap.Strings("nao_resolvidos", naoResolvidos))
+	return response, nil
+}
+
+func (srv *Service) getTemplateMensagem(userID string, id string) (*entity.TemplateMensagem, *exceptions.RestErr) {
+	idTemplate, err := strconv.Atoi(id)
+	if err != nil || idTemplate < 1 {
+		return nil, exceptions.NewBadRequestError("Invalid template ID")
+	}
+
+	template, dbErr := srv.dbClient.GetTemplateMensagemByID(userID, idTemplate)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Template not found")
+		}
+		zap.L().Error("Error getting template by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	return template, nil
+}
+
+// dadosLoja busca no banco master os dados do tenant usados nos campos loja.*
+func (srv *Service) dadosLoja(userID string) disparo.Loja {
+	id, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil || srv.dbmaster == nil {
+		return disparo.Loja{}
+	}
+	user := srv.dbmaster.GetUserByID(uint(id))
+	return disparo.Loja{Nome: user.NomeEmpresa, Cidade: user.City, Estado: user.State}
+}
+
+func validarTemplateMensagem(request dtos.TemplateMensagemRequest) *exceptions.RestErr {
+	if request.Canal == disparo.CanalEmail && request.Assunto == nil {
+		return exceptions.NewBadRequestError("Assunto is required for email templates")
+	}
+	_, _, err := compilarTemplateMensagem(*entity.BuildTemplateMensagemEntity(request))
+	if err != nil {
+		return exceptions.NewBadRequestError(fmt.Sprintf("Invalid template: %v", err))
+	}
+	return nil
+}
+
+// compilarTemplateMensagem compila o assunto (nil fora do email) e o corpo do template
+func compilarTemplateMensagem(template entity.TemplateMensagem) (*disparo.Template, *disparo.Template, error) {
+	var assunto *disparo.Template
+	if template.Assunto != nil {
+		var err error
+		if assunto, err = disparo.CompilarTemplate(*template.Assunto); err != nil {
+			return nil, nil, fmt.Errorf("assunto: %w", err)
+		}
+	}
+	corpo, err := disparo.CompilarTemplate(template.Corpo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("corpo: %w", err)
+	}
+	return assunto, corpo, nil
+}
+
+func buildTemplateMensagemResponse(template entity.TemplateMensagem) dtos.TemplateMensagemResponse {
+	response := dtos.TemplateMensagemResponse{
+		ID:              template.ID,
+		Nome:            template.Nome,
+		Canal:           template.Canal,
+		Assunto:         template.Assunto,
+		Corpo:           template.Corpo,
+		Campos:          []string{},
+		DataCriacao:     template.DataCriacao,
+		DataAtualizacao: template.DataAtualizacao,
+	}
+	if assunto, corpo, err := compilarTemplateMensagem(template); err == nil {
+		if assunto != nil {
+			response.Campos = append(response.Campos, assunto.Campos()...)
+		}
+		for _, campo := range corpo.Campos() {
+			if !contemCampo(response.Campos, campo) {
+				response.Campos = append(response.Campos, campo)
+			}
+		}
+	}
+	return response
+}
+
+func contemCampo(campos []string, campo string) bool {
+	for _, c := range campos {
+		if c == campo {
+			return true
+		}
+	}
+	return false
+}
+
+func validarCanal(canal string) *exceptions.RestErr {
+	switch canal {
+	case "", disparo.CanalEmail, disparo.CanalWhatsApp, disparo.CanalSMS:
+		return nil
+	default:
+		return exceptions.NewBadRequestError("Invalid canal, expected 'email', 'whatsapp' or 'sms'")
+	}
+}
+
+// FUNÇÕES DE CANAIS DE CAMPANHAS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (srv *Service) GetCanaisCampanhaService(userID string, idCampanha string) (*dtos.CanaisCampanhaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get canais campanha service", zap.String("idCampanha", idCampanha))
+
+	campanha := srv.dbClient.GetCampanhaByID(idCampanha, userID)
+	if campanha.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Campanha not found")
+	}
+
+	return srv.buildCanaisCampanhaResponse(userID, campanha.ID)
+}
+
+// SetCanaisCampanhaService define os canais da campanha e, opcionalmente, o template de cada um. Sem template, o
+// canal usa a mensagem padrão (saudação e descrição da campanha).
+func (srv *Service) SetCanaisCampanhaService(userID string, idCampanha string, request dtos.CanaisCampanhaRequest) (*dtos.CanaisCampanhaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting set canais campanha service", zap.String("idCampanha", idCampanha), zap.Int("canais_count", len(request.Canais)))
+
+	campanha := srv.dbClient.GetCampanhaByID(idCampanha, userID)
+	if campanha.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Campanha not found")
+	}
+
+	canais := make([]entity.CampanhaCanal, 0, len(request.Canais))
+	vistos := make(map[string]bool, len(request.Canais))
+	for _, canal := range request.Canais {
+		if vistos[canal.Canal] {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Channel '%s' is repeated", canal.Canal))
+		}
+		vistos[canal.Canal] = true
+
+		if canal.IDTemplate != nil {
+			template, restErr := srv.getTemplateMensagem(userID, strconv.Itoa(*canal.IDTemplate))
+			if restErr != nil {
+				return nil, restErr
+			}
+			if template.Canal != canal.Canal {
+				return nil, exceptions.NewBadRequestError(fmt.Sprintf("Template %d is for channel '%s'", template.ID, template.Canal))
+			}
+		}
+		canais = append(canais, entity.CampanhaCanal{IDCampanha: campanha.ID, Canal: canal.Canal, IDTemplate: canal.IDTemplate})
+	}
+
+	if dbErr := srv.dbClient.SetCanaisCampanha(userID, campanha.ID, canais); dbErr != nil {
+		zap.L().Error("Error setting canais of campanha", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Canais of campanha set successfully", zap.Int("idCampanha", campanha.ID), zap.Int("canais_count", len(canais)))
+	return srv.buildCanaisCampanhaResponse(userID, campanha.ID)
+}
+
+func (srv *Service) buildCanaisCampanhaResponse(userID string, idCampanha int) (*dtos.CanaisCampanhaResponse, *exceptions.RestErr) {
+	canais, dbErr := srv.dbClient.GetCanaisCampanha(userID, idCampanha)
+	if dbErr != nil {
+		zap.L().Error("Error getting canais of campanha", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.CanaisCampanhaResponse{
+		IDCampanha: idCampanha,
+		Canais:     make([]dtos.CanalCampanhaResponse, len(canais)),
+	}
+	for i, canal := range canais {
+		response.Canais[i] = dtos.CanalCampanhaResponse{
+			Canal:        canal.Canal,
+			IDTemplate:   canal.IDTemplate,
+			NomeTemplate: canal.NomeTemplate,
+		}
+	}
+	return response, nil
+}
+
+// ValidarCampanhaService monta as mensagens de todos os destinatários, sem disparar, e lista os clientes com campos
+// do template sem valor. Sem canais, usa os canais configurados na campanha.
+func (srv *Service) ValidarCampanhaService(userID string, idCampanha string, canais []string) (*dtos.ValidacaoCampanhaResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting validar campanha service", zap.String("idCampanha", idCampanha), zap.Strings("canais", canais))
+
+	for _, canal := range canais {
+		if canal == "" {
+			return nil, exceptions.NewBadRequestError("Invalid canal, expected 'email', 'whatsapp' or 'sms'")
+		}
+		if restErr := validarCanal(canal); restErr != nil {
+			return nil, restErr
+		}
+	}
+
+	campanha := srv.dbClient.GetCampanhaByID(idCampanha, userID)
+	if campanha.ID == 0 {
+		return nil, exceptions.NewNotFoundError("Campanha not found")
+	}
+
+	plano, restErr := srv.planejarDisparo(userID, campanha, canais)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := &dtos.ValidacaoCampanhaResponse{
+		IDCampanha:    campanha.ID,
+		Valida:        plano.naoResolvidos == 0,
+		Destinatarios: plano.destinatarios,
+		Canais:        plano.canais,
+	}
+
+	zap.L().Info("Campanha validated successfully", zap.Int("idCampanha", campanha.ID), zap.Bool("valida", response.Valida), zap.Int("nao_resolvidos", plano.naoResolvidos))
+	return response, nil
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TESTES PARA CreateTemplateMensagemService
+func TestService_CreateTemplateMensagemService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	assunto := "{{campanha.nome}} do {{pet.nome}}"
+	mockDBClient.On("CreateTemplateMensagem", "1", mock.AnythingOfType("*entity.TemplateMensagem")).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.TemplateMensagem).ID = 3
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.CreateTemplateMensagemService("1", dtos.TemplateMensagemRequest{
+		Nome:    "Aniversário do pet",
+		Canal:   "email",
+		Assunto: &assunto,
+		Corpo:   "Oi {{cliente.primeiro_nome}}, o {{pet.nome}} faz aniversário!",
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 3, result.ID)
+	assert.Equal(t, []string{"campanha.nome", "pet.nome", "cliente.primeiro_nome"}, result.Campos)
+	assert.Equal(t, result.DataCriacao, result.DataAtualizacao)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateTemplateMensagemService_Invalido(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  dtos.TemplateMensagemRequest
+		mensagem string
+	}{
+		{
+			"email sem assunto",
+			dtos.TemplateMensagemRequest{Nome: "Boas-vindas", Canal: "email", Corpo: "Oi {{cliente.nome}}"},
+			"Assunto is required for email templates",
+		},
+		{
+			"campo desconhecido",
+			dtos.TemplateMensagemRequest{Nome: "Boas-vindas", Canal: "whatsapp", Corpo: "Oi {{cliente.senha}}"},
+			"Invalid template: corpo: unknown field 'cliente.senha'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDBClient := new(MockDBClient)
+
+			service := &Service{
+				dbClient: mockDBClient,
+			}
+
+			// Act
+			result, err := service.CreateTemplateMensagemService("1", tt.request)
+
+			// Assert
+			assert.Nil(t, result)
+			assert.NotNil(t, err)
+			assert.Equal(t, 400, err.Code)
+			assert.Contains(t, err.Message, tt.mensagem)
+
+			mockDBClient.AssertNotCalled(t, "CreateTemplateMensagem", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TESTES PARA UpdateTemplateMensagemService
+func TestService_UpdateTemplateMensagemService_CanalEmUso(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetTemplateMensagemByID", "1", 3).Return(&entity.TemplateMensagem{ID: 3, Nome: "Banho", Canal: "whatsapp", Corpo: "Oi"}, nil)
+	mockDBClient.On("CountCampanhasTemplate", "1", 3).Return(2, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.UpdateTemplateMensagemService("1", "3", dtos.TemplateMensagemRequest{Nome: "Banho", Canal: "sms", Corpo: "Oi"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertNotCalled(t, "UpdateTemplateMensagem", mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_UpdateTemplateMensagemService_MesmoCanalNaoConsultaCampanhas(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetTemplateMensagemByID", "1", 3).Return(&entity.TemplateMensagem{ID: 3, Nome: "Banho", Canal: "whatsapp", Corpo: "Oi", DataCriacao: "2025-03-01 10:00:00"}, nil)
+	mockDBClient.On("UpdateTemplateMensagem", "1", mock.MatchedBy(func(template entity.TemplateMensagem) bool {
+		return template.ID == 3 && template.Corpo == "Oi {{cliente.primeiro_nome}}" && template.DataCriacao == "2025-03-01 10:00:00"
+	})).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.UpdateTemplateMensagemService("1", "3", dtos.TemplateMensagemRequest{Nome: "Banho", Canal: "whatsapp", Corpo: "Oi {{cliente.primeiro_nome}}"})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cliente.primeiro_nome"}, result.Campos)
+
+	mockDBClient.AssertNotCalled(t, "CountCampanhasTemplate", mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA DeleteTemplateMensagemService
+func TestService_DeleteTemplateMensagemService_EmUso(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetTemplateMensagemByID", "1", 3).Return(&entity.TemplateMensagem{ID: 3, Canal: "sms", Corpo: "Oi"}, nil)
+	mockDBClient.On("CountCampanhasTemplate", "1", 3).Return(1, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.DeleteTemplateMensagemService("1", "3")
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertNotCalled(t, "DeleteTemplateMensagem", mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA PreviewTemplateMensagemService
+func TestService_PreviewTemplateMensagemService_CamposNaoResolvidos(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetTemplateMensagemByID", "1", 3).Return(&entity.TemplateMensagem{ID: 3, Canal: "whatsapp", Corpo: "Oi {{cliente.primeiro_nome}}! O {{pet.nome}} está em {{endereco.bairro}}?"}, nil)
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7, NomeCliente: "Ana Souza", NumeroCelular: "(19) 99999-0000"})
+	mockDBClient.On("GetPetsEnderecosClientes", "1", []int{7}).Return([]entity.Pet{{ClienteID: 7, NomePet: "Thor"}}, []entity.Endereco{}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.PreviewTemplateMensagemService("1", "3", "7", "")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "19999990000", result.Destino)
+	assert.Equal(t, "Oi Ana! O Thor está em ?", result.Corpo)
+	assert.Equal(t, []string{"endereco.bairro"}, result.CamposNaoResolvidos)
+
+	mockDBClient.AssertNotCalled(t, "GetCampanhaByID", mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA SetCanaisCampanhaService
+func TestService_SetCanaisCampanhaService_TemplateDeOutroCanal(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	idTemplate := 3
+	mockDBClient.On("GetCampanhaByID", "5", "1").Return(&entity.Campanha{ID: 5, Nome: "Banho"})
+	mockDBClient.On("GetTemplateMensagemByID", "1", 3).Return(&entity.TemplateMensagem{ID: 3, Canal: "email", Corpo: "Oi"}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.SetCanaisCampanhaService("1", "5", dtos.CanaisCampanhaRequest{Canais: []dtos.CanalCampanhaRequest{{Canal: "whatsapp", IDTemplate: &idTemplate}}})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+	assert.Equal(t, "Template 3 is for channel 'email'", err.Message)
+
+	mockDBClient.AssertNotCalled(t, "SetCanaisCampanha", mock.Anything, mock.Anything, mock.Anything)
+	mockDBClient.AssertExpectations(t)
+}
@@ -0,0 +1,93 @@
+package valorizacao
+
+import (
+	"math"
+	"sort"
+)
+
+// Lote representa um lote de estoque visto em uma data de corte
+type Lote struct {
+	DataEntrada        string  // data de entrada do lote (YYYY-MM-DD HH:MM:SS), define as camadas do PEPS
+	QuantidadeRecebida int     // quantidade que entrou no lote
+	SaldoNaData        int     // saldo do lote na data de corte
+	CustoUnitario      float64 // custo de aquisição do lote
+}
+
+// Resultado é a valorização de um conjunto de lotes (normalmente de um produto)
+type Resultado struct {
+	Quantidade int
+	CustoMedio float64
+	ValorCMP   float64 // custo médio ponderado
+	ValorPEPS  float64 // primeiro a entrar, primeiro a sair (FIFO)
+}
+
+// Calcular valoriza o saldo dos lotes pelos métodos de custo médio ponderado e PEPS.
+//
+// No custo médio, o custo unitário é a média das entradas ponderada pela quantidade recebida.
+// No PEPS, as entradas formam camadas pela data de entrada e as saídas consomem as mais antigas primeiro, então o
+// saldo total é valorizado pelas entradas mais recentes. Isso independe de qual lote a baixa física consumiu (que
+// segue o vencimento). Se o saldo passar do total recebido, o excedente fica com o custo da entrada mais antiga.
+func Calcular(lotes []Lote) Resultado {
+	var resultado Resultado
+	var quantidadeRecebida int
+	var valorRecebido float64
+
+	for _, lote := range lotes {
+		if lote.SaldoNaData > 0 {
+			resultado.Quantidade += lote.SaldoNaData
+		}
+		if lote.QuantidadeRecebida > 0 {
+			quantidadeRecebida += lote.QuantidadeRecebida
+			valorRecebido += float64(lote.QuantidadeRecebida) * lote.CustoUnitario
+		}
+	}
+
+	if quantidadeRecebida > 0 {
+		resultado.CustoMedio = valorRecebido / float64(quantidadeRecebida)
+	}
+	resultado.ValorCMP = float64(resultado.Quantidade) * resultado.CustoMedio
+	resultado.ValorPEPS = valorPEPS(lotes, resultado.Quantidade)
+
+	resultado.CustoMedio = Arredondar(resultado.CustoMedio)
+	resultado.ValorCMP = Arredondar(resultado.ValorCMP)
+	resultado.ValorPEPS = Arredondar(resultado.ValorPEPS)
+	return resultado
+}
+
+// valorPEPS valoriza a quantidade em estoque pelas camadas de entrada, da mais recente para a mais antiga. Lotes com
+// a mesma data de entrada mantêm a ordem recebida.
+func valorPEPS(lotes []Lote, quantidade int) float64 {
+	camadas := make([]Lote, len(lotes))
+	copy(camadas, lotes)
+	sort.SliceStable(camadas, func(i, j int) bool { return camadas[i].DataEntrada < camadas[j].DataEntrada })
+
+	valor := 0.0
+	restante := quantidade
+	for i := len(camadas) - 1; i >= 0 && restante > 0; i-- {
+		if camadas[i].QuantidadeRecebida <= 0 {
+			continue
+		}
+		consumida := min(camadas[i].QuantidadeRecebida, restante)
+		valor += float64(consumida) * camadas[i].CustoUnitario
+		restante -= consumida
+	}
+
+	if restante > 0 && len(camadas) > 0 {
+		valor += float64(restante) * camadas[0].CustoUnitario
+	}
+	return valor
+}
+
+// Margem calcula a margem bruta unitária e percentual de um preço de venda sobre o custo
+func Margem(precoVenda, custo float64) (float64, float64) {
+	margem := precoVenda - custo
+	if precoVenda <= 0 {
+		return Arredondar(margem), 0
+	}
+	return Arredondar(margem), Arredondar(margem / precoVenda * 100)
+}
+
+// Arredondar arredonda valores monetários para centavos
+func Arredondar(valor float64) float64 {
+	return math.Round(valor*100) / 100
+}
@@ -0,0 +1,99 @@
+package valorizacao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcular_CustoMedioEPEPS(t *testing.T) {
+	// Arrange: 10 un a 10,00 e depois 10 un a 20,00; restam 12 unidades
+	lotes := []Lote{
+		{QuantidadeRecebida: 10, SaldoNaData: 2, CustoUnitario: 10},
+		{QuantidadeRecebida: 10, SaldoNaData: 10, CustoUnitario: 20},
+	}
+
+	// Act
+	resultado := Calcular(lotes)
+
+	// Assert
+	assert.Equal(t, 12, resultado.Quantidade)
+	assert.Equal(t, 15.0, resultado.CustoMedio)
+	assert.Equal(t, 180.0, resultado.ValorCMP)
+	// PEPS: restam as entradas mais recentes, 10 un a 20,00 + 2 un a 10,00
+	assert.Equal(t, 220.0, resultado.ValorPEPS)
+}
+
+func TestCalcular_SemLotes(t *testing.T) {
+	resultado := Calcular(nil)
+
+	assert.Equal(t, Resultado{}, resultado)
+}
+
+func TestCalcular_SaldoMaiorQueRecebido(t *testing.T) {
+	// Ajuste positivo de inventário deixa o saldo acima do recebido
+	lotes := []Lote{
+		{QuantidadeRecebida: 5, SaldoNaData: 7, CustoUnitario: 10},
+	}
+
+	resultado := Calcular(lotes)
+
+	assert.Equal(t, 7, resultado.Quantidade)
+	assert.Equal(t, 70.0, resultado.ValorCMP)
+	assert.Equal(t, 70.0, resultado.ValorPEPS)
+}
+
+func TestCalcular_IgnoraSaldoNegativo(t *testing.T) {
+	lotes := []Lote{
+		{QuantidadeRecebida: 5, SaldoNaData: -1, CustoUnitario: 10},
+		{QuantidadeRecebida: 5, SaldoNaData: 3, CustoUnitario: 10},
+	}
+
+	resultado := Calcular(lotes)
+
+	assert.Equal(t, 3, resultado.Quantidade)
+	assert.Equal(t, 30.0, resultado.ValorCMP)
+	assert.Equal(t, 30.0, resultado.ValorPEPS)
+}
+
+func TestCalcular_PEPSIndependeDaBaixaFisica(t *testing.T) {
+	// A baixa por vencimento consumiu o lote mais recente; no PEPS as saídas consomem a entrada mais antiga
+	lotes := []Lote{
+		{DataEntrada: "2025-02-01 10:00:00", QuantidadeRecebida: 10, SaldoNaData: 0, CustoUnitario: 20},
+		{DataEntrada: "2025-01-01 10:00:00", QuantidadeRecebida: 10, SaldoNaData: 4, CustoUnitario: 10},
+	}
+
+	resultado := Calcular(lotes)
+
+	assert.Equal(t, 4, resultado.Quantidade)
+	assert.Equal(t, 80.0, resultado.ValorPEPS)
+	assert.Equal(t, 60.0, resultado.ValorCMP)
+}
+
+func TestCalcular_PEPSExcedenteNoCustoMaisAntigo(t *testing.T) {
+	// Ajuste positivo de inventário deixa o saldo acima do total recebido
+	lotes := []Lote{
+		{DataEntrada: "2025-01-01 10:00:00", QuantidadeRecebida: 2, SaldoNaData: 3, CustoUnitario: 10},
+		{DataEntrada: "2025-02-01 10:00:00", QuantidadeRecebida: 2, SaldoNaData: 2, CustoUnitario: 20},
+	}
+
+	resultado := Calcular(lotes)
+
+	assert.Equal(t, 5, resultado.Quantidade)
+	assert.Equal(t, 70.0, resultado.ValorPEPS)
+}
+
+func TestMargem(t *testing.T) {
+	margem, percentual := Margem(50, 30)
+	assert.Equal(t, 20.0, margem)
+	assert.Equal(t, 40.0, percentual)
+
+	margem, percentual = Margem(0, 30)
+	assert.Equal(t, -30.0, margem)
+	assert.Equal(t, 0.0, percentual)
+}
+
+func TestArredondar(t *testing.T) {
+	assert.Equal(t, 10.13, Arredondar(10.125000001))
+	assert.Equal(t, 0.0, Arredondar(0.004))
+}
@@ -0,0 +1,446 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/betine97/back-project.git/src/model/service/estoque"
+	"github.com/betine97/back-project.git/src/model/service/valorizacao"
+	"go.uber.org/zap"
+)
+
+// FUNÇÕES DE VARIAÇÕES E KITS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (srv *Service) GetVariacoesService(userID string, id string) (*dtos.VariacaoListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get variacoes service", zap.String("id", id))
+
+	pai, restErr := srv.getProduto(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	variacoes, dbErr := srv.dbClient.GetVariacoes(pai.IDProduto, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting variacoes from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.VariacaoListResponse{
+		Produto:   buildProductResponse(*pai),
+		Variacoes: []dtos.VariacaoResponse{},
+	}
+	for _, variacao := range variacoes {
+		response.Variacoes = append(response.Variacoes, dtos.VariacaoResponse{
+			ProductResponse: buildProductResponse(variacao.Produto),
+			Saldo:           variacao.Saldo,
+		})
+	}
+
+	zap.L().Info("Get variacoes service completed successfully", zap.Int("count", len(response.Variacoes)))
+	return response, nil
+}
+
+// CreateVariacaoService cadastra uma variação com SKU, código de barras, preço e estoque próprios,
+// herdando marca, categoria, destinado_para e fornecedor do produto pai
+func (srv *Service) CreateVariacaoService(userID string, id string, request dtos.CreateVariacaoRequest) (int, *exceptions.RestErr) {
+	zap.L().Info("Starting create variacao service", zap.String("id_produto_pai", id), zap.String("variacao", request.Variacao))
+
+	pai, restErr := srv.getProduto(userID, id)
+	if restErr != nil {
+		return 0, restErr
+	}
+	if restErr := srv.validarProdutoPai(userID, pai); restErr != nil {
+		return 0, restErr
+	}
+	if restErr := srv.validarCodigosUnicos(userID, 0, request.CodigoBarra, request.SKU); restErr != nil {
+		return 0, restErr
+	}
+
+	nome := request.NomeProduto
+	if nome == "" {
+		nome = fmt.Sprintf("%s %s", pai.NomeProduto, request.Variacao)
+	}
+	dataCadastro := request.DataCadastro
+	if dataCadastro == "" {
+		dataCadastro = time.Now().Format("2006-01-02")
+	}
+
+	idPai := pai.IDProduto
+	variacao := &entity.Produto{
+		DataCadastro:  dataCadastro,
+		CodigoBarra:   request.CodigoBarra,
+		NomeProduto:   nome,
+		SKU:           request.SKU,
+		Categoria:     pai.Categoria,
+		IDCategoria:   pai.IDCategoria,
+		DestinadoPara: pai.DestinadoPara,
+		Variacao:      request.Variacao,
+		Marca:         pai.Marca,
+		Descricao:     request.Descricao,
+		Status:        request.Status,
+		PrecoVenda:    request.PrecoVenda,
+		IDFornecedor:  pai.IDFornecedor,
+		EstoqueMinimo: request.EstoqueMinimo,
+		Tipo:          entity.ProdutoTipoVariacao,
+		IDProdutoPai:  &idPai,
+	}
+
+	if dbErr := srv.dbClient.CreateVariacao(pai.IDProduto, variacao, userID); dbErr != nil {
+		zap.L().Error("Error creating variacao in database", zap.Error(dbErr))
+		return 0, erroGravacaoProduto(dbErr)
+	}
+
+	zap.L().Info("Variacao created successfully", zap.Int("id_produto", variacao.IDProduto), zap.Int("id_produto_pai", pai.IDProduto))
+	return variacao.IDProduto, nil
+}
+
+// VincularVariacaoService transforma um produto já cadastrado em variação do produto pai
+func (srv *Service) VincularVariacaoService(userID string, id string, idVariacao string) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting vincular variacao service", zap.String("id_produto_pai", id), zap.String("id_produto", idVariacao))
+
+	if id == idVariacao {
+		return false, exceptions.NewBadRequestError("A product cannot be a variation of itself")
+	}
+
+	pai, restErr := srv.getProduto(userID, id)
+	if restErr != nil {
+		return false, restErr
+	}
+	if restErr := srv.validarProdutoPai(userID, pai); restErr != nil {
+		return false, restErr
+	}
+
+	variacao, restErr := srv.getProduto(userID, idVariacao)
+	if restErr != nil {
+		return false, restErr
+	}
+	if variacao.Tipo != entity.ProdutoTipoSimples {
+		return false, exceptions.NewConflictError(fmt.Sprintf("Only simple products can become variations, product %d is of type '%s'", variacao.IDProduto, variacao.Tipo))
+	}
+
+	compartilhados := map[string]interface{}{
+		"marca":          pai.Marca,
+		"categoria":      pai.Categoria,
+		"id_categoria":   pai.IDCategoria,
+		"destinado_para": pai.DestinadoPara,
+		"id_fornecedor":  pai.IDFornecedor,
+	}
+
+	if dbErr := srv.dbClient.VincularVariacao(pai.IDProduto, variacao.IDProduto, compartilhados, userID); dbErr != nil {
+		zap.L().Error("Error linking variacao in database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Variacao linked successfully", zap.Int("id_produto", variacao.IDProduto), zap.Int("id_produto_pai", pai.IDProduto))
+	return true, nil
+}
+
+// GetKitComponentesService retorna a composição do kit e o estoque derivado dos componentes
+func (srv *Service) GetKitComponentesService(userID string, id string) (*dtos.KitComponentesResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get kit componentes service", zap.String("id", id))
+
+	kit, restErr := srv.getProduto(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if kit.Tipo != entity.ProdutoTipoKit {
+		return nil, exceptions.NewBadRequestError("Product is not a kit")
+	}
+
+	response, restErr := srv.buildKitComponentesResponse(userID, *kit)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	zap.L().Info("Get kit componentes service completed successfully", zap.Int("componentes", len(response.Componentes)), zap.Int("estoque_disponivel", response.EstoqueDisponivel))
+	return response, nil
+}
+
+// SetKitComponentesService define a composição do kit; o produto passa a ser do tipo kit e não tem estoque próprio
+func (srv *Service) SetKitComponentesService(userID string, id string, request dtos.SetKitComponentesRequest) (*dtos.KitComponentesResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting set kit componentes service", zap.String("id", id), zap.Int("componentes", len(request.Componentes)))
+
+	kit, restErr := srv.getProduto(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if kit.Tipo != entity.ProdutoTipoSimples && kit.Tipo != entity.ProdutoTipoKit {
+		return nil, exceptions.NewConflictError(fmt.Sprintf("Only simple products can become kits, product is of type '%s'", kit.Tipo))
+	}
+
+	// Um kit não pode ser componente de outro kit nem agrupar variações
+	emKit, dbErr := srv.dbClient.ProdutoEmKit(kit.IDProduto, userID)
+	if dbErr != nil {
+		zap.L().Error("Error checking produto em kit", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	if emKit {
+		return nil, exceptions.NewConflictError("Product is a component of another kit")
+	}
+	temVariacoes, dbErr := srv.dbClient.ProdutoTemVariacoes(kit.IDProduto, userID)
+	if dbErr != nil {
+		zap.L().Error("Error checking produto variacoes", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	if temVariacoes {
+		return nil, exceptions.NewConflictError("Product has variations and cannot become a kit")
+	}
+
+	if kit.Tipo == entity.ProdutoTipoSimples {
+		saldos, dbErr := srv.dbClient.GetSaldoProdutos([]int{kit.IDProduto}, userID)
+		if dbErr != nil {
+			zap.L().Error("Error getting saldo produto", zap.Error(dbErr))
+			return nil, exceptions.NewInternalServerError("Internal server error")
+		}
+		if saldos[kit.IDProduto] > 0 {
+			return nil, exceptions.NewConflictError("Product has its own stock and cannot become a kit")
+		}
+	}
+
+	idsComponentes := make([]int, 0, len(request.Componentes))
+	componentes := make([]entity.KitComponente, 0, len(request.Componentes))
+	vistos := map[int]bool{}
+	for _, componente := range request.Componentes {
+		if componente.IDProduto == kit.IDProduto {
+			return nil, exceptions.NewBadRequestError("A kit cannot contain itself")
+		}
+		if vistos[componente.IDProduto] {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Duplicated component %d", componente.IDProduto))
+		}
+		vistos[componente.IDProduto] = true
+		idsComponentes = append(idsComponentes, componente.IDProduto)
+		componentes = append(componentes, entity.KitComponente{
+			IDKit:      kit.IDProduto,
+			IDProduto:  componente.IDProduto,
+			Quantidade: componente.Quantidade,
+		})
+	}
+
+	produtos, dbErr := srv.dbClient.GetProdutosByIDs(idsComponentes, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting componentes from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	tipos := map[int]string{}
+	for _, produto := range produtos {
+		tipos[produto.IDProduto] = produto.Tipo
+	}
+	for _, idComponente := range idsComponentes {
+		tipo, ok := tipos[idComponente]
+		if !ok {
+			return nil, exceptions.NewNotFoundError(fmt.Sprintf("Component product %d not found", idComponente))
+		}
+		if tipo == entity.ProdutoTipoKit || tipo == entity.ProdutoTipoPai {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Component product %d is of type '%s'; components must be simple products or variations", idComponente, tipo))
+		}
+	}
+
+	if dbErr := srv.dbClient.SetKitComponentes(kit.IDProduto, componentes, userID); dbErr != nil {
+		zap.L().Error("Error setting kit componentes in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	kit.Tipo = entity.ProdutoTipoKit
+	response, restErr := srv.buildKitComponentesResponse(userID, *kit)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	zap.L().Info("Kit componentes set successfully", zap.Int("id_kit", kit.IDProduto), zap.Int("componentes", len(componentes)))
+	return response, nil
+}
+
+// CreateSaidaEstoqueService registra uma saída manual de estoque (perda, consumo interno, etc.)
+func (srv *Service) CreateSaidaEstoqueService(userID string, request dtos.CreateSaidaEstoqueRequest) (*dtos.SaidaEstoqueResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting saida estoque service", zap.Int("itens", len(request.Itens)))
+
+	movimentacoes, restErr := srv.baixarEstoque(userID, request.Itens, entity.MovimentacaoOrigemManual, 0, request.Observacao)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response := &dtos.SaidaEstoqueResponse{Movimentacoes: []dtos.MovimentacaoEstoqueResponse{}}
+	for _, movimentacao := range movimentacoes {
+		response.Movimentacoes = append(response.Movimentacoes, dtos.MovimentacaoEstoqueResponse{
+			IDEstoque:     movimentacao.IDEstoque,
+			IDProduto:     movimentacao.IDProduto,
+			IDLote:        movimentacao.IDLote,
+			Quantidade:    movimentacao.Quantidade,
+			CustoUnitario: movimentacao.CustoUnitario,
+		})
+		response.CustoTotal += float64(-movimentacao.Quantidade) * movimentacao.CustoUnitario
+	}
+	response.CustoTotal = valorizacao.Arredondar(response.CustoTotal)
+
+	zap.L().Info("Saida estoque service completed successfully", zap.Int("movimentacoes", len(response.Movimentacoes)), zap.Float64("custo_total", response.CustoTotal))
+	return response, nil
+}
+
+// baixarEstoque retira as quantidades do estoque numa única transação.
+func (srv *Service) baixarEstoque(userID string, itens []dtos.ItemSaidaEstoqueRequest, origem string, idReferencia int, observacao string) ([]entity.MovimentacaoEstoque, *exceptions.RestErr) {
+	saidas, restErr := srv.planejarSaidasEstoque(userID, itens)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	movimentacoes, dbErr := srv.dbClient.RegistrarSaidaEstoque(saidas, origem, idReferencia, observacao, time.Now().Format("2006-01-02 15:04:05"), userID)
+	if dbErr != nil {
+		return nil, erroSaidaEstoque(dbErr)
+	}
+
+	return movimentacoes, nil
+}
+
+// planejarSaidasEstoque soma as quantidades por produto que terão de sair dos lotes.
+// Kits são substituídos pelos componentes; produtos pai não têm estoque e são rejeitados.
+func (srv *Service) planejarSaidasEstoque(userID string, itens []dtos.ItemSaidaEstoqueRequest) ([]entity.SaidaEstoque, *exceptions.RestErr) {
+	quantidades := map[int]int{}
+	var ids []int
+	for _, item := range itens {
+		if _, ok := quantidades[item.IDProduto]; !ok {
+			ids = append(ids, item.IDProduto)
+		}
+		quantidades[item.IDProduto] += item.Quantidade
+	}
+
+	produtos, dbErr := srv.dbClient.GetProdutosByIDs(ids, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting produtos from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	tipos := map[int]string{}
+	for _, produto := range produtos {
+		tipos[produto.IDProduto] = produto.Tipo
+	}
+
+	var idsKits []int
+	for _, idProduto := range ids {
+		tipo, ok := tipos[idProduto]
+		if !ok {
+			return nil, exceptions.NewNotFoundError(fmt.Sprintf("Product %d not found", idProduto))
+		}
+		switch tipo {
+		case entity.ProdutoTipoPai:
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Product %d is a parent product; choose one of its variations", idProduto))
+		case entity.ProdutoTipoKit:
+			idsKits = append(idsKits, idProduto)
+		}
+	}
+
+	// Substituir cada kit pelos componentes multiplicados pela quantidade de kits
+	saidasPorProduto := map[int]int{}
+	var ordem []int
+	adicionar := func(idProduto, quantidade int) {
+		if _, ok := saidasPorProduto[idProduto]; !ok {
+			ordem = append(ordem, idProduto)
+		}
+		saidasPorProduto[idProduto] += quantidade
+	}
+
+	componentesPorKit := map[int][]entity.KitComponente{}
+	if len(idsKits) > 0 {
+		componentes, dbErr := srv.dbClient.GetComponentesDosKits(idsKits, userID)
+		if dbErr != nil {
+			zap.L().Error("Error getting componentes dos kits", zap.Error(dbErr))
+			return nil, exceptions.NewInternalServerError("Internal server error")
+		}
+		for _, componente := range componentes {
+			componentesPorKit[componente.IDKit] = append(componentesPorKit[componente.IDKit], componente)
+		}
+	}
+
+	for _, idProduto := range ids {
+		if tipos[idProduto] != entity.ProdutoTipoKit {
+			adicionar(idProduto, quantidades[idProduto])
+			continue
+		}
+		componentes := componentesPorKit[idProduto]
+		if len(componentes) == 0 {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Kit %d has no components", idProduto))
+		}
+		for _, componente := range componentes {
+			adicionar(componente.IDProduto, componente.Quantidade*quantidades[idProduto])
+		}
+	}
+
+	saidas := make([]entity.SaidaEstoque, 0, len(ordem))
+	for _, idProduto := range ordem {
+		saidas = append(saidas, entity.SaidaEstoque{IDProduto: idProduto, Quantidade: saidasPorProduto[idProduto]})
+	}
+
+	return saidas, nil
+}
+
+// erroSaidaEstoque converte o erro da baixa: falta de saldo vira 409, o resto 500
+func erroSaidaEstoque(dbErr error) *exceptions.RestErr {
+	var insuficiente *persistence.EstoqueInsuficienteError
+	if errors.As(dbErr, &insuficiente) {
+		return exceptions.NewConflictError(fmt.Sprintf("Insufficient stock for product %d: requested %d, available %d", insuficiente.IDProduto, insuficiente.Solicitado, insuficiente.Disponivel))
+	}
+	zap.L().Error("Error registering saida estoque", zap.Error(dbErr))
+	return exceptions.NewInternalServerError("Internal server error")
+}
+
+// validarProdutoPai verifica se o produto pode agrupar variações: precisa ser simples ou pai,
+// e um produto simples não pode ter estoque próprio nem fazer parte de um kit
+func (srv *Service) validarProdutoPai(userID string, pai *entity.Produto) *exceptions.RestErr {
+	if pai.Tipo == entity.ProdutoTipoPai {
+		return nil
+	}
+	if pai.Tipo != entity.ProdutoTipoSimples {
+		return exceptions.NewConflictError(fmt.Sprintf("Product of type '%s' cannot have variations", pai.Tipo))
+	}
+
+	saldos, dbErr := srv.dbClient.GetSaldoProdutos([]int{pai.IDProduto}, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting saldo produto", zap.Error(dbErr))
+		return exceptions.NewInternalServerError("Internal server error")
+	}
+	if saldos[pai.IDProduto] > 0 {
+		return exceptions.NewConflictError("Product has its own stock and cannot become a parent product; register it as a variation instead")
+	}
+
+	emKit, dbErr := srv.dbClient.ProdutoEmKit(pai.IDProduto, userID)
+	if dbErr != nil {
+		zap.L().Error("Error checking produto em kit", zap.Error(dbErr))
+		return exceptions.NewInternalServerError("Internal server error")
+	}
+	if emKit {
+		return exceptions.NewConflictError("Product is a component of a kit and cannot become a parent product")
+	}
+	return nil
+}
+
+func (srv *Service) buildKitComponentesResponse(userID string, kit entity.Produto) (*dtos.KitComponentesResponse, *exceptions.RestErr) {
+	componentes, dbErr := srv.dbClient.GetKitComponentes(kit.IDProduto, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting kit componentes from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.KitComponentesResponse{
+		IDKit:       kit.IDProduto,
+		NomeProduto: kit.NomeProduto,
+		Componentes: []dtos.KitComponenteResponse{},
+	}
+	calculo := make([]estoque.Componente, 0, len(componentes))
+	for _, componente := range componentes {
+		calculo = append(calculo, estoque.Componente{IDProduto: componente.IDProduto, Quantidade: componente.Quantidade, Saldo: componente.Saldo})
+		response.Componentes = append(response.Componentes, dtos.KitComponenteResponse{
+			IDProduto:     componente.IDProduto,
+			NomeProduto:   componente.NomeProduto,
+			SKU:           componente.SKU,
+			Quantidade:    componente.Quantidade,
+			Saldo:         componente.Saldo,
+			KitsPossiveis: estoque.DisponivelKit([]estoque.Componente{calculo[len(calculo)-1]}),
+		})
+	}
+	response.EstoqueDisponivel = estoque.DisponivelKit(calculo)
+
+	return response, nil
+}
@@ -0,0 +1,269 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TESTES PARA CreateVariacaoService
+func TestService_CreateVariacaoService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	pai := &entity.Produto{IDProduto: 3, NomeProduto: "Ração", Marca: "Marca", Tipo: entity.ProdutoTipoSimples}
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(pai, nil)
+	mockDBClient.On("GetSaldoProdutos", []int{3}, "1").Return(map[int]int{}, nil)
+	mockDBClient.On("ProdutoEmKit", 3, "1").Return(false, nil)
+	mockDBClient.On("GetProductBySKU", "RAC-15KG", "1").Return(&entity.Produto{})
+	mockDBClient.On("CreateVariacao", 3, mock.MatchedBy(func(variacao *entity.Produto) bool {
+		return variacao.NomeProduto == "Ração 15kg" && variacao.Marca == "Marca" &&
+			variacao.Tipo == entity.ProdutoTipoVariacao && *variacao.IDProdutoPai == 3
+	}), "1").Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.Produto).IDProduto = 8
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	id, err := service.CreateVariacaoService("1", "3", dtos.CreateVariacaoRequest{Variacao: "15kg", SKU: "RAC-15KG", PrecoVenda: 99.9})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 8, id)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateVariacaoService_PaiComEstoque(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(&entity.Produto{IDProduto: 3, Tipo: entity.ProdutoTipoSimples}, nil)
+	mockDBClient.On("GetSaldoProdutos", []int{3}, "1").Return(map[int]int{3: 5}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	id, err := service.CreateVariacaoService("1", "3", dtos.CreateVariacaoRequest{Variacao: "15kg"})
+
+	// Assert
+	assert.Equal(t, 0, id)
+	assert.NotNil(t, err)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA VincularVariacaoService
+func TestService_VincularVariacaoService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	pai := &entity.Produto{IDProduto: 3, Marca: "Marca", Tipo: entity.ProdutoTipoPai}
+
+	mockDBClient.On("GetProductByID", "3", "1").Return(pai, nil)
+	mockDBClient.On("GetProductByID", "5", "1").Return(&entity.Produto{IDProduto: 5, Tipo: entity.ProdutoTipoSimples}, nil)
+	mockDBClient.On("VincularVariacao", 3, 5, mock.MatchedBy(func(compartilhados map[string]interface{}) bool {
+		return compartilhados["marca"] == "Marca"
+	}), "1").Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.VincularVariacaoService("1", "3", "5")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, success)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_VincularVariacaoService_SameProduct(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.VincularVariacaoService("1", "3", "3")
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA SetKitComponentesService
+func TestService_SetKitComponentesService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	kit := &entity.Produto{IDProduto: 10, NomeProduto: "Kit Banho", Tipo: entity.ProdutoTipoSimples}
+	componentes := []entity.KitComponenteDetalhe{
+		{IDProduto: 1, NomeProduto: "Shampoo", Quantidade: 1, Saldo: 7},
+		{IDProduto: 2, NomeProduto: "Toalha", Quantidade: 2, Saldo: 6},
+	}
+
+	mockDBClient.On("GetProductByID", "10", "1").Return(kit, nil)
+	mockDBClient.On("ProdutoEmKit", 10, "1").Return(false, nil)
+	mockDBClient.On("ProdutoTemVariacoes", 10, "1").Return(false, nil)
+	mockDBClient.On("GetSaldoProdutos", []int{10}, "1").Return(map[int]int{}, nil)
+	mockDBClient.On("GetProdutosByIDs", []int{1, 2}, "1").Return([]entity.Produto{
+		{IDProduto: 1, Tipo: entity.ProdutoTipoSimples},
+		{IDProduto: 2, Tipo: entity.ProdutoTipoVariacao},
+	}, nil)
+	mockDBClient.On("SetKitComponentes", 10, []entity.KitComponente{
+		{IDKit: 10, IDProduto: 1, Quantidade: 1},
+		{IDKit: 10, IDProduto: 2, Quantidade: 2},
+	}, "1").Return(nil)
+	mockDBClient.On("GetKitComponentes", 10, "1").Return(componentes, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.SetKitComponentesRequest{Componentes: []dtos.KitComponenteRequest{
+		{IDProduto: 1, Quantidade: 1},
+		{IDProduto: 2, Quantidade: 2},
+	}}
+
+	// Act
+	result, err := service.SetKitComponentesService("1", "10", request)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 10, result.IDKit)
+	assert.Len(t, result.Componentes, 2)
+	assert.Equal(t, 3, result.EstoqueDisponivel)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_SetKitComponentesService_ComponenteEmOutroKit(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProductByID", "10", "1").Return(&entity.Produto{IDProduto: 10, Tipo: entity.ProdutoTipoSimples}, nil)
+	mockDBClient.On("ProdutoEmKit", 10, "1").Return(true, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.SetKitComponentesService("1", "10", dtos.SetKitComponentesRequest{Componentes: []dtos.KitComponenteRequest{{IDProduto: 1, Quantidade: 1}}})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Product is a component of another kit", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_SetKitComponentesService_ComVariacoes(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProductByID", "10", "1").Return(&entity.Produto{IDProduto: 10, Tipo: entity.ProdutoTipoSimples}, nil)
+	mockDBClient.On("ProdutoEmKit", 10, "1").Return(false, nil)
+	mockDBClient.On("ProdutoTemVariacoes", 10, "1").Return(true, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.SetKitComponentesService("1", "10", dtos.SetKitComponentesRequest{Componentes: []dtos.KitComponenteRequest{{IDProduto: 1, Quantidade: 1}}})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Product has variations and cannot become a kit", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA CreateSaidaEstoqueService
+func TestService_CreateSaidaEstoqueService_KitSubstituidoPelosComponentes(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProdutosByIDs", []int{10, 1}, "1").Return([]entity.Produto{
+		{IDProduto: 10, Tipo: entity.ProdutoTipoKit},
+		{IDProduto: 1, Tipo: entity.ProdutoTipoSimples},
+	}, nil)
+	mockDBClient.On("GetComponentesDosKits", []int{10}, "1").Return([]entity.KitComponente{
+		{IDKit: 10, IDProduto: 1, Quantidade: 1},
+		{IDKit: 10, IDProduto: 2, Quantidade: 2},
+	}, nil)
+	mockDBClient.On("RegistrarSaidaEstoque", []entity.SaidaEstoque{
+		{IDProduto: 1, Quantidade: 4},
+		{IDProduto: 2, Quantidade: 6},
+	}, entity.MovimentacaoOrigemManual, 0, "Avaria", mock.AnythingOfType("string"), "1").Return([]entity.MovimentacaoEstoque{
+		{IDProduto: 1, Quantidade: -4, CustoUnitario: 2.5},
+		{IDProduto: 2, Quantidade: -6, CustoUnitario: 1},
+	}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.CreateSaidaEstoqueRequest{
+		Itens:      []dtos.ItemSaidaEstoqueRequest{{IDProduto: 10, Quantidade: 3}, {IDProduto: 1, Quantidade: 1}},
+		Observacao: "Avaria",
+	}
+
+	// Act
+	result, err := service.CreateSaidaEstoqueService("1", request)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Len(t, result.Movimentacoes, 2)
+	assert.Equal(t, 16.0, result.CustoTotal)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateSaidaEstoqueService_EstoqueInsuficiente(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProdutosByIDs", []int{1}, "1").Return([]entity.Produto{{IDProduto: 1, Tipo: entity.ProdutoTipoSimples}}, nil)
+	mockDBClient.On("RegistrarSaidaEstoque", []entity.SaidaEstoque{{IDProduto: 1, Quantidade: 5}}, entity.MovimentacaoOrigemManual, 0, "", mock.AnythingOfType("string"), "1").
+		Return(nil, &persistence.EstoqueInsuficienteError{IDProduto: 1, Solicitado: 5, Disponivel: 2})
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.CreateSaidaEstoqueService("1", dtos.CreateSaidaEstoqueRequest{Itens: []dtos.ItemSaidaEstoqueRequest{{IDProduto: 1, Quantidade: 5}}})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Insufficient stock for product 1: requested 5, available 2", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
@@ -0,0 +1,527 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/betine97/back-project.git/cmd/config/exceptions"
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/betine97/back-project.git/src/model/service/vendas"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FUNÇÕES DE VENDAS ------------------------------------------------------------------------------------------------------------------------------------
+
+func (srv *Service) GetAllVendasService(userID string, status string, idCliente string, page, limit int) (*dtos.VendaListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get all vendas service", zap.String("status", status), zap.String("id_cliente", idCliente), zap.Int("page", page), zap.Int("limit", limit))
+
+	idClienteInt, restErr := validarFiltrosVendas(status, idCliente)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	// Validar parâmetros de paginação
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 30
+	}
+
+	offset := (page - 1) * limit
+
+	vendasEncontradas, total, dbErr := srv.dbClient.GetVendasPaginated(userID, status, idClienteInt, limit, offset)
+	if dbErr != nil {
+		zap.L().Error("Error getting vendas from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	vendaResponses := make([]dtos.VendaResponse, len(vendasEncontradas))
+	for i, venda := range vendasEncontradas {
+		vendaResponses[i] = buildVendaResponse(venda)
+	}
+
+	totalPages := (total + limit - 1) / limit
+
+	response := &dtos.VendaListResponse{
+		Vendas:     vendaResponses,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+
+	zap.L().Info("Vendas service completed successfully", zap.Int("total", total))
+	return response, nil
+}
+
+func (srv *Service) GetVendaByIDService(userID string, id string) (*dtos.VendaDetalheResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get venda by ID service", zap.String("id", id))
+
+	venda, restErr := srv.getVenda(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	response, restErr := srv.buildVendaDetalheResponse(userID, *venda)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	zap.L().Info("Successfully retrieved venda by ID", zap.String("id", id))
+	return response, nil
+}
+
+func (srv *Service) GetItensVendaService(userID string, id string) (*dtos.ItemVendaListResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting get itens venda service", zap.String("id", id))
+
+	venda, restErr := srv.getVenda(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	itens, dbErr := srv.dbClient.GetItensVenda(venda.IDVenda, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting itens venda from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	response := &dtos.ItemVendaListResponse{
+		IDVenda: venda.IDVenda,
+		Itens:   buildItensVendaResponse(itens),
+		Total:   len(itens),
+	}
+
+	zap.L().Info("Itens venda service completed successfully", zap.Int("total", response.Total))
+	return response, nil
+}
+
+// CreateVendaService registra a venda aberta com os itens; o estoque só é baixado no pagamento
+func (srv *Service) CreateVendaService(userID string, request dtos.CreateVendaRequest) (int, *exceptions.RestErr) {
+	zap.L().Info("Starting venda creation service", zap.Int("itens", len(request.Itens)))
+
+	if request.IDCliente != nil {
+		if cliente := srv.dbClient.GetClienteByID(strconv.Itoa(*request.IDCliente), userID); cliente.ID == 0 {
+			return 0, exceptions.NewNotFoundError(fmt.Sprintf("Cliente %d not found", *request.IDCliente))
+		}
+	}
+
+	itens, restErr := srv.montarItensVenda(userID, request.Itens)
+	if restErr != nil {
+		return 0, restErr
+	}
+
+	subtotais := make([]float64, len(itens))
+	for i, item := range itens {
+		subtotais[i] = item.Subtotal
+	}
+	totais, err := vendas.Totalizar(subtotais, request.Desconto)
+	if err != nil {
+		return 0, exceptions.NewBadRequestError(err.Error())
+	}
+
+	venda := &entity.Venda{
+		IDCliente:      request.IDCliente,
+		DataVenda:      time.Now().Format(formatoDataHora),
+		Subtotal:       totais.Subtotal,
+		Desconto:       totais.Desconto,
+		ValorTotal:     totais.ValorTotal,
+		FormaPagamento: request.FormaPagamento,
+		Status:         entity.VendaStatusAberta,
+		Observacao:     request.Observacao,
+	}
+
+	if dbErr := srv.dbClient.CreateVenda(venda, itens, userID); dbErr != nil {
+		zap.L().Error("Error creating venda in database", zap.Error(dbErr))
+		return 0, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Venda created successfully", zap.Int("id", venda.IDVenda), zap.Float64("valor_total", venda.ValorTotal))
+	return venda.IDVenda, nil
+}
+
+// AdicionarItemVendaService inclui um item numa venda ainda aberta
+func (srv *Service) AdicionarItemVendaService(userID string, id string, request dtos.ItemVendaRequest) (*dtos.VendaDetalheResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting adicionar item venda service", zap.String("id", id), zap.Int("id_produto", request.IDProduto))
+
+	venda, restErr := srv.getVenda(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if venda.Status != entity.VendaStatusAberta {
+		return nil, exceptions.NewConflictError("Venda is not open")
+	}
+
+	itens, restErr := srv.montarItensVenda(userID, []dtos.ItemVendaRequest{request})
+	if restErr != nil {
+		return nil, restErr
+	}
+	item := itens[0]
+	item.IDVenda = venda.IDVenda
+
+	if dbErr := srv.dbClient.AdicionarItemVenda(&item, userID); dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewConflictError("Venda is not open")
+		}
+		zap.L().Error("Error adding item to venda in database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	venda, restErr = srv.getVenda(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	response, restErr := srv.buildVendaDetalheResponse(userID, *venda)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	zap.L().Info("Item venda added successfully", zap.Int("id_venda", venda.IDVenda), zap.Int("id_item", item.IDItem))
+	return response, nil
+}
+
+// PagarVendaService confirma a venda aberta e baixa do estoque os produtos vendidos (kits pelos componentes)
+func (srv *Service) PagarVendaService(userID string, id string, request dtos.PagarVendaRequest) (*dtos.VendaDetalheResponse, *exceptions.RestErr) {
+	zap.L().Info("Starting pagar venda service", zap.String("id", id), zap.String("forma_pagamento", request.FormaPagamento))
+
+	venda, restErr := srv.getVenda(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if venda.Status != entity.VendaStatusAberta {
+		return nil, exceptions.NewConflictError("Venda is not open")
+	}
+
+	formaPagamento := venda.FormaPagamento
+	if request.FormaPagamento != "" {
+		formaPagamento = request.FormaPagamento
+	}
+	if formaPagamento == "" {
+		return nil, exceptions.NewBadRequestError("forma_pagamento is required")
+	}
+	if !formaPagamentoValida(formaPagamento) {
+		return nil, exceptions.NewBadRequestError(fmt.Sprintf("Invalid forma_pagamento '%s'", formaPagamento))
+	}
+
+	itens, dbErr := srv.dbClient.GetItensVenda(venda.IDVenda, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting itens venda from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	itensSaida := make([]dtos.ItemSaidaEstoqueRequest, len(itens))
+	for i, item := range itens {
+		itensSaida[i] = dtos.ItemSaidaEstoqueRequest{IDProduto: item.IDProduto, Quantidade: item.Quantidade}
+	}
+	saidas, restErr := srv.planejarSaidasEstoque(userID, itensSaida)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	dataPagamento := time.Now().Format(formatoDataHora)
+
+	var movimentoCaixa *entity.CaixaMovimento
+	if request.IDCaixa > 0 {
+		sessao, restErr := srv.getCaixaSessao(userID, strconv.Itoa(request.IDCaixa))
+		if restErr != nil {
+			return nil, restErr
+		}
+		if sessao.Status != entity.CaixaStatusAberto {
+			return nil, exceptions.NewConflictError("Caixa is not open")
+		}
+		movimentoCaixa = &entity.CaixaMovimento{
+			IDSessao:       sessao.ID,
+			Tipo:           entity.CaixaMovimentoVenda,
+			FormaPagamento: formaPagamento,
+			Valor:          venda.ValorTotal,
+			IDVenda:        &venda.IDVenda,
+			Descricao:      fmt.Sprintf("Venda %d", venda.IDVenda),
+			DataMovimento:  dataPagamento,
+		}
+	}
+
+	// Pagamento com crédito na loja (trocas): o valor sai do saldo do cliente da venda
+	var debitoCredito *entity.CreditoCliente
+	if formaPagamento == entity.FormaPagamentoCreditoLoja {
+		if venda.IDCliente == nil {
+			return nil, exceptions.NewBadRequestError("forma_pagamento 'credito_loja' requires a venda with id_cliente")
+		}
+		debitoCredito = &entity.CreditoCliente{
+			IDCliente:      *venda.IDCliente,
+			Tipo:           entity.CreditoTipoDebito,
+			Valor:          venda.ValorTotal,
+			Origem:         entity.CreditoOrigemVenda,
+			IDReferencia:   venda.IDVenda,
+			DataLancamento: dataPagamento,
+			Descricao:      fmt.Sprintf("Venda %d", venda.IDVenda),
+		}
+	}
+
+	creditoPontos, restErr := srv.pontosVenda(userID, *venda, formaPagamento, dataPagamento)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	movimentacoes, dbErr := srv.dbClient.PagarVenda(venda.IDVenda, formaPagamento, saidas, dataPagamento, movimentoCaixa, debitoCredito, creditoPontos, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewConflictError("Venda is not open")
+		}
+		if errors.Is(dbErr, persistence.ErrCaixaFechado) {
+			return nil, exceptions.NewConflictError("Caixa is not open")
+		}
+		if errors.Is(dbErr, persistence.ErrCreditoInsuficiente) {
+			return nil, exceptions.NewConflictError("Insufficient store credit for the cliente")
+		}
+		return nil, erroSaidaEstoque(dbErr)
+	}
+
+	venda, restErr = srv.getVenda(userID, id)
+	if restErr != nil {
+		return nil, restErr
+	}
+	response, restErr := srv.buildVendaDetalheResponse(userID, *venda)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	zap.L().Info("Venda paid successfully", zap.Int("id_venda", venda.IDVenda), zap.Int("movimentacoes", len(movimentacoes)))
+	return response, nil
+}
+
+// CancelarVendaService cancela a venda aberta ou paga; na paga, o estoque baixado volta para os mesmos lotes
+func (srv *Service) CancelarVendaService(userID string, id string) (bool, *exceptions.RestErr) {
+	zap.L().Info("Starting cancelar venda service", zap.String("id", id))
+
+	venda, restErr := srv.getVenda(userID, id)
+	if restErr != nil {
+		return false, restErr
+	}
+	if venda.Status != entity.VendaStatusAberta && venda.Status != entity.VendaStatusPaga {
+		return false, exceptions.NewConflictError(fmt.Sprintf("Venda with status '%s' cannot be canceled", venda.Status))
+	}
+
+	if venda.Status == entity.VendaStatusPaga {
+		_, devolucoes, dbErr := srv.dbClient.GetDevolucoesPaginated(userID, venda.IDVenda, 0, 1, 0)
+		if dbErr != nil {
+			zap.L().Error("Error getting devolucoes from database", zap.Error(dbErr))
+			return false, exceptions.NewInternalServerError("Internal server error")
+		}
+		if devolucoes > 0 {
+			return false, exceptions.NewConflictError("Venda has devolucoes and cannot be canceled")
+		}
+	}
+
+	dataCancelamento := time.Now().Format(formatoDataHora)
+
+	// A venda paga no PDV é estornada na mesma sessão de caixa, se ela ainda estiver aberta
+	var estornoCaixa *entity.CaixaMovimento
+	if venda.Status == entity.VendaStatusPaga && venda.IDCaixaSessao != nil {
+		estornoCaixa = &entity.CaixaMovimento{
+			IDSessao:       *venda.IDCaixaSessao,
+			Tipo:           entity.CaixaMovimentoEstorno,
+			FormaPagamento: venda.FormaPagamento,
+			Valor:          venda.ValorTotal,
+			IDVenda:        &venda.IDVenda,
+			Descricao:      fmt.Sprintf("Cancelamento da venda %d", venda.IDVenda),
+			DataMovimento:  dataCancelamento,
+		}
+	}
+
+	// A venda paga com crédito na loja devolve o valor ao saldo do cliente
+	var estornoCredito *entity.CreditoCliente
+	if venda.Status == entity.VendaStatusPaga && venda.FormaPagamento == entity.FormaPagamentoCreditoLoja && venda.IDCliente != nil {
+		estornoCredito = &entity.CreditoCliente{
+			IDCliente:      *venda.IDCliente,
+			Tipo:           entity.CreditoTipoCredito,
+			Valor:          venda.ValorTotal,
+			Origem:         entity.CreditoOrigemVenda,
+			IDReferencia:   venda.IDVenda,
+			DataLancamento: dataCancelamento,
+			Descricao:      fmt.Sprintf("Cancelamento da venda %d", venda.IDVenda),
+		}
+	}
+
+	// Os pontos de fidelidade ganhos na venda são retirados do saldo do cliente
+	var estornoPontos *entity.PontosCliente
+	if venda.Status == entity.VendaStatusPaga && venda.IDCliente != nil {
+		estornoPontos = &entity.PontosCliente{
+			IDCliente:      *venda.IDCliente,
+			Tipo:           entity.PontosTipoDebito,
+			Origem:         entity.PontosOrigemCancelamento,
+			IDReferencia:   venda.IDVenda,
+			DataLancamento: dataCancelamento,
+			Descricao:      fmt.Sprintf("Cancelamento da venda %d", venda.IDVenda),
+		}
+	}
+
+	dbErr := srv.dbClient.CancelarVenda(venda.IDVenda, venda.Status, dataCancelamento, estornoCaixa, estornoCredito, estornoPontos, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return false, exceptions.NewConflictError("Venda status changed, try again")
+		}
+		zap.L().Error("Error canceling venda in database", zap.Error(dbErr))
+		return false, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	zap.L().Info("Venda canceled successfully", zap.String("id", id))
+	return true, nil
+}
+
+// montarItensVenda confere os produtos e calcula preço e subtotal de cada item.
+// Sem preço informado vale o preço de venda atual; produtos pai não podem ser vendidos.
+func (srv *Service) montarItensVenda(userID string, requests []dtos.ItemVendaRequest) ([]entity.ItemVenda, *exceptions.RestErr) {
+	ids := make([]int, 0, len(requests))
+	for _, request := range requests {
+		ids = append(ids, request.IDProduto)
+	}
+
+	produtos, dbErr := srv.dbClient.GetProdutosByIDs(ids, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting produtos from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+	produtosPorID := make(map[int]entity.Produto, len(produtos))
+	for _, produto := range produtos {
+		produtosPorID[produto.IDProduto] = produto
+	}
+
+	itens := make([]entity.ItemVenda, 0, len(requests))
+	for _, request := range requests {
+		produto, ok := produtosPorID[request.IDProduto]
+		if !ok {
+			return nil, exceptions.NewNotFoundError(fmt.Sprintf("Product %d not found", request.IDProduto))
+		}
+		if produto.Tipo == entity.ProdutoTipoPai {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Product %d is a parent product; choose one of its variations", produto.IDProduto))
+		}
+
+		precoUnitario := produto.PrecoVenda
+		if request.PrecoUnitario != nil {
+			precoUnitario = *request.PrecoUnitario
+		}
+		if precoUnitario <= 0 {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Product %d has no sale price; inform preco_unitario", produto.IDProduto))
+		}
+
+		subtotal, err := vendas.Subtotal(vendas.Item{Quantidade: request.Quantidade, PrecoUnitario: precoUnitario, Desconto: request.Desconto})
+		if err != nil {
+			return nil, exceptions.NewBadRequestError(fmt.Sprintf("Product %d: %s", produto.IDProduto, err.Error()))
+		}
+
+		itens = append(itens, entity.ItemVenda{
+			IDProduto:     produto.IDProduto,
+			NomeProduto:   produto.NomeProduto,
+			Quantidade:    request.Quantidade,
+			PrecoUnitario: precoUnitario,
+			Desconto:      request.Desconto,
+			Subtotal:      subtotal,
+		})
+	}
+
+	return itens, nil
+}
+
+// getVenda busca a venda pelo ID da rota e traduz a ausência em 404
+func (srv *Service) getVenda(userID string, id string) (*entity.Venda, *exceptions.RestErr) {
+	idInt := 0
+	if _, err := fmt.Sscanf(id, "%d", &idInt); err != nil {
+		zap.L().Error("Error converting venda id to int", zap.Error(err))
+		return nil, exceptions.NewBadRequestError("Invalid venda ID")
+	}
+
+	venda, dbErr := srv.dbClient.GetVendaByID(idInt, userID)
+	if dbErr != nil {
+		if errors.Is(dbErr, gorm.ErrRecordNotFound) {
+			return nil, exceptions.NewNotFoundError("Venda not found")
+		}
+		zap.L().Error("Error getting venda by ID", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	return venda, nil
+}
+
+// validarFiltrosVendas confere o status e converte o id_cliente (0 quando não informado)
+func validarFiltrosVendas(status string, idCliente string) (int, *exceptions.RestErr) {
+	switch status {
+	case "", entity.VendaStatusAberta, entity.VendaStatusPaga, entity.VendaStatusCancelada, entity.VendaStatusDevolvida:
+	default:
+		return 0, exceptions.NewBadRequestError("Invalid status, expected 'aberta', 'paga', 'cancelada' or 'devolvida'")
+	}
+
+	idClienteInt := 0
+	if idCliente != "" {
+		if _, err := fmt.Sscanf(idCliente, "%d", &idClienteInt); err != nil || idClienteInt <= 0 {
+			return 0, exceptions.NewBadRequestError("Invalid id_cliente")
+		}
+	}
+	return idClienteInt, nil
+}
+
+func formaPagamentoValida(formaPagamento string) bool {
+	for _, forma := range entity.FormasPagamento {
+		if forma == formaPagamento {
+			return true
+		}
+	}
+	return false
+}
+
+func (srv *Service) buildVendaDetalheResponse(userID string, venda entity.Venda) (*dtos.VendaDetalheResponse, *exceptions.RestErr) {
+	itens, dbErr := srv.dbClient.GetItensVenda(venda.IDVenda, userID)
+	if dbErr != nil {
+		zap.L().Error("Error getting itens venda from database", zap.Error(dbErr))
+		return nil, exceptions.NewInternalServerError("Internal server error")
+	}
+
+	return &dtos.VendaDetalheResponse{
+		VendaResponse: buildVendaResponse(venda),
+		Itens:         buildItensVendaResponse(itens),
+	}, nil
+}
+
+func buildVendaResponse(venda entity.Venda) dtos.VendaResponse {
+	response := dtos.VendaResponse{
+		ID:             venda.IDVenda,
+		IDCliente:      venda.IDCliente,
+		DataVenda:      venda.DataVenda,
+		Subtotal:       venda.Subtotal,
+		Desconto:       venda.Desconto,
+		ValorTotal:     venda.ValorTotal,
+		FormaPagamento: venda.FormaPagamento,
+		Status:         venda.Status,
+		Observacao:     venda.Observacao,
+		IDCaixaSessao:  venda.IDCaixaSessao,
+	}
+	if venda.DataPagamento != nil {
+		response.DataPagamento = *venda.DataPagamento
+	}
+	if venda.DataCancelamento != nil {
+		response.DataCancelamento = *venda.DataCancelamento
+	}
+	return response
+}
+
+func buildItensVendaResponse(itens []entity.ItemVenda) []dtos.ItemVendaResponse {
+	response := make([]dtos.ItemVendaResponse, 0, len(itens))
+	for _, item := range itens {
+		response = append(response, dtos.ItemVendaResponse{
+			ID:                  item.IDItem,
+			IDVenda:             item.IDVenda,
+			IDProduto:           item.IDProduto,
+			NomeProduto:         item.NomeProduto,
+			Quantidade:          item.Quantidade,
+			PrecoUnitario:       item.PrecoUnitario,
+			Desconto:            item.Desconto,
+			Subtotal:            item.Subtotal,
+			QuantidadeDevolvida: item.QuantidadeDevolvida,
+		})
+	}
+	return response
+}
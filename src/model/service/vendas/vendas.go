@@ -0,0 +1,94 @@
+package vendas
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Item é uma linha da venda; Desconto é o valor em reais abatido do total da linha
+type Item struct {
+	Quantidade    int
+	PrecoUnitario float64
+	Desconto      float64
+}
+
+// Totais da venda, já arredondados para centavos
+type Totais struct {
+	Subtotal   float64 // soma dos subtotais dos itens
+	Desconto   float64 // desconto aplicado sobre a venda inteira
+	ValorTotal float64
+}
+
+// Subtotal retorna quantidade × preço menos o desconto do item.
+// O desconto não pode ser negativo nem maior que o valor bruto da linha.
+func Subtotal(item Item) (float64, error) {
+	bruto := arredondar(float64(item.Quantidade) * item.PrecoUnitario)
+	if item.Desconto < 0 {
+		return 0, errors.New("desconto must not be negative")
+	}
+	if item.Desconto > bruto {
+		return 0, fmt.Errorf("desconto %.2f is greater than the item value %.2f", item.Desconto, bruto)
+	}
+	return arredondar(bruto - item.Desconto), nil
+}
+
+// Totalizar soma os subtotais dos itens e aplica o desconto da venda, que não pode passar do subtotal
+func Totalizar(subtotais []float64, desconto float64) (Totais, error) {
+	var subtotal float64
+	for _, valor := range subtotais {
+		subtotal += valor
+	}
+	subtotal = arredondar(subtotal)
+
+	if desconto < 0 {
+		return Totais{}, errors.New("desconto must not be negative")
+	}
+	desconto = arredondar(desconto)
+	if desconto > subtotal {
+		return Totais{}, fmt.Errorf("desconto %.2f is greater than the venda subtotal %.2f", desconto, subtotal)
+	}
+
+	return Totais{
+		Subtotal:   subtotal,
+		Desconto:   desconto,
+		ValorTotal: arredondar(subtotal - desconto),
+	}, nil
+}
+
+// Devolucao descreve as unidades devolvidas de um item vendido
+type Devolucao struct {
+	SubtotalItem    float64 // subtotal do item na venda, já com o desconto do item
+	QuantidadeItem  int     // quantidade vendida
+	JaDevolvida     int     // quantidade devolvida antes desta devolução
+	Quantidade      int     // quantidade devolvida agora
+	SubtotalVenda   float64 // soma dos itens da venda
+	ValorTotalVenda float64 // subtotal da venda menos o desconto da venda
+}
+
+// ValorDevolucao retorna o valor a restituir pelas unidades devolvidas: o subtotal do item proporcional à quantidade,
+// com o desconto da venda rateado pelo peso do item. O cálculo é acumulado sobre o que já foi devolvido,
+// para que devoluções parciais somem exatamente o valor pago pelo item.
+func ValorDevolucao(devolucao Devolucao) (float64, error) {
+	if devolucao.Quantidade <= 0 {
+		return 0, errors.New("quantidade must be greater than zero")
+	}
+	disponivel := devolucao.QuantidadeItem - devolucao.JaDevolvida
+	if devolucao.Quantidade > disponivel {
+		return 0, fmt.Errorf("quantidade %d is greater than the %d units available for return", devolucao.Quantidade, disponivel)
+	}
+
+	fator := 1.0
+	if devolucao.SubtotalVenda > 0 {
+		fator = devolucao.ValorTotalVenda / devolucao.SubtotalVenda
+	}
+	pagoAte := func(quantidade int) float64 {
+		return arredondar(devolucao.SubtotalItem * fator * float64(quantidade) / float64(devolucao.QuantidadeItem))
+	}
+
+	return arredondar(pagoAte(devolucao.JaDevolvida+devolucao.Quantidade) - pagoAte(devolucao.JaDevolvida)), nil
+}
+
+func arredondar(valor float64) float64 {
+	return math.Round(valor*100) / 100
+}
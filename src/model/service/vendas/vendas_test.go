@@ -0,0 +1,81 @@
+package vendas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubtotal(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     Item
+		esperado float64
+	}{
+		{"sem desconto", Item{Quantidade: 3, PrecoUnitario: 19.9}, 59.7},
+		{"com desconto", Item{Quantidade: 2, PrecoUnitario: 129.9, Desconto: 9.8}, 250},
+		{"desconto igual ao valor", Item{Quantidade: 1, PrecoUnitario: 10, Desconto: 10}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subtotal, err := Subtotal(tt.item)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.esperado, subtotal)
+		})
+	}
+}
+
+func TestSubtotal_DescontoInvalido(t *testing.T) {
+	_, err := Subtotal(Item{Quantidade: 1, PrecoUnitario: 10, Desconto: 10.01})
+	assert.Error(t, err)
+
+	_, err = Subtotal(Item{Quantidade: 1, PrecoUnitario: 10, Desconto: -1})
+	assert.Error(t, err)
+}
+
+func TestTotalizar(t *testing.T) {
+	totais, err := Totalizar([]float64{59.7, 250, 0.1}, 9.8)
+	assert.NoError(t, err)
+	assert.Equal(t, Totais{Subtotal: 309.8, Desconto: 9.8, ValorTotal: 300}, totais)
+}
+
+func TestTotalizar_DescontoMaiorQueSubtotal(t *testing.T) {
+	_, err := Totalizar([]float64{10}, 10.5)
+	assert.Error(t, err)
+
+	_, err = Totalizar([]float64{10}, -0.5)
+	assert.Error(t, err)
+}
+
+func TestValorDevolucao(t *testing.T) {
+	tests := []struct {
+		name      string
+		devolucao Devolucao
+		esperado  float64
+	}{
+		{"item inteiro sem desconto na venda", Devolucao{SubtotalItem: 250, QuantidadeItem: 2, Quantidade: 2, SubtotalVenda: 295, ValorTotalVenda: 295}, 250},
+		{"uma unidade sem desconto na venda", Devolucao{SubtotalItem: 250, QuantidadeItem: 2, Quantidade: 1, SubtotalVenda: 295, ValorTotalVenda: 295}, 125},
+		{"desconto da venda rateado", Devolucao{SubtotalItem: 50, QuantidadeItem: 1, Quantidade: 1, SubtotalVenda: 100, ValorTotalVenda: 90}, 45},
+		{"primeira de três unidades", Devolucao{SubtotalItem: 10, QuantidadeItem: 3, Quantidade: 1, SubtotalVenda: 10, ValorTotalVenda: 10}, 3.33},
+		{"segunda de três unidades", Devolucao{SubtotalItem: 10, QuantidadeItem: 3, JaDevolvida: 1, Quantidade: 1, SubtotalVenda: 10, ValorTotalVenda: 10}, 3.34},
+		{"última de três unidades", Devolucao{SubtotalItem: 10, QuantidadeItem: 3, JaDevolvida: 2, Quantidade: 1, SubtotalVenda: 10, ValorTotalVenda: 10}, 3.33},
+		{"venda com subtotal zero", Devolucao{QuantidadeItem: 1, Quantidade: 1}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valor, err := ValorDevolucao(tt.devolucao)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.esperado, valor)
+		})
+	}
+}
+
+func TestValorDevolucao_QuantidadeInvalida(t *testing.T) {
+	_, err := ValorDevolucao(Devolucao{SubtotalItem: 10, QuantidadeItem: 2, JaDevolvida: 1, Quantidade: 2})
+	assert.Error(t, err)
+
+	_, err = ValorDevolucao(Devolucao{SubtotalItem: 10, QuantidadeItem: 2, Quantidade: 0})
+	assert.Error(t, err)
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/betine97/back-project.git/src/model/dtos"
+	entity "github.com/betine97/back-project.git/src/model/entitys"
+	"github.com/betine97/back-project.git/src/model/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// TESTES PARA CreateVendaService
+func TestService_CreateVendaService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	idCliente := 7
+	precoInformado := 15.0
+
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{ID: 7})
+	mockDBClient.On("GetProdutosByIDs", []int{1, 2}, "1").Return([]entity.Produto{
+		{IDProduto: 1, NomeProduto: "Ração", PrecoVenda: 10, Tipo: entity.ProdutoTipoSimples},
+		{IDProduto: 2, NomeProduto: "Petisco", PrecoVenda: 12, Tipo: entity.ProdutoTipoSimples},
+	}, nil)
+	mockDBClient.On("CreateVenda", mock.MatchedBy(func(venda *entity.Venda) bool {
+		return *venda.IDCliente == 7 && venda.Subtotal == 33 && venda.Desconto == 3 && venda.ValorTotal == 30 &&
+			venda.Status == entity.VendaStatusAberta
+	}), mock.MatchedBy(func(itens []entity.ItemVenda) bool {
+		return len(itens) == 2 && itens[0].Subtotal == 18 && itens[1].PrecoUnitario == 15 && itens[1].Subtotal == 15
+	}), "1").Run(func(args mock.Arguments) {
+		args.Get(0).(*entity.Venda).IDVenda = 21
+	}).Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	request := dtos.CreateVendaRequest{
+		IDCliente: &idCliente,
+		Desconto:  3,
+		Itens: []dtos.ItemVendaRequest{
+			{IDProduto: 1, Quantidade: 2, Desconto: 2},
+			{IDProduto: 2, Quantidade: 1, PrecoUnitario: &precoInformado},
+		},
+	}
+
+	// Act
+	id, err := service.CreateVendaService("1", request)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 21, id)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateVendaService_ProdutoPai(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetProdutosByIDs", []int{3}, "1").Return([]entity.Produto{{IDProduto: 3, PrecoVenda: 10, Tipo: entity.ProdutoTipoPai}}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	id, err := service.CreateVendaService("1", dtos.CreateVendaRequest{Itens: []dtos.ItemVendaRequest{{IDProduto: 3, Quantidade: 1}}})
+
+	// Assert
+	assert.Equal(t, 0, id)
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CreateVendaService_ClienteNotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	idCliente := 7
+	mockDBClient.On("GetClienteByID", "7", "1").Return(&entity.Cliente{})
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	id, err := service.CreateVendaService("1", dtos.CreateVendaRequest{IDCliente: &idCliente, Itens: []dtos.ItemVendaRequest{{IDProduto: 1, Quantidade: 1}}})
+
+	// Assert
+	assert.Equal(t, 0, id)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Cliente 7 not found", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA PagarVendaService
+func TestService_PagarVendaService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	idCliente := 7
+	aberta := &entity.Venda{IDVenda: 21, IDCliente: &idCliente, ValorTotal: 30, Status: entity.VendaStatusAberta}
+	dataPagamento := "2025-03-01 10:00:00"
+	paga := &entity.Venda{IDVenda: 21, IDCliente: &idCliente, ValorTotal: 30, FormaPagamento: entity.FormaPagamentoPix, Status: entity.VendaStatusPaga, DataPagamento: &dataPagamento}
+	itens := []entity.ItemVenda{{IDItem: 1, IDVenda: 21, IDProduto: 1, Quantidade: 3, Subtotal: 30}}
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(aberta, nil).Once()
+	mockDBClient.On("GetVendaByID", 21, "1").Return(paga, nil).Once()
+	mockDBClient.On("GetItensVenda", 21, "1").Return(itens, nil)
+	mockDBClient.On("GetProdutosByIDs", []int{1}, "1").Return([]entity.Produto{{IDProduto: 1, Tipo: entity.ProdutoTipoSimples}}, nil)
+	mockDBClient.On("GetFidelidadeConfig", "1").Return(&entity.FidelidadeConfig{Ativo: true, PontosPorReal: 2}, nil)
+	mockDBClient.On("PagarVenda", 21, entity.FormaPagamentoPix, []entity.SaidaEstoque{{IDProduto: 1, Quantidade: 3}}, mock.AnythingOfType("string"),
+		(*entity.CaixaMovimento)(nil), (*entity.CreditoCliente)(nil), mock.MatchedBy(func(pontos *entity.PontosCliente) bool {
+			return pontos.IDCliente == 7 && pontos.Pontos == 60 && pontos.DataExpiracao == nil
+		}), "1").Return([]entity.MovimentacaoEstoque{{IDProduto: 1, Quantidade: -3}}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.PagarVendaService("1", "21", dtos.PagarVendaRequest{FormaPagamento: entity.FormaPagamentoPix})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, entity.VendaStatusPaga, result.Status)
+	assert.Equal(t, dataPagamento, result.DataPagamento)
+	assert.Len(t, result.Itens, 1)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_PagarVendaService_FormaPagamentoObrigatoria(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(&entity.Venda{IDVenda: 21, Status: entity.VendaStatusAberta}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.PagarVendaService("1", "21", dtos.PagarVendaRequest{})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "forma_pagamento is required", err.Message)
+	assert.Equal(t, 400, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_PagarVendaService_VendaNaoAberta(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(&entity.Venda{IDVenda: 21, Status: entity.VendaStatusPaga}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.PagarVendaService("1", "21", dtos.PagarVendaRequest{FormaPagamento: entity.FormaPagamentoPix})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Venda is not open", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_PagarVendaService_CreditoInsuficiente(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	idCliente := 7
+	venda := &entity.Venda{IDVenda: 21, IDCliente: &idCliente, ValorTotal: 30, Status: entity.VendaStatusAberta}
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(venda, nil)
+	mockDBClient.On("GetItensVenda", 21, "1").Return([]entity.ItemVenda{{IDProduto: 1, Quantidade: 1}}, nil)
+	mockDBClient.On("GetProdutosByIDs", []int{1}, "1").Return([]entity.Produto{{IDProduto: 1, Tipo: entity.ProdutoTipoSimples}}, nil)
+	mockDBClient.On("PagarVenda", 21, entity.FormaPagamentoCreditoLoja, mock.Anything, mock.AnythingOfType("string"),
+		(*entity.CaixaMovimento)(nil), mock.MatchedBy(func(debito *entity.CreditoCliente) bool {
+			return debito.IDCliente == 7 && debito.Tipo == entity.CreditoTipoDebito && debito.Valor == 30
+		}), (*entity.PontosCliente)(nil), "1").Return(nil, persistence.ErrCreditoInsuficiente)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	result, err := service.PagarVendaService("1", "21", dtos.PagarVendaRequest{FormaPagamento: entity.FormaPagamentoCreditoLoja})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Insufficient store credit for the cliente", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+// TESTES PARA CancelarVendaService
+func TestService_CancelarVendaService_Success(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(&entity.Venda{IDVenda: 21, Status: entity.VendaStatusAberta}, nil)
+	mockDBClient.On("CancelarVenda", 21, entity.VendaStatusAberta, mock.AnythingOfType("string"),
+		(*entity.CaixaMovimento)(nil), (*entity.CreditoCliente)(nil), (*entity.PontosCliente)(nil), "1").Return(nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.CancelarVendaService("1", "21")
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, success)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CancelarVendaService_JaCancelada(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(&entity.Venda{IDVenda: 21, Status: entity.VendaStatusCancelada}, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.CancelarVendaService("1", "21")
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Venda with status 'cancelada' cannot be canceled", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CancelarVendaService_ComDevolucoes(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(&entity.Venda{IDVenda: 21, Status: entity.VendaStatusPaga}, nil)
+	mockDBClient.On("GetDevolucoesPaginated", "1", 21, 0, 1, 0).Return([]entity.Devolucao{{ID: 1}}, 1, nil)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.CancelarVendaService("1", "21")
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Venda has devolucoes and cannot be canceled", err.Message)
+	assert.Equal(t, 409, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
+
+func TestService_CancelarVendaService_NotFound(t *testing.T) {
+	// Arrange
+	mockDBClient := new(MockDBClient)
+
+	mockDBClient.On("GetVendaByID", 21, "1").Return(nil, gorm.ErrRecordNotFound)
+
+	service := &Service{
+		dbClient: mockDBClient,
+	}
+
+	// Act
+	success, err := service.CancelarVendaService("1", "21")
+
+	// Assert
+	assert.False(t, success)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Venda not found", err.Message)
+	assert.Equal(t, 404, err.Code)
+
+	mockDBClient.AssertExpectations(t)
+}
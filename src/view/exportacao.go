@@ -0,0 +1,203 @@
+package view
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Formatos aceitos em ?format= nas listagens
+const (
+	FormatoExportacaoCSV  = "csv"
+	FormatoExportacaoXLSX = "xlsx"
+)
+
+const planilhaExportacao = "Dados"
+
+// ContentTypeExportacao retorna o content type do formato, ou vazio se o formato não for suportado
+func ContentTypeExportacao(formato string) string {
+	switch formato {
+	case FormatoExportacaoCSV:
+		return "text/csv; charset=utf-8"
+	case FormatoExportacaoXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return ""
+	}
+}
+
+// ExportWriter escreve registros (DTOs de resposta) como linhas de uma planilha, um por vez.
+// As colunas são os campos do DTO com o mesmo nome usado no JSON; campos de lista ou objeto ficam de fora,
+// e os campos de structs embutidas entram no lugar delas, como no JSON.
+type ExportWriter struct {
+	saida  saidaExportacao
+	campos [][]int
+}
+
+type saidaExportacao interface {
+	linha(valores []interface{}) error
+	fechar() error
+}
+
+// NewExportWriter escreve o cabeçalho a partir do tipo de modelo; os registros passados depois devem ser do mesmo tipo
+func NewExportWriter(w io.Writer, formato string, modelo interface{}) (*ExportWriter, error) {
+	tipo := reflect.Indirect(reflect.ValueOf(modelo)).Type()
+
+	var campos [][]int
+	var cabecalho []interface{}
+	colunasExportacao(tipo, nil, &campos, &cabecalho)
+
+	var saida saidaExportacao
+	switch formato {
+	case FormatoExportacaoCSV:
+		writer, err := newCSVWriter(w)
+		if err != nil {
+			return nil, err
+		}
+		saida = &saidaCSV{writer: writer}
+	case FormatoExportacaoXLSX:
+		arquivo := excelize.NewFile()
+		if err := arquivo.SetSheetName(arquivo.GetSheetName(0), planilhaExportacao); err != nil {
+			return nil, err
+		}
+		stream, err := arquivo.NewStreamWriter(planilhaExportacao)
+		if err != nil {
+			return nil, err
+		}
+		saida = &saidaXLSX{w: w, arquivo: arquivo, stream: stream}
+	default:
+		return nil, fmt.Errorf("unsupported export format %q, use csv or xlsx", formato)
+	}
+
+	if err := saida.linha(cabecalho); err != nil {
+		return nil, err
+	}
+	return &ExportWriter{saida: saida, campos: campos}, nil
+}
+
+func (e *ExportWriter) Write(registro interface{}) error {
+	valor := reflect.Indirect(reflect.ValueOf(registro))
+	valores := make([]interface{}, len(e.campos))
+	for i, campo := range e.campos {
+		valores[i] = valorExportacao(valor.FieldByIndex(campo))
+	}
+	return e.saida.linha(valores)
+}
+
+// Close conclui o arquivo; no XLSX é aqui que a planilha é escrita na saída
+func (e *ExportWriter) Close() error {
+	return e.saida.fechar()
+}
+
+type saidaCSV struct {
+	writer *csv.Writer
+}
+
+func (s *saidaCSV) linha(valores []interface{}) error {
+	linha := make([]string, len(valores))
+	for i, valor := range valores {
+		switch v := valor.(type) {
+		case nil:
+			linha[i] = ""
+		case float64:
+			linha[i] = formatarDecimal(v)
+		case bool:
+			linha[i] = "Não"
+			if v {
+				linha[i] = "Sim"
+			}
+		default:
+			linha[i] = fmt.Sprint(v)
+		}
+	}
+	return s.writer.Write(linha)
+}
+
+func (s *saidaCSV) fechar() error {
+	s.writer.Flush()
+	return s.writer.Error()
+}
+
+// saidaXLSX usa o stream writer do excelize, que guarda as linhas em arquivo temporário em vez de memória
+type saidaXLSX struct {
+	w       io.Writer
+	arquivo *excelize.File
+	stream  *excelize.StreamWriter
+	linhas  int
+}
+
+func (s *saidaXLSX) linha(valores []interface{}) error {
+	s.linhas++
+	celula, err := excelize.CoordinatesToCellName(1, s.linhas)
+	if err != nil {
+		return err
+	}
+	return s.stream.SetRow(celula, valores)
+}
+
+func (s *saidaXLSX) fechar() error {
+	defer s.arquivo.Close()
+	if err := s.stream.Flush(); err != nil {
+		return err
+	}
+	return s.arquivo.Write(s.w)
+}
+
+// colunasExportacao monta o caminho de cada campo exportável e o nome da coluna, descendo nas structs embutidas
+func colunasExportacao(tipo reflect.Type, caminho []int, campos *[][]int, cabecalho *[]interface{}) {
+	for i := 0; i < tipo.NumField(); i++ {
+		campo := tipo.Field(i)
+		nome := strings.Split(campo.Tag.Get("json"), ",")[0]
+		indice := append(append([]int{}, caminho...), i)
+		if campo.Anonymous && campo.Type.Kind() == reflect.Struct && nome == "" {
+			colunasExportacao(campo.Type, indice, campos, cabecalho)
+			continue
+		}
+		if !campo.IsExported() || nome == "-" || !exportavel(campo.Type) {
+			continue
+		}
+		if nome == "" {
+			nome = campo.Name
+		}
+		*campos = append(*campos, indice)
+		*cabecalho = append(*cabecalho, nome)
+	}
+}
+
+func exportavel(tipo reflect.Type) bool {
+	if tipo.Kind() == reflect.Pointer {
+		tipo = tipo.Elem()
+	}
+	switch tipo.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Struct, reflect.Array, reflect.Interface, reflect.Func, reflect.Chan:
+		return false
+	default:
+		return true
+	}
+}
+
+func valorExportacao(valor reflect.Value) interface{} {
+	if valor.Kind() == reflect.Pointer {
+		if valor.IsNil() {
+			return nil
+		}
+		valor = valor.Elem()
+	}
+	switch valor.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return valor.Float()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return valor.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(valor.Uint(), 10)
+	case reflect.Bool:
+		return valor.Bool()
+	default:
+		return valor.String()
+	}
+}
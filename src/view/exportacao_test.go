@@ -0,0 +1,102 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+type registroExportacaoTeste struct {
+	ID         int      `json:"id"`
+	Nome       string   `json:"nome"`
+	Preco      float64  `json:"preco_venda"`
+	Ativo      bool     `json:"ativo"`
+	Idade      *int     `json:"idade,omitempty"`
+	Tags       []string `json:"tags"`
+	Interno    string   `json:"-"`
+	naoExposto string
+}
+
+func TestExportWriterCSV(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	idade := 3
+
+	// Act
+	writer, err := NewExportWriter(&buf, FormatoExportacaoCSV, registroExportacaoTeste{})
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(registroExportacaoTeste{ID: 1, Nome: "Ração; Premium", Preco: 1234.5, Ativo: true, Idade: &idade, Tags: []string{"a"}}))
+	require.NoError(t, writer.Write(&registroExportacaoTeste{ID: 2, Nome: "Areia", Preco: 19.9}))
+	require.NoError(t, writer.Close())
+
+	// Assert
+	assert.True(t, strings.HasPrefix(buf.String(), utf8BOM))
+	linhas := strings.Split(strings.TrimSpace(strings.TrimPrefix(buf.String(), utf8BOM)), "\n")
+	assert.Equal(t, []string{
+		"id;nome;preco_venda;ativo;idade",
+		"1;\"Ração; Premium\";1234,50;Sim;3",
+		"2;Areia;19,90;Não;",
+	}, linhas)
+}
+
+func TestExportWriterCSVSemRegistros(t *testing.T) {
+	var buf bytes.Buffer
+
+	writer, err := NewExportWriter(&buf, FormatoExportacaoCSV, &registroExportacaoTeste{})
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	assert.Equal(t, utf8BOM+"id;nome;preco_venda;ativo;idade\n", buf.String())
+}
+
+type notasExportacaoTeste struct {
+	Nota     int    `json:"nota"`
+	Segmento string `json:"segmento"`
+}
+
+type registroEmbutidoExportacaoTeste struct {
+	ID int `json:"id"`
+	notasExportacaoTeste
+}
+
+func TestExportWriterCSVStructEmbutida(t *testing.T) {
+	var buf bytes.Buffer
+
+	writer, err := NewExportWriter(&buf, FormatoExportacaoCSV, registroEmbutidoExportacaoTeste{})
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(registroEmbutidoExportacaoTeste{ID: 7, notasExportacaoTeste: notasExportacaoTeste{Nota: 5, Segmento: "campeoes"}}))
+	require.NoError(t, writer.Close())
+
+	linhas := strings.Split(strings.TrimSpace(strings.TrimPrefix(buf.String(), utf8BOM)), "\n")
+	assert.Equal(t, []string{"id;nota;segmento", "7;5;campeoes"}, linhas)
+}
+
+func TestExportWriterXLSX(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+
+	// Act
+	writer, err := NewExportWriter(&buf, FormatoExportacaoXLSX, registroExportacaoTeste{})
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(registroExportacaoTeste{ID: 7, Nome: "Coleira", Preco: 45.9}))
+	require.NoError(t, writer.Close())
+
+	// Assert
+	arquivo, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer arquivo.Close()
+	linhas, err := arquivo.GetRows(planilhaExportacao)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "nome", "preco_venda", "ativo", "idade"}, linhas[0])
+	assert.Equal(t, []string{"7", "Coleira", "45.9", "FALSE"}, linhas[1])
+}
+
+func TestExportWriterFormatoInvalido(t *testing.T) {
+	_, err := NewExportWriter(&bytes.Buffer{}, "pdf", registroExportacaoTeste{})
+	assert.Error(t, err)
+	assert.Empty(t, ContentTypeExportacao("pdf"))
+}
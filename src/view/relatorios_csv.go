@@ -0,0 +1,107 @@
+package view
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+)
+
+// utf8BOM faz o Excel reconhecer o arquivo como UTF-8 (acentos)
+const utf8BOM = "\xEF\xBB\xBF"
+
+// newCSVWriter cria um writer no padrão do Excel em português: BOM UTF-8 e ';' como separador
+func newCSVWriter(w io.Writer) (*csv.Writer, error) {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return nil, err
+	}
+	writer := csv.NewWriter(w)
+	writer.Comma = ';'
+	return writer, nil
+}
+
+// formatarDecimal usa vírgula como separador decimal
+func formatarDecimal(valor float64) string {
+	return strings.Replace(strconv.FormatFloat(valor, 'f', 2, 64), ".", ",", 1)
+}
+
+// WriteValorizacaoEstoqueCSV escreve o relatório de valorização de estoque em CSV
+func WriteValorizacaoEstoqueCSV(w io.Writer, relatorio *dtos.ValorizacaoEstoqueResponse) error {
+	writer, err := newCSVWriter(w)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Write([]string{"id", relatorio.Agrupamento, "quantidade", "custo_medio", "valor_custo_medio", "valor_peps", "valor_venda"}); err != nil {
+		return err
+	}
+
+	for _, item := range relatorio.Itens {
+		id := ""
+		if item.ID != 0 {
+			id = strconv.Itoa(item.ID)
+		}
+		err := writer.Write([]string{
+			id,
+			item.Nome,
+			strconv.Itoa(item.Quantidade),
+			formatarDecimal(item.CustoMedio),
+			formatarDecimal(item.ValorCustoMedio),
+			formatarDecimal(item.ValorPEPS),
+			formatarDecimal(item.ValorVenda),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = writer.Write([]string{
+		"",
+		"TOTAL",
+		strconv.Itoa(relatorio.TotalQuantidade),
+		"",
+		formatarDecimal(relatorio.TotalCustoMedio),
+		formatarDecimal(relatorio.TotalPEPS),
+		formatarDecimal(relatorio.TotalVenda),
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteMargemBrutaCSV escreve o relatório de margem bruta por produto em CSV
+func WriteMargemBrutaCSV(w io.Writer, relatorio *dtos.MargemBrutaResponse) error {
+	writer, err := newCSVWriter(w)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Write([]string{"id_produto", "nome_produto", "categoria", "preco_venda", "custo_medio", "margem_unitaria", "margem_percentual", "quantidade", "margem_potencial"}); err != nil {
+		return err
+	}
+
+	for _, item := range relatorio.Produtos {
+		err := writer.Write([]string{
+			strconv.Itoa(item.IDProduto),
+			item.NomeProduto,
+			item.Categoria,
+			formatarDecimal(item.PrecoVenda),
+			formatarDecimal(item.CustoMedio),
+			formatarDecimal(item.MargemUnitaria),
+			formatarDecimal(item.MargemPercentual),
+			strconv.Itoa(item.Quantidade),
+			formatarDecimal(item.MargemPotencial),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
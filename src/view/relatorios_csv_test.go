@@ -0,0 +1,63 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	dtos "github.com/betine97/back-project.git/src/model/dtos"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteValorizacaoEstoqueCSV(t *testing.T) {
+	// Arrange
+	relatorio := &dtos.ValorizacaoEstoqueResponse{
+		DataCorte:   "2025-01-31",
+		Agrupamento: "categoria",
+		Itens: []dtos.ValorizacaoEstoqueItemResponse{
+			{Nome: "Rações", Quantidade: 12, CustoMedio: 15, ValorCustoMedio: 180, ValorPEPS: 220, ValorVenda: 359.88},
+		},
+		TotalQuantidade: 12,
+		TotalCustoMedio: 180,
+		TotalPEPS:       220,
+		TotalVenda:      359.88,
+	}
+	var buf bytes.Buffer
+
+	// Act
+	err := WriteValorizacaoEstoqueCSV(&buf, relatorio)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(buf.String(), utf8BOM))
+	linhas := strings.Split(strings.TrimSpace(strings.TrimPrefix(buf.String(), utf8BOM)), "\n")
+	assert.Len(t, linhas, 3)
+	assert.Equal(t, "id;categoria;quantidade;custo_medio;valor_custo_medio;valor_peps;valor_venda", linhas[0])
+	assert.Equal(t, ";Rações;12;15,00;180,00;220,00;359,88", linhas[1])
+	assert.Equal(t, ";TOTAL;12;;180,00;220,00;359,88", linhas[2])
+}
+
+func TestWriteMargemBrutaCSV(t *testing.T) {
+	// Arrange
+	relatorio := &dtos.MargemBrutaResponse{
+		Produtos: []dtos.MargemBrutaItemResponse{
+			{IDProduto: 3, NomeProduto: "Ração; Premium", Categoria: "Rações", PrecoVenda: 50, CustoMedio: 30, MargemUnitaria: 20, MargemPercentual: 40, Quantidade: 2, MargemPotencial: 40},
+		},
+	}
+	var buf bytes.Buffer
+
+	// Act
+	err := WriteMargemBrutaCSV(&buf, relatorio)
+
+	// Assert
+	assert.NoError(t, err)
+	linhas := strings.Split(strings.TrimSpace(strings.TrimPrefix(buf.String(), utf8BOM)), "\n")
+	assert.Len(t, linhas, 2)
+	// Campo com o separador deve vir entre aspas
+	assert.Equal(t, `3;"Ração; Premium";Rações;50,00;30,00;20,00;40,00;2;40,00`, linhas[1])
+}
+
+func TestFormatarDecimal(t *testing.T) {
+	assert.Equal(t, "1234,50", formatarDecimal(1234.5))
+	assert.Equal(t, "-0,10", formatarDecimal(-0.1))
+}
@@ -96,7 +96,7 @@ func setupE2ETestSuite(t *testing.T) *E2ETestSuite {
 	mockRedis := &MockRedis{}
 	mockTokenGen := &MockTokenGenerator{}
 
-	serviceInstance := service.NewServiceInstance(cryptoService, dbMaster, dbClient, mockRedis, mockTokenGen)
+	serviceInstance := service.NewServiceInstance(cryptoService, dbMaster, dbClient, mockRedis, mockTokenGen, nil, nil)
 	controllerInstance := controller.NewControllerInstance(serviceInstance)
 
 	// Setup Fiber app
@@ -108,7 +108,7 @@ func setupPerformanceTestSuite(t TestingInterface) *PerformanceTestSuite {
 	mockRedis := &MockRedis{}
 	mockTokenGen := &MockTokenGenerator{}
 
-	serviceInstance := service.NewServiceInstance(cryptoService, dbMaster, dbClient, mockRedis, mockTokenGen)
+	serviceInstance := service.NewServiceInstance(cryptoService, dbMaster, dbClient, mockRedis, mockTokenGen, nil, nil)
 	controllerInstance := controller.NewControllerInstance(serviceInstance)
 
 	// Setup Fiber app